@@ -15,13 +15,14 @@ import (
 
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/genesisstream"
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
 	abci "github.com/tendermint/tendermint/abci/types"
-	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -56,6 +57,7 @@ import (
 	v14 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v14"
 	v15 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v15"
 	v16 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v16"
+	v17 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v17"
 	v3 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v3"
 	v4 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v4"
 	v5 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v5"
@@ -101,7 +103,7 @@ var (
 
 	// _ sdksimapp.App = (*OsmosisApp)(nil)
 
-	Upgrades = []upgrades.Upgrade{v4.Upgrade, v5.Upgrade, v7.Upgrade, v9.Upgrade, v11.Upgrade, v12.Upgrade, v13.Upgrade, v14.Upgrade, v15.Upgrade, v16.Upgrade}
+	Upgrades = []upgrades.Upgrade{v4.Upgrade, v5.Upgrade, v7.Upgrade, v9.Upgrade, v11.Upgrade, v12.Upgrade, v13.Upgrade, v14.Upgrade, v15.Upgrade, v16.Upgrade, v17.Upgrade}
 	Forks    = []upgrades.Fork{v3.Fork, v6.Fork, v8.Fork, v10.Fork}
 )
 
@@ -141,6 +143,7 @@ type OsmosisApp struct {
 
 	mm           *module.Manager
 	configurator module.Configurator
+	invariants   *invariants.Registry
 	homePath     string
 }
 
@@ -270,6 +273,7 @@ func NewOsmosisApp(
 	app.mm.SetOrderInitGenesis(OrderInitGenesis(app.mm.ModuleNames())...)
 
 	app.mm.RegisterInvariants(app.CrisisKeeper)
+	app.invariants = declareInvariants(app.mm)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.AppCodec(), app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
@@ -358,8 +362,10 @@ func (app *OsmosisApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abc
 
 // InitChainer application update at chain initialization.
 func (app *OsmosisApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
-	var genesisState GenesisState
-	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+	// The module genesis are sub-slices of the app state rather than copies,
+	// the modules with large state unmarshalling theirs one element at a time.
+	genesisState, err := genesisstream.SplitObject(req.AppStateBytes)
+	if err != nil {
 		panic(err)
 	}
 
@@ -398,6 +404,11 @@ func (app *OsmosisApp) ModuleManager() module.Manager {
 	return *app.mm
 }
 
+// InvariantRegistry returns the registry of the invariants declared by the modules.
+func (app *OsmosisApp) InvariantRegistry() *invariants.Registry {
+	return app.invariants
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
 func (app *OsmosisApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
@@ -7,6 +7,7 @@ import (
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/cosmwasm/msg"
@@ -124,3 +125,49 @@ func (s *KeeperTestHelper) JoinTransmuterPool(lpAddress sdk.AccAddress, poolId u
 	request := transmuter.JoinPoolExecuteMsgRequest{}
 	cosmwasm.MustExecute[transmuter.JoinPoolExecuteMsgRequest, msg.EmptyStruct](s.Ctx, s.App.ContractKeeper, pool.GetContractAddress(), lpAddress, coins, request)
 }
+
+// SetupJoinExitCosmWasmPools makes the contracts of the cosmwasm pools handle the join and exit pool sudo messages,
+// which the transmuter contract doesn't implement. The pools mint one share per token joined, and pay the shares
+// exited out of their balances, pro rata.
+func (s *KeeperTestHelper) SetupJoinExitCosmWasmPools() {
+	s.App.CosmwasmPoolKeeper.SetContractKeeper(joinExitContractKeeper{ContractKeeper: s.App.ContractKeeper, bankKeeper: s.App.BankKeeper})
+}
+
+// joinExitContractKeeper handles the join and exit pool sudo messages in place of the pool contracts.
+type joinExitContractKeeper struct {
+	cosmwasmpooltypes.ContractKeeper
+	bankKeeper *bankkeeper.BaseKeeper
+}
+
+func (k joinExitContractKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msgBz []byte) ([]byte, error) {
+	var request struct {
+		JoinPool *msg.JoinPool `json:"join_pool"`
+		ExitPool *msg.ExitPool `json:"exit_pool"`
+	}
+	if err := json.Unmarshal(msgBz, &request); err != nil || (request.JoinPool == nil && request.ExitPool == nil) {
+		return k.ContractKeeper.Sudo(ctx, contractAddress, msgBz)
+	}
+
+	if request.JoinPool != nil {
+		shareOutAmount := sdk.ZeroInt()
+		for _, coin := range request.JoinPool.TokensIn {
+			shareOutAmount = shareOutAmount.Add(coin.Amount)
+		}
+		return json.Marshal(msg.JoinPoolSudoMsgResponse{ShareOutAmount: shareOutAmount})
+	}
+
+	// the shares exited are already burned, so the remaining liquidity includes them.
+	balances := k.bankKeeper.GetAllBalances(ctx, contractAddress)
+	totalLiquidity := sdk.ZeroInt()
+	for _, coin := range balances {
+		totalLiquidity = totalLiquidity.Add(coin.Amount)
+	}
+	tokensOut := sdk.NewCoins()
+	for _, coin := range balances {
+		tokensOut = tokensOut.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(request.ExitPool.ShareInAmount).Quo(totalLiquidity)))
+	}
+	if err := k.bankKeeper.SendCoins(ctx, contractAddress, sdk.MustAccAddressFromBech32(request.ExitPool.Sender), tokensOut); err != nil {
+		return nil, err
+	}
+	return json.Marshal(msg.ExitPoolSudoMsgResponse{TokensOut: tokensOut})
+}
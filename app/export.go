@@ -1,14 +1,22 @@
 package app
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/genesisstream"
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
@@ -16,6 +24,25 @@ import (
 func (app *OsmosisApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs []string, modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
+	exported, err := app.ExportValidators(forZeroHeight, jailAllowedAddrs)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	// as if they could withdraw from the start of the next block
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	genState := app.mm.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
+	exported.AppState, err = json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	return exported, nil
+}
+
+// ExportValidators exports everything but the application state for a genesis file, the application
+// state being written by WriteAppState.
+func (app *OsmosisApp) ExportValidators(forZeroHeight bool, jailAllowedAddrs []string) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
 
@@ -26,21 +53,218 @@ func (app *OsmosisApp) ExportAppStateAndValidators(
 		return servertypes.ExportedApp{}, fmt.Errorf("forZeroHeight not supported")
 	}
 
-	genState := app.mm.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
-	appState, err := json.MarshalIndent(genState, "", "  ")
-	if err != nil {
-		return servertypes.ExportedApp{}, err
-	}
-
 	validators, err := staking.WriteValidators(ctx, *app.StakingKeeper)
 	return servertypes.ExportedApp{
-		AppState:        appState,
 		Validators:      validators,
 		Height:          height,
 		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
 	}, err
 }
 
+// WriteAppState writes the application state exported by ExportAppStateAndValidators to w, as the sorted JSON
+// written to the genesis file. Each module is exported from its own branch of the committed state, and the
+// modules implementing genesisstream.HasStreamingExport stream their genesis instead of holding it in memory.
+// With a parallelism above 1, that many modules are exported concurrently, each one to a temporary file,
+// the files being then written to w in order.
+func (app *OsmosisApp) WriteAppState(w io.Writer, modulesToExport []string, parallelism int) error {
+	moduleNames, err := app.sortedModulesToExport(modulesToExport)
+	if err != nil {
+		return err
+	}
+	header := tmproto.Header{Height: app.LastBlockHeight()}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteByte('{')
+	writeKey := func(i int) error {
+		if i > 0 {
+			bw.WriteByte(',')
+		}
+		key, err := json.Marshal(moduleNames[i])
+		if err != nil {
+			return err
+		}
+		bw.Write(key)
+		return bw.WriteByte(':')
+	}
+
+	if parallelism <= 1 {
+		for i, moduleName := range moduleNames {
+			if err := writeKey(i); err != nil {
+				return err
+			}
+			ctx, err := app.committedStateContext(header)
+			if err != nil {
+				return err
+			}
+			if err := app.exportModuleGenesis(ctx, moduleName, bw); err != nil {
+				return err
+			}
+		}
+	} else {
+		err := app.exportModuleGenesisFiles(moduleNames, header, parallelism, func(i int, file *os.File) error {
+			if err := writeKey(i); err != nil {
+				return err
+			}
+			_, err := io.Copy(bw, file)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	bw.WriteByte('}')
+	return bw.Flush()
+}
+
+// sortedModulesToExport returns the sorted names of the modules to export, all of them if none is given.
+func (app *OsmosisApp) sortedModulesToExport(modulesToExport []string) ([]string, error) {
+	if len(modulesToExport) == 0 {
+		modulesToExport = app.mm.OrderExportGenesis
+	}
+
+	seen := map[string]bool{}
+	moduleNames := []string{}
+	for _, moduleName := range modulesToExport {
+		if _, ok := app.mm.Modules[moduleName]; !ok {
+			return nil, fmt.Errorf("unknown module %s", moduleName)
+		}
+		if seen[moduleName] {
+			continue
+		}
+		seen[moduleName] = true
+		moduleNames = append(moduleNames, moduleName)
+	}
+	// the application state is a JSON object with sorted keys.
+	sort.Strings(moduleNames)
+	return moduleNames, nil
+}
+
+// exportModuleGenesisFiles exports the genesis of the modules concurrently, each one to a temporary file
+// from a branch of the committed state, calling write with each file in the order of the modules.
+func (app *OsmosisApp) exportModuleGenesisFiles(moduleNames []string, header tmproto.Header, parallelism int, write func(i int, file *os.File) error) error {
+	dir, err := os.MkdirTemp("", "osmosis-export")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	errs := make([]error, len(moduleNames))
+	done := make([]chan struct{}, len(moduleNames))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	// the modules are exported in order, so that the files are written as soon as possible.
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sem := make(chan struct{}, parallelism)
+		for i, moduleName := range moduleNames {
+			select {
+			case sem <- struct{}{}:
+			case <-stop:
+				return
+			}
+			wg.Add(1)
+			go func(i int, moduleName string) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				defer close(done[i])
+				errs[i] = app.exportModuleGenesisFile(filepath.Join(dir, moduleName+".json"), moduleName, header)
+			}(i, moduleName)
+		}
+	}()
+	defer wg.Wait()
+	defer close(stop)
+
+	for i, moduleName := range moduleNames {
+		<-done[i]
+		if errs[i] != nil {
+			return errs[i]
+		}
+		if err := writeModuleGenesisFile(filepath.Join(dir, moduleName+".json"), func(file *os.File) error { return write(i, file) }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportModuleGenesisFile exports the genesis of the module to the file, from a branch of the committed state.
+func (app *OsmosisApp) exportModuleGenesisFile(path string, moduleName string, header tmproto.Header) error {
+	ctx, err := app.committedStateContext(header)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	bw := bufio.NewWriter(file)
+	if err := app.exportModuleGenesis(ctx, moduleName, bw); err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// committedStateContext returns a context on a branch of the committed state at the header height.
+// Unlike the check state, the branches can be read concurrently, and are discarded along with the
+// values they cache once the module is exported.
+func (app *OsmosisApp) committedStateContext(header tmproto.Header) (sdk.Context, error) {
+	ms, err := app.NewUncachedContext(true, header).MultiStore().CacheMultiStoreWithVersion(header.Height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	return sdk.NewContext(ms, header, true, app.Logger()), nil
+}
+
+// writeModuleGenesisFile calls write with the opened file, then removes it.
+func writeModuleGenesisFile(path string, write func(file *os.File) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	defer file.Close()
+	return write(file)
+}
+
+// exportModuleGenesis writes the sorted JSON of the module genesis to w, streaming it if the module supports it.
+func (app *OsmosisApp) exportModuleGenesis(ctx sdk.Context, moduleName string, w io.Writer) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s genesis export panicked: %v", moduleName, r)
+		}
+	}()
+
+	module := app.mm.Modules[moduleName]
+	if streamingModule, ok := module.(genesisstream.HasStreamingExport); ok {
+		if err := streamingModule.ExportGenesisTo(ctx, app.appCodec, w); err != nil {
+			return fmt.Errorf("%s genesis export: %w", moduleName, err)
+		}
+		return nil
+	}
+
+	bz := module.ExportGenesis(ctx, app.appCodec)
+	if len(bz) == 0 {
+		bz = []byte("null")
+	}
+	bz, err = sdk.SortJSON(bz)
+	if err != nil {
+		return fmt.Errorf("%s genesis export: %w", moduleName, err)
+	}
+	_, err = w.Write(bz)
+	return err
+}
+
 func (app *OsmosisApp) ExportState(ctx sdk.Context) map[string]json.RawMessage {
 	return app.mm.ExportGenesis(ctx, app.AppCodec())
 }
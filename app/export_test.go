@@ -0,0 +1,81 @@
+package app_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/osmosis-labs/osmosis/v16/app"
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v16/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v16/x/twap/types"
+)
+
+type ExportTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestExportTestSuite(t *testing.T) {
+	suite.Run(t, new(ExportTestSuite))
+}
+
+// streamedModules are the modules streaming their genesis export and import.
+var streamedModules = []string{cltypes.ModuleName, incentivestypes.ModuleName, lockuptypes.ModuleName, twaptypes.ModuleName}
+
+func (s *ExportTestSuite) TestWriteAppState() {
+	s.Setup()
+	// a CL pool with a locked position, creating locks, synthetic locks, gauges and twap records.
+	s.PrepareConcentratedPoolWithCoinsAndLockedFullRangePosition(apptesting.ETH, apptesting.USDC)
+	s.PrepareBalancerPool()
+	s.LockTokens(s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("foo", 100)), time.Hour)
+	s.Commit()
+
+	exported, err := s.App.ExportAppStateAndValidators(false, nil, nil)
+	s.Require().NoError(err)
+	expected := sdk.MustSortJSON(exported.AppState)
+
+	for _, parallelism := range []int{1, 4} {
+		var buf bytes.Buffer
+		s.Require().NoError(s.App.WriteAppState(&buf, nil, parallelism))
+		s.Require().Equal(string(expected), buf.String(), "parallelism %d", parallelism)
+	}
+
+	// a subset of the modules, in any order and with duplicates.
+	exported, err = s.App.ExportAppStateAndValidators(false, nil, streamedModules)
+	s.Require().NoError(err)
+	var subset bytes.Buffer
+	s.Require().NoError(s.App.WriteAppState(&subset, append([]string{lockuptypes.ModuleName}, streamedModules...), 2))
+	s.Require().Equal(string(sdk.MustSortJSON(exported.AppState)), subset.String())
+
+	var buf bytes.Buffer
+	s.Require().Error(s.App.WriteAppState(&buf, []string{"unknown"}, 1))
+
+	// the written state is imported by the streaming InitGenesis of the modules, which export it back.
+	var written bytes.Buffer
+	s.Require().NoError(s.App.WriteAppState(&written, nil, 1))
+	// the invariants of the imported state are not the concern of this test.
+	appOpts := viper.New()
+	appOpts.Set(crisis.FlagSkipGenesisInvariants, true)
+	importApp := app.NewOsmosisApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, s.T().TempDir(), 0, appOpts, app.EmptyWasmOpts)
+	importApp.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   written.Bytes(),
+	})
+	importApp.Commit()
+
+	var reexported bytes.Buffer
+	s.Require().NoError(importApp.WriteAppState(&reexported, streamedModules, 1))
+	s.Require().Equal(subset.String(), reexported.String())
+}
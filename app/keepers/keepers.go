@@ -246,6 +246,9 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	// Configure the hooks keeper
 	hooksKeeper := ibchookskeeper.NewKeeper(
 		appKeepers.keys[ibchookstypes.StoreKey],
+		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.ScopedTransferKeeper,
+		appKeepers.BankKeeper,
 	)
 	appKeepers.IBCHooksKeeper = &hooksKeeper
 
@@ -440,7 +443,14 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,osmosis,cosmwasm_1_1,cosmwasm_1_2"
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.BankKeeper, appKeepers.TokenFactoryKeeper), wasmOpts...)
+	wasmOpts = append(owasm.RegisterCustomPlugins(
+		appKeepers.BankKeeper,
+		appKeepers.TokenFactoryKeeper,
+		appKeepers.PoolManagerKeeper,
+		appKeepers.TwapKeeper,
+		appKeepers.LockupKeeper,
+		appKeepers.ConcentratedLiquidityKeeper,
+	), wasmOpts...)
 	wasmOpts = append(owasm.RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
 
 	wasmKeeper := wasm.NewKeeper(
@@ -494,7 +504,9 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(protorevtypes.RouterKey, protorev.NewProtoRevProposalHandler(*appKeepers.ProtoRevKeeper)).
 		AddRoute(gammtypes.RouterKey, gamm.NewMigrationRecordHandler(*appKeepers.GAMMKeeper)).
 		AddRoute(concentratedliquiditytypes.RouterKey, concentratedliquidity.NewConcentratedLiquidityProposalHandler(*appKeepers.ConcentratedLiquidityKeeper)).
-		AddRoute(cosmwasmpooltypes.RouterKey, cosmwasmpool.NewCosmWasmPoolProposalHandler(*appKeepers.CosmwasmPoolKeeper))
+		AddRoute(cosmwasmpooltypes.RouterKey, cosmwasmpool.NewCosmWasmPoolProposalHandler(*appKeepers.CosmwasmPoolKeeper)).
+		AddRoute(ibcratelimittypes.RouterKey, ibcratelimit.NewRateLimitProposalHandler(appKeepers.RateLimitingICS4Wrapper)).
+		AddRoute(valsetpreftypes.RouterKey, valsetpref.NewValidatorSetPreferenceProposalHandler(*appKeepers.ValidatorSetPreferenceKeeper))
 
 	// The gov proposal types can be individually enabled
 	if len(wasmEnabledProposals) != 0 {
@@ -537,6 +549,7 @@ func (appKeepers *AppKeepers) WireICS20PreWasmKeeper(
 
 	// ChannelKeeper wrapper for rate limiting SendPacket(). The wasmKeeper needs to be added after it's created
 	rateLimitingICS4Wrapper := ibcratelimit.NewICS4Middleware(
+		appKeepers.keys[ibcratelimittypes.StoreKey],
 		appKeepers.HooksICS4Wrapper,
 		appKeepers.AccountKeeper,
 		// wasm keeper we set later.
@@ -560,6 +573,8 @@ func (appKeepers *AppKeepers) WireICS20PreWasmKeeper(
 		appKeepers.ScopedTransferKeeper,
 	)
 	appKeepers.TransferKeeper = &transferKeeper
+	// The hooks keeper sends the funds recovered from intermediary senders back over IBC
+	hooksKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
 	appKeepers.RawIcs20TransferAppModule = transfer.NewAppModule(*appKeepers.TransferKeeper)
 
 	// Packet Forward Middleware
@@ -706,6 +721,13 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		),
 	)
 
+	appKeepers.CosmwasmPoolKeeper.SetHooks(
+		cosmwasmpooltypes.NewMultiCosmwasmPoolHooks(
+			// insert cosmwasm pool hooks receivers here
+			appKeepers.PoolIncentivesKeeper.Hooks(),
+		),
+	)
+
 	appKeepers.LockupKeeper.SetHooks(
 		lockuptypes.NewMultiLockupHooks(
 			// insert lockup hooks receivers here
@@ -735,6 +757,13 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.ValidatorSetPreferenceKeeper.EpochHooks(),
+		),
+	)
+
+	appKeepers.DowntimeKeeper.SetHooks(
+		downtimetypes.NewMultiDowntimeHooks(
+		// insert downtime hooks receivers here
 		),
 	)
 
@@ -782,5 +811,6 @@ func KVStoreKeys() []string {
 		icqtypes.StoreKey,
 		packetforwardtypes.StoreKey,
 		cosmwasmpooltypes.StoreKey,
+		ibcratelimittypes.StoreKey,
 	}
 }
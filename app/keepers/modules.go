@@ -32,10 +32,12 @@ import (
 	_ "github.com/osmosis-labs/osmosis/v16/client/docs/statik"
 	clclient "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client"
 	concentratedliquidity "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/clmodule"
+	cwpoolclient "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/client"
 	cosmwasmpoolmodule "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/module"
 	downtimemodule "github.com/osmosis-labs/osmosis/v16/x/downtime-detector/module"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm"
 	gammclient "github.com/osmosis-labs/osmosis/v16/x/gamm/client"
+	ibcratelimitclient "github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/client"
 	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/ibcratelimitmodule"
 	"github.com/osmosis-labs/osmosis/v16/x/incentives"
 	"github.com/osmosis-labs/osmosis/v16/x/lockup"
@@ -49,6 +51,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v16/x/tokenfactory"
 	"github.com/osmosis-labs/osmosis/v16/x/twap/twapmodule"
 	"github.com/osmosis-labs/osmosis/v16/x/txfees"
+	valsetprefclient "github.com/osmosis-labs/osmosis/v16/x/valset-pref/client"
 	valsetprefmodule "github.com/osmosis-labs/osmosis/v16/x/valset-pref/valpref-module"
 	"github.com/osmosis-labs/osmosis/x/epochs"
 	ibc_hooks "github.com/osmosis-labs/osmosis/x/ibc-hooks"
@@ -82,6 +85,12 @@ var AppModuleBasics = []module.AppModuleBasic{
 			gammclient.UpdateMigrationRecordsProposalHandler,
 			clclient.CreateConcentratedLiquidityPoolProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			cwpoolclient.MigratePoolContractsProposalHandler,
+			cwpoolclient.EnablePoolsProposalHandler,
+			ibcratelimitclient.SetRateLimitProposalHandler,
+			ibcratelimitclient.RemoveRateLimitProposalHandler,
+			valsetprefclient.SetNamedValidatorSetProposalHandler,
+			valsetprefclient.RemoveNamedValidatorSetProposalHandler,
 		)...,
 	),
 	params.AppModuleBasic{},
@@ -27,6 +27,8 @@ import (
 
 	ibc_hooks "github.com/osmosis-labs/osmosis/x/ibc-hooks"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -121,7 +123,7 @@ var moduleAccountPermissions = map[string][]string{
 	tokenfactorytypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 	valsetpreftypes.ModuleName:               {authtypes.Staking},
 	poolmanagertypes.ModuleName:              nil,
-	cosmwasmpooltypes.ModuleName:             nil,
+	cosmwasmpooltypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 }
 
 // appModules return modules to initialize module manager.
@@ -181,7 +183,7 @@ func appModules(
 		tokenfactory.NewAppModule(*app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper),
 		valsetprefmodule.NewAppModule(appCodec, *app.ValidatorSetPreferenceKeeper),
 		ibcratelimitmodule.NewAppModule(*app.RateLimitingICS4Wrapper),
-		ibc_hooks.NewAppModule(app.AccountKeeper),
+		ibc_hooks.NewAppModule(app.AccountKeeper, *app.IBCHooksKeeper),
 		icq.NewAppModule(*app.AppKeepers.ICQKeeper),
 		packetforward.NewAppModule(app.PacketForwardKeeper),
 		cwpoolmodule.NewAppModule(*app.CosmwasmPoolKeeper),
@@ -275,6 +277,18 @@ func OrderInitGenesis(allModuleNames []string) []string {
 	}
 }
 
+// declareInvariants returns the registry of the invariants declared by the modules,
+// in init genesis order.
+func declareInvariants(mm *module.Manager) *invariants.Registry {
+	r := invariants.NewRegistry()
+	for _, moduleName := range mm.OrderInitGenesis {
+		if m, ok := mm.Modules[moduleName].(invariants.HasInvariants); ok {
+			m.DeclareInvariants(r)
+		}
+	}
+	return r
+}
+
 // ModuleAccountAddrs returns all the app's module account addresses.
 func ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
@@ -310,6 +324,10 @@ func (app *OsmosisApp) GetPoolManagerKeeper() simtypes.PoolManagerKeeper {
 	return app.AppKeepers.PoolManagerKeeper
 }
 
+func (app *OsmosisApp) GetTokenFactoryKeeper() simtypes.TokenFactoryKeeper {
+	return app.AppKeepers.TokenFactoryKeeper
+}
+
 func (app *OsmosisApp) GetTxConfig() client.TxConfig {
 	return MakeEncodingConfig().TxConfig
 }
@@ -0,0 +1,21 @@
+package v17
+
+import (
+	"github.com/osmosis-labs/osmosis/v16/app/upgrades"
+
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	ibcratelimittypes "github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/types"
+)
+
+// UpgradeName defines the on-chain upgrade name for the Osmosis v17 upgrade.
+const UpgradeName = "v17"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added:   []string{ibcratelimittypes.StoreKey},
+		Deleted: []string{},
+	},
+}
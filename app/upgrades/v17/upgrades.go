@@ -0,0 +1,43 @@
+package v17
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v16/app/keepers"
+	"github.com/osmosis-labs/osmosis/v16/app/upgrades"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	bpm upgrades.BaseAppParamManager,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// Run migrations before applying any other state changes.
+		// NOTE: DO NOT PUT ANY STATE CHANGES BEFORE RunMigrations().
+		migrations, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// The concentrated liquidity math rounds in favor of the pool with directed rounding from this upgrade on.
+		keepers.ConcentratedLiquidityKeeper.EnableDirectedRounding(ctx)
+		// Swaps compute their tick from the sqrt price and no longer loop on amounts too small to move it.
+		keepers.ConcentratedLiquidityKeeper.EnableSwapFixes(ctx)
+
+		// Cosmwasm pools can be created with MsgCreateCosmWasmPool from this upgrade on.
+		keepers.CosmwasmPoolKeeper.EnableMsgCreatePool(ctx)
+
+		// Set the cosmwasm pool contract call gas limits and circuit breaker parameters added in this upgrade.
+		cosmwasmPoolSubspace := keepers.GetSubspace(cosmwasmpooltypes.ModuleName)
+		cosmwasmPoolSubspace.Set(ctx, cosmwasmpooltypes.KeySudoGasLimit, uint64(cosmwasmpooltypes.DefaultSudoGasLimit))
+		cosmwasmPoolSubspace.Set(ctx, cosmwasmpooltypes.KeyQueryGasLimit, uint64(cosmwasmpooltypes.DefaultQueryGasLimit))
+		cosmwasmPoolSubspace.Set(ctx, cosmwasmpooltypes.KeyMaxConsecutiveFailures, uint64(cosmwasmpooltypes.DefaultMaxConsecutiveFailures))
+
+		return migrations, nil
+	}
+}
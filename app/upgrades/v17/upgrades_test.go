@@ -0,0 +1,74 @@
+package v17_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	v17 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v17"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func (suite *UpgradeTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+const dummyUpgradeHeight = 5
+
+func dummyUpgrade(suite *UpgradeTestSuite) {
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: v17.UpgradeName, Height: dummyUpgradeHeight}
+	err := suite.App.UpgradeKeeper.ScheduleUpgrade(suite.Ctx, plan)
+	suite.Require().NoError(err)
+	_, exists := suite.App.UpgradeKeeper.GetUpgradePlan(suite.Ctx)
+	suite.Require().True(exists)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight)
+}
+
+func (suite *UpgradeTestSuite) TestUpgrade() {
+	// Remove the state that is only set from the v17 upgrade on.
+	paramsStore := prefix.NewStore(suite.Ctx.KVStore(suite.App.AppKeepers.GetKey(paramstypes.StoreKey)), []byte(cosmwasmpooltypes.ModuleName+"/"))
+	paramsStore.Delete(cosmwasmpooltypes.KeySudoGasLimit)
+	paramsStore.Delete(cosmwasmpooltypes.KeyQueryGasLimit)
+	paramsStore.Delete(cosmwasmpooltypes.KeyMaxConsecutiveFailures)
+
+	clStore := suite.Ctx.KVStore(suite.App.AppKeepers.GetKey(cltypes.StoreKey))
+	clStore.Delete(cltypes.KeyDirectedRoundingEnabled)
+	clStore.Delete(cltypes.KeySwapFixesEnabled)
+
+	cosmwasmPoolStore := suite.Ctx.KVStore(suite.App.AppKeepers.GetKey(cosmwasmpooltypes.StoreKey))
+	cosmwasmPoolStore.Delete(cosmwasmpooltypes.MsgCreatePoolEnabledKey)
+
+	suite.Require().Panics(func() {
+		suite.App.CosmwasmPoolKeeper.GetParams(suite.Ctx)
+	})
+
+	dummyUpgrade(suite)
+	suite.Require().NotPanics(func() {
+		suite.App.BeginBlocker(suite.Ctx, abci.RequestBeginBlock{})
+	})
+
+	cosmwasmPoolParams := suite.App.CosmwasmPoolKeeper.GetParams(suite.Ctx)
+	suite.Require().Equal(uint64(cosmwasmpooltypes.DefaultSudoGasLimit), cosmwasmPoolParams.SudoGasLimit)
+	suite.Require().Equal(uint64(cosmwasmpooltypes.DefaultQueryGasLimit), cosmwasmPoolParams.QueryGasLimit)
+	suite.Require().Equal(uint64(cosmwasmpooltypes.DefaultMaxConsecutiveFailures), cosmwasmPoolParams.MaxConsecutiveFailures)
+
+	suite.Require().True(clStore.Has(cltypes.KeyDirectedRoundingEnabled))
+	suite.Require().True(clStore.Has(cltypes.KeySwapFixesEnabled))
+	suite.Require().True(cosmwasmPoolStore.Has(cosmwasmpooltypes.MsgCreatePoolEnabledKey))
+}
@@ -14,8 +14,12 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	appparams "github.com/osmosis-labs/osmosis/v16/app/params"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	clgenesis "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types/genesis"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v16/x/superfluid/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -27,6 +31,7 @@ import (
 const (
 	FlagSelectPoolIds      = "breakdown-by-pool-ids"
 	FlagMinimumStakeAmount = "minimum-stake-amount"
+	FlagOutputFormat       = "output-format"
 )
 
 type DeriveSnapshot struct {
@@ -36,39 +41,48 @@ type DeriveSnapshot struct {
 
 // DerivedAccount provide fields of snapshot per account
 // It is the simplified struct we are presenting in this 'balances from state export' snapshot for people.
+// Bonded includes locked concentrated liquidity positions, and SuperfluidBonded is the part of Bonded
+// that is superfluid delegated. ConcentratedLiquidity is the liquidity of the positions that are not locked,
+// and Positions details every position of the account.
 type DerivedAccount struct {
 	// TODO: Consider removing this, since duplicated
-	Address             string               `json:"address"`
-	LiquidBalances      sdk.Coins            `json:"liquid_balance"`
-	Staked              sdk.Int              `json:"staked"`
-	UnbondingStake      sdk.Int              `json:"unbonding_stake"`
-	Bonded              sdk.Coins            `json:"bonded"`
-	BondedBySelectPools map[uint64]sdk.Coins `json:"bonded_by_select_pools"`
-	TotalBalances       sdk.Coins            `json:"total_balances"`
+	Address               string               `json:"address"`
+	LiquidBalances        sdk.Coins            `json:"liquid_balance"`
+	Staked                sdk.Int              `json:"staked"`
+	UnbondingStake        sdk.Int              `json:"unbonding_stake"`
+	Bonded                sdk.Coins            `json:"bonded"`
+	SuperfluidBonded      sdk.Coins            `json:"superfluid_bonded"`
+	BondedBySelectPools   map[uint64]sdk.Coins `json:"bonded_by_select_pools"`
+	ConcentratedLiquidity sdk.Coins            `json:"concentrated_liquidity"`
+	UnclaimedRewards      sdk.Coins            `json:"unclaimed_rewards"`
+	Positions             []DerivedPosition    `json:"positions,omitempty"`
+	TotalBalances         sdk.Coins            `json:"total_balances"`
 }
 
 // newDerivedAccount returns a new derived account.
 func newDerivedAccount(address string) DerivedAccount {
 	return DerivedAccount{
-		Address:        address,
-		LiquidBalances: sdk.Coins{},
-		Staked:         sdk.ZeroInt(),
-		UnbondingStake: sdk.ZeroInt(),
-		Bonded:         sdk.Coins{},
+		Address:               address,
+		LiquidBalances:        sdk.Coins{},
+		Staked:                sdk.ZeroInt(),
+		UnbondingStake:        sdk.ZeroInt(),
+		Bonded:                sdk.Coins{},
+		SuperfluidBonded:      sdk.Coins{},
+		ConcentratedLiquidity: sdk.Coins{},
+		UnclaimedRewards:      sdk.Coins{},
 	}
 }
 
 // underlyingCoins returns liquidity pool's underlying coin balances.
-func underlyingCoins(originCoins sdk.Coins, pools map[string]gammtypes.CFMMPoolI) sdk.Coins {
+// pools is a map from LP share denom -> pool.
+func underlyingCoins(originCoins sdk.Coins, pools map[string]derivedPool) sdk.Coins {
 	balances := sdk.Coins{}
 	convertAgain := false
 	for _, coin := range originCoins {
-		if pools[coin.Denom] != nil {
-			pool := pools[coin.Denom]
-			assets := pool.GetTotalPoolLiquidity(sdk.Context{})
-			for _, asset := range assets {
-				balances = balances.Add(sdk.NewCoin(asset.Denom, asset.Amount.Mul(coin.Amount).Quo(pool.GetTotalShares())))
-				if pools[asset.Denom] != nil { // this happens when there's a pool for LP token swap
+		if pool, ok := pools[coin.Denom]; ok {
+			for _, asset := range pool.Liquidity {
+				balances = balances.Add(sdk.NewCoin(asset.Denom, asset.Amount.Mul(coin.Amount).Quo(pool.TotalShares)))
+				if _, ok := pools[asset.Denom]; ok { // this happens when there's a pool for LP token swap
 					convertAgain = true
 				}
 			}
@@ -83,22 +97,20 @@ func underlyingCoins(originCoins sdk.Coins, pools map[string]gammtypes.CFMMPoolI
 	return balances
 }
 
-// pools is a map from LP share string -> pool.
-// TODO: Make a separate type for this.
+// pools is a map from LP share denom -> pool.
 func underlyingCoinsForSelectPools(
 	originCoins sdk.Coins,
-	pools map[string]gammtypes.CFMMPoolI,
+	pools map[string]derivedPool,
 	selectPoolIDs []uint64,
 ) map[uint64]sdk.Coins {
 	balancesByPool := make(map[uint64]sdk.Coins)
 
 	for _, coin := range originCoins {
-		isLpShare := pools[coin.Denom] != nil
+		pool, isLpShare := pools[coin.Denom]
 		if !isLpShare {
 			continue
 		}
-		pool := pools[coin.Denom]
-		coinPoolID := pool.GetId()
+		coinPoolID := pool.Id
 
 		isSelectPoolID := false
 		// check if poolID in select pool IDs
@@ -182,6 +194,14 @@ Example:
 				}
 			}
 
+			outputFormat, err := cmd.Flags().GetString(FlagOutputFormat)
+			if err != nil {
+				return err
+			}
+			if outputFormat != OutputFormatJSON && outputFormat != OutputFormatCSV && outputFormat != OutputFormatParquet {
+				return fmt.Errorf("invalid output format %q, expected one of %s, %s or %s", outputFormat, OutputFormatJSON, OutputFormatCSV, OutputFormatParquet)
+			}
+
 			// Produce the map of address to total atom balance, both staked and UnbondingStake
 			snapshotAccs := make(map[string]DerivedAccount)
 
@@ -221,6 +241,21 @@ Example:
 				snapshotAccs[address] = acc
 			}
 
+			superfluidGenesis := superfluidtypes.GenesisState{}
+			if len(genState[superfluidtypes.ModuleName]) > 0 {
+				clientCtx.Codec.MustUnmarshalJSON(genState[superfluidtypes.ModuleName], &superfluidGenesis)
+			}
+			// Superfluid delegations are made by intermediary accounts on behalf of the superfluid locks,
+			// which are already accounted for as bonded.
+			intermediaryAccounts := make(map[string]bool)
+			for _, intermediaryAccount := range superfluidGenesis.IntermediaryAccounts {
+				intermediaryAccounts[intermediaryAccount.GetAccAddress().String()] = true
+			}
+			superfluidLockIds := make(map[uint64]bool)
+			for _, connection := range superfluidGenesis.IntemediaryAccountConnections {
+				superfluidLockIds[connection.LockId] = true
+			}
+
 			// Make a map from validator operator address to the v036 validator type
 			validators := make(map[string]stakingtypes.Validator)
 			for _, validator := range stakingGenesis.Validators {
@@ -229,6 +264,9 @@ Example:
 
 			for _, delegation := range stakingGenesis.Delegations {
 				address := delegation.DelegatorAddress
+				if intermediaryAccounts[address] {
+					continue
+				}
 
 				acc, ok := snapshotAccs[address]
 				if !ok {
@@ -255,7 +293,18 @@ Example:
 					acc = newDerivedAccount(address)
 				}
 
-				acc.Bonded = acc.Bonded.Add(lock.Coins...)
+				// The liquidity of locked concentrated liquidity positions is added with the positions below.
+				lockedCoins := sdk.Coins{}
+				for _, coin := range lock.Coins {
+					if !isConcentratedLockupDenom(coin.Denom) {
+						lockedCoins = lockedCoins.Add(coin)
+					}
+				}
+
+				acc.Bonded = acc.Bonded.Add(lockedCoins...)
+				if superfluidLockIds[lock.ID] {
+					acc.SuperfluidBonded = acc.SuperfluidBonded.Add(lockedCoins...)
+				}
 				snapshotAccs[address] = acc
 			}
 
@@ -264,18 +313,25 @@ Example:
 				clientCtx.Codec.MustUnmarshalJSON(genState["gamm"], &gammGenesis)
 			}
 
-			// collect gamm pools
-			pools := make(map[string]gammtypes.CFMMPoolI)
-			for _, any := range gammGenesis.Pools {
-				var pool gammtypes.CFMMPoolI
-				err := clientCtx.InterfaceRegistry.UnpackAny(any, &pool)
-				if err != nil {
-					panic(err)
-				}
-				pools[gammtypes.GetPoolShareDenom(pool.GetId())] = pool
+			cosmwasmPoolGenesis := cosmwasmpooltypes.GenesisState{}
+			if len(genState[cosmwasmpooltypes.ModuleName]) > 0 {
+				clientCtx.Codec.MustUnmarshalJSON(genState[cosmwasmpooltypes.ModuleName], &cosmwasmPoolGenesis)
+			}
+
+			// collect gamm and cosmwasm pools
+			pools, err := gammPoolsByShareDenom(clientCtx.InterfaceRegistry, gammGenesis)
+			if err != nil {
+				return err
+			}
+			cosmwasmPools, err := cosmwasmPoolsByShareDenom(clientCtx.InterfaceRegistry, cosmwasmPoolGenesis, bankGenesis)
+			if err != nil {
+				return err
+			}
+			for shareDenom, pool := range cosmwasmPools {
+				pools[shareDenom] = pool
 			}
 
-			// convert balances to underlying coins and sum up balances to total balance
+			// convert balances to underlying coins
 			for addr, account := range snapshotAccs {
 				// All pool shares are in liquid balances OR bonded balances (locked),
 				// therefore underlyingCoinsForSelectPools on liquidBalances + bondedBalances
@@ -284,11 +340,70 @@ Example:
 					account.LiquidBalances.Add(account.Bonded...), pools, selectBondedPoolIDs)
 				account.LiquidBalances = underlyingCoins(account.LiquidBalances, pools)
 				account.Bonded = underlyingCoins(account.Bonded, pools)
+				account.SuperfluidBonded = underlyingCoins(account.SuperfluidBonded, pools)
+				snapshotAccs[addr] = account
+			}
+
+			clGenesis := clgenesis.GenesisState{}
+			if len(genState[cltypes.ModuleName]) > 0 {
+				clientCtx.Codec.MustUnmarshalJSON(genState[cltypes.ModuleName], &clGenesis)
+			}
+			clPools, err := clPoolStatesById(clientCtx.InterfaceRegistry, clGenesis)
+			if err != nil {
+				return err
+			}
+
+			// add concentrated liquidity positions, which are already in underlying coins
+			for _, positionData := range clGenesis.PositionData {
+				if positionData.Position == nil {
+					continue
+				}
+				poolState, ok := clPools[positionData.Position.PoolId]
+				if !ok {
+					return fmt.Errorf("position %d is in pool %d which is not in the genesis", positionData.Position.PositionId, positionData.Position.PoolId)
+				}
+				position, err := derivePosition(poolState, positionData)
+				if err != nil {
+					return err
+				}
+				position.Superfluid = position.LockId != 0 && superfluidLockIds[position.LockId]
+
+				address := positionData.Position.Address
+				acc, ok := snapshotAccs[address]
+				if !ok {
+					acc = newDerivedAccount(address)
+				}
+
+				if position.LockId != 0 {
+					acc.Bonded = acc.Bonded.Add(position.Liquidity...)
+					// only locked positions are bonded, as for the shares of the other pools.
+					if osmoutils.Contains(selectBondedPoolIDs, position.PoolId) {
+						if acc.BondedBySelectPools == nil {
+							acc.BondedBySelectPools = make(map[uint64]sdk.Coins)
+						}
+						acc.BondedBySelectPools[position.PoolId] = acc.BondedBySelectPools[position.PoolId].Add(position.Liquidity...)
+					}
+				} else {
+					acc.ConcentratedLiquidity = acc.ConcentratedLiquidity.Add(position.Liquidity...)
+				}
+				if position.Superfluid {
+					acc.SuperfluidBonded = acc.SuperfluidBonded.Add(position.Liquidity...)
+				}
+				acc.UnclaimedRewards = acc.UnclaimedRewards.Add(position.UnclaimedRewards...)
+				acc.Positions = append(acc.Positions, position)
+
+				snapshotAccs[address] = acc
+			}
+
+			// sum up balances to total balance
+			for addr, account := range snapshotAccs {
 				account.TotalBalances = sdk.NewCoins().
 					Add(account.LiquidBalances...).
 					Add(sdk.NewCoin(appparams.BaseCoinUnit, account.Staked)).
 					Add(sdk.NewCoin(appparams.BaseCoinUnit, account.UnbondingStake)).
-					Add(account.Bonded...)
+					Add(account.Bonded...).
+					Add(account.ConcentratedLiquidity...).
+					Add(account.UnclaimedRewards...)
 				snapshotAccs[addr] = account
 			}
 
@@ -299,19 +414,21 @@ Example:
 
 			fmt.Printf("# accounts: %d\n", len(snapshotAccs))
 
-			// export snapshot json
-			snapshotJSON, err := json.MarshalIndent(snapshot, "", "    ")
+			// export snapshot
+			outputFile, err := os.Create(snapshotOutput)
 			if err != nil {
-				return fmt.Errorf("failed to marshal snapshot: %w", err)
+				return err
 			}
+			defer outputFile.Close()
 
-			err = os.WriteFile(snapshotOutput, snapshotJSON, 0o644)
-			return err
+			return writeSnapshot(outputFile, snapshot, outputFormat)
 		},
 	}
 
 	cmd.Flags().String(FlagSelectPoolIds, "",
 		"Output a special breakdown for amount LP'd to the provided pools. Usage --breakdown-by-pool-ids=1,2,605")
+	cmd.Flags().String(FlagOutputFormat, OutputFormatJSON,
+		"Format of the snapshot: json, or one row per account, category and denom in csv or parquet")
 
 	return cmd
 }
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+
+	appparams "github.com/osmosis-labs/osmosis/v16/app/params"
+)
+
+const (
+	OutputFormatJSON    = "json"
+	OutputFormatCSV     = "csv"
+	OutputFormatParquet = "parquet"
+)
+
+// Categories of the rows of the csv and parquet snapshots, one per field of DerivedAccount.
+const (
+	CategoryLiquid                = "liquid"
+	CategoryStaked                = "staked"
+	CategoryUnbonding             = "unbonding"
+	CategoryBonded                = "bonded"
+	CategorySuperfluidBonded      = "superfluid_bonded"
+	CategoryBondedBySelectPool    = "bonded_by_select_pool"
+	CategoryConcentratedLiquidity = "concentrated_liquidity"
+	CategoryPositionLiquidity     = "position_liquidity"
+	CategoryPositionRewards       = "position_unclaimed_rewards"
+	CategoryUnclaimedRewards      = "unclaimed_rewards"
+	CategoryTotal                 = "total"
+)
+
+// SnapshotRow is a row of the csv and parquet snapshots, giving the amount of a denom held by an account
+// in a given category. The pool and position ids are zero for the categories that are not per pool or position.
+// Amounts are strings since they can overflow 64 bits integers.
+type SnapshotRow struct {
+	Address    string `parquet:"name=address, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Category   string `parquet:"name=category, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	PoolId     int64  `parquet:"name=pool_id, type=INT64, convertedtype=UINT_64"`
+	PositionId int64  `parquet:"name=position_id, type=INT64, convertedtype=UINT_64"`
+	Denom      string `parquet:"name=denom, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Amount     string `parquet:"name=amount, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+var snapshotRowHeader = []string{"address", "category", "pool_id", "position_id", "denom", "amount"}
+
+// snapshotRows flattens the snapshot into rows, ordered by address, then category, pool, position and denom.
+func snapshotRows(snapshot DeriveSnapshot) []SnapshotRow {
+	addresses := make([]string, 0, len(snapshot.Accounts))
+	for address := range snapshot.Accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	rows := []SnapshotRow{}
+	for _, address := range addresses {
+		account := snapshot.Accounts[address]
+		addCoins := func(category string, poolId, positionId uint64, coins sdk.Coins) {
+			for _, coin := range coins {
+				rows = append(rows, SnapshotRow{
+					Address:    address,
+					Category:   category,
+					PoolId:     int64(poolId),
+					PositionId: int64(positionId),
+					Denom:      coin.Denom,
+					Amount:     coin.Amount.String(),
+				})
+			}
+		}
+
+		addCoins(CategoryLiquid, 0, 0, account.LiquidBalances)
+		addCoins(CategoryStaked, 0, 0, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, account.Staked)))
+		addCoins(CategoryUnbonding, 0, 0, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, account.UnbondingStake)))
+		addCoins(CategoryBonded, 0, 0, account.Bonded)
+		addCoins(CategorySuperfluidBonded, 0, 0, account.SuperfluidBonded)
+
+		poolIds := make([]uint64, 0, len(account.BondedBySelectPools))
+		for poolId := range account.BondedBySelectPools {
+			poolIds = append(poolIds, poolId)
+		}
+		sort.Slice(poolIds, func(i, j int) bool { return poolIds[i] < poolIds[j] })
+		for _, poolId := range poolIds {
+			addCoins(CategoryBondedBySelectPool, poolId, 0, account.BondedBySelectPools[poolId])
+		}
+
+		addCoins(CategoryConcentratedLiquidity, 0, 0, account.ConcentratedLiquidity)
+		for _, position := range account.Positions {
+			addCoins(CategoryPositionLiquidity, position.PoolId, position.PositionId, position.Liquidity)
+			addCoins(CategoryPositionRewards, position.PoolId, position.PositionId, position.UnclaimedRewards)
+		}
+		addCoins(CategoryUnclaimedRewards, 0, 0, account.UnclaimedRewards)
+		addCoins(CategoryTotal, 0, 0, account.TotalBalances)
+	}
+	return rows
+}
+
+// writeSnapshot writes the snapshot in the given format: the snapshot itself as json,
+// or its rows as csv or parquet.
+func writeSnapshot(w io.Writer, snapshot DeriveSnapshot, format string) error {
+	switch format {
+	case OutputFormatJSON:
+		snapshotJSON, err := json.MarshalIndent(snapshot, "", "    ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal snapshot: %w", err)
+		}
+		_, err = w.Write(snapshotJSON)
+		return err
+	case OutputFormatCSV:
+		return writeSnapshotCSV(w, snapshotRows(snapshot))
+	case OutputFormatParquet:
+		return writeSnapshotParquet(w, snapshotRows(snapshot))
+	default:
+		return fmt.Errorf("invalid output format %q, expected one of %s, %s or %s", format, OutputFormatJSON, OutputFormatCSV, OutputFormatParquet)
+	}
+}
+
+func writeSnapshotCSV(w io.Writer, rows []SnapshotRow) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(snapshotRowHeader); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := []string{
+			row.Address,
+			row.Category,
+			strconv.FormatUint(uint64(row.PoolId), 10),
+			strconv.FormatUint(uint64(row.PositionId), 10),
+			row.Denom,
+			row.Amount,
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func writeSnapshotParquet(w io.Writer, rows []SnapshotRow) error {
+	parquetWriter, err := writer.NewParquetWriterFromWriter(w, new(SnapshotRow), 4)
+	if err != nil {
+		return err
+	}
+	parquetWriter.CompressionType = parquet.CompressionCodec_SNAPPY
+	for _, row := range rows {
+		if err := parquetWriter.Write(row); err != nil {
+			return err
+		}
+	}
+	return parquetWriter.WriteStop()
+}
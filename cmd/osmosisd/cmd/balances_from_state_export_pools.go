@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	clmodel "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	clgenesis "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types/genesis"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+// derivedPool is the liquidity backing the shares of a gamm or cosmwasm pool.
+type derivedPool struct {
+	Id          uint64
+	Liquidity   sdk.Coins
+	TotalShares sdk.Int
+}
+
+// DerivedPosition is the breakdown of a concentrated liquidity position held by an account.
+type DerivedPosition struct {
+	PositionId uint64 `json:"position_id"`
+	PoolId     uint64 `json:"pool_id"`
+	// LockId is the id of the lock holding the position, zero if the position is not locked.
+	LockId     uint64 `json:"lock_id"`
+	Superfluid bool   `json:"superfluid"`
+	// Liquidity is the amount of each asset that withdrawing the position would return.
+	Liquidity sdk.Coins `json:"liquidity"`
+	// UnclaimedRewards are the spread rewards and incentives accrued by the position and not claimed yet.
+	UnclaimedRewards sdk.Coins `json:"unclaimed_rewards"`
+}
+
+// gammPoolsByShareDenom returns the pools of the gamm genesis keyed by their share denom.
+func gammPoolsByShareDenom(unpacker codectypes.AnyUnpacker, gammGenesis gammtypes.GenesisState) (map[string]derivedPool, error) {
+	pools := make(map[string]derivedPool)
+	for _, any := range gammGenesis.Pools {
+		var pool gammtypes.CFMMPoolI
+		if err := unpacker.UnpackAny(any, &pool); err != nil {
+			return nil, err
+		}
+		pools[gammtypes.GetPoolShareDenom(pool.GetId())] = derivedPool{
+			Id:          pool.GetId(),
+			Liquidity:   pool.GetTotalPoolLiquidity(sdk.Context{}),
+			TotalShares: pool.GetTotalShares(),
+		}
+	}
+	return pools, nil
+}
+
+// cosmwasmPoolsByShareDenom returns the pools of the cosmwasm pool genesis keyed by their share denom.
+// The liquidity of a cosmwasm pool is the balance of its contract, and its total shares the supply of its share denom.
+func cosmwasmPoolsByShareDenom(unpacker codectypes.AnyUnpacker, cosmwasmPoolGenesis cosmwasmpooltypes.GenesisState, bankGenesis banktypes.GenesisState) (map[string]derivedPool, error) {
+	balances := make(map[string]sdk.Coins, len(bankGenesis.Balances))
+	for _, balance := range bankGenesis.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+
+	pools := make(map[string]derivedPool)
+	for _, any := range cosmwasmPoolGenesis.Pools {
+		var pool cosmwasmpooltypes.CosmWasmExtension
+		if err := unpacker.UnpackAny(any, &pool); err != nil {
+			return nil, err
+		}
+		shareDenom := cosmwasmpooltypes.GetPoolShareDenom(pool.GetId())
+		totalShares := bankGenesis.Supply.AmountOf(shareDenom)
+		// liquidity provided other than by joining the pool is not represented by shares.
+		if totalShares.IsZero() {
+			continue
+		}
+		pools[shareDenom] = derivedPool{
+			Id:          pool.GetId(),
+			Liquidity:   balances[pool.GetContractAddress()],
+			TotalShares: totalShares,
+		}
+	}
+	return pools, nil
+}
+
+// isConcentratedLockupDenom returns true if the denom is the one of locked concentrated liquidity positions.
+func isConcentratedLockupDenom(denom string) bool {
+	return strings.HasPrefix(denom, cltypes.ConcentratedLiquidityTokenPrefix+"/")
+}
+
+// clPoolState is the state of a concentrated liquidity pool required to derive the balances of its positions.
+type clPoolState struct {
+	pool  cltypes.ConcentratedPoolExtension
+	ticks map[int64]clmodel.TickInfo
+	// spreadRewardGrowth and uptimeGrowth are the values of the spread reward and uptime accumulators.
+	spreadRewardGrowth sdk.DecCoins
+	uptimeGrowth       []sdk.DecCoins
+}
+
+// clPoolStatesById returns the state of the pools of the concentrated liquidity genesis keyed by pool id.
+func clPoolStatesById(unpacker codectypes.AnyUnpacker, clGenesis clgenesis.GenesisState) (map[uint64]clPoolState, error) {
+	pools := make(map[uint64]clPoolState, len(clGenesis.PoolData))
+	for _, poolData := range clGenesis.PoolData {
+		var pool cltypes.ConcentratedPoolExtension
+		if err := unpacker.UnpackAny(poolData.Pool, &pool); err != nil {
+			return nil, err
+		}
+
+		ticks := make(map[int64]clmodel.TickInfo, len(poolData.Ticks))
+		for _, tick := range poolData.Ticks {
+			ticks[tick.TickIndex] = tick.Info
+		}
+
+		var spreadRewardGrowth sdk.DecCoins
+		if poolData.SpreadRewardAccumulator.AccumContent != nil {
+			spreadRewardGrowth = poolData.SpreadRewardAccumulator.AccumContent.AccumValue
+		}
+		uptimeGrowth := make([]sdk.DecCoins, len(poolData.IncentivesAccumulators))
+		for i, uptimeAccumulator := range poolData.IncentivesAccumulators {
+			if uptimeAccumulator.AccumContent != nil {
+				uptimeGrowth[i] = uptimeAccumulator.AccumContent.AccumValue
+			}
+		}
+
+		pools[pool.GetId()] = clPoolState{
+			pool:               pool,
+			ticks:              ticks,
+			spreadRewardGrowth: spreadRewardGrowth,
+			uptimeGrowth:       uptimeGrowth,
+		}
+	}
+	return pools, nil
+}
+
+// derivePosition returns the breakdown of a concentrated liquidity position given the state of its pool.
+// The liquidity is rounded down as when withdrawing the position, and the rewards are the ones that
+// claiming them would return, without forfeiting the incentives of uptimes the position has not reached yet.
+func derivePosition(poolState clPoolState, positionData clgenesis.PositionData) (DerivedPosition, error) {
+	position := positionData.Position
+	if position == nil {
+		return DerivedPosition{}, fmt.Errorf("position data with lock id %d has no position", positionData.LockId)
+	}
+	pool := poolState.pool
+
+	liquidity := sdk.NewCoins()
+	if position.Liquidity.IsPositive() {
+		amount0, amount1, err := pool.CalcActualAmounts(sdk.Context{}, position.LowerTick, position.UpperTick, position.Liquidity.Neg())
+		if err != nil {
+			return DerivedPosition{}, err
+		}
+		liquidity = sdk.NewCoins(
+			sdk.NewCoin(pool.GetToken0(), amount0.Neg().TruncateInt()),
+			sdk.NewCoin(pool.GetToken1(), amount1.Neg().TruncateInt()),
+		)
+	}
+
+	unclaimedRewards, err := positionUnclaimedRewards(poolState, positionData)
+	if err != nil {
+		return DerivedPosition{}, fmt.Errorf("position %d: %w", position.PositionId, err)
+	}
+
+	return DerivedPosition{
+		PositionId:       position.PositionId,
+		PoolId:           position.PoolId,
+		LockId:           positionData.LockId,
+		Liquidity:        liquidity,
+		UnclaimedRewards: unclaimedRewards,
+	}, nil
+}
+
+// positionUnclaimedRewards returns the spread rewards and incentives accrued by a position and not claimed yet.
+// As on claim, the rewards of each accumulator are truncated separately.
+func positionUnclaimedRewards(poolState clPoolState, positionData clgenesis.PositionData) (sdk.Coins, error) {
+	position := positionData.Position
+	currentTick := poolState.pool.GetCurrentTick()
+	lowerTickInfo := poolState.ticks[position.LowerTick]
+	upperTickInfo := poolState.ticks[position.UpperTick]
+
+	// spread reward growth inside = global growth - growth above the upper tick - growth below the lower tick
+	spreadRewardGrowthAbove := spreadRewardGrowthOutsideTick(position.UpperTick, upperTickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal, currentTick, poolState.spreadRewardGrowth, true)
+	spreadRewardGrowthBelow := spreadRewardGrowthOutsideTick(position.LowerTick, lowerTickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal, currentTick, poolState.spreadRewardGrowth, false)
+	spreadRewardGrowthInside, isNegative := poolState.spreadRewardGrowth.SafeSub(spreadRewardGrowthAbove.Add(spreadRewardGrowthBelow...))
+	if isNegative {
+		return nil, fmt.Errorf("negative spread reward growth inside ticks [%d, %d)", position.LowerTick, position.UpperTick)
+	}
+
+	rewards, err := accruedRewards(positionData.SpreadRewardAccumRecord, spreadRewardGrowthInside)
+	if err != nil {
+		return nil, err
+	}
+
+	uptimeGrowthInside, err := uptimeGrowthInsideRange(poolState, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return nil, err
+	}
+	for i, record := range positionData.UptimeAccumRecords {
+		if i >= len(uptimeGrowthInside) {
+			break
+		}
+		incentives, err := accruedRewards(record, uptimeGrowthInside[i])
+		if err != nil {
+			return nil, err
+		}
+		rewards = rewards.Add(incentives...)
+	}
+
+	return rewards, nil
+}
+
+// spreadRewardGrowthOutsideTick returns the spread reward growth above the upper tick, or below the lower tick,
+// from the growth tracked by the tick in the opposite direction of its last traversal.
+func spreadRewardGrowthOutsideTick(tick int64, growthOppositeDirectionOfLastTraversal sdk.DecCoins, currentTick int64, globalGrowth sdk.DecCoins, isUpperTick bool) sdk.DecCoins {
+	if (isUpperTick && currentTick >= tick) || (!isUpperTick && currentTick < tick) {
+		return globalGrowth.Sub(growthOppositeDirectionOfLastTraversal)
+	}
+	return growthOppositeDirectionOfLastTraversal
+}
+
+// uptimeGrowthInsideRange returns the growth of each uptime accumulator inside [lowerTick, upperTick).
+func uptimeGrowthInsideRange(poolState clPoolState, lowerTick, upperTick int64) ([]sdk.DecCoins, error) {
+	numUptimes := len(poolState.uptimeGrowth)
+	lowerTickInfo := poolState.ticks[lowerTick]
+	upperTickInfo := poolState.ticks[upperTick]
+	lowerTickGrowth := uptimeTrackerValues(lowerTickInfo.UptimeTrackers.List, numUptimes)
+	upperTickGrowth := uptimeTrackerValues(upperTickInfo.UptimeTrackers.List, numUptimes)
+
+	currentTick := poolState.pool.GetCurrentTick()
+	if currentTick < lowerTick {
+		return osmoutils.SubDecCoinArrays(lowerTickGrowth, upperTickGrowth)
+	} else if currentTick < upperTick {
+		globalMinusUpper, err := osmoutils.SubDecCoinArrays(poolState.uptimeGrowth, upperTickGrowth)
+		if err != nil {
+			return nil, err
+		}
+		return osmoutils.SubDecCoinArrays(globalMinusUpper, lowerTickGrowth)
+	}
+	return osmoutils.SubDecCoinArrays(upperTickGrowth, lowerTickGrowth)
+}
+
+// uptimeTrackerValues returns the growth outside of the uptime trackers of a tick,
+// with an empty growth for the uptimes the tick does not track.
+func uptimeTrackerValues(trackers []clmodel.UptimeTracker, numUptimes int) []sdk.DecCoins {
+	values := make([]sdk.DecCoins, numUptimes)
+	for i := range values {
+		if i < len(trackers) {
+			values[i] = trackers[i].UptimeGrowthOutside
+		} else {
+			values[i] = sdk.NewDecCoins()
+		}
+	}
+	return values
+}
+
+// accruedRewards returns the truncated rewards of an accumulator record given the growth inside the position's range,
+// that is its unclaimed rewards plus its shares times the growth since the record was last updated.
+func accruedRewards(record accum.Record, growthInside sdk.DecCoins) (sdk.Coins, error) {
+	growthSinceLastUpdate, isNegative := growthInside.SafeSub(record.AccumValuePerShare)
+	if isNegative {
+		return nil, fmt.Errorf("accumulator value per share %s is greater than the growth inside the position %s", record.AccumValuePerShare, growthInside)
+	}
+	rewards := record.UnclaimedRewardsTotal.Add(growthSinceLastUpdate.MulDec(record.NumShares)...)
+	truncatedRewards, _ := rewards.TruncateDecimal()
+	return truncatedRewards, nil
+}
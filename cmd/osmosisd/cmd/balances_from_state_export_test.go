@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	clmath "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
+	clmodel "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
+	clgenesis "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types/genesis"
+)
+
+func TestUnderlyingCoins(t *testing.T) {
+	pools := map[string]derivedPool{
+		"gamm/pool/1": {Id: 1, Liquidity: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000), sdk.NewInt64Coin("uatom", 500)), TotalShares: sdk.NewInt(100)},
+		// a cosmwasm pool whose liquidity contains the shares of another pool
+		"cw-pool/2": {Id: 2, Liquidity: sdk.NewCoins(sdk.NewInt64Coin("gamm/pool/1", 50), sdk.NewInt64Coin("uion", 10)), TotalShares: sdk.NewInt(10)},
+	}
+
+	tests := map[string]struct {
+		coins    sdk.Coins
+		expected sdk.Coins
+	}{
+		"no shares": {
+			coins:    sdk.NewCoins(sdk.NewInt64Coin("uosmo", 7)),
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 7)),
+		},
+		"gamm shares": {
+			coins:    sdk.NewCoins(sdk.NewInt64Coin("gamm/pool/1", 10), sdk.NewInt64Coin("uosmo", 7)),
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 107), sdk.NewInt64Coin("uatom", 50)),
+		},
+		"cosmwasm pool shares backed by gamm shares": {
+			coins:    sdk.NewCoins(sdk.NewInt64Coin("cw-pool/2", 2)),
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100), sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uion", 2)),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, underlyingCoins(tc.coins, pools))
+		})
+	}
+
+	require.Equal(t, map[uint64]sdk.Coins{2: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100), sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uion", 2))},
+		underlyingCoinsForSelectPools(sdk.NewCoins(sdk.NewInt64Coin("gamm/pool/1", 10), sdk.NewInt64Coin("cw-pool/2", 2)), pools, []uint64{2}))
+}
+
+func TestDerivePosition(t *testing.T) {
+	const (
+		lowerTick = int64(-1000)
+		upperTick = int64(1000)
+	)
+	pool, err := clmodel.NewConcentratedLiquidityPool(1, "uatom", "uosmo", 100, sdk.ZeroDec())
+	require.NoError(t, err)
+	pool.SetCurrentTick(0)
+	pool.SetCurrentSqrtPrice(sdk.OneDec())
+
+	positionLiquidity := sdk.NewDec(1_000_000)
+	poolState := clPoolState{
+		pool: &pool,
+		ticks: map[int64]clmodel.TickInfo{
+			lowerTick: {
+				SpreadRewardGrowthOppositeDirectionOfLastTraversal: sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 2)),
+				UptimeTrackers: clmodel.UptimeTrackers{List: []clmodel.UptimeTracker{{UptimeGrowthOutside: sdk.NewDecCoins(sdk.NewInt64DecCoin("uion", 1))}}},
+			},
+			upperTick: {
+				SpreadRewardGrowthOppositeDirectionOfLastTraversal: sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 3)),
+				UptimeTrackers: clmodel.UptimeTrackers{List: []clmodel.UptimeTracker{{UptimeGrowthOutside: sdk.NewDecCoins(sdk.NewInt64DecCoin("uion", 2))}}},
+			},
+		},
+		spreadRewardGrowth: sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 10)),
+		uptimeGrowth:       []sdk.DecCoins{sdk.NewDecCoins(sdk.NewInt64DecCoin("uion", 6))},
+	}
+	positionData := clgenesis.PositionData{
+		Position: &clmodel.Position{PositionId: 3, Address: "osmo1owner", PoolId: 1, LowerTick: lowerTick, UpperTick: upperTick, Liquidity: positionLiquidity},
+		LockId:   4,
+		// spread reward growth inside is 10 - 2 - 3 = 5, hence 0.5 + (5 - 1) * 100 = 400.5 unclaimed uosmo.
+		SpreadRewardAccumRecord: accum.Record{
+			NumShares:             sdk.NewDec(100),
+			AccumValuePerShare:    sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 1)),
+			UnclaimedRewardsTotal: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uosmo", sdk.NewDecWithPrec(5, 1))),
+		},
+		// uptime growth inside is 6 - 2 - 1 = 3, hence 3 * 10 = 30 unclaimed uion.
+		UptimeAccumRecords: []accum.Record{{
+			NumShares:             sdk.NewDec(10),
+			AccumValuePerShare:    sdk.NewDecCoins(),
+			UnclaimedRewardsTotal: sdk.NewDecCoins(),
+		}},
+	}
+
+	position, err := derivePosition(poolState, positionData)
+	require.NoError(t, err)
+
+	_, _, sqrtPriceLower, sqrtPriceUpper, err := clmath.TicksToSqrtPrice(lowerTick, upperTick)
+	require.NoError(t, err)
+	expectedAmount0 := clmath.CalcAmount0Delta(positionLiquidity, sdk.OneDec(), sqrtPriceUpper, false).TruncateInt()
+	expectedAmount1 := clmath.CalcAmount1Delta(positionLiquidity, sdk.OneDec(), sqrtPriceLower, false).TruncateInt()
+
+	require.Equal(t, DerivedPosition{
+		PositionId:       3,
+		PoolId:           1,
+		LockId:           4,
+		Liquidity:        sdk.NewCoins(sdk.NewCoin("uatom", expectedAmount0), sdk.NewCoin("uosmo", expectedAmount1)),
+		UnclaimedRewards: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 400), sdk.NewInt64Coin("uion", 30)),
+	}, position)
+
+	// a record ahead of the growth inside the position's range is invalid.
+	positionData.SpreadRewardAccumRecord.AccumValuePerShare = sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 6))
+	_, err = derivePosition(poolState, positionData)
+	require.Error(t, err)
+}
+
+func TestWriteSnapshot(t *testing.T) {
+	snapshot := DeriveSnapshot{
+		NumberAccounts: 1,
+		Accounts: map[string]DerivedAccount{
+			"osmo1owner": {
+				Address:             "osmo1owner",
+				LiquidBalances:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 5)),
+				Staked:              sdk.NewInt(10),
+				UnbondingStake:      sdk.ZeroInt(),
+				BondedBySelectPools: map[uint64]sdk.Coins{1: sdk.NewCoins(sdk.NewInt64Coin("uatom", 2))},
+				Positions: []DerivedPosition{{
+					PositionId:       3,
+					PoolId:           1,
+					Liquidity:        sdk.NewCoins(sdk.NewInt64Coin("uatom", 2)),
+					UnclaimedRewards: sdk.NewCoins(sdk.NewInt64Coin("uion", 1)),
+				}},
+				ConcentratedLiquidity: sdk.NewCoins(sdk.NewInt64Coin("uatom", 2)),
+				UnclaimedRewards:      sdk.NewCoins(sdk.NewInt64Coin("uion", 1)),
+				TotalBalances:         sdk.NewCoins(sdk.NewInt64Coin("uatom", 7), sdk.NewInt64Coin("uion", 1), sdk.NewInt64Coin("uosmo", 10)),
+			},
+		},
+	}
+
+	var csvOutput bytes.Buffer
+	require.NoError(t, writeSnapshot(&csvOutput, snapshot, OutputFormatCSV))
+	require.Equal(t, `address,category,pool_id,position_id,denom,amount
+osmo1owner,liquid,0,0,uatom,5
+osmo1owner,staked,0,0,uosmo,10
+osmo1owner,bonded_by_select_pool,1,0,uatom,2
+osmo1owner,concentrated_liquidity,0,0,uatom,2
+osmo1owner,position_liquidity,1,3,uatom,2
+osmo1owner,position_unclaimed_rewards,1,3,uion,1
+osmo1owner,unclaimed_rewards,0,0,uion,1
+osmo1owner,total,0,0,uatom,7
+osmo1owner,total,0,0,uion,1
+osmo1owner,total,0,0,uosmo,10
+`, csvOutput.String())
+
+	var parquetOutput bytes.Buffer
+	require.NoError(t, writeSnapshot(&parquetOutput, snapshot, OutputFormatParquet))
+	// parquet files start and end with the magic number.
+	require.True(t, bytes.HasPrefix(parquetOutput.Bytes(), []byte("PAR1")))
+	require.True(t, bytes.HasSuffix(parquetOutput.Bytes(), []byte("PAR1")))
+
+	var jsonOutput bytes.Buffer
+	require.NoError(t, writeSnapshot(&jsonOutput, snapshot, OutputFormatJSON))
+	require.Contains(t, jsonOutput.String(), `"num_accounts": 1`)
+
+	require.Error(t, writeSnapshot(&jsonOutput, snapshot, "xml"))
+}
@@ -0,0 +1,157 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	osmosis "github.com/osmosis-labs/osmosis/v16/app"
+)
+
+const (
+	flagInvariantsGenesis       = "genesis"
+	flagInvariantsSkipExpensive = "skip-expensive"
+	flagInvariantsModules       = "modules"
+)
+
+// DebugCheckInvariantsCmd returns the command checking the invariants declared by the modules against
+// the application state of a stopped node, or of a genesis file.
+func DebugCheckInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Check the module invariants against the state of a stopped node, or of a genesis file, offline",
+		Long: `Check the module invariants against the state of a stopped node, or of a genesis file, offline.
+By default, the application DB of the node home is opened read-only at the given height (the latest one by default).
+With --genesis, the genesis file is imported in memory instead, skipping the crisis genesis invariants.
+Invariants are either cheap or expensive, the expensive ones iterating over large collections of state such as
+positions or locks. The command fails if any checked invariant is broken.
+
+Example:
+	osmosisd debug check-invariants --height 10000000 --skip-expensive
+	osmosisd debug check-invariants --genesis exported.json --modules concentratedliquidity,lockup`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			genesisFile, err := cmd.Flags().GetString(flagInvariantsGenesis)
+			if err != nil {
+				return err
+			}
+			skipExpensive, err := cmd.Flags().GetBool(flagInvariantsSkipExpensive)
+			if err != nil {
+				return err
+			}
+			modules, err := cmd.Flags().GetStringSlice(flagInvariantsModules)
+			if err != nil {
+				return err
+			}
+
+			var (
+				app *osmosis.OsmosisApp
+				ctx sdk.Context
+			)
+			if genesisFile != "" {
+				var cleanup func()
+				app, ctx, cleanup, err = loadStateFromGenesis(genesisFile)
+				if err != nil {
+					return err
+				}
+				defer cleanup()
+			} else {
+				var closeDB func()
+				app, ctx, closeDB, err = loadStateFromCmd(cmd)
+				if err != nil {
+					return err
+				}
+				defer closeDB()
+			}
+
+			maxCost := invariants.Expensive
+			if skipExpensive {
+				maxCost = invariants.Cheap
+			}
+			registry := app.InvariantRegistry()
+			for _, module := range modules {
+				if len(registry.Invariants(invariants.Expensive, module)) == 0 {
+					return fmt.Errorf("module %s declares no invariants", module)
+				}
+			}
+
+			return checkInvariants(cmd, ctx, registry.Invariants(maxCost, modules...))
+		},
+	}
+
+	cmd.Flags().String(flagInvariantsGenesis, "", "Genesis file whose state is checked, instead of the node application DB")
+	cmd.Flags().Int64(flagStateHeight, 0, "Height of the state to check, the latest one if 0")
+	cmd.Flags().Bool(flagInvariantsSkipExpensive, false, "Only check the cheap invariants")
+	cmd.Flags().StringSlice(flagInvariantsModules, nil, "Comma separated modules whose invariants are checked, all of them if empty")
+
+	return cmd
+}
+
+// checkInvariants checks the invariants and prints their results, failing if any of them is broken.
+func checkInvariants(cmd *cobra.Command, ctx sdk.Context, invs []invariants.Invariant) error {
+	broken := 0
+	for _, result := range invariants.Check(ctx, invs) {
+		status := "ok"
+		if result.Broken {
+			status = "BROKEN"
+			broken++
+		}
+		cmd.Printf("%-6s %s (%s, %s)\n", status, result.Name(), result.Cost, result.Duration)
+		if result.Broken {
+			cmd.Println(strings.TrimRight(result.Message, "\n"))
+		}
+	}
+
+	if broken > 0 {
+		return fmt.Errorf("%d of %d invariants broken at height %d", broken, len(invs), ctx.BlockHeight())
+	}
+	cmd.Printf("%d invariants hold at height %d\n", len(invs), ctx.BlockHeight())
+	return nil
+}
+
+// loadStateFromGenesis imports the genesis file into an in memory app, and returns a context on its
+// committed state. The returned function removes the temporary home of the app.
+func loadStateFromGenesis(genesisFile string) (*osmosis.OsmosisApp, sdk.Context, func(), error) {
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return nil, sdk.Context{}, nil, err
+	}
+
+	// the wasm VM of the app stores the contract codes under its home.
+	homeDir, err := os.MkdirTemp("", "osmosis-check-invariants")
+	if err != nil {
+		return nil, sdk.Context{}, nil, err
+	}
+	cleanup := func() { os.RemoveAll(homeDir) }
+
+	// the invariants are checked by the command, not while importing the genesis.
+	appOpts := viper.New()
+	appOpts.Set(crisis.FlagSkipGenesisInvariants, true)
+	app := osmosis.NewOsmosisApp(log.NewNopLogger(), tmdb.NewMemDB(), nil, true, map[int64]bool{}, homeDir, 0, appOpts, osmosis.EmptyWasmOpts)
+
+	app.InitChain(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	})
+	app.Commit()
+
+	header := tmproto.Header{ChainID: genDoc.ChainID, Height: app.LastBlockHeight(), Time: genDoc.GenesisTime}
+	return app, app.NewContext(true, header), cleanup, nil
+}
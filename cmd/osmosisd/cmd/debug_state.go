@@ -0,0 +1,410 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	osmosis "github.com/osmosis-labs/osmosis/v16/app"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+const (
+	flagStateHeight = "height"
+	flagStatePrefix = "prefix"
+	flagStateLimit  = "limit"
+)
+
+// DebugStateCmd returns the command family querying the application state of a stopped node.
+func DebugStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Query the application state of a stopped node, offline",
+		Long: `Query the application state of a stopped node, offline.
+The application DB of the node home is opened read-only at the given height (the latest one by default),
+and keeper level queries are run against it. The node must be stopped, since it holds a lock on its DBs.
+
+Example:
+	osmosisd debug state pools 1 --height 10000000
+	osmosisd debug state raw twap --prefix 726563656e745f74776170 --limit 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.PersistentFlags().Int64(flagStateHeight, 0, "Height of the state to query, the latest one if 0")
+
+	cmd.AddCommand(
+		stateQueryCmd("pools [pool-id]", "Query all the pools, or the pool with the given id", cobra.MaximumNArgs(1), queryStatePools),
+		stateQueryCmd("positions [pool-id]", "Query the concentrated liquidity positions of a pool", cobra.ExactArgs(1), queryStatePositions),
+		stateQueryCmd("position [position-id]", "Query a concentrated liquidity position", cobra.ExactArgs(1), queryStatePosition),
+		stateQueryCmd("locks [owner]", "Query the locks of an owner, or all the locks", cobra.MaximumNArgs(1), queryStateLocks),
+		stateQueryCmd("lock [lock-id]", "Query a lock", cobra.ExactArgs(1), queryStateLock),
+		stateQueryCmd("gauges [gauge-id]", "Query all the gauges, or the gauge with the given id", cobra.MaximumNArgs(1), queryStateGauges),
+		stateQueryCmd("twap-records [pool-id]", "Query the most recent twap records of a pool", cobra.ExactArgs(1), queryStateTwapRecords),
+		stateRawCmd(),
+	)
+
+	return cmd
+}
+
+// stateQueryFn queries the loaded state and returns the json result.
+type stateQueryFn func(app *osmosis.OsmosisApp, ctx sdk.Context, args []string) ([]byte, error)
+
+func stateQueryCmd(use, short string, args cobra.PositionalArgs, query stateQueryFn) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, ctx, closeDB, err := loadStateFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			out, err := query(app, ctx, args)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+}
+
+func stateRawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "raw [store-key]",
+		Short: "Dump the raw entries of a module store, decoding the keys and values of known prefixes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefixFlag, err := cmd.Flags().GetString(flagStatePrefix)
+			if err != nil {
+				return err
+			}
+			prefix, err := hex.DecodeString(prefixFlag)
+			if err != nil {
+				return fmt.Errorf("invalid hex prefix %q: %w", prefixFlag, err)
+			}
+			if len(prefix) == 0 {
+				// iterate over the whole store, since store iterators do not accept empty keys.
+				prefix = nil
+			}
+			limit, err := cmd.Flags().GetUint64(flagStateLimit)
+			if err != nil {
+				return err
+			}
+
+			app, ctx, closeDB, err := loadStateFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			storeKey := app.GetKey(args[0])
+			if storeKey == nil {
+				return fmt.Errorf("unknown store key %s", args[0])
+			}
+
+			store := ctx.KVStore(storeKey)
+			iterator := sdk.KVStorePrefixIterator(store, prefix)
+			defer iterator.Close()
+
+			entries := []rawStoreEntry{}
+			for ; iterator.Valid() && (limit == 0 || uint64(len(entries)) < limit); iterator.Next() {
+				entries = append(entries, decodeStoreEntry(app.AppCodec(), args[0], iterator.Key(), iterator.Value()))
+			}
+
+			out, err := json.MarshalIndent(entries, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagStatePrefix, "", "Hex encoded prefix of the keys to dump")
+	cmd.Flags().Uint64(flagStateLimit, 100, "Maximum number of entries to dump, unlimited if 0")
+	return cmd
+}
+
+// loadStateFromCmd opens the application DB of the node home, read-only for goleveldb, and loads the app at the height
+// given by the flags. The writes of the app are discarded with the other backends. The returned function closes the DB.
+func loadStateFromCmd(cmd *cobra.Command) (*osmosis.OsmosisApp, sdk.Context, func(), error) {
+	height, err := cmd.Flags().GetInt64(flagStateHeight)
+	if err != nil {
+		return nil, sdk.Context{}, nil, err
+	}
+
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	homeDir := serverCtx.Config.RootDir
+	dataDir := filepath.Join(homeDir, "data")
+
+	db, err := openNodeDB(dataDir, "application", appDBBackend())
+	if err != nil {
+		return nil, sdk.Context{}, nil, fmt.Errorf("failed to open the application DB, is the node stopped? %w", err)
+	}
+
+	app := osmosis.NewOsmosisApp(log.NewNopLogger(), readOnlyDB{db}, nil, false, map[int64]bool{}, homeDir, 0, serverCtx.Viper, osmosis.EmptyWasmOpts)
+	if height == 0 {
+		err = app.LoadLatestVersion()
+	} else {
+		err = app.LoadHeight(height)
+	}
+	if err != nil {
+		db.Close()
+		return nil, sdk.Context{}, nil, err
+	}
+
+	header := tmproto.Header{Height: app.LastBlockHeight()}
+	if blockHeader, ok := loadBlockHeader(serverCtx.Config.DBDir(), tmdb.BackendType(serverCtx.Config.DBBackend), header.Height); ok {
+		header = blockHeader
+	} else {
+		cmd.PrintErrf("block %d not found in the block store, queries depending on the block time are run at the zero time\n", header.Height)
+	}
+
+	return app, app.NewContext(true, header), func() { db.Close() }, nil
+}
+
+// readOnlyDB wraps a DB opened read-only, discarding the writes made by the app while loading its stores,
+// such as the app version, so that the DB of the node is left untouched.
+type readOnlyDB struct {
+	tmdb.DB
+}
+
+func (readOnlyDB) Set(_, _ []byte) error     { return nil }
+func (readOnlyDB) SetSync(_, _ []byte) error { return nil }
+func (readOnlyDB) Delete(_ []byte) error     { return nil }
+func (readOnlyDB) DeleteSync(_ []byte) error { return nil }
+
+func (db readOnlyDB) NewBatch() tmdb.Batch {
+	return readOnlyBatch{db.DB.NewBatch()}
+}
+
+// readOnlyBatch discards the batched writes.
+type readOnlyBatch struct {
+	tmdb.Batch
+}
+
+func (b readOnlyBatch) Write() error     { return b.Close() }
+func (b readOnlyBatch) WriteSync() error { return b.Close() }
+
+// loadBlockHeader returns the header of the block at the given height from the block store, if present.
+func loadBlockHeader(dbDir string, backend tmdb.BackendType, height int64) (tmproto.Header, bool) {
+	db, err := openNodeDB(dbDir, "blockstore", backend)
+	if err != nil {
+		return tmproto.Header{}, false
+	}
+	defer db.Close()
+
+	blockMeta := tmstore.NewBlockStore(db).LoadBlockMeta(height)
+	if blockMeta == nil {
+		return tmproto.Header{}, false
+	}
+	return *blockMeta.Header.ToProto(), true
+}
+
+// openNodeDB opens a DB of a node with the given backend, read-only for goleveldb.
+func openNodeDB(dbDir, name string, backend tmdb.BackendType) (tmdb.DB, error) {
+	if backend == tmdb.GoLevelDBBackend {
+		return tmdb.NewGoLevelDBWithOpts(name, dbDir, &opt.Options{ReadOnly: true})
+	}
+	return tmdb.NewDB(name, backend, dbDir)
+}
+
+// appDBBackend returns the backend of the application DB, which is goleveldb unless set at build time.
+func appDBBackend() tmdb.BackendType {
+	if sdk.DBBackend != "" {
+		return tmdb.BackendType(sdk.DBBackend)
+	}
+	return tmdb.GoLevelDBBackend
+}
+
+func queryStatePools(app *osmosis.OsmosisApp, ctx sdk.Context, args []string) ([]byte, error) {
+	cdc := app.AppCodec()
+	if len(args) == 1 {
+		poolId, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := app.PoolManagerKeeper.GetPool(ctx, poolId)
+		if err != nil {
+			return nil, err
+		}
+		return poolJSON(cdc, pool)
+	}
+
+	pools, err := app.PoolManagerKeeper.AllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	poolsJSON := make([]json.RawMessage, 0, len(pools))
+	for _, pool := range pools {
+		bz, err := poolJSON(cdc, pool)
+		if err != nil {
+			return nil, err
+		}
+		poolsJSON = append(poolsJSON, bz)
+	}
+	return json.MarshalIndent(poolsJSON, "", "  ")
+}
+
+// poolJSON marshals a pool with its type, cosmwasm pools being marshalled as their store model.
+func poolJSON(cdc codec.Codec, pool poolmanagertypes.PoolI) ([]byte, error) {
+	if cwPool, ok := pool.(cosmwasmpooltypes.CosmWasmExtension); ok {
+		return cdc.MarshalInterfaceJSON(cwPool.GetStoreModel())
+	}
+	return cdc.MarshalInterfaceJSON(pool)
+}
+
+func queryStatePositions(app *osmosis.OsmosisApp, ctx sdk.Context, args []string) ([]byte, error) {
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	// the keys of the pool positions are the pool position prefix of the pool, followed by the separator and the position id.
+	store := ctx.KVStore(app.GetKey(cltypes.StoreKey))
+	poolPositionPrefix := cltypes.KeyPoolPosition(poolId)
+	positionIds, err := osmoutils.GatherValuesFromStorePrefixWithKeyParser(store, poolPositionPrefix, func(key, _ []byte) (uint64, error) {
+		positionIdBz := key[len(poolPositionPrefix)+len(cltypes.KeySeparator):]
+		if len(positionIdBz) != 8 {
+			return 0, fmt.Errorf("invalid pool position key %x", key)
+		}
+		return sdk.BigEndianToUint64(positionIdBz), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]proto.Message, 0, len(positionIds))
+	for _, positionId := range positionIds {
+		position, err := app.ConcentratedLiquidityKeeper.GetPosition(ctx, positionId)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, &position)
+	}
+	return protoJSONList(app.AppCodec(), positions)
+}
+
+func queryStatePosition(app *osmosis.OsmosisApp, ctx sdk.Context, args []string) ([]byte, error) {
+	positionId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	position, err := app.ConcentratedLiquidityKeeper.GetPosition(ctx, positionId)
+	if err != nil {
+		return nil, err
+	}
+	return app.AppCodec().MarshalJSON(&position)
+}
+
+func queryStateLocks(app *osmosis.OsmosisApp, ctx sdk.Context, args []string) ([]byte, error) {
+	var locks []proto.Message
+	if len(args) == 1 {
+		owner, err := sdk.AccAddressFromBech32(args[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, lock := range app.LockupKeeper.GetAccountPeriodLocks(ctx, owner) {
+			lock := lock
+			locks = append(locks, &lock)
+		}
+	} else {
+		allLocks, err := app.LockupKeeper.GetPeriodLocks(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lock := range allLocks {
+			lock := lock
+			locks = append(locks, &lock)
+		}
+	}
+	return protoJSONList(app.AppCodec(), locks)
+}
+
+func queryStateLock(app *osmosis.OsmosisApp, ctx sdk.Context, args []string) ([]byte, error) {
+	lockId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	lock, err := app.LockupKeeper.GetLockByID(ctx, lockId)
+	if err != nil {
+		return nil, err
+	}
+	return app.AppCodec().MarshalJSON(lock)
+}
+
+func queryStateGauges(app *osmosis.OsmosisApp, ctx sdk.Context, args []string) ([]byte, error) {
+	if len(args) == 1 {
+		gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		gauge, err := app.IncentivesKeeper.GetGaugeByID(ctx, gaugeId)
+		if err != nil {
+			return nil, err
+		}
+		return app.AppCodec().MarshalJSON(gauge)
+	}
+
+	gauges := app.IncentivesKeeper.GetGauges(ctx)
+	gaugeMsgs := make([]proto.Message, 0, len(gauges))
+	for _, gauge := range gauges {
+		gauge := gauge
+		gaugeMsgs = append(gaugeMsgs, &gauge)
+	}
+	return protoJSONList(app.AppCodec(), gaugeMsgs)
+}
+
+func queryStateTwapRecords(app *osmosis.OsmosisApp, ctx sdk.Context, args []string) ([]byte, error) {
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	records, err := app.TwapKeeper.GetAllMostRecentRecordsForPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	recordMsgs := make([]proto.Message, 0, len(records))
+	for _, record := range records {
+		record := record
+		recordMsgs = append(recordMsgs, &record)
+	}
+	return protoJSONList(app.AppCodec(), recordMsgs)
+}
+
+// protoJSONList marshals the messages into a json array.
+func protoJSONList(cdc codec.JSONCodec, msgs []proto.Message) ([]byte, error) {
+	msgsJSON := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		bz, err := cdc.MarshalJSON(msg)
+		if err != nil {
+			return nil, err
+		}
+		msgsJSON = append(msgsJSON, bz)
+	}
+	return json.MarshalIndent(msgsJSON, "", "  ")
+}
@@ -0,0 +1,257 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	clmodel "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v16/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v16/x/twap/types"
+)
+
+// rawStoreEntry is a store entry dumped by the raw state command. The kind, decoded key and value
+// are only set when the key matches a known prefix of the store.
+type rawStoreEntry struct {
+	Key        string          `json:"key"`
+	Kind       string          `json:"kind,omitempty"`
+	DecodedKey string          `json:"decoded_key,omitempty"`
+	Value      json.RawMessage `json:"value,omitempty"`
+	RawValue   string          `json:"raw_value,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// keyDecoder decodes the entries of a store under a key prefix.
+type keyDecoder struct {
+	prefix []byte
+	kind   string
+	// decodeKey decodes the key, stripped of the prefix.
+	decodeKey func(key []byte) (string, error)
+	// decodeValue decodes the value into json, the value is dumped as hex when nil.
+	decodeValue func(cdc codec.Codec, bz []byte) ([]byte, error)
+}
+
+// storeKeyDecoders are the key decoders of the module stores, by store key.
+// The layouts mirror the keys.go files of the modules.
+var storeKeyDecoders = map[string][]keyDecoder{
+	twaptypes.StoreKey: {
+		{prefix: []byte("recent_twap" + twaptypes.KeySeparator), kind: "most_recent_twap", decodeKey: separatedKey(twaptypes.KeySeparator, "pool_id", "denom0", "denom1"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &twaptypes.TwapRecord{} })},
+		{prefix: []byte(twaptypes.HistoricalTWAPTimeIndexPrefix), kind: "historical_twap_by_time", decodeKey: separatedKey(twaptypes.KeySeparator, "time", "pool_id", "denom0", "denom1"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &twaptypes.TwapRecord{} })},
+		{prefix: []byte(twaptypes.HistoricalTWAPPoolIndexPrefix), kind: "historical_twap_by_pool", decodeKey: separatedKey(twaptypes.KeySeparator, "pool_id", "denom0", "denom1", "time"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &twaptypes.TwapRecord{} })},
+	},
+	cltypes.StoreKey: {
+		{prefix: cltypes.TickPrefix, kind: "tick", decodeKey: decodeCLTickKey, decodeValue: protoValue(func() codec.ProtoMarshaler { return &clmodel.TickInfo{} })},
+		{prefix: cltypes.PositionPrefix, kind: "address_pool_position", decodeKey: decodeCLAddressPoolPositionKey},
+		{prefix: cltypes.PoolPrefix, kind: "pool", decodeKey: decimalKey("pool_id"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &clmodel.Pool{} })},
+		{prefix: cltypes.IncentivePrefix, kind: "incentive_record", decodeKey: separatedKey(cltypes.KeySeparator, "", "pool_id", "min_uptime_index", "denom", "incentive_creator"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &cltypes.IncentiveRecordBody{} })},
+		{prefix: cltypes.KeyNextGlobalPositionId, kind: "next_position_id", decodeKey: emptyKey, decodeValue: protoValue(func() codec.ProtoMarshaler { return &gogotypes.UInt64Value{} })},
+		{prefix: cltypes.PositionIdPrefix, kind: "position", decodeKey: decimalKey("position_id"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &clmodel.Position{} })},
+		{prefix: cltypes.PoolPositionPrefix, kind: "pool_position", decodeKey: decodeCLPoolPositionKey},
+		{prefix: cltypes.PositionToLockPrefix, kind: "position_to_lock", decodeKey: decimalKey("position_id"), decodeValue: bigEndianValue},
+		{prefix: cltypes.FullRangeLiquidityPrefix, kind: "full_range_liquidity", decodeKey: decimalKey("pool_id"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &sdk.DecProto{} })},
+		{prefix: cltypes.BalancerFullRangePrefix, kind: "balancer_full_range", decodeKey: separatedKey(cltypes.KeySeparator, "", "cl_pool_id", "balancer_pool_id", "uptime_index"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &sdk.DecProto{} })},
+		{prefix: cltypes.LockToPositionPrefix, kind: "lock_to_position", decodeKey: decimalKey("lock_id"), decodeValue: bigEndianValue},
+		{prefix: []byte("accum/acc/pos/"), kind: "accumulator_position", decodeKey: quotedKey, decodeValue: protoValue(func() codec.ProtoMarshaler { return &accum.Record{} })},
+		{prefix: []byte("accum/acc/"), kind: "accumulator", decodeKey: quotedKey, decodeValue: protoValue(func() codec.ProtoMarshaler { return &accum.AccumulatorContent{} })},
+	},
+	lockuptypes.StoreKey: {
+		{prefix: lockuptypes.KeyLastLockID, kind: "last_lock_id", decodeKey: emptyKey, decodeValue: bigEndianValue},
+		{prefix: lockuptypes.KeyPrefixPeriodLock, kind: "lock", decodeKey: indexedBigEndianKey(lockuptypes.KeyIndexSeparator, "lock_id"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &lockuptypes.PeriodLock{} })},
+		{prefix: lockuptypes.KeyPrefixSyntheticLockup, kind: "synthetic_lock", decodeKey: decodeSyntheticLockKey, decodeValue: protoValue(func() codec.ProtoMarshaler { return &lockuptypes.SyntheticLock{} })},
+	},
+	incentivestypes.StoreKey: {
+		{prefix: incentivestypes.KeyLastGaugeID, kind: "last_gauge_id", decodeKey: emptyKey, decodeValue: bigEndianValue},
+		{prefix: incentivestypes.KeyPrefixPeriodGauge, kind: "gauge", decodeKey: indexedBigEndianKey(incentivestypes.KeyIndexSeparator, "gauge_id"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &incentivestypes.Gauge{} })},
+		{prefix: incentivestypes.KeyPrefixUpcomingGauges, kind: "upcoming_gauge_ref"},
+		{prefix: incentivestypes.KeyPrefixActiveGauges, kind: "active_gauge_ref"},
+		{prefix: incentivestypes.KeyPrefixFinishedGauges, kind: "finished_gauge_ref"},
+		{prefix: incentivestypes.KeyPrefixGaugesByDenom, kind: "gauges_by_denom"},
+	},
+	gammtypes.StoreKey: {
+		{prefix: gammtypes.KeyNextGlobalPoolId, kind: "next_pool_id", decodeKey: emptyKey, decodeValue: protoValue(func() codec.ProtoMarshaler { return &gogotypes.UInt64Value{} })},
+		{prefix: gammtypes.KeyPrefixPools, kind: "pool", decodeKey: bigEndianKey("pool_id"), decodeValue: gammPoolValue},
+		{prefix: gammtypes.KeyTotalLiquidity, kind: "total_liquidity", decodeKey: quotedKey},
+		{prefix: gammtypes.KeyPrefixMigrationInfoBalancerPool, kind: "migration_info_balancer_pool", decodeKey: bigEndianKey("balancer_pool_id")},
+		{prefix: gammtypes.KeyPrefixMigrationInfoCLPool, kind: "migration_info_cl_pool", decodeKey: bigEndianKey("cl_pool_id")},
+	},
+	poolmanagertypes.StoreKey: {
+		{prefix: poolmanagertypes.KeyNextGlobalPoolId, kind: "next_pool_id", decodeKey: emptyKey, decodeValue: protoValue(func() codec.ProtoMarshaler { return &gogotypes.UInt64Value{} })},
+		{prefix: poolmanagertypes.SwapModuleRouterPrefix, kind: "pool_route", decodeKey: decimalKey("pool_id"), decodeValue: protoValue(func() codec.ProtoMarshaler { return &poolmanagertypes.ModuleRoute{} })},
+	},
+}
+
+// decodeStoreEntry decodes an entry of the given store with the decoder of the longest prefix matching its key.
+// Entries without a matching decoder, or failing to decode, are dumped as hex.
+func decodeStoreEntry(cdc codec.Codec, storeName string, key, value []byte) rawStoreEntry {
+	entry := rawStoreEntry{Key: hex.EncodeToString(key)}
+
+	var decoder *keyDecoder
+	for i, candidate := range storeKeyDecoders[storeName] {
+		if bytes.HasPrefix(key, candidate.prefix) && (decoder == nil || len(candidate.prefix) > len(decoder.prefix)) {
+			decoder = &storeKeyDecoders[storeName][i]
+		}
+	}
+	if decoder == nil {
+		entry.RawValue = hex.EncodeToString(value)
+		return entry
+	}
+
+	entry.Kind = decoder.kind
+	if decoder.decodeKey != nil {
+		decodedKey, err := decoder.decodeKey(key[len(decoder.prefix):])
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		entry.DecodedKey = decodedKey
+	}
+	if decoder.decodeValue == nil {
+		entry.RawValue = hex.EncodeToString(value)
+		return entry
+	}
+	decodedValue, err := decoder.decodeValue(cdc, value)
+	if err != nil {
+		entry.Error = err.Error()
+		entry.RawValue = hex.EncodeToString(value)
+		return entry
+	}
+	entry.Value = decodedValue
+	return entry
+}
+
+func emptyKey(key []byte) (string, error) {
+	if len(key) != 0 {
+		return "", fmt.Errorf("unexpected key suffix %x", key)
+	}
+	return "", nil
+}
+
+func quotedKey(key []byte) (string, error) {
+	return strconv.Quote(string(key)), nil
+}
+
+func decimalKey(name string) func(key []byte) (string, error) {
+	return func(key []byte) (string, error) {
+		id, err := strconv.ParseUint(string(key), 10, 64)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s=%d", name, id), nil
+	}
+}
+
+func bigEndianKey(name string) func(key []byte) (string, error) {
+	return func(key []byte) (string, error) {
+		if len(key) != 8 {
+			return "", fmt.Errorf("invalid big endian %s %x", name, key)
+		}
+		return fmt.Sprintf("%s=%d", name, sdk.BigEndianToUint64(key)), nil
+	}
+}
+
+// indexedBigEndianKey decodes the keys made of an index separator followed by a big endian id.
+func indexedBigEndianKey(separator []byte, name string) func(key []byte) (string, error) {
+	return func(key []byte) (string, error) {
+		if !bytes.HasPrefix(key, separator) {
+			return "", fmt.Errorf("missing separator in key %x", key)
+		}
+		return bigEndianKey(name)(key[len(separator):])
+	}
+}
+
+// separatedKey decodes the keys made of separated named parts, unnamed parts being expected to be empty.
+func separatedKey(separator string, names ...string) func(key []byte) (string, error) {
+	return func(key []byte) (string, error) {
+		parts := strings.Split(string(key), separator)
+		if len(parts) != len(names) {
+			return "", fmt.Errorf("expected %d parts separated by %q in key %q", len(names), separator, key)
+		}
+		decoded := make([]string, 0, len(names))
+		for i, name := range names {
+			if name == "" {
+				continue
+			}
+			decoded = append(decoded, fmt.Sprintf("%s=%s", name, parts[i]))
+		}
+		return strings.Join(decoded, " "), nil
+	}
+}
+
+// decodeCLTickKey decodes the big endian pool id, followed by the encoded tick index.
+func decodeCLTickKey(key []byte) (string, error) {
+	if len(key) != cltypes.KeyTickLengthBytes-len(cltypes.TickPrefix) {
+		return "", fmt.Errorf("invalid tick key length %d", len(key))
+	}
+	tickIndex, err := cltypes.TickIndexFromBytes(key[8:])
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("pool_id=%d tick_index=%d", sdk.BigEndianToUint64(key[:8]), tickIndex), nil
+}
+
+// decodeCLAddressPoolPositionKey decodes the hex encoded owner address, the pool id and the position id.
+func decodeCLAddressPoolPositionKey(key []byte) (string, error) {
+	parts := strings.Split(string(key), cltypes.KeySeparator)
+	if len(parts) != 4 || parts[0] != "" {
+		return "", fmt.Errorf("invalid position key %q", key)
+	}
+	addr, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("address=%s pool_id=%s position_id=%s", sdk.AccAddress(addr), parts[2], parts[3]), nil
+}
+
+// decodeCLPoolPositionKey decodes the big endian pool id and position id.
+func decodeCLPoolPositionKey(key []byte) (string, error) {
+	if len(key) != 8+len(cltypes.KeySeparator)+8 {
+		return "", fmt.Errorf("invalid pool position key %x", key)
+	}
+	return fmt.Sprintf("pool_id=%d position_id=%d", sdk.BigEndianToUint64(key[:8]), sdk.BigEndianToUint64(key[8+len(cltypes.KeySeparator):])), nil
+}
+
+// decodeSyntheticLockKey decodes the big endian lock id and the synthetic denom.
+func decodeSyntheticLockKey(key []byte) (string, error) {
+	parts := bytes.SplitN(key, lockuptypes.KeyIndexSeparator, 3)
+	if len(parts) != 3 || len(parts[0]) != 0 || len(parts[1]) != 8 {
+		return "", fmt.Errorf("invalid synthetic lock key %x", key)
+	}
+	return fmt.Sprintf("lock_id=%d synth_denom=%s", sdk.BigEndianToUint64(parts[1]), parts[2]), nil
+}
+
+func protoValue(newMsg func() codec.ProtoMarshaler) func(cdc codec.Codec, bz []byte) ([]byte, error) {
+	return func(cdc codec.Codec, bz []byte) ([]byte, error) {
+		msg := newMsg()
+		if err := cdc.Unmarshal(bz, msg); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(msg)
+	}
+}
+
+func bigEndianValue(_ codec.Codec, bz []byte) ([]byte, error) {
+	if len(bz) != 8 {
+		return nil, fmt.Errorf("invalid big endian value %x", bz)
+	}
+	return json.Marshal(strconv.FormatUint(sdk.BigEndianToUint64(bz), 10))
+}
+
+func gammPoolValue(cdc codec.Codec, bz []byte) ([]byte, error) {
+	var pool gammtypes.CFMMPoolI
+	if err := cdc.UnmarshalInterface(bz, &pool); err != nil {
+		return nil, err
+	}
+	return cdc.MarshalInterfaceJSON(pool)
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	osmosis "github.com/osmosis-labs/osmosis/v16/app"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v16/x/twap/types"
+)
+
+func TestDecodeStoreEntry(t *testing.T) {
+	cdc := osmosis.MakeEncodingConfig().Marshaler
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	recordTime := time.Unix(1000, 0).UTC()
+
+	twapRecord := twaptypes.TwapRecord{
+		PoolId:                      1,
+		Asset0Denom:                 "uatom",
+		Asset1Denom:                 "uosmo",
+		Time:                        recordTime,
+		P0LastSpotPrice:             sdk.OneDec(),
+		P1LastSpotPrice:             sdk.OneDec(),
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
+	}
+	twapRecordBz, err := cdc.Marshal(&twapRecord)
+	require.NoError(t, err)
+	twapRecordJSON, err := cdc.MarshalJSON(&twapRecord)
+	require.NoError(t, err)
+
+	lock := lockuptypes.NewPeriodLock(7, addr, addr.String(), time.Hour, time.Time{}, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10)))
+	lockBz, err := cdc.Marshal(&lock)
+	require.NoError(t, err)
+	lockJSON, err := cdc.MarshalJSON(&lock)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		storeName string
+		key       []byte
+		value     []byte
+		expected  rawStoreEntry
+	}{
+		"most recent twap record": {
+			storeName: twaptypes.StoreKey,
+			key:       twaptypes.FormatMostRecentTWAPKey(1, "uatom", "uosmo"),
+			value:     twapRecordBz,
+			expected: rawStoreEntry{
+				Kind:       "most_recent_twap",
+				DecodedKey: "pool_id=00000000000000000001 denom0=uatom denom1=uosmo",
+				Value:      twapRecordJSON,
+			},
+		},
+		"historical twap record by pool": {
+			storeName: twaptypes.StoreKey,
+			key:       twaptypes.FormatHistoricalPoolIndexTWAPKey(1, "uatom", "uosmo", recordTime),
+			value:     twapRecordBz,
+			expected: rawStoreEntry{
+				Kind:       "historical_twap_by_pool",
+				DecodedKey: "pool_id=1 denom0=uatom denom1=uosmo time=1970-01-01T00:16:40.000000000",
+				Value:      twapRecordJSON,
+			},
+		},
+		"negative cl tick": {
+			storeName: cltypes.StoreKey,
+			key:       cltypes.KeyTick(2, -100),
+			value:     []byte{0xFF},
+			expected: rawStoreEntry{
+				Kind:       "tick",
+				DecodedKey: "pool_id=2 tick_index=-100",
+				RawValue:   "ff",
+				Error:      "unexpected EOF",
+			},
+		},
+		"cl position of an address": {
+			storeName: cltypes.StoreKey,
+			key:       cltypes.KeyAddressPoolIdPositionId(addr, 2, 3),
+			value:     []byte{1},
+			expected: rawStoreEntry{
+				Kind:       "address_pool_position",
+				DecodedKey: "address=" + addr.String() + " pool_id=2 position_id=3",
+				RawValue:   "01",
+			},
+		},
+		"cl pool position": {
+			storeName: cltypes.StoreKey,
+			key:       cltypes.KeyPoolPositionPositionId(2, 3),
+			value:     []byte{1},
+			expected: rawStoreEntry{
+				Kind:       "pool_position",
+				DecodedKey: "pool_id=2 position_id=3",
+				RawValue:   "01",
+			},
+		},
+		"lock": {
+			storeName: lockuptypes.StoreKey,
+			key:       append(append(lockuptypes.KeyPrefixPeriodLock, lockuptypes.KeyIndexSeparator...), sdk.Uint64ToBigEndian(7)...),
+			value:     lockBz,
+			expected: rawStoreEntry{
+				Kind:       "lock",
+				DecodedKey: "lock_id=7",
+				Value:      lockJSON,
+			},
+		},
+		"unknown prefix": {
+			storeName: lockuptypes.StoreKey,
+			key:       []byte{0x42, 0x01},
+			value:     []byte{0x02},
+			expected: rawStoreEntry{
+				RawValue: "02",
+			},
+		},
+		"unknown store": {
+			storeName: "unknown",
+			key:       []byte{0x01},
+			value:     []byte{0x02},
+			expected: rawStoreEntry{
+				RawValue: "02",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.expected.Key = hex.EncodeToString(tc.key)
+			entry := decodeStoreEntry(cdc, tc.storeName, tc.key, tc.value)
+			if tc.expected.Error != "" {
+				require.Contains(t, entry.Error, tc.expected.Error)
+				entry.Error = tc.expected.Error
+			}
+			require.Equal(t, tc.expected, entry)
+		})
+	}
+}
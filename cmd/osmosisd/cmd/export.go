@@ -0,0 +1,209 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/cobra"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	osmosis "github.com/osmosis-labs/osmosis/v16/app"
+)
+
+const (
+	flagExportOutputDocument = "output-document"
+	flagExportParallelism    = "parallelism"
+	// flagTraceStore is the flag of the SDK export command enabling the KVStore tracing.
+	flagTraceStore = "trace-store"
+)
+
+// appLoader creates the app on the given DB and loads it at the given height, the latest one if -1.
+type appLoader func(logger log.Logger, db tmdb.DB, traceStore io.Writer, height int64, appOpts servertypes.AppOptions) (*osmosis.OsmosisApp, error)
+
+// ExportCmd returns the export command, replacing the SDK one. It writes the same genesis, byte for byte,
+// but streams the application state to the output, the independent modules being exported in parallel.
+// Like the SDK command, it returns the genesis file as is when there is no loader or the app is uninitialized.
+func ExportCmd(loadApp appLoader, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export state to JSON",
+		Long: `Export state to JSON.
+The application state is streamed to the output instead of being held in memory: the modules with large state,
+such as concentrated liquidity, lockup, twap and incentives, export their genesis one element at a time, and up to
+--parallelism modules are exported concurrently. The exported genesis is the same as the one of the SDK export command.
+
+Example:
+	osmosisd export --output-document genesis.json
+	osmosisd export --height 10000000 --parallelism 1 --modules-to-export concentratedliquidity,lockup`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			if _, err := os.Stat(config.GenesisFile()); os.IsNotExist(err) {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+			modulesToExport, _ := cmd.Flags().GetStringSlice(server.FlagModulesToExport)
+			outputDocument, _ := cmd.Flags().GetString(flagExportOutputDocument)
+			traceWriterFile, _ := cmd.Flags().GetString(flagTraceStore)
+			parallelism, err := cmd.Flags().GetInt(flagExportParallelism)
+			if err != nil {
+				return err
+			}
+
+			// like the SDK export command, the genesis is written to stderr by default.
+			out := cmd.OutOrStderr()
+			var file *os.File
+			if outputDocument != "" {
+				file, err = os.Create(outputDocument)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+
+			if loadApp == nil {
+				cmd.PrintErrln("WARNING: App exporter not defined. Returning genesis file.")
+				return writeGenesisFile(out, file, config.GenesisFile())
+			}
+
+			db, err := tmdb.NewDB("application", appDBBackend(), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// the trace writer is left nil, not a nil file, when tracing is disabled.
+			var traceWriter io.Writer
+			if traceWriterFile != "" {
+				traceFile, err := os.OpenFile(traceWriterFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+				if err != nil {
+					return err
+				}
+				defer traceFile.Close()
+				traceWriter = traceFile
+			}
+
+			app, err := loadApp(serverCtx.Logger, db, traceWriter, height, serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %v", err)
+			}
+			if app.LastBlockHeight() == 0 {
+				cmd.PrintErrln("WARNING: App is uninitialized. Returning genesis file.")
+				return writeGenesisFile(out, file, config.GenesisFile())
+			}
+			exported, err := app.ExportValidators(forZeroHeight, jailAllowedAddrs)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %v", err)
+			}
+
+			doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			// the application state is written in place of the null one.
+			doc.AppState = json.RawMessage(`null`)
+			doc.Validators = exported.Validators
+			doc.InitialHeight = exported.Height
+			doc.ConsensusParams = &tmproto.ConsensusParams{
+				Block: tmproto.BlockParams{
+					MaxBytes:   exported.ConsensusParams.Block.MaxBytes,
+					MaxGas:     exported.ConsensusParams.Block.MaxGas,
+					TimeIotaMs: doc.ConsensusParams.Block.TimeIotaMs,
+				},
+				Evidence: tmproto.EvidenceParams{
+					MaxAgeNumBlocks: exported.ConsensusParams.Evidence.MaxAgeNumBlocks,
+					MaxAgeDuration:  exported.ConsensusParams.Evidence.MaxAgeDuration,
+					MaxBytes:        exported.ConsensusParams.Evidence.MaxBytes,
+				},
+				Validator: tmproto.ValidatorParams{
+					PubKeyTypes: exported.ConsensusParams.Validator.PubKeyTypes,
+				},
+			}
+
+			// NOTE: Tendermint uses a custom JSON decoder for GenesisDoc
+			// (except for stuff inside AppState). Inside AppState, we're free
+			// to encode as protobuf or amino.
+			encoded, err := tmjson.Marshal(doc)
+			if err != nil {
+				return err
+			}
+			encoded, err = sdk.SortJSON(encoded)
+			if err != nil {
+				return err
+			}
+			before, after, found := bytes.Cut(encoded, []byte(`"app_state":null`))
+			if !found {
+				return fmt.Errorf("app_state not found in the genesis document")
+			}
+
+			w := bufio.NewWriter(out)
+			w.Write(before)
+			w.WriteString(`"app_state":`)
+			if err := app.WriteAppState(w, modulesToExport, parallelism); err != nil {
+				return fmt.Errorf("error exporting state: %v", err)
+			}
+			w.Write(after)
+			w.WriteByte('\n')
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if file != nil {
+				return file.Close()
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().Bool(server.FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().StringSlice(server.FlagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
+	cmd.Flags().StringSlice(server.FlagModulesToExport, []string{}, "Comma-separated list of modules to export. If empty, will export all modules")
+	cmd.Flags().String(flagExportOutputDocument, "", "Exported genesis is written to the given file instead of stderr")
+	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
+	cmd.Flags().Int(flagExportParallelism, runtime.NumCPU(), "Number of modules exported concurrently, 1 to export them sequentially")
+
+	return cmd
+}
+
+// writeGenesisFile writes the genesis file to out, closing file if set.
+func writeGenesisFile(out io.Writer, file *os.File, genesisFile string) error {
+	genesis, err := os.ReadFile(genesisFile)
+	if err != nil {
+		return err
+	}
+	if _, err := out.Write(genesis); err != nil {
+		return err
+	}
+	if file != nil {
+		return file.Close()
+	}
+	return nil
+}
@@ -4,41 +4,57 @@ package cmd
 
 import (
 	"fmt"
-	"os/exec"
 	"strconv"
 
+	"github.com/cosmos/iavl"
 	"github.com/spf13/cobra"
 
-	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/util"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmstore "github.com/tendermint/tendermint/store"
 	tmdb "github.com/tendermint/tm-db"
 
-	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/tendermint/tendermint/config"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/app/keepers"
 )
 
 const (
-	batchMaxSize      = 1000
-	kValidators       = "validatorsKey:"
-	kConsensusParams  = "consensusParamsKey:"
-	kABCIResponses    = "abciResponsesKey:"
-	fullHeight        = "full_height"
-	minHeight         = "min_height"
-	defaultFullHeight = "188000"
-	defaultMinHeight  = "1000"
+	batchMaxSize            = 1000
+	kValidators             = "validatorsKey:"
+	kConsensusParams        = "consensusParamsKey:"
+	kABCIResponses          = "abciResponsesKey:"
+	fullHeight              = "full_height"
+	minHeight               = "min_height"
+	appKeepRecent           = "app_keep_recent"
+	dryRun                  = "dry-run"
+	defaultFullHeight       = "188000"
+	defaultMinHeight        = "1000"
+	defaultAppKeepRecent    = "0"
+	blockStoreDBName        = "blockstore"
+	stateDBName             = "state"
+	applicationDBName       = "application"
+	iavlStorePrefixFmt      = "s/k:%s/"
+	commitInfoKeyPrefix     = "s/"
+	iavlCacheSize           = 10000
+	iavlVersionsBatchMaxLen = 1000
+	// valSetCheckpointInterval is the interval at which tendermint stores the validator set in full.
+	valSetCheckpointInterval = 100000
 )
 
-// forceprune gets cmd to convert any bech32 address to an osmo prefix.
+// forceprune gets cmd to prune and compact the blockstore, state and application DBs of a stopped node.
 func forceprune() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "forceprune",
-		Short: "Forceprune option prunes and compacts blockstore.db and state.db.",
-		Long: `Forceprune option prunes and compacts blockstore.db and state.db. One needs to shut down chain before running forceprune. By default it keeps last 188000 blocks (approximately 2 weeks of data) blockstore and state db (validator and consensus information) and 1000 blocks of abci responses from state.db. Everything beyond these heights in blockstore and state.db is pruned. ABCI Responses are stored in index db and so redundant especially if one is running pruned nodes. As a result we are removing ABCI data from state.db aggressively by default. One can override height for blockstore.db and state.db by using -f option and for abci response by using -m option. 
+		Short: "Forceprune option prunes and compacts blockstore.db, state.db and application.db.",
+		Long: `Forceprune option prunes and compacts blockstore.db, state.db and application.db. One needs to shut down chain before running forceprune, which is checked through the lock files of the DBs. By default it keeps last 188000 blocks (approximately 2 weeks of data) blockstore and state db (validator and consensus information) and 1000 blocks of abci responses from state.db. Everything beyond these heights is pruned. application.db is only pruned with the -a option, which keeps the given number of recent versions of its IAVL stores and their commit infos. ABCI Responses are stored in index db and so redundant especially if one is running pruned nodes. As a result we are removing ABCI data from state.db aggressively by default. One can override height for blockstore.db and state.db by using -f option and for abci response by using -m option.
+The blockstore and state DBs are opened with the db_backend of config.toml, and the application DB with the backend osmosisd was built with. With --dry-run, nothing is pruned and the number of keys and bytes each step would remove is reported.
 Example:
-	osmosisd forceprune -f 188000 -m 1000,
-which would keep blockchain and state data of last 188000 blocks (approximately 2 weeks) and ABCI responses of last 1000 blocks.`,
+	osmosisd forceprune -f 188000 -m 1000 -a 188000,
+which would keep blockchain and state data of last 188000 blocks (approximately 2 weeks), ABCI responses of last 1000 blocks and application state of last 188000 blocks.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			fullHeightFlag, err := cmd.Flags().GetString(fullHeight)
 			if err != nil {
@@ -50,16 +66,14 @@ which would keep blockchain and state data of last 188000 blocks (approximately
 				return err
 			}
 
-			clientCtx := client.GetClientContextFromCmd(cmd)
-			conf := config.DefaultConfig()
-			dbPath := clientCtx.HomeDir + "/" + conf.DBPath
-
-			cmdr := exec.Command("osmosisd", "status")
-			err = cmdr.Run()
+			appKeepRecentFlag, err := cmd.Flags().GetString(appKeepRecent)
+			if err != nil {
+				return err
+			}
 
-			if err == nil {
-				// continue only if throws errror
-				return nil
+			isDryRun, err := cmd.Flags().GetBool(dryRun)
+			if err != nil {
+				return err
 			}
 
 			fullHeight, err := strconv.ParseInt(fullHeightFlag, 10, 64)
@@ -72,20 +86,49 @@ which would keep blockchain and state data of last 188000 blocks (approximately
 				return err
 			}
 
-			startHeight, currentHeight, err := pruneBlockStoreAndGetHeights(dbPath, fullHeight)
+			appKeepRecent, err := strconv.ParseInt(appKeepRecentFlag, 10, 64)
 			if err != nil {
 				return err
 			}
 
-			err = compactBlockStore(dbPath)
+			conf := server.GetServerContextFromCmd(cmd).Config
+			dbPath := conf.DBDir()
+			tmBackend := tmdb.BackendType(conf.DBBackend)
+			appBackend := tmdb.GoLevelDBBackend
+			if sdk.DBBackend != "" {
+				appBackend = tmdb.BackendType(sdk.DBBackend)
+			}
+
+			for _, name := range []string{blockStoreDBName, stateDBName, applicationDBName} {
+				if err := checkDBUnlocked(dbPath, name); err != nil {
+					return err
+				}
+			}
+
+			var startHeight, currentHeight int64
+			err = forcepruneDB(dbPath, blockStoreDBName, tmBackend, isDryRun, func(db tmdb.DB) (stats pruneStats, err error) {
+				startHeight, currentHeight, stats, err = pruneBlockStoreAndGetHeights(db, fullHeight, isDryRun)
+				return stats, err
+			})
 			if err != nil {
 				return err
 			}
 
-			err = forcepruneStateStore(dbPath, startHeight, currentHeight, minHeight, fullHeight)
+			err = forcepruneDB(dbPath, stateDBName, tmBackend, isDryRun, func(db tmdb.DB) (pruneStats, error) {
+				return forcepruneStateStore(db, startHeight, currentHeight, minHeight, fullHeight, isDryRun)
+			})
 			if err != nil {
 				return err
 			}
+
+			if appKeepRecent > 0 {
+				err = forcepruneDB(dbPath, applicationDBName, appBackend, isDryRun, func(db tmdb.DB) (pruneStats, error) {
+					return forcepruneApplicationStore(db, keepers.KVStoreKeys(), appKeepRecent, isDryRun)
+				})
+				if err != nil {
+					return err
+				}
+			}
 			fmt.Println("Done ...")
 
 			return nil
@@ -94,120 +137,362 @@ which would keep blockchain and state data of last 188000 blocks (approximately
 
 	cmd.Flags().StringP(fullHeight, "f", defaultFullHeight, "Full height to chop to")
 	cmd.Flags().StringP(minHeight, "m", defaultMinHeight, "Min height for ABCI to chop to")
+	cmd.Flags().StringP(appKeepRecent, "a", defaultAppKeepRecent, "Number of recent versions of the application state to keep, 0 to keep all of them")
+	cmd.Flags().Bool(dryRun, false, "Report how many keys and bytes would be pruned, without pruning anything")
 	return cmd
 }
 
-// pruneBlockStoreAndGetHeights prunes blockstore and returns the startHeight and currentHeight.
-func pruneBlockStoreAndGetHeights(dbPath string, fullHeight int64) (
-	startHeight int64, currentHeight int64, err error,
-) {
-	opts := opt.Options{
-		DisableSeeksCompaction: true,
-	}
+// pruneStats counts the keys removed by a pruning step, and their size with their values.
+type pruneStats struct {
+	keys  uint64
+	bytes uint64
+}
 
-	db_bs, err := tmdb.NewGoLevelDBWithOpts("blockstore", dbPath, &opts)
+// addKey counts the given key if it is present in the DB.
+func (s *pruneStats) addKey(db tmdb.DB, key []byte) error {
+	value, err := db.Get(key)
 	if err != nil {
-		return 0, 0, err
+		return err
 	}
+	if value != nil {
+		s.addEntry(key, value)
+	}
+	return nil
+}
 
-	defer db_bs.Close()
+func (s *pruneStats) addEntry(key, value []byte) {
+	s.keys++
+	s.bytes += uint64(len(key) + len(value))
+}
 
-	bs := tmstore.NewBlockStore(db_bs)
-	startHeight = bs.Base()
-	currentHeight = bs.Height()
+func (s pruneStats) String() string {
+	return fmt.Sprintf("%d keys, %d bytes", s.keys, s.bytes)
+}
 
-	fmt.Println("Pruning Block Store ...")
-	prunedBlocks, err := bs.PruneBlocks(currentHeight - fullHeight)
+// forcepruneDB opens the DB with the given backend, prunes it and then compacts it, unless running dry.
+func forcepruneDB(dbPath, name string, backend tmdb.BackendType, isDryRun bool, prune func(db tmdb.DB) (pruneStats, error)) error {
+	db, err := openForcepruneDB(dbPath, name, backend)
 	if err != nil {
-		return 0, 0, err
+		return err
 	}
-	fmt.Println("Pruned Block Store ...", prunedBlocks)
+	defer db.Close()
 
-	// N.B: We duplicate the call to db_bs.Close() on top of
-	// the call in defer statement above to make sure that the resources
-	// are properly released and any potential error from Close()
-	// is handled. Close() should be idempotent so this is acceptable.
-	if err := db_bs.Close(); err != nil {
-		return 0, 0, err
+	fmt.Printf("Pruning %s.db ...\n", name)
+	stats, err := prune(db)
+	if err != nil {
+		return err
+	}
+	if isDryRun {
+		fmt.Printf("Would prune %s from %s.db\n", stats, name)
+		return nil
 	}
+	fmt.Printf("Pruned %s from %s.db\n", stats, name)
 
-	return startHeight, currentHeight, nil
+	fmt.Printf("Compacting %s.db ...\n", name)
+	return compactDB(db, name)
 }
 
-// compactBlockStore compacts block storage.
-func compactBlockStore(dbPath string) (err error) {
-	compactOpts := opt.Options{
-		DisableSeeksCompaction: true,
+// openForcepruneDB opens the DB with the given backend, disabling seeks compaction for goleveldb.
+func openForcepruneDB(dbPath, name string, backend tmdb.BackendType) (tmdb.DB, error) {
+	if backend == tmdb.GoLevelDBBackend {
+		return tmdb.NewGoLevelDBWithOpts(name, dbPath, &opt.Options{DisableSeeksCompaction: true})
 	}
+	return tmdb.NewDB(name, backend, dbPath)
+}
 
-	fmt.Println("Compacting Block Store ...")
+// compacter is implemented by the DBs of the backends supporting compaction, other than goleveldb.
+type compacter interface {
+	Compact(start, end []byte) error
+}
 
-	db, err := leveldb.OpenFile(dbPath+"/blockstore.db", &compactOpts)
-	defer func() {
-		err = db.Close()
-	}()
-	if err != nil {
-		return err
-	}
-	if err = db.CompactRange(*util.BytesPrefix([]byte{})); err != nil {
-		return err
+// compactDB compacts the whole DB, if supported by its backend.
+func compactDB(db tmdb.DB, name string) error {
+	switch db := db.(type) {
+	case *tmdb.GoLevelDB:
+		return db.DB().CompactRange(util.Range{})
+	case compacter:
+		return db.Compact(nil, nil)
+	default:
+		fmt.Printf("Compaction of %s.db is not supported by its backend, skipping ...\n", name)
+		return nil
 	}
-	return nil
 }
 
-// forcepruneStateStore prunes and compacts state storage.
-func forcepruneStateStore(dbPath string, startHeight, currentHeight, minHeight, fullHeight int64) error {
-	opts := opt.Options{
-		DisableSeeksCompaction: true,
+// pruneBlockStoreAndGetHeights prunes blockstore and returns the startHeight and currentHeight.
+func pruneBlockStoreAndGetHeights(db tmdb.DB, fullHeight int64, isDryRun bool) (
+	startHeight int64, currentHeight int64, stats pruneStats, err error,
+) {
+	bs := tmstore.NewBlockStore(db)
+	startHeight = bs.Base()
+	currentHeight = bs.Height()
+
+	retainHeight := currentHeight - fullHeight
+	if retainHeight <= startHeight {
+		return startHeight, currentHeight, stats, nil
 	}
 
-	db, err := leveldb.OpenFile(dbPath+"/state.db", &opts)
+	// these are the keys deleted by the block store when pruning.
+	for height := startHeight; height < retainHeight; height++ {
+		meta := bs.LoadBlockMeta(height)
+		if meta == nil {
+			continue
+		}
+		keys := [][]byte{
+			[]byte(fmt.Sprintf("H:%v", height)),
+			[]byte(fmt.Sprintf("BH:%x", meta.BlockID.Hash)),
+			[]byte(fmt.Sprintf("C:%v", height)),
+			[]byte(fmt.Sprintf("SC:%v", height)),
+		}
+		for part := 0; part < int(meta.BlockID.PartSetHeader.Total); part++ {
+			keys = append(keys, []byte(fmt.Sprintf("P:%v:%v", height, part)))
+		}
+		for _, key := range keys {
+			if err := stats.addKey(db, key); err != nil {
+				return 0, 0, pruneStats{}, err
+			}
+		}
+	}
+	if isDryRun {
+		return startHeight, currentHeight, stats, nil
+	}
+
+	prunedBlocks, err := bs.PruneBlocks(retainHeight)
 	if err != nil {
-		return err
+		return 0, 0, pruneStats{}, err
 	}
-	defer db.Close()
+	fmt.Println("Pruned Block Store ...", prunedBlocks)
 
-	stateDBKeys := []string{kValidators, kConsensusParams, kABCIResponses}
-	fmt.Println("Pruning State Store ...")
-	for i, s := range stateDBKeys {
-		fmt.Println(i, s)
+	return startHeight, currentHeight, stats, nil
+}
 
-		retain_height := int64(0)
+// forcepruneStateStore prunes state storage.
+func forcepruneStateStore(db tmdb.DB, startHeight, currentHeight, minHeight, fullHeight int64, isDryRun bool) (stats pruneStats, err error) {
+	stateDBKeys := []string{kValidators, kConsensusParams, kABCIResponses}
+	for _, s := range stateDBKeys {
+		retainHeight := currentHeight - fullHeight
 		if s == kABCIResponses {
-			retain_height = currentHeight - minHeight
-		} else {
-			retain_height = currentHeight - fullHeight
+			retainHeight = currentHeight - minHeight
 		}
+		fmt.Println(s, startHeight, currentHeight, retainHeight)
 
-		batch := new(leveldb.Batch)
-		curBatchSize := uint64(0)
+		keepHeights, err := referencedStateHeights(db, s, retainHeight)
+		if err != nil {
+			return pruneStats{}, err
+		}
 
-		fmt.Println(startHeight, currentHeight, retain_height)
+		batch := db.NewBatch()
+		curBatchSize := uint64(0)
+		for c := startHeight; c < retainHeight; c++ {
+			if keepHeights[c] {
+				continue
+			}
+			key := []byte(s + strconv.FormatInt(c, 10))
+			if err := stats.addKey(db, key); err != nil {
+				batch.Close()
+				return pruneStats{}, err
+			}
+			if isDryRun {
+				continue
+			}
 
-		for c := startHeight; c < retain_height; c++ {
-			batch.Delete([]byte(s + strconv.FormatInt(c, 10)))
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return pruneStats{}, err
+			}
 			curBatchSize++
 
-			if curBatchSize%batchMaxSize == 0 && curBatchSize > 0 {
-				err := db.Write(batch, nil)
-				if err != nil {
-					return err
+			if curBatchSize%batchMaxSize == 0 {
+				if err := batch.Write(); err != nil {
+					batch.Close()
+					return pruneStats{}, err
 				}
-				batch.Reset()
-				batch = new(leveldb.Batch)
+				batch.Close()
+				batch = db.NewBatch()
+			}
+		}
+
+		if !isDryRun {
+			if err := batch.Write(); err != nil {
+				batch.Close()
+				return pruneStats{}, err
 			}
 		}
+		batch.Close()
+	}
+
+	return stats, nil
+}
+
+// referencedStateHeights returns the heights older than retainHeight of the validators or consensus params
+// records that must be kept, as tendermint only stores them in full when they change and the records at
+// the following heights refer to them. This mirrors the state store pruning of tendermint.
+func referencedStateHeights(db tmdb.DB, prefix string, retainHeight int64) (map[int64]bool, error) {
+	keepHeights := map[int64]bool{}
+	if prefix == kABCIResponses {
+		return keepHeights, nil
+	}
+
+	bz, err := db.Get([]byte(prefix + strconv.FormatInt(retainHeight, 10)))
+	if err != nil || len(bz) == 0 {
+		return keepHeights, err
+	}
+
+	if prefix == kValidators {
+		var valInfo tmstate.ValidatorsInfo
+		if err := valInfo.Unmarshal(bz); err != nil {
+			return nil, err
+		}
+		if valInfo.ValidatorSet == nil {
+			keepHeights[valInfo.LastHeightChanged] = true
+			// validator sets are also stored in full at every checkpoint.
+			checkpointHeight := retainHeight - retainHeight%valSetCheckpointInterval
+			if checkpointHeight > valInfo.LastHeightChanged {
+				keepHeights[checkpointHeight] = true
+			}
+		}
+		return keepHeights, nil
+	}
+
+	var paramsInfo tmstate.ConsensusParamsInfo
+	if err := paramsInfo.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	if paramsInfo.ConsensusParams.Equal(&tmproto.ConsensusParams{}) {
+		keepHeights[paramsInfo.LastHeightChanged] = true
+	}
+	return keepHeights, nil
+}
+
+// forcepruneApplicationStore deletes the versions of the IAVL stores of the application state
+// older than the keepRecent last ones.
+func forcepruneApplicationStore(db tmdb.DB, storeNames []string, keepRecent int64, isDryRun bool) (stats pruneStats, err error) {
+	// the stores share the latest version of the multistore, unless they were not committed yet.
+	commitInfoRetainVersion := int64(0)
+	for _, name := range storeNames {
+		storePrefix := fmt.Sprintf(iavlStorePrefixFmt, name)
+		storeDB := tmdb.NewPrefixDB(db, []byte(storePrefix))
+
+		// fast storage upgrades are skipped, since they write to the DB.
+		tree, err := iavl.NewMutableTree(storeDB, iavlCacheSize, true)
+		if err != nil {
+			return pruneStats{}, err
+		}
+		latestVersion, err := tree.LoadVersion(0)
+		if err != nil {
+			return pruneStats{}, fmt.Errorf("failed to load store %s: %w", name, err)
+		}
+
+		versions := tree.AvailableVersions()
+		retainVersion := latestVersion - keepRecent
+		if retainVersion > commitInfoRetainVersion {
+			commitInfoRetainVersion = retainVersion
+		}
+		if len(versions) == 0 || retainVersion <= int64(versions[0]) {
+			continue
+		}
+		firstVersion := int64(versions[0])
 
-		err := db.Write(batch, nil)
+		storeStats, err := iavlPruneStats(storeDB, firstVersion, retainVersion)
 		if err != nil {
-			return err
+			return pruneStats{}, err
+		}
+		// the stats are computed on the prefixed DB, so the store prefix is added to each key.
+		storeStats.bytes += storeStats.keys * uint64(len(storePrefix))
+		fmt.Printf("%s: versions %d to %d, %s\n", name, firstVersion, retainVersion-1, storeStats)
+		stats.keys += storeStats.keys
+		stats.bytes += storeStats.bytes
+		if isDryRun {
+			continue
+		}
+
+		for fromVersion := firstVersion; fromVersion < retainVersion; fromVersion += iavlVersionsBatchMaxLen {
+			toVersion := fromVersion + iavlVersionsBatchMaxLen
+			if toVersion > retainVersion {
+				toVersion = retainVersion
+			}
+			if err := tree.DeleteVersionsRange(fromVersion, toVersion); err != nil {
+				return pruneStats{}, fmt.Errorf("failed to prune store %s: %w", name, err)
+			}
 		}
-		batch.Reset()
 	}
 
-	fmt.Println("Compacting State Store ...")
-	if err = db.CompactRange(*util.BytesPrefix([]byte{})); err != nil {
-		return err
+	commitInfoStats, err := pruneCommitInfos(db, commitInfoRetainVersion, isDryRun)
+	if err != nil {
+		return pruneStats{}, err
 	}
-	return nil
+	fmt.Printf("commit infos: versions below %d, %s\n", commitInfoRetainVersion, commitInfoStats)
+	stats.keys += commitInfoStats.keys
+	stats.bytes += commitInfoStats.bytes
+
+	return stats, nil
+}
+
+// pruneCommitInfos deletes the commit infos of the multistore, stored at s/<version>, of the versions below retainVersion.
+func pruneCommitInfos(db tmdb.DB, retainVersion int64, isDryRun bool) (stats pruneStats, err error) {
+	// the commit info keys sort between s/0 and s/:, before the keys of the stores, s/k:<name>/, and s/latest.
+	iterator, err := db.Iterator([]byte(commitInfoKeyPrefix+"0"), []byte(commitInfoKeyPrefix+":"))
+	if err != nil {
+		return pruneStats{}, err
+	}
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		version, err := strconv.ParseInt(string(iterator.Key()[len(commitInfoKeyPrefix):]), 10, 64)
+		if err != nil || version >= retainVersion {
+			continue
+		}
+		stats.addEntry(iterator.Key(), iterator.Value())
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return pruneStats{}, err
+	}
+	if isDryRun {
+		return stats, nil
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return pruneStats{}, err
+		}
+	}
+	return stats, batch.Write()
+}
+
+// iavlPruneStats counts the keys removed by deleting the versions of an IAVL tree from its first version
+// up to toVersion, exclusive: the version roots, the orphans that are not part of the remaining versions, and their nodes.
+// The key formats are the ones of the IAVL node DB.
+func iavlPruneStats(db tmdb.DB, firstVersion, toVersion int64) (stats pruneStats, err error) {
+	rootsIterator, err := db.Iterator(iavlVersionKey('r', firstVersion), iavlVersionKey('r', toVersion))
+	if err != nil {
+		return pruneStats{}, err
+	}
+	defer rootsIterator.Close()
+	for ; rootsIterator.Valid(); rootsIterator.Next() {
+		stats.addEntry(rootsIterator.Key(), rootsIterator.Value())
+	}
+
+	// orphan keys are 'o' | to version | from version | node hash. As every version older than
+	// the orphan is deleted, the orphaned node is deleted with it.
+	orphansIterator, err := db.Iterator(iavlVersionKey('o', firstVersion), iavlVersionKey('o', toVersion))
+	if err != nil {
+		return pruneStats{}, err
+	}
+	defer orphansIterator.Close()
+	for ; orphansIterator.Valid(); orphansIterator.Next() {
+		orphanKey := orphansIterator.Key()
+		stats.addEntry(orphanKey, orphansIterator.Value())
+
+		nodeKey := append([]byte{'n'}, orphanKey[1+2*8:]...)
+		if err := stats.addKey(db, nodeKey); err != nil {
+			return pruneStats{}, err
+		}
+	}
+
+	return stats, nil
+}
+
+func iavlVersionKey(prefix byte, version int64) []byte {
+	return append([]byte{prefix}, sdk.Uint64ToBigEndian(uint64(version))...)
 }
@@ -0,0 +1,47 @@
+//go:build !windows
+
+package cmd
+
+// DONTCOVER
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// checkDBUnlocked returns an error if the LOCK file of the DB is held by another process, such as a running node.
+// Backends lock their DB either with flock, like goleveldb, or with fcntl, like pebble and rocksdb,
+// two kinds of locks that do not conflict with each other, so both are tried.
+func checkDBUnlocked(dbPath, name string) error {
+	lockFile, err := os.OpenFile(filepath.Join(dbPath, name+".db", "LOCK"), os.O_RDWR, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer lockFile.Close()
+
+	lockedErr := fmt.Errorf("%s.db is locked by another process, stop the node before running forceprune", name)
+	fd := lockFile.Fd()
+	if err := syscall.Flock(int(fd), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return lockedErr
+		}
+		return err
+	}
+	defer syscall.Flock(int(fd), syscall.LOCK_UN) //nolint:errcheck
+
+	lock := syscall.Flock_t{Type: syscall.F_WRLCK}
+	if err := syscall.FcntlFlock(fd, syscall.F_SETLK, &lock); err != nil {
+		if errors.Is(err, syscall.EAGAIN) || errors.Is(err, syscall.EACCES) {
+			return lockedErr
+		}
+		return err
+	}
+	lock.Type = syscall.F_UNLCK
+	return syscall.FcntlFlock(fd, syscall.F_SETLK, &lock)
+}
@@ -0,0 +1,11 @@
+//go:build windows
+
+package cmd
+
+// DONTCOVER
+
+// checkDBUnlocked is a no-op on windows, where the DBs of a running node are locked
+// exclusively, so that opening them fails anyway.
+func checkDBUnlocked(dbPath, name string) error {
+	return nil
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+// dbStats returns the number of keys of the DB and their size with their values.
+func dbStats(t *testing.T, db tmdb.DB) pruneStats {
+	iterator, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer iterator.Close()
+
+	stats := pruneStats{}
+	for ; iterator.Valid(); iterator.Next() {
+		stats.addEntry(iterator.Key(), iterator.Value())
+	}
+	return stats
+}
+
+func TestForcepruneApplicationStore(t *testing.T) {
+	const (
+		numVersions = 20
+		keepRecent  = 5
+	)
+	storeNames := []string{"bank", "twap"}
+
+	db := tmdb.NewMemDB()
+	for _, name := range storeNames {
+		tree, err := iavl.NewMutableTree(tmdb.NewPrefixDB(db, []byte(fmt.Sprintf(iavlStorePrefixFmt, name))), 0, false)
+		require.NoError(t, err)
+		for version := 1; version <= numVersions; version++ {
+			// every version overwrites a key and adds a new one, orphaning some nodes.
+			_, err := tree.Set([]byte("overwritten"), []byte(strconv.Itoa(version)))
+			require.NoError(t, err)
+			_, err = tree.Set([]byte(fmt.Sprintf("key%d", version)), []byte(name))
+			require.NoError(t, err)
+			_, _, err = tree.SaveVersion()
+			require.NoError(t, err)
+		}
+	}
+	// the commit infos of the pruned versions are deleted with them.
+	for version := 1; version <= numVersions; version++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("s/%d", version)), []byte(strconv.Itoa(version))))
+	}
+	// other keys outside of the stores are left untouched.
+	require.NoError(t, db.Set([]byte("s/latest"), []byte{1}))
+
+	before := dbStats(t, db)
+
+	dryRunStats, err := forcepruneApplicationStore(db, storeNames, keepRecent, true)
+	require.NoError(t, err)
+	require.NotZero(t, dryRunStats.keys)
+	require.Equal(t, before, dbStats(t, db))
+
+	stats, err := forcepruneApplicationStore(db, storeNames, keepRecent, false)
+	require.NoError(t, err)
+	require.Equal(t, dryRunStats, stats)
+
+	after := dbStats(t, db)
+	require.Equal(t, before.keys-stats.keys, after.keys)
+	require.Equal(t, before.bytes-stats.bytes, after.bytes)
+
+	for _, name := range storeNames {
+		tree, err := iavl.NewMutableTree(tmdb.NewPrefixDB(db, []byte(fmt.Sprintf(iavlStorePrefixFmt, name))), 0, true)
+		require.NoError(t, err)
+		latestVersion, err := tree.LoadVersion(0)
+		require.NoError(t, err)
+		require.Equal(t, int64(numVersions), latestVersion)
+		require.Equal(t, []int{15, 16, 17, 18, 19, 20}, tree.AvailableVersions())
+
+		// the oldest retained version is still fully readable.
+		oldestTree, err := tree.GetImmutable(numVersions - keepRecent)
+		require.NoError(t, err)
+		for version := 1; version <= numVersions-keepRecent; version++ {
+			value, err := oldestTree.Get([]byte(fmt.Sprintf("key%d", version)))
+			require.NoError(t, err)
+			require.Equal(t, []byte(name), value)
+		}
+	}
+
+	for version := 1; version <= numVersions; version++ {
+		has, err := db.Has([]byte(fmt.Sprintf("s/%d", version)))
+		require.NoError(t, err)
+		require.Equal(t, version >= numVersions-keepRecent, has)
+	}
+	has, err := db.Has([]byte("s/latest"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// nothing is left to prune.
+	stats, err = forcepruneApplicationStore(db, storeNames, keepRecent, false)
+	require.NoError(t, err)
+	require.Equal(t, pruneStats{}, stats)
+}
+
+func TestForcepruneStateStore(t *testing.T) {
+	db := tmdb.NewMemDB()
+	for height := int64(1); height <= 10; height++ {
+		// the validator set changes at height 2 and the consensus params at height 1 only,
+		// the records of the other heights refer to these.
+		valInfo := tmstate.ValidatorsInfo{LastHeightChanged: 2}
+		if height <= 2 {
+			valInfo = tmstate.ValidatorsInfo{LastHeightChanged: height, ValidatorSet: &tmproto.ValidatorSet{}}
+		}
+		paramsInfo := tmstate.ConsensusParamsInfo{LastHeightChanged: 1}
+		if height == 1 {
+			paramsInfo.ConsensusParams = tmproto.ConsensusParams{Block: tmproto.BlockParams{MaxBytes: 1}}
+		}
+		valInfoBz, err := valInfo.Marshal()
+		require.NoError(t, err)
+		paramsInfoBz, err := paramsInfo.Marshal()
+		require.NoError(t, err)
+
+		heightStr := strconv.FormatInt(height, 10)
+		require.NoError(t, db.Set([]byte(kValidators+heightStr), valInfoBz))
+		require.NoError(t, db.Set([]byte(kConsensusParams+heightStr), paramsInfoBz))
+		require.NoError(t, db.Set([]byte(kABCIResponses+heightStr), []byte{1, 2}))
+	}
+
+	// keep 6 heights of validators and consensus params, and 2 of abci responses,
+	// along with the validators of height 2 and consensus params of height 1.
+	dryRunStats, err := forcepruneStateStore(db, 1, 10, 2, 6, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2+2+7), dryRunStats.keys)
+	require.Equal(t, uint64(30), dbStats(t, db).keys)
+
+	stats, err := forcepruneStateStore(db, 1, 10, 2, 6, false)
+	require.NoError(t, err)
+	require.Equal(t, dryRunStats, stats)
+	require.Equal(t, uint64(30-11), dbStats(t, db).keys)
+
+	for _, key := range []string{kValidators + "1", kValidators + "3", kConsensusParams + "2", kABCIResponses + "7"} {
+		has, err := db.Has([]byte(key))
+		require.NoError(t, err)
+		require.False(t, has, key)
+	}
+	for _, key := range []string{kValidators + "2", kValidators + "4", kConsensusParams + "1", kConsensusParams + "4", kABCIResponses + "8"} {
+		has, err := db.Has([]byte(key))
+		require.NoError(t, err)
+		require.True(t, has, key)
+	}
+}
@@ -0,0 +1,510 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	osmosis "github.com/osmosis-labs/osmosis/v16/app"
+	"github.com/osmosis-labs/osmosis/v16/app/keepers"
+)
+
+const (
+	flagReplayContinueOnResultDiff = "continue-on-result-diff"
+	flagReplayMaxKeyDiffs          = "max-key-diffs"
+	flagReplayMaxBlocks            = "max-blocks"
+)
+
+// ReplayCmd returns the command re-executing the recorded blocks of a stopped node with the current binary,
+// and reporting how the replay diverges from what was recorded.
+func ReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [start-height] [end-height]",
+		Short: "Re-execute the recorded blocks of a stopped node with the current binary, and report the divergences",
+		Long: `Re-execute the recorded blocks of a stopped node with the current binary, and report the divergences from what was recorded.
+The application state of the node home is loaded at start-height - 1, which must not be pruned, and the blocks of the block store
+are executed through the app up to end-height. The DBs of the node are only read from, the state written by the replay being kept
+in memory, so the node must be stopped. As the state written by the replay, the IAVL nodes of every replayed block, is held in memory
+until the command exits, the number of replayed blocks is capped by --max-blocks. The app is run in a temporary home, removed when
+the command exits, whose wasm directory is seeded with a copy of the contract code of the node, so that the contract code stored
+by replayed txs is not written to the node home.
+
+For each block, the app hash is compared with the one recorded in the next block header, and the ABCI responses with the ones of
+the state DB when they were kept (discard_abci_responses = false in config.toml), else with the last results hash of the next block
+header. A store whose replayed state diverges from the recorded one fails to be committed at the heights whose application state was
+kept, in which case the keys written by the replay with a different value are listed, decoded for the known store layouts.
+The first divergence is attributed to the earliest part of the block, begin block, tx or end block, whose results or writes differ.
+
+The replay stops at the first block whose state diverges, and by default at the first block whose results diverge.
+The report is printed as json, and the command fails if the replay diverged.
+
+Example:
+	osmosisd replay 10000001 10000100 --max-key-diffs 50
+	osmosisd replay 10000001 10001000 --max-blocks 1000`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			if endHeight < startHeight {
+				return fmt.Errorf("end height %d is below start height %d", endHeight, startHeight)
+			}
+			continueOnResultDiff, err := cmd.Flags().GetBool(flagReplayContinueOnResultDiff)
+			if err != nil {
+				return err
+			}
+			maxKeyDiffs, err := cmd.Flags().GetInt(flagReplayMaxKeyDiffs)
+			if err != nil {
+				return err
+			}
+			maxBlocks, err := cmd.Flags().GetInt64(flagReplayMaxBlocks)
+			if err != nil {
+				return err
+			}
+			if endHeight-startHeight+1 > maxBlocks {
+				return fmt.Errorf("%d blocks to replay, above the maximum of %d set by --%s", endHeight-startHeight+1, maxBlocks, flagReplayMaxBlocks)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			conf := serverCtx.Config
+			dbPath := conf.DBDir()
+			tmBackend := tmdb.BackendType(conf.DBBackend)
+
+			blockStoreDB, err := openReplayDB(dbPath, blockStoreDBName, tmBackend)
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			stateDB, err := openReplayDB(dbPath, stateDBName, tmBackend)
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+			// like the SDK, the application DB is kept in the data directory of the home, whatever the tendermint db_dir.
+			appDB, err := openReplayDB(filepath.Join(conf.RootDir, "data"), applicationDBName, appDBBackend())
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			replayHome, err := os.MkdirTemp("", "osmosisd-replay-")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(replayHome)
+			if err := copyWasmCode(conf.RootDir, replayHome); err != nil {
+				return fmt.Errorf("failed to copy the contract code of the node: %w", err)
+			}
+
+			replayer, err := newBlockReplayer(appDB, blockStoreDB, stateDB, replayHome, serverCtx.Viper, startHeight, endHeight)
+			if err != nil {
+				return err
+			}
+			replayer.maxKeyDiffs = maxKeyDiffs
+
+			report := replayReport{StartHeight: startHeight, EndHeight: endHeight, DivergentBlocks: []blockReport{}}
+			for height := startHeight; height <= endHeight; height++ {
+				blockReport, err := replayer.replayBlock(height)
+				if err != nil {
+					return err
+				}
+				report.LastReplayed = height
+				if !blockReport.diverged() {
+					cmd.PrintErrf("replayed block %d\n", height)
+					continue
+				}
+
+				cmd.PrintErrf("replayed block %d: diverged\n", height)
+				report.DivergentBlocks = append(report.DivergentBlocks, blockReport)
+				if report.FirstDivergence == nil {
+					report.FirstDivergence = replayer.locateDivergence(height, blockReport.firstDivergentPhase())
+				}
+				if blockReport.stateDiverged() || !continueOnResultDiff {
+					break
+				}
+			}
+
+			out, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+
+			if report.FirstDivergence != nil {
+				return fmt.Errorf("replay diverged at height %d, %s", report.FirstDivergence.Height, report.FirstDivergence.Phase)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagReplayContinueOnResultDiff, false, "Continue after the blocks whose results diverge but whose state does not")
+	cmd.Flags().Int(flagReplayMaxKeyDiffs, 20, "Maximum number of differing keys listed per divergent store")
+	cmd.Flags().Int64(flagReplayMaxBlocks, 100, "Maximum number of blocks replayed, whose written state is held in memory")
+	return cmd
+}
+
+// openReplayDB opens a DB of the node with the given backend, read-only for goleveldb.
+func openReplayDB(dbPath, name string, backend tmdb.BackendType) (tmdb.DB, error) {
+	db, err := openNodeDB(dbPath, name, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s.db, is the node stopped? %w", name, err)
+	}
+	return db, nil
+}
+
+// copyWasmCode copies the contract code stored in the wasm directory of the node home to the one of the given home.
+// The compiled modules are not copied, the VM compiling them again when they are missing from its cache.
+func copyWasmCode(nodeHome, home string) error {
+	// the VM stores the code in the state directory of its data directory, wasm/wasm.
+	codeDir := filepath.Join("wasm", "wasm", "state")
+	src := filepath.Join(nodeHome, codeDir)
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(home, codeDir, rel)
+		if entry.IsDir() {
+			return os.MkdirAll(dst, 0o755)
+		}
+		return copyFile(path, dst)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// blockReplayer re-executes recorded blocks through an app loaded from the application DB of a node,
+// whose writes are buffered in memory.
+type blockReplayer struct {
+	app        *osmosis.OsmosisApp
+	appDB      tmdb.DB
+	blockStore *tmstore.BlockStore
+	stateStore sm.Store
+	// state is the latest tendermint state, holding the hashes recorded for the last block.
+	state       sm.State
+	recorder    *writeRecorder
+	maxKeyDiffs int
+}
+
+func newBlockReplayer(appDB, blockStoreDB, stateDB tmdb.DB, homeDir string, appOpts servertypes.AppOptions, startHeight, endHeight int64) (*blockReplayer, error) {
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: false})
+	state, err := stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	if state.IsEmpty() {
+		return nil, fmt.Errorf("no tendermint state found")
+	}
+	if startHeight <= state.InitialHeight {
+		return nil, fmt.Errorf("start height %d must be above the initial height %d, the initial block is not replayable", startHeight, state.InitialHeight)
+	}
+	if endHeight > state.LastBlockHeight {
+		return nil, fmt.Errorf("end height %d is above the last block height %d", endHeight, state.LastBlockHeight)
+	}
+
+	recorder := newWriteRecorder()
+	app := osmosis.NewOsmosisApp(log.NewNopLogger(), newOverlayDB(appDB), nil, false, map[int64]bool{}, homeDir, 0, appOpts, osmosis.EmptyWasmOpts,
+		baseapp.SetInterBlockCache(recorder))
+	if err := app.LoadHeight(startHeight - 1); err != nil {
+		return nil, fmt.Errorf("failed to load the application state at height %d, is it pruned? %w", startHeight-1, err)
+	}
+	if err := app.WasmKeeper.InitializePinnedCodes(app.NewUncachedContext(true, tmproto.Header{})); err != nil {
+		return nil, err
+	}
+
+	return &blockReplayer{
+		app:        app,
+		appDB:      appDB,
+		blockStore: tmstore.NewBlockStore(blockStoreDB),
+		stateStore: stateStore,
+		state:      state,
+		recorder:   recorder,
+	}, nil
+}
+
+// replayBlock executes and commits the block at the given height, and compares its results and state with the recorded ones.
+func (r *blockReplayer) replayBlock(height int64) (blockReport, error) {
+	block := r.blockStore.LoadBlock(height)
+	if block == nil {
+		return blockReport{}, fmt.Errorf("block %d not found in the block store", height)
+	}
+	recordedAppHash, recordedResultsHash, err := r.recordedHashes(height)
+	if err != nil {
+		return blockReport{}, err
+	}
+	beginBlockReq, err := r.beginBlockRequest(block)
+	if err != nil {
+		return blockReport{}, err
+	}
+
+	r.recorder.startBlock()
+	replayed := &tmstate.ABCIResponses{DeliverTxs: make([]*abci.ResponseDeliverTx, len(block.Txs))}
+	beginBlockRes := r.app.BeginBlock(beginBlockReq)
+	replayed.BeginBlock = &beginBlockRes
+	for i, tx := range block.Txs {
+		r.recorder.phase = blockPhase(i)
+		deliverTxRes := r.app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		replayed.DeliverTxs[i] = &deliverTxRes
+	}
+	r.recorder.phase = phaseEndBlock
+	endBlockRes := r.app.EndBlock(abci.RequestEndBlock{Height: height})
+	replayed.EndBlock = &endBlockRes
+	r.recorder.phase = phaseBlock
+
+	report := blockReport{Height: height, RecordedAppHash: hex.EncodeToString(recordedAppHash)}
+	if recorded, err := r.stateStore.LoadABCIResponses(height); err == nil {
+		report.ResultsSource = "abci_responses"
+		report.ResultDiffs = diffBlockResults(recorded, replayed)
+	} else {
+		report.ResultsSource = "last_results_hash"
+		report.ResultDiffs = diffField(phaseBlock, "last_results_hash", hex.EncodeToString(recordedResultsHash), hex.EncodeToString(sm.ABCIResponsesResultsHash(replayed)))
+	}
+
+	if commitErr := r.commit(); commitErr != nil {
+		// the stores conflicting with the recorded ones were not committed, commit the other ones
+		// to find out which ones they are.
+		report.StoreDiffs = r.conflictingStoreDiffs(height)
+		if len(report.StoreDiffs) == 0 {
+			return blockReport{}, fmt.Errorf("failed to commit block %d: %w", height, commitErr)
+		}
+		return report, nil
+	}
+
+	report.ReplayedAppHash = hex.EncodeToString(r.app.LastCommitID().Hash)
+	if report.ReplayedAppHash != report.RecordedAppHash {
+		report.StoreDiffs = r.storeHashDiffs(height)
+	}
+	return report, nil
+}
+
+// recordedHashes returns the app hash and the results hash recorded for the block at the given height,
+// from the header of the next block, or from the tendermint state for the last block.
+func (r *blockReplayer) recordedHashes(height int64) (appHash, resultsHash []byte, err error) {
+	if height == r.state.LastBlockHeight {
+		return r.state.AppHash, r.state.LastResultsHash, nil
+	}
+	blockMeta := r.blockStore.LoadBlockMeta(height + 1)
+	if blockMeta == nil {
+		return nil, nil, fmt.Errorf("block %d not found in the block store, its header holds the hashes of block %d", height+1, height)
+	}
+	return blockMeta.Header.AppHash, blockMeta.Header.LastResultsHash, nil
+}
+
+// beginBlockRequest builds the begin block request of the block as tendermint does, with the votes of the last commit
+// and the misbehaviours of the evidence.
+func (r *blockReplayer) beginBlockRequest(block *tmtypes.Block) (abci.RequestBeginBlock, error) {
+	lastValSet, err := r.stateStore.LoadValidators(block.Height - 1)
+	if err != nil {
+		return abci.RequestBeginBlock{}, err
+	}
+	if block.LastCommit.Size() != len(lastValSet.Validators) {
+		return abci.RequestBeginBlock{}, fmt.Errorf("commit size %d of block %d doesn't match the validator set size %d",
+			block.LastCommit.Size(), block.Height, len(lastValSet.Validators))
+	}
+	votes := make([]abci.VoteInfo, len(lastValSet.Validators))
+	for i, val := range lastValSet.Validators {
+		votes[i] = abci.VoteInfo{
+			Validator:       tmtypes.TM2PB.Validator(val),
+			SignedLastBlock: !block.LastCommit.Signatures[i].Absent(),
+		}
+	}
+
+	byzantineValidators := []abci.Evidence{}
+	for _, evidence := range block.Evidence.Evidence {
+		byzantineValidators = append(byzantineValidators, evidence.ABCI()...)
+	}
+
+	return abci.RequestBeginBlock{
+		Hash:                block.Hash(),
+		Header:              *block.Header.ToProto(),
+		LastCommitInfo:      abci.LastCommitInfo{Round: block.LastCommit.Round, Votes: votes},
+		ByzantineValidators: byzantineValidators,
+	}, nil
+}
+
+// commit commits the replayed block. Stores whose replayed state conflicts with the state recorded at the same height
+// panic when committed, which is returned as an error.
+func (r *blockReplayer) commit() (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("%v", rec)
+		}
+	}()
+	r.app.Commit()
+	return nil
+}
+
+// conflictingStoreDiffs commits the stores left uncommitted by a failed commit of the block at the given height,
+// and returns the ones failing to, as their replayed state conflicts with the recorded one.
+func (r *blockReplayer) conflictingStoreDiffs(height int64) []storeDiff {
+	recordedCommitInfo := r.recordedCommitInfo(height)
+	storeDiffs := []storeDiff{}
+	for _, key := range r.sortedStoreKeys() {
+		store, ok := r.recorder.stores[key].(*iavl.Store)
+		if !ok || store.LastCommitID().Version == height {
+			continue
+		}
+		if r.commitStore(store) == nil {
+			continue
+		}
+
+		diff := storeDiff{Store: key.Name(), RecordedHash: recordedStoreHash(recordedCommitInfo, key.Name())}
+		previous, previousErr := store.GetImmutable(height - 1)
+		recorded, recordedErr := store.GetImmutable(height)
+		if previousErr != nil || recordedErr != nil {
+			diff.Note = "the recorded state could not be loaded"
+		} else {
+			diff.KeyDiffs, diff.TotalKeyDiffs = diffWrittenKeys(r.app.AppCodec(), key.Name(), r.recorder.writes[key.Name()], previous, recorded, store, r.maxKeyDiffs)
+			if diff.TotalKeyDiffs == 0 {
+				diff.Note = "the keys written by the replay match, the recorded block wrote other keys"
+			}
+		}
+		storeDiffs = append(storeDiffs, diff)
+	}
+	return storeDiffs
+}
+
+// commitStore commits the store, returning the panic of a conflict with the recorded state as an error.
+func (r *blockReplayer) commitStore(store *iavl.Store) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("%v", rec)
+		}
+	}()
+	store.Commit()
+	return nil
+}
+
+// storeHashDiffs returns the stores whose committed hash differs from the one recorded in the commit info of the application DB,
+// for the heights whose application state was pruned, so that the replayed state could be committed.
+func (r *blockReplayer) storeHashDiffs(height int64) []storeDiff {
+	recordedCommitInfo := r.recordedCommitInfo(height)
+	if recordedCommitInfo == nil {
+		return nil
+	}
+	storeDiffs := []storeDiff{}
+	for _, key := range r.sortedStoreKeys() {
+		store, ok := r.recorder.stores[key].(*iavl.Store)
+		if !ok {
+			continue
+		}
+		recordedHash := recordedStoreHash(recordedCommitInfo, key.Name())
+		replayedHash := hex.EncodeToString(store.LastCommitID().Hash)
+		if recordedHash != replayedHash {
+			storeDiffs = append(storeDiffs, storeDiff{
+				Store:        key.Name(),
+				RecordedHash: recordedHash,
+				ReplayedHash: replayedHash,
+				Note:         "the recorded state is pruned, the keys could not be compared",
+			})
+		}
+	}
+	return storeDiffs
+}
+
+// recordedCommitInfo returns the commit info recorded in the application DB at the given height, nil if it is missing.
+func (r *blockReplayer) recordedCommitInfo(height int64) *storetypes.CommitInfo {
+	bz, err := r.appDB.Get([]byte(fmt.Sprintf("s/%d", height)))
+	if err != nil || bz == nil {
+		return nil
+	}
+	commitInfo := &storetypes.CommitInfo{}
+	if err := commitInfo.Unmarshal(bz); err != nil {
+		return nil
+	}
+	return commitInfo
+}
+
+func recordedStoreHash(commitInfo *storetypes.CommitInfo, storeName string) string {
+	if commitInfo == nil {
+		return ""
+	}
+	for _, storeInfo := range commitInfo.StoreInfos {
+		if storeInfo.Name == storeName {
+			return hex.EncodeToString(storeInfo.CommitId.Hash)
+		}
+	}
+	return ""
+}
+
+// sortedStoreKeys returns the keys of the module stores, sorted by name.
+func (r *blockReplayer) sortedStoreKeys() []storetypes.StoreKey {
+	keys := make([]storetypes.StoreKey, 0, len(r.recorder.stores))
+	for _, name := range keepers.KVStoreKeys() {
+		if key := r.app.GetKey(name); key != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+	return keys
+}
+
+// locateDivergence returns the divergence at the given phase of the block, with the hash and messages of its tx if any.
+func (r *blockReplayer) locateDivergence(height int64, phase blockPhase) *divergence {
+	div := &divergence{Height: height, Phase: phase}
+	if phase < 0 || phase == phaseEndBlock {
+		return div
+	}
+	block := r.blockStore.LoadBlock(height)
+	if block == nil || int(phase) >= len(block.Txs) {
+		return div
+	}
+	div.TxHash = fmt.Sprintf("%X", block.Txs[phase].Hash())
+	if tx, err := osmosis.GetEncodingConfig().TxConfig.TxDecoder()(block.Txs[phase]); err == nil {
+		for _, msg := range tx.GetMsgs() {
+			div.Msgs = append(div.Msgs, sdk.MsgTypeURL(msg))
+		}
+	}
+	return div
+}
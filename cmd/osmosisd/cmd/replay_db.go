@@ -0,0 +1,278 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	errOverlayKeyEmpty    = errors.New("key cannot be empty")
+	errOverlayValueNil    = errors.New("value cannot be nil")
+	errOverlayBatchClosed = errors.New("batch has been written or closed")
+)
+
+// overlayDB buffers the writes made to a DB in memory, leaving the underlying DB untouched.
+// Reads and iterators see the buffered writes over the content of the underlying DB,
+// so that an app can commit blocks on top of the DB of a node without modifying it.
+// Nothing is ever evicted: the buffered writes, which include the IAVL nodes of every committed version, grow with
+// the number of blocks committed, so the number of blocks committed over an overlayDB must be bounded by its user.
+type overlayDB struct {
+	cache storetypes.CacheKVStore
+}
+
+var _ tmdb.DB = (*overlayDB)(nil)
+
+// newOverlayDB returns a DB buffering the writes made over the given DB, which is only read from.
+func newOverlayDB(db tmdb.DB) *overlayDB {
+	return &overlayDB{cache: cachekv.NewStore(dbadapter.Store{DB: db})}
+}
+
+func (db *overlayDB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errOverlayKeyEmpty
+	}
+	return db.cache.Get(key), nil
+}
+
+func (db *overlayDB) Has(key []byte) (bool, error) {
+	if len(key) == 0 {
+		return false, errOverlayKeyEmpty
+	}
+	return db.cache.Has(key), nil
+}
+
+func (db *overlayDB) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errOverlayKeyEmpty
+	}
+	if value == nil {
+		return errOverlayValueNil
+	}
+	db.cache.Set(key, value)
+	return nil
+}
+
+func (db *overlayDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+func (db *overlayDB) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errOverlayKeyEmpty
+	}
+	db.cache.Delete(key)
+	return nil
+}
+
+func (db *overlayDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+func (db *overlayDB) Iterator(start, end []byte) (tmdb.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errOverlayKeyEmpty
+	}
+	return db.cache.Iterator(start, end), nil
+}
+
+func (db *overlayDB) ReverseIterator(start, end []byte) (tmdb.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errOverlayKeyEmpty
+	}
+	return db.cache.ReverseIterator(start, end), nil
+}
+
+// Close is a no-op, the underlying DB being closed by its owner.
+func (db *overlayDB) Close() error {
+	return nil
+}
+
+func (db *overlayDB) NewBatch() tmdb.Batch {
+	return &overlayBatch{db: db}
+}
+
+func (db *overlayDB) Print() error {
+	iterator, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", iterator.Key(), iterator.Value())
+	}
+	return iterator.Error()
+}
+
+func (db *overlayDB) Stats() map[string]string {
+	return map[string]string{"database.type": "overlayDB"}
+}
+
+// overlayOp is a write batched in an overlayBatch, a delete if the value is nil.
+type overlayOp struct {
+	key   []byte
+	value []byte
+}
+
+// overlayBatch batches writes to an overlayDB, applied in order when written.
+type overlayBatch struct {
+	db  *overlayDB
+	ops []overlayOp
+}
+
+var _ tmdb.Batch = (*overlayBatch)(nil)
+
+func (b *overlayBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errOverlayKeyEmpty
+	}
+	if value == nil {
+		return errOverlayValueNil
+	}
+	if b.db == nil {
+		return errOverlayBatchClosed
+	}
+	b.ops = append(b.ops, overlayOp{key: key, value: value})
+	return nil
+}
+
+func (b *overlayBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errOverlayKeyEmpty
+	}
+	if b.db == nil {
+		return errOverlayBatchClosed
+	}
+	b.ops = append(b.ops, overlayOp{key: key})
+	return nil
+}
+
+func (b *overlayBatch) Write() error {
+	if b.db == nil {
+		return errOverlayBatchClosed
+	}
+	for _, op := range b.ops {
+		if op.value == nil {
+			b.db.cache.Delete(op.key)
+		} else {
+			b.db.cache.Set(op.key, op.value)
+		}
+	}
+	return b.Close()
+}
+
+func (b *overlayBatch) WriteSync() error {
+	return b.Write()
+}
+
+func (b *overlayBatch) Close() error {
+	b.db = nil
+	b.ops = nil
+	return nil
+}
+
+// writeRecorder records the keys written to the module stores by a block, with the phase of the block that last wrote them.
+// It wraps the IAVL stores of the app as their inter-block cache, so that the writes reaching the deliver state of the block
+// are recorded: the writes of its begin and end blocks, and the ones of its txs when their state is written, which excludes
+// the writes of failed txs besides their fees.
+type writeRecorder struct {
+	phase  blockPhase
+	writes map[string]map[string]blockPhase
+	stores map[storetypes.StoreKey]storetypes.CommitKVStore
+}
+
+var _ storetypes.MultiStorePersistentCache = (*writeRecorder)(nil)
+
+func newWriteRecorder() *writeRecorder {
+	return &writeRecorder{
+		phase:  phaseBeginBlock,
+		writes: map[string]map[string]blockPhase{},
+		stores: map[storetypes.StoreKey]storetypes.CommitKVStore{},
+	}
+}
+
+func (r *writeRecorder) GetStoreCache(key storetypes.StoreKey, store storetypes.CommitKVStore) storetypes.CommitKVStore {
+	r.stores[key] = store
+	return recordedStore{CommitKVStore: store, name: key.Name(), recorder: r}
+}
+
+func (r *writeRecorder) Unwrap(key storetypes.StoreKey) storetypes.CommitKVStore {
+	return r.stores[key]
+}
+
+// Reset is a no-op, as the recorder caches no state.
+func (r *writeRecorder) Reset() {}
+
+// startBlock clears the recorded writes, before executing a new block.
+func (r *writeRecorder) startBlock() {
+	r.phase = phaseBeginBlock
+	r.writes = map[string]map[string]blockPhase{}
+}
+
+func (r *writeRecorder) record(storeName string, key []byte) {
+	storeWrites, ok := r.writes[storeName]
+	if !ok {
+		storeWrites = map[string]blockPhase{}
+		r.writes[storeName] = storeWrites
+	}
+	storeWrites[string(key)] = r.phase
+}
+
+// recordedStore is a store whose branches record the keys written to them.
+type recordedStore struct {
+	storetypes.CommitKVStore
+	name     string
+	recorder *writeRecorder
+}
+
+func (s recordedStore) CacheWrap() storetypes.CacheWrap {
+	return recordingCacheStore{CacheKVStore: cachekv.NewStore(s.CommitKVStore), name: s.name, recorder: s.recorder}
+}
+
+// CacheWrapWithTrace does not trace, the replayed app having no tracer.
+func (s recordedStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+// CacheWrapWithListeners does not notify the listeners, the replayed app having none.
+func (s recordedStore) CacheWrapWithListeners(_ storetypes.StoreKey, _ []storetypes.WriteListener) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+// recordingCacheStore is a branch of a store recording the keys written to it.
+// Its own branches are plain ones, whose writes are recorded when they are written to it.
+type recordingCacheStore struct {
+	storetypes.CacheKVStore
+	name     string
+	recorder *writeRecorder
+}
+
+func (s recordingCacheStore) Set(key, value []byte) {
+	s.recorder.record(s.name, key)
+	s.CacheKVStore.Set(key, value)
+}
+
+func (s recordingCacheStore) Delete(key []byte) {
+	s.recorder.record(s.name, key)
+	s.CacheKVStore.Delete(key)
+}
+
+func (s recordingCacheStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s recordingCacheStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s recordingCacheStore) CacheWrapWithListeners(storeKey storetypes.StoreKey, listeners []storetypes.WriteListener) storetypes.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestOverlayDB(t *testing.T) {
+	underlying := tmdb.NewMemDB()
+	require.NoError(t, underlying.Set([]byte("a"), []byte("1")))
+	require.NoError(t, underlying.Set([]byte("b"), []byte("2")))
+	require.NoError(t, underlying.Set([]byte("c"), []byte("3")))
+
+	db := newOverlayDB(underlying)
+	require.NoError(t, db.Set([]byte("b"), []byte("20")))
+	require.NoError(t, db.Set([]byte("d"), []byte("4")))
+	require.NoError(t, db.Delete([]byte("c")))
+
+	value, err := db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("20"), value)
+	has, err := db.Has([]byte("c"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	iterator, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	entries := map[string]string{}
+	var keys []string
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, string(iterator.Key()))
+		entries[string(iterator.Key())] = string(iterator.Value())
+	}
+	require.NoError(t, iterator.Close())
+	require.Equal(t, []string{"a", "b", "d"}, keys)
+	require.Equal(t, map[string]string{"a": "1", "b": "20", "d": "4"}, entries)
+
+	reverseIterator, err := db.ReverseIterator([]byte("a"), []byte("d"))
+	require.NoError(t, err)
+	keys = nil
+	for ; reverseIterator.Valid(); reverseIterator.Next() {
+		keys = append(keys, string(reverseIterator.Key()))
+	}
+	require.NoError(t, reverseIterator.Close())
+	require.Equal(t, []string{"b", "a"}, keys)
+
+	// The underlying DB is left untouched, even once the overlay is closed.
+	require.NoError(t, db.Close())
+	value, err = underlying.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+	has, err = underlying.Has([]byte("c"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = underlying.Has([]byte("d"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.ErrorIs(t, db.Set(nil, []byte("1")), errOverlayKeyEmpty)
+	require.ErrorIs(t, db.Set([]byte("e"), nil), errOverlayValueNil)
+	_, err = db.Iterator([]byte{}, nil)
+	require.ErrorIs(t, err, errOverlayKeyEmpty)
+}
+
+func TestOverlayBatch(t *testing.T) {
+	underlying := tmdb.NewMemDB()
+	require.NoError(t, underlying.Set([]byte("a"), []byte("1")))
+	db := newOverlayDB(underlying)
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("b"), []byte("2")))
+	require.NoError(t, batch.Delete([]byte("a")))
+	require.NoError(t, batch.Set([]byte("a"), []byte("10")))
+
+	// The batched writes are only applied when written, in order.
+	has, err := db.Has([]byte("b"))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.NoError(t, batch.Write())
+	value, err := db.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("10"), value)
+	value, err = db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+
+	require.ErrorIs(t, batch.Set([]byte("c"), []byte("3")), errOverlayBatchClosed)
+	require.ErrorIs(t, batch.Write(), errOverlayBatchClosed)
+
+	value, err = underlying.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+}
+
+func TestWriteRecorder(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	recorder := newWriteRecorder()
+	store := recorder.GetStoreCache(key, commitKVStoreStub{Store: dbadapter.Store{DB: tmdb.NewMemDB()}})
+
+	// Writes to the branch of the deliver state are recorded with the current phase.
+	deliverState := store.CacheWrap().(storetypes.CacheKVStore)
+	deliverState.Set([]byte("a"), []byte("1"))
+
+	// Writes to a tx branch are recorded only once written to the deliver state.
+	recorder.phase = 0
+	txState := deliverState.CacheWrap().(storetypes.CacheKVStore)
+	txState.Set([]byte("b"), []byte("2"))
+	txState.Delete([]byte("a"))
+	_, recorded := recorder.writes[key.Name()]["b"]
+	require.False(t, recorded)
+	txState.Write()
+
+	recorder.phase = 1
+	failedTxState := deliverState.CacheWrap().(storetypes.CacheKVStore)
+	failedTxState.Set([]byte("c"), []byte("3"))
+
+	require.Equal(t, map[string]map[string]blockPhase{
+		key.Name(): {"a": 0, "b": 0},
+	}, recorder.writes)
+	require.Equal(t, store.(recordedStore).CommitKVStore, recorder.Unwrap(key))
+
+	recorder.startBlock()
+	require.Empty(t, recorder.writes)
+	require.Equal(t, phaseBeginBlock, recorder.phase)
+}
+
+// commitKVStoreStub is a commit store over a KV store, whose commitment methods are not implemented.
+type commitKVStoreStub struct {
+	storetypes.Committer
+	dbadapter.Store
+}
@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// blockPhase identifies the part of a block being executed: its begin block, one of its txs by index, or its end block.
+type blockPhase int
+
+const (
+	// phaseBlock refers to the block as a whole, for the differences that can't be attributed to a part of it.
+	phaseBlock      blockPhase = -2
+	phaseBeginBlock blockPhase = -1
+	phaseEndBlock   blockPhase = math.MaxInt32
+)
+
+func (p blockPhase) String() string {
+	switch p {
+	case phaseBlock:
+		return "block"
+	case phaseBeginBlock:
+		return "begin_block"
+	case phaseEndBlock:
+		return "end_block"
+	default:
+		return fmt.Sprintf("tx %d", int(p))
+	}
+}
+
+func (p blockPhase) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// replayReport is the report of a replay, listing the blocks whose replay diverged from what was recorded.
+type replayReport struct {
+	StartHeight     int64         `json:"start_height"`
+	EndHeight       int64         `json:"end_height"`
+	LastReplayed    int64         `json:"last_replayed_height"`
+	FirstDivergence *divergence   `json:"first_divergence,omitempty"`
+	DivergentBlocks []blockReport `json:"divergent_blocks"`
+}
+
+// divergence locates the first part of a block whose replay diverged.
+type divergence struct {
+	Height int64      `json:"height"`
+	Phase  blockPhase `json:"phase"`
+	TxHash string     `json:"tx_hash,omitempty"`
+	// Msgs are the type urls of the messages of the divergent tx.
+	Msgs []string `json:"msgs,omitempty"`
+}
+
+// blockReport lists the differences between the replay of a block and what was recorded.
+type blockReport struct {
+	Height          int64  `json:"height"`
+	RecordedAppHash string `json:"recorded_app_hash"`
+	// ReplayedAppHash is empty when the replayed state could not be committed, as it conflicts with the recorded one.
+	ReplayedAppHash string `json:"replayed_app_hash,omitempty"`
+	// ResultsSource is where the recorded results come from: the ABCI responses of the state DB, the last results hash of
+	// the next block header when the responses were discarded, or none.
+	ResultsSource string       `json:"results_source"`
+	ResultDiffs   []resultDiff `json:"result_diffs,omitempty"`
+	StoreDiffs    []storeDiff  `json:"store_diffs,omitempty"`
+}
+
+// diverged returns true if the replay of the block diverged in any way.
+func (r blockReport) diverged() bool {
+	return r.stateDiverged() || len(r.ResultDiffs) != 0
+}
+
+// stateDiverged returns true if the replayed state of the block differs from the recorded one.
+func (r blockReport) stateDiverged() bool {
+	return r.ReplayedAppHash != r.RecordedAppHash || len(r.StoreDiffs) != 0
+}
+
+// firstDivergentPhase returns the earliest phase of the block a difference is attributed to,
+// the block as a whole if none is.
+func (r blockReport) firstDivergentPhase() blockPhase {
+	first := phaseBlock
+	consider := func(phase blockPhase) {
+		if phase != phaseBlock && (first == phaseBlock || phase < first) {
+			first = phase
+		}
+	}
+	for _, diff := range r.ResultDiffs {
+		consider(diff.Phase)
+	}
+	for _, storeDiff := range r.StoreDiffs {
+		for _, keyDiff := range storeDiff.KeyDiffs {
+			consider(keyDiff.LastWrittenBy)
+		}
+	}
+	return first
+}
+
+// resultDiff is a field of an ABCI response whose replayed value differs from the recorded one.
+type resultDiff struct {
+	Phase    blockPhase `json:"phase"`
+	Field    string     `json:"field"`
+	Recorded string     `json:"recorded"`
+	Replayed string     `json:"replayed"`
+}
+
+// storeDiff is a module store whose replayed state differs from the recorded one.
+type storeDiff struct {
+	Store        string `json:"store"`
+	RecordedHash string `json:"recorded_hash,omitempty"`
+	ReplayedHash string `json:"replayed_hash,omitempty"`
+	// KeyDiffs are the keys written by the replay whose value differs from the recorded state, at most the maximum number
+	// of diffs requested. Keys only written by the recorded block are not listed.
+	KeyDiffs      []keyDiff `json:"key_diffs,omitempty"`
+	TotalKeyDiffs int       `json:"total_key_diffs"`
+	// Note explains why the keys could not be compared, if so.
+	Note string `json:"note,omitempty"`
+}
+
+// keyDiff is a key written by the replay whose replayed value differs from the recorded one.
+// An entry is nil when the key is absent from the state.
+type keyDiff struct {
+	LastWrittenBy blockPhase     `json:"last_written_by"`
+	Previous      *rawStoreEntry `json:"previous"`
+	Recorded      *rawStoreEntry `json:"recorded"`
+	Replayed      *rawStoreEntry `json:"replayed"`
+}
+
+// diffBlockResults compares the replayed ABCI responses of a block with the recorded ones.
+func diffBlockResults(recorded, replayed *tmstate.ABCIResponses) []resultDiff {
+	diffs := diffEvents(phaseBeginBlock, "events", recorded.BeginBlock.GetEvents(), replayed.BeginBlock.GetEvents())
+
+	diffs = append(diffs, diffField(phaseBlock, "txs", strconv.Itoa(len(recorded.DeliverTxs)), strconv.Itoa(len(replayed.DeliverTxs)))...)
+	for i := 0; i < len(recorded.DeliverTxs) && i < len(replayed.DeliverTxs); i++ {
+		diffs = append(diffs, diffDeliverTx(blockPhase(i), recorded.DeliverTxs[i], replayed.DeliverTxs[i])...)
+	}
+
+	diffs = append(diffs, diffField(phaseEndBlock, "validator_updates",
+		formatValidatorUpdates(recorded.EndBlock.GetValidatorUpdates()), formatValidatorUpdates(replayed.EndBlock.GetValidatorUpdates()))...)
+	diffs = append(diffs, diffField(phaseEndBlock, "consensus_param_updates",
+		recorded.EndBlock.GetConsensusParamUpdates().String(), replayed.EndBlock.GetConsensusParamUpdates().String())...)
+	diffs = append(diffs, diffEvents(phaseEndBlock, "events", recorded.EndBlock.GetEvents(), replayed.EndBlock.GetEvents())...)
+	return diffs
+}
+
+// diffDeliverTx compares the replayed result of a tx with the recorded one.
+// The log is only compared for failed txs, the log of successful txs being their events, compared as such.
+func diffDeliverTx(phase blockPhase, recorded, replayed *abci.ResponseDeliverTx) []resultDiff {
+	diffs := diffField(phase, "code", formatCode(recorded), formatCode(replayed))
+	diffs = append(diffs, diffField(phase, "data", hex.EncodeToString(recorded.GetData()), hex.EncodeToString(replayed.GetData()))...)
+	diffs = append(diffs, diffField(phase, "gas_wanted", strconv.FormatInt(recorded.GetGasWanted(), 10), strconv.FormatInt(replayed.GetGasWanted(), 10))...)
+	diffs = append(diffs, diffField(phase, "gas_used", strconv.FormatInt(recorded.GetGasUsed(), 10), strconv.FormatInt(replayed.GetGasUsed(), 10))...)
+	if !recorded.IsOK() || !replayed.IsOK() {
+		diffs = append(diffs, diffField(phase, "log", recorded.GetLog(), replayed.GetLog())...)
+	}
+	return append(diffs, diffEvents(phase, "events", recorded.GetEvents(), replayed.GetEvents())...)
+}
+
+// diffEvents reports the number of events if it differs, and the first differing event, the following ones
+// being usually shifted by the first difference. The indexing flag of the attributes, which depends on the
+// configuration of the node, is ignored.
+func diffEvents(phase blockPhase, field string, recorded, replayed []abci.Event) []resultDiff {
+	diffs := diffField(phase, field, strconv.Itoa(len(recorded)), strconv.Itoa(len(replayed)))
+	for i := 0; i < len(recorded) || i < len(replayed); i++ {
+		recordedEvent, replayedEvent := formatEvent(recorded, i), formatEvent(replayed, i)
+		if recordedEvent != replayedEvent {
+			return append(diffs, resultDiff{Phase: phase, Field: fmt.Sprintf("%s[%d]", field, i), Recorded: recordedEvent, Replayed: replayedEvent})
+		}
+	}
+	return diffs
+}
+
+// diffField returns the difference of the field, if its recorded and replayed values differ.
+func diffField(phase blockPhase, field, recorded, replayed string) []resultDiff {
+	if recorded == replayed {
+		return nil
+	}
+	return []resultDiff{{Phase: phase, Field: field, Recorded: recorded, Replayed: replayed}}
+}
+
+// formatEvent formats the event at the given index as type{key=value,...}, or returns an empty string if there is none.
+func formatEvent(events []abci.Event, i int) string {
+	if i >= len(events) {
+		return ""
+	}
+	attributes := make([]string, 0, len(events[i].Attributes))
+	for _, attribute := range events[i].Attributes {
+		attributes = append(attributes, string(attribute.Key)+"="+string(attribute.Value))
+	}
+	return events[i].Type + "{" + strings.Join(attributes, ",") + "}"
+}
+
+func formatCode(res *abci.ResponseDeliverTx) string {
+	if res.GetCodespace() == "" {
+		return strconv.FormatUint(uint64(res.GetCode()), 10)
+	}
+	return fmt.Sprintf("%s/%d", res.GetCodespace(), res.GetCode())
+}
+
+func formatValidatorUpdates(updates []abci.ValidatorUpdate) string {
+	formatted := make([]string, 0, len(updates))
+	for _, update := range updates {
+		formatted = append(formatted, update.String())
+	}
+	return strings.Join(formatted, ",")
+}
+
+// diffWrittenKeys compares the replayed values of the keys written to a store with the recorded ones, in key order.
+// It returns at most maxDiffs of the differing keys, along with their total number.
+func diffWrittenKeys(cdc codec.Codec, storeName string, writes map[string]blockPhase, previous, recorded, replayed storetypes.KVStore, maxDiffs int) (diffs []keyDiff, total int) {
+	keys := make([]string, 0, len(writes))
+	for key := range writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		recordedValue, replayedValue := recorded.Get([]byte(key)), replayed.Get([]byte(key))
+		if bytes.Equal(recordedValue, replayedValue) {
+			continue
+		}
+		total++
+		if len(diffs) < maxDiffs {
+			diffs = append(diffs, keyDiff{
+				LastWrittenBy: writes[key],
+				Previous:      storeEntryOrNil(cdc, storeName, []byte(key), previous.Get([]byte(key))),
+				Recorded:      storeEntryOrNil(cdc, storeName, []byte(key), recordedValue),
+				Replayed:      storeEntryOrNil(cdc, storeName, []byte(key), replayedValue),
+			})
+		}
+	}
+	return diffs, total
+}
+
+// storeEntryOrNil decodes the store entry, or returns nil if the value is nil as the key is absent.
+func storeEntryOrNil(cdc codec.Codec, storeName string, key, value []byte) *rawStoreEntry {
+	if value == nil {
+		return nil
+	}
+	entry := decodeStoreEntry(cdc, storeName, key, value)
+	return &entry
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+
+	osmosis "github.com/osmosis-labs/osmosis/v16/app"
+)
+
+func TestDiffBlockResults(t *testing.T) {
+	event := func(typ string, attributes ...string) abci.Event {
+		event := abci.Event{Type: typ}
+		for i := 0; i < len(attributes); i += 2 {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: []byte(attributes[i]), Value: []byte(attributes[i+1]), Index: true})
+		}
+		return event
+	}
+	responses := func(txs ...*abci.ResponseDeliverTx) *tmstate.ABCIResponses {
+		return &tmstate.ABCIResponses{
+			BeginBlock: &abci.ResponseBeginBlock{Events: []abci.Event{event("mint", "amount", "10")}},
+			DeliverTxs: txs,
+			EndBlock:   &abci.ResponseEndBlock{Events: []abci.Event{event("epoch_end", "epoch_number", "1")}},
+		}
+	}
+	sendTx := func() *abci.ResponseDeliverTx {
+		return &abci.ResponseDeliverTx{GasWanted: 200000, GasUsed: 80000, Events: []abci.Event{
+			event("coin_spent", "spender", "addr1", "amount", "5000uosmo"),
+			event("coin_received", "receiver", "addr2", "amount", "5000uosmo"),
+		}}
+	}
+
+	validatorUpdate := abci.ValidatorUpdate{Power: 10}
+
+	tests := map[string]struct {
+		recorded *tmstate.ABCIResponses
+		replayed func() *tmstate.ABCIResponses
+		expected []resultDiff
+	}{
+		"identical results": {
+			recorded: responses(sendTx()),
+			replayed: func() *tmstate.ABCIResponses { return responses(sendTx()) },
+		},
+		"attribute index flag is ignored": {
+			recorded: responses(sendTx()),
+			replayed: func() *tmstate.ABCIResponses {
+				res := responses(sendTx())
+				res.DeliverTxs[0].Events[0].Attributes[0].Index = false
+				return res
+			},
+		},
+		"tx gas and event": {
+			recorded: responses(sendTx(), sendTx()),
+			replayed: func() *tmstate.ABCIResponses {
+				res := responses(sendTx(), sendTx())
+				res.DeliverTxs[1].GasUsed = 80001
+				res.DeliverTxs[1].Events[1].Attributes[1].Value = []byte("5001uosmo")
+				return res
+			},
+			expected: []resultDiff{
+				{Phase: 1, Field: "gas_used", Recorded: "80000", Replayed: "80001"},
+				{Phase: 1, Field: "events[1]", Recorded: "coin_received{receiver=addr2,amount=5000uosmo}", Replayed: "coin_received{receiver=addr2,amount=5001uosmo}"},
+			},
+		},
+		"failed tx": {
+			recorded: responses(sendTx()),
+			replayed: func() *tmstate.ABCIResponses {
+				return responses(&abci.ResponseDeliverTx{Codespace: "sdk", Code: 5, Log: "insufficient funds", GasWanted: 200000, GasUsed: 80000})
+			},
+			expected: []resultDiff{
+				{Phase: 0, Field: "code", Recorded: "0", Replayed: "sdk/5"},
+				{Phase: 0, Field: "log", Recorded: "", Replayed: "insufficient funds"},
+				{Phase: 0, Field: "events", Recorded: "2", Replayed: "0"},
+				{Phase: 0, Field: "events[0]", Recorded: "coin_spent{spender=addr1,amount=5000uosmo}", Replayed: ""},
+			},
+		},
+		"tx count, begin and end block events": {
+			recorded: responses(sendTx()),
+			replayed: func() *tmstate.ABCIResponses {
+				res := responses()
+				res.BeginBlock.Events = append(res.BeginBlock.Events, event("transfer"))
+				res.EndBlock.Events[0].Type = "epoch_start"
+				return res
+			},
+			expected: []resultDiff{
+				{Phase: phaseBeginBlock, Field: "events", Recorded: "1", Replayed: "2"},
+				{Phase: phaseBeginBlock, Field: "events[1]", Recorded: "", Replayed: "transfer{}"},
+				{Phase: phaseBlock, Field: "txs", Recorded: "1", Replayed: "0"},
+				{Phase: phaseEndBlock, Field: "events[0]", Recorded: "epoch_end{epoch_number=1}", Replayed: "epoch_start{epoch_number=1}"},
+			},
+		},
+		"validator updates": {
+			recorded: responses(),
+			replayed: func() *tmstate.ABCIResponses {
+				res := responses()
+				res.EndBlock.ValidatorUpdates = []abci.ValidatorUpdate{validatorUpdate}
+				return res
+			},
+			expected: []resultDiff{
+				{Phase: phaseEndBlock, Field: "validator_updates", Recorded: "", Replayed: validatorUpdate.String()},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, diffBlockResults(tc.recorded, tc.replayed()))
+		})
+	}
+}
+
+func TestFirstDivergentPhase(t *testing.T) {
+	tests := map[string]struct {
+		report   blockReport
+		expected blockPhase
+	}{
+		"app hash only": {
+			report:   blockReport{RecordedAppHash: "aa", ReplayedAppHash: "bb"},
+			expected: phaseBlock,
+		},
+		"tx count only": {
+			report:   blockReport{ResultDiffs: []resultDiff{{Phase: phaseBlock, Field: "txs"}}},
+			expected: phaseBlock,
+		},
+		"earliest of results and writes": {
+			report: blockReport{
+				ResultDiffs: []resultDiff{{Phase: phaseEndBlock}, {Phase: 3}},
+				StoreDiffs:  []storeDiff{{KeyDiffs: []keyDiff{{LastWrittenBy: 5}, {LastWrittenBy: 2}}}},
+			},
+			expected: 2,
+		},
+		"begin block": {
+			report: blockReport{
+				ResultDiffs: []resultDiff{{Phase: 0}},
+				StoreDiffs:  []storeDiff{{KeyDiffs: []keyDiff{{LastWrittenBy: phaseBeginBlock}}}},
+			},
+			expected: phaseBeginBlock,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.report.firstDivergentPhase())
+		})
+	}
+}
+
+func TestDiffWrittenKeys(t *testing.T) {
+	cdc := osmosis.MakeEncodingConfig().Marshaler
+	newStore := func(entries ...string) dbadapter.Store {
+		store := dbadapter.Store{DB: tmdb.NewMemDB()}
+		for i := 0; i < len(entries); i += 2 {
+			store.Set([]byte(entries[i]), []byte(entries[i+1]))
+		}
+		return store
+	}
+	entry := func(key, value string) *rawStoreEntry {
+		return &rawStoreEntry{Key: hex.EncodeToString([]byte(key)), RawValue: hex.EncodeToString([]byte(value))}
+	}
+
+	previous := newStore("a", "1", "b", "2", "c", "3")
+	recorded := newStore("a", "10", "b", "2", "c", "30", "e", "5")
+	replayed := newStore("a", "11", "b", "2", "d", "4", "e", "5")
+	writes := map[string]blockPhase{"a": 0, "b": 1, "c": phaseEndBlock, "d": 2, "e": phaseBeginBlock}
+
+	diffs, total := diffWrittenKeys(cdc, "test", writes, previous, recorded, replayed, 10)
+	require.Equal(t, 3, total)
+	require.Equal(t, []keyDiff{
+		{LastWrittenBy: 0, Previous: entry("a", "1"), Recorded: entry("a", "10"), Replayed: entry("a", "11")},
+		{LastWrittenBy: phaseEndBlock, Previous: entry("c", "3"), Recorded: entry("c", "30")},
+		{LastWrittenBy: 2, Replayed: entry("d", "4")},
+	}, diffs)
+
+	diffs, total = diffWrittenKeys(cdc, "test", writes, previous, recorded, replayed, 1)
+	require.Equal(t, 3, total)
+	require.Len(t, diffs, 1)
+}
@@ -158,6 +158,19 @@ arbitrage-min-gas-fee = ".005"
 # This is the minimum gas fee any tx with high gas demand should have, denominated in uosmo per gas
 # Default value of ".0025" then means that a tx with 1 million gas costs (.0025 uosmo/gas) * 1_000_000 gas = .0025 osmo
 min-gas-price-for-high-gas-tx = ".0025"
+
+# Whether the arbitrage tx classifier looks at the messages nested in authz MsgExec messages.
+arbitrage-filter-unwrap-authz = "true"
+
+# The maximum number of nested authz MsgExec messages the arbitrage tx classifier unwraps.
+arbitrage-filter-max-unwrap-depth = "4"
+
+# Whether a tx that sees the same denom twice across all of its swap routes is classified as an arbitrage.
+arbitrage-filter-repeated-denoms = "true"
+
+# Contract addresses (e.g. swap routers) whose executions are treated as swaps by the arbitrage tx classifier.
+# A tx executing one of these contracts along with any other swap is classified as an arbitrage.
+arbitrage-filter-swap-contracts = []
 `
 
 	return OsmosisAppTemplate, OsmosisAppCfg
@@ -170,10 +183,13 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 	debugCmd := debug.Cmd()
 	debugCmd.AddCommand(ConvertBech32Cmd())
+	debugCmd.AddCommand(DebugStateCmd())
+	debugCmd.AddCommand(DebugCheckInvariantsCmd())
 
 	rootCmd.AddCommand(
 		// genutilcli.InitCmd(osmosis.ModuleBasics, osmosis.DefaultNodeHome),
 		forceprune(),
+		ReplayCmd(),
 		InitCmd(osmosis.ModuleBasics, osmosis.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, osmosis.DefaultNodeHome),
 		genutilcli.MigrateGenesisCmd(),
@@ -193,6 +209,13 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	)
 
 	server.AddCommands(rootCmd, osmosis.DefaultNodeHome, newApp, createOsmosisAppAndExport, addModuleInitFlags)
+	// the SDK export command is replaced by one streaming the application state.
+	for _, subCmd := range rootCmd.Commands() {
+		if subCmd.Name() == "export" {
+			rootCmd.RemoveCommand(subCmd)
+		}
+	}
+	rootCmd.AddCommand(ExportCmd(loadOsmosisAppForExport, osmosis.DefaultNodeHome))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -319,6 +342,16 @@ func createOsmosisAppAndExport(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string,
 	appOpts servertypes.AppOptions, modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
+	app, err := loadOsmosisAppForExport(logger, db, traceStore, height, appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return app.ExportAppStateAndValidators(forZeroHeight, jailWhiteList, modulesToExport)
+}
+
+// loadOsmosisAppForExport creates the app and loads it at the given height, the latest one if -1.
+func loadOsmosisAppForExport(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, appOpts servertypes.AppOptions) (*osmosis.OsmosisApp, error) {
 	encCfg := osmosis.MakeEncodingConfig() // Ideally, we would reuse the one created by NewRootCmd.
 	encCfg.Marshaler = codec.NewProtoCodec(encCfg.InterfaceRegistry)
 	loadLatest := height == -1
@@ -327,9 +360,8 @@ func createOsmosisAppAndExport(
 
 	if !loadLatest {
 		if err := app.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
+			return nil, err
 		}
 	}
-
-	return app.ExportAppStateAndValidators(forZeroHeight, jailWhiteList, modulesToExport)
+	return app, nil
 }
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"go/format"
 	"os"
 	"path/filepath"
 	"strings"
@@ -9,10 +12,18 @@ import (
 
 	"github.com/pkg/errors"
 
+	// registers the proto files of all the modules, whose descriptors are used for the clients and schemas.
+	_ "github.com/osmosis-labs/osmosis/v16/app"
 	"github.com/osmosis-labs/osmosis/v16/cmd/querygen/templates"
 )
 
-var grpcTemplate template.Template
+const stargateWhitelistPath = "wasmbinding/stargate_whitelist_generated.go"
+
+var (
+	grpcTemplate              template.Template
+	queryClientTemplate       template.Template
+	stargateWhitelistTemplate template.Template
+)
 
 func main() {
 	err := parseTemplates()
@@ -22,11 +33,19 @@ func main() {
 	}
 
 	queryYMLs := crawlForQueryYMLs()
+	whitelistModules := []templates.StargateWhitelistModule{}
 	for _, path := range queryYMLs {
-		err := codegenQueryYml(path)
+		whitelistModule, err := codegenQueryYml(path)
 		if err != nil {
 			fmt.Println(errors.Wrap(err, fmt.Sprintf("error in code generating %s ", path)))
+			continue
 		}
+		whitelistModules = append(whitelistModules, whitelistModule)
+	}
+
+	err = codegenStargateWhitelist(whitelistModules)
+	if err != nil {
+		fmt.Println(errors.Wrap(err, "error in code generating the stargate whitelist"))
 	}
 }
 
@@ -36,6 +55,18 @@ func parseTemplates() error {
 		return err
 	}
 	grpcTemplate = *grpcTemplatePtr
+
+	queryClientTemplatePtr, err := template.ParseFiles("cmd/querygen/templates/query_client.tmpl")
+	if err != nil {
+		return err
+	}
+	queryClientTemplate = *queryClientTemplatePtr
+
+	stargateWhitelistTemplatePtr, err := template.ParseFiles("cmd/querygen/templates/stargate_whitelist.tmpl")
+	if err != nil {
+		return err
+	}
+	stargateWhitelistTemplate = *stargateWhitelistTemplatePtr
 	return nil
 }
 
@@ -58,17 +89,38 @@ func crawlForQueryYMLs() []string {
 	return queryYmls
 }
 
-func codegenQueryYml(filepath string) error {
+// codegenQueryYml generates the code of the query.yml, and returns its queries to whitelist.
+func codegenQueryYml(filepath string) (templates.StargateWhitelistModule, error) {
 	queryYml, err := templates.ReadYmlFile(filepath)
 	if err != nil {
-		return err
+		return templates.StargateWhitelistModule{}, err
 	}
 
 	err = codegenGrpcPackage(queryYml)
 	if err != nil {
-		return err
+		return templates.StargateWhitelistModule{}, err
+	}
+
+	service, err := templates.LoadProtoService(queryYml.ProtoFile())
+	if err != nil {
+		return templates.StargateWhitelistModule{}, err
+	}
+
+	queryClientTemplateData, err := templates.QueryClientTemplateFromQueryYml(queryYml, service)
+	if err != nil {
+		return templates.StargateWhitelistModule{}, err
+	}
+	err = codegenQueryClientPackage(queryClientTemplateData)
+	if err != nil {
+		return templates.StargateWhitelistModule{}, err
+	}
+
+	err = codegenQuerySchema(queryYml, service)
+	if err != nil {
+		return templates.StargateWhitelistModule{}, err
 	}
-	return err
+
+	return templates.StargateWhitelistModuleFromQueryYml(queryYml, queryClientTemplateData), nil
 }
 
 func codegenGrpcPackage(queryYml templates.QueryYml) error {
@@ -91,3 +143,44 @@ func codegenGrpcPackage(queryYml templates.QueryYml) error {
 
 	return grpcTemplate.Execute(f, grpcTemplateData)
 }
+
+func codegenQueryClientPackage(queryClientTemplateData templates.QueryClientTemplate) error {
+	fsClientPath := templates.ParseFilePathFromImportPath(queryClientTemplateData.ClientPath)
+	return executeGoTemplate(queryClientTemplate, queryClientTemplateData, fsClientPath+"/queryclient/query_client.go")
+}
+
+func codegenQuerySchema(queryYml templates.QueryYml, service templates.ProtoService) error {
+	schema, err := templates.QuerySchemaFromQueryYml(queryYml, service)
+	if err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fsClientPath := templates.ParseFilePathFromImportPath(queryYml.ClientPath)
+	return os.WriteFile(fsClientPath+"/queryclient/query_schema.json", append(bz, '\n'), 0o644)
+}
+
+func codegenStargateWhitelist(modules []templates.StargateWhitelistModule) error {
+	stargateWhitelistTemplateData := templates.StargateWhitelistTemplateFromModules(modules)
+	return executeGoTemplate(stargateWhitelistTemplate, stargateWhitelistTemplateData, stargateWhitelistPath)
+}
+
+// executeGoTemplate generates the gofmt-ed Go file at the given path, creating its directory if needed.
+func executeGoTemplate(tmpl template.Template, data interface{}, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	bz, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, 0o644)
+}
@@ -0,0 +1,107 @@
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// ProtoService is the gRPC query service of a query.yml, as registered by its generated proto code.
+type ProtoService struct {
+	// e.g. osmosis.twap.v1beta1
+	Package string
+	// e.g. osmosis.twap.v1beta1.Query
+	FullName string
+	// key is the method name, e.g. `ArithmeticTwap`
+	Methods map[string]ProtoMethod
+}
+
+type ProtoMethod struct {
+	// full name of the request message, e.g. osmosis.twap.v1beta1.ArithmeticTwapRequest
+	Request string
+	// full name of the response message, e.g. osmosis.twap.v1beta1.ArithmeticTwapResponse
+	Response string
+}
+
+// LoadProtoService returns the query service of the registered proto file,
+// e.g. osmosis/twap/v1beta1/query.proto
+func LoadProtoService(protoFile string) (ProtoService, error) {
+	gz := proto.FileDescriptor(protoFile)
+	if gz == nil {
+		return ProtoService{}, fmt.Errorf("proto file %s is not registered", protoFile)
+	}
+	fd, err := extractFileDescriptor(gz)
+	if err != nil {
+		return ProtoService{}, err
+	}
+	if len(fd.Service) != 1 {
+		return ProtoService{}, fmt.Errorf("proto file %s must define exactly one service, got %d", protoFile, len(fd.Service))
+	}
+
+	service := fd.Service[0]
+	protoService := ProtoService{
+		Package:  fd.GetPackage(),
+		FullName: fd.GetPackage() + "." + service.GetName(),
+		Methods:  map[string]ProtoMethod{},
+	}
+	for _, method := range service.Method {
+		protoService.Methods[method.GetName()] = ProtoMethod{
+			Request:  strings.TrimPrefix(method.GetInputType(), "."),
+			Response: strings.TrimPrefix(method.GetOutputType(), "."),
+		}
+	}
+	return protoService, nil
+}
+
+// QueryPath returns the path of the query, used by gRPC, ABCI and Stargate queries,
+// e.g. /osmosis.twap.v1beta1.Query/ArithmeticTwap
+func (s ProtoService) QueryPath(queryName string) string {
+	return "/" + s.FullName + "/" + queryName
+}
+
+// GoTypeName returns the name of the generated Go type of a message of the service package,
+// e.g. ArithmeticTwapRequest for osmosis.twap.v1beta1.ArithmeticTwapRequest
+func (s ProtoService) GoTypeName(messageName string) (string, error) {
+	if !strings.HasPrefix(messageName, s.Package+".") {
+		return "", fmt.Errorf("message %s is not in package %s", messageName, s.Package)
+	}
+	return strings.ReplaceAll(strings.TrimPrefix(messageName, s.Package+"."), ".", "_"), nil
+}
+
+// loadMessageDescriptor returns the descriptor of the registered message, e.g. cosmos.base.v1beta1.Coin
+func loadMessageDescriptor(messageName string) (*descriptor.DescriptorProto, error) {
+	messageType := proto.MessageType(messageName)
+	if messageType == nil {
+		return nil, fmt.Errorf("message %s is not registered", messageName)
+	}
+	message, ok := reflect.New(messageType.Elem()).Interface().(descriptor.Message)
+	if !ok {
+		return nil, fmt.Errorf("message %s has no descriptor", messageName)
+	}
+	_, md := descriptor.ForMessage(message)
+	return md, nil
+}
+
+func extractFileDescriptor(gz []byte) (*descriptor.FileDescriptorProto, error) {
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	fd := &descriptor.FileDescriptorProto{}
+	if err := proto.Unmarshal(bz, fd); err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
@@ -0,0 +1,114 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	// registers the proto files of the queries.
+	_ "github.com/osmosis-labs/osmosis/v16/x/poolmanager/client/queryproto"
+	_ "github.com/osmosis-labs/osmosis/v16/x/twap/client/queryproto"
+)
+
+func TestLoadProtoService(t *testing.T) {
+	queryYml := QueryYml{protoPath: "proto/osmosis/poolmanager/v1beta1/query.yml"}
+	require.Equal(t, "osmosis/poolmanager/v1beta1/query.proto", queryYml.ProtoFile())
+
+	service, err := LoadProtoService(queryYml.ProtoFile())
+	require.NoError(t, err)
+	require.Equal(t, "osmosis.poolmanager.v1beta1.Query", service.FullName)
+	require.Equal(t, ProtoMethod{
+		Request:  "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountInRequest",
+		Response: "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse",
+	}, service.Methods["EstimateSinglePoolSwapExactAmountIn"])
+	require.Equal(t, "/osmosis.poolmanager.v1beta1.Query/NumPools", service.QueryPath("NumPools"))
+
+	_, err = service.GoTypeName("cosmos.base.v1beta1.Coin")
+	require.Error(t, err)
+
+	_, err = LoadProtoService("osmosis/unknown/query.proto")
+	require.Error(t, err)
+}
+
+func TestQueryClientAndStargateWhitelist(t *testing.T) {
+	queryYml := QueryYml{
+		Keeper:     Keeper{Path: "github.com/osmosis-labs/osmosis/v16/x/poolmanager"},
+		ClientPath: "github.com/osmosis-labs/osmosis/v16/x/poolmanager/client",
+		Queries: map[string]YmlQueryDescriptor{
+			"NumPools":                            {},
+			"EstimateSinglePoolSwapExactAmountIn": {Deterministic: true},
+		},
+		protoPath: "proto/osmosis/poolmanager/v1beta1/query.yml",
+	}
+	service, err := LoadProtoService(queryYml.ProtoFile())
+	require.NoError(t, err)
+
+	clientTemplate, err := QueryClientTemplateFromQueryYml(queryYml, service)
+	require.NoError(t, err)
+	require.Equal(t, []QueryClientQuery{
+		{
+			QueryName: "EstimateSinglePoolSwapExactAmountIn",
+			Path:      "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn",
+			Request:   "EstimateSinglePoolSwapExactAmountInRequest",
+			Response:  "EstimateSwapExactAmountInResponse",
+		},
+		{
+			QueryName: "NumPools",
+			Path:      "/osmosis.poolmanager.v1beta1.Query/NumPools",
+			Request:   "NumPoolsRequest",
+			Response:  "NumPoolsResponse",
+		},
+	}, clientTemplate.Queries)
+
+	module := StargateWhitelistModuleFromQueryYml(queryYml, clientTemplate)
+	require.Equal(t, StargateWhitelistModule{
+		Name:           "poolmanager",
+		Alias:          "poolmanagerqueryproto",
+		QueryprotoPath: "github.com/osmosis-labs/osmosis/v16/x/poolmanager/client/queryproto",
+		Queries: []StargateWhitelistQuery{{
+			Path:     "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn",
+			Response: "EstimateSwapExactAmountInResponse",
+		}},
+	}, module)
+
+	// modules without deterministic queries are not whitelisted.
+	whitelist := StargateWhitelistTemplateFromModules([]StargateWhitelistModule{module, {Name: "cosmwasmpool"}})
+	require.Equal(t, []StargateWhitelistModule{module}, whitelist.Modules)
+
+	queryYml.Queries["Unknown"] = YmlQueryDescriptor{}
+	_, err = QueryClientTemplateFromQueryYml(queryYml, service)
+	require.Error(t, err)
+}
+
+func TestQuerySchemaFromQueryYml(t *testing.T) {
+	queryYml := QueryYml{
+		Queries: map[string]YmlQueryDescriptor{
+			"ArithmeticTwap": {Deterministic: true},
+		},
+		protoPath: "proto/osmosis/twap/v1beta1/query.yml",
+	}
+	service, err := LoadProtoService(queryYml.ProtoFile())
+	require.NoError(t, err)
+
+	schema, err := QuerySchemaFromQueryYml(queryYml, service)
+	require.NoError(t, err)
+	require.Equal(t, "osmosis.twap.v1beta1.Query", schema.Title)
+	require.Equal(t, QuerySchemaEntry{
+		Path:          "/osmosis.twap.v1beta1.Query/ArithmeticTwap",
+		Deterministic: true,
+		Request:       &JSONSchema{Ref: "#/definitions/osmosis.twap.v1beta1.ArithmeticTwapRequest"},
+		Response:      &JSONSchema{Ref: "#/definitions/osmosis.twap.v1beta1.ArithmeticTwapResponse"},
+	}, schema.Queries["ArithmeticTwap"])
+
+	require.Equal(t, &JSONSchema{Type: "object", Properties: map[string]*JSONSchema{
+		"pool_id":     {Type: "string", Pattern: "^-?[0-9]+$"},
+		"base_asset":  {Type: "string"},
+		"quote_asset": {Type: "string"},
+		"start_time":  {Type: "string", Format: "date-time"},
+		"end_time":    {Type: "string", Format: "date-time"},
+	}}, schema.Definitions["osmosis.twap.v1beta1.ArithmeticTwapRequest"])
+	// sdk.Dec is a string with a custom type.
+	require.Equal(t, &JSONSchema{Type: "object", Properties: map[string]*JSONSchema{
+		"arithmetic_twap": {Type: "string"},
+	}}, schema.Definitions["osmosis.twap.v1beta1.ArithmeticTwapResponse"])
+}
@@ -0,0 +1,53 @@
+package templates
+
+import (
+	"fmt"
+	"sort"
+)
+
+type QueryClientTemplate struct {
+	ProtoPath  string
+	ClientPath string
+	Queries    []QueryClientQuery
+}
+
+type QueryClientQuery struct {
+	QueryName string
+	// e.g. /osmosis.twap.v1beta1.Query/ArithmeticTwap
+	Path string
+	// Go types of queryproto, e.g. ArithmeticTwapRequest
+	Request  string
+	Response string
+}
+
+func QueryClientTemplateFromQueryYml(queryYml QueryYml, service ProtoService) (QueryClientTemplate, error) {
+	queries := []QueryClientQuery{}
+	for queryName := range queryYml.Queries {
+		method, ok := service.Methods[queryName]
+		if !ok {
+			return QueryClientTemplate{}, fmt.Errorf("query %s is not a method of %s", queryName, service.FullName)
+		}
+		request, err := service.GoTypeName(method.Request)
+		if err != nil {
+			return QueryClientTemplate{}, err
+		}
+		response, err := service.GoTypeName(method.Response)
+		if err != nil {
+			return QueryClientTemplate{}, err
+		}
+		queries = append(queries, QueryClientQuery{
+			QueryName: queryName,
+			Path:      service.QueryPath(queryName),
+			Request:   request,
+			Response:  response,
+		})
+	}
+	sort.Slice(queries, func(i, j int) bool {
+		return queries[i].QueryName < queries[j].QueryName
+	})
+	return QueryClientTemplate{
+		ProtoPath:  queryYml.protoPath,
+		ClientPath: queryYml.ClientPath,
+		Queries:    queries,
+	}, nil
+}
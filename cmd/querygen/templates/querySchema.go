@@ -0,0 +1,189 @@
+package templates
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// QuerySchema is the JSON schema description of the queries of a query.yml, for contract developers.
+// Messages are described with their proto3 JSON mapping, as returned to contracts by Stargate queries.
+type QuerySchema struct {
+	Schema string `json:"$schema"`
+	// e.g. osmosis.twap.v1beta1.Query
+	Title string `json:"title"`
+	// key is the query name, e.g. `ArithmeticTwap`
+	Queries map[string]QuerySchemaEntry `json:"queries"`
+	// key is the full message name, e.g. osmosis.twap.v1beta1.ArithmeticTwapRequest
+	Definitions map[string]*JSONSchema `json:"definitions"`
+}
+
+type QuerySchemaEntry struct {
+	Path          string      `json:"path"`
+	Deterministic bool        `json:"deterministic"`
+	Request       *JSONSchema `json:"request"`
+	Response      *JSONSchema `json:"response"`
+}
+
+// JSONSchema is the subset of JSON schema needed to describe proto messages.
+type JSONSchema struct {
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	ContentEncoding      string                 `json:"contentEncoding,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+}
+
+// wellKnownSchemas are the schemas of the well-known types having a special proto3 JSON mapping.
+var wellKnownSchemas = map[string]*JSONSchema{
+	"google.protobuf.Timestamp": {Type: "string", Format: "date-time"},
+	"google.protobuf.Duration":  {Type: "string", Pattern: `^-?[0-9]+(\.[0-9]+)?s$`},
+	"google.protobuf.Any": {Type: "object", Properties: map[string]*JSONSchema{
+		"@type": {Type: "string"},
+	}},
+}
+
+// QuerySchemaFromQueryYml returns the JSON schema of the queries of the query.yml, using the descriptors
+// of their registered proto messages.
+func QuerySchemaFromQueryYml(queryYml QueryYml, service ProtoService) (QuerySchema, error) {
+	schema := QuerySchema{
+		Schema:      jsonSchemaDraft,
+		Title:       service.FullName,
+		Queries:     map[string]QuerySchemaEntry{},
+		Definitions: map[string]*JSONSchema{},
+	}
+	for queryName, query := range queryYml.Queries {
+		method, ok := service.Methods[queryName]
+		if !ok {
+			return QuerySchema{}, fmt.Errorf("query %s is not a method of %s", queryName, service.FullName)
+		}
+		request, err := schema.messageSchema(method.Request)
+		if err != nil {
+			return QuerySchema{}, err
+		}
+		response, err := schema.messageSchema(method.Response)
+		if err != nil {
+			return QuerySchema{}, err
+		}
+		schema.Queries[queryName] = QuerySchemaEntry{
+			Path:          service.QueryPath(queryName),
+			Deterministic: query.Deterministic,
+			Request:       request,
+			Response:      response,
+		}
+	}
+	return schema, nil
+}
+
+// messageSchema returns a reference to the definition of the message, adding it and the messages
+// of its fields to the definitions if not already there.
+func (s QuerySchema) messageSchema(messageName string) (*JSONSchema, error) {
+	if schema, ok := wellKnownSchemas[messageName]; ok {
+		return schema, nil
+	}
+	ref := &JSONSchema{Ref: "#/definitions/" + messageName}
+	if _, ok := s.Definitions[messageName]; ok {
+		return ref, nil
+	}
+
+	md, err := loadMessageDescriptor(messageName)
+	if err != nil {
+		return nil, err
+	}
+	definition := &JSONSchema{Type: "object", Properties: map[string]*JSONSchema{}}
+	// added before the fields, for recursive messages to refer to it.
+	s.Definitions[messageName] = definition
+	for _, field := range md.Field {
+		fieldSchema, err := s.fieldSchema(md, field)
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %s: %w", field.GetName(), messageName, err)
+		}
+		// proto JSON of the codec uses the original field names.
+		definition.Properties[field.GetName()] = fieldSchema
+	}
+	return ref, nil
+}
+
+func (s QuerySchema) fieldSchema(md *descriptor.DescriptorProto, field *descriptor.FieldDescriptorProto) (*JSONSchema, error) {
+	if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED &&
+		field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		if mapEntry := findMapEntry(md, field.GetTypeName()); mapEntry != nil {
+			// map keys are always strings in JSON.
+			_, valueField := mapEntry.GetMapFields()
+			valueSchema, err := s.singularFieldSchema(valueField)
+			if err != nil {
+				return nil, err
+			}
+			return &JSONSchema{Type: "object", AdditionalProperties: valueSchema}, nil
+		}
+	}
+
+	schema, err := s.singularFieldSchema(field)
+	if err != nil {
+		return nil, err
+	}
+	if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return &JSONSchema{Type: "array", Items: schema}, nil
+	}
+	return schema, nil
+}
+
+func (s QuerySchema) singularFieldSchema(field *descriptor.FieldDescriptorProto) (*JSONSchema, error) {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return s.messageSchema(strings.TrimPrefix(field.GetTypeName(), "."))
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return enumSchema(strings.TrimPrefix(field.GetTypeName(), ".")), nil
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return &JSONSchema{Type: "number"}, nil
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_SINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return &JSONSchema{Type: "integer"}, nil
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		// 64 bits integers are encoded as strings in proto3 JSON.
+		return &JSONSchema{Type: "string", Pattern: "^-?[0-9]+$"}, nil
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return &JSONSchema{Type: "boolean"}, nil
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return &JSONSchema{Type: "string"}, nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return &JSONSchema{Type: "string", ContentEncoding: "base64"}, nil
+	default:
+		return nil, fmt.Errorf("unsupported field type %s", field.GetType())
+	}
+}
+
+// enumSchema returns the schema of the enum, whose values are encoded with their names in proto3 JSON.
+func enumSchema(enumName string) *JSONSchema {
+	values := proto.EnumValueMap(enumName)
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return values[names[i]] < values[names[j]]
+	})
+	return &JSONSchema{Type: "string", Enum: names}
+}
+
+// findMapEntry returns the nested map entry message of the type name, if any.
+func findMapEntry(md *descriptor.DescriptorProto, typeName string) *descriptor.DescriptorProto {
+	for _, nested := range md.NestedType {
+		if strings.HasSuffix(typeName, "."+nested.GetName()) && nested.GetOptions().GetMapEntry() {
+			return nested
+		}
+	}
+	return nil
+}
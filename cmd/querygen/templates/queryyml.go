@@ -2,6 +2,7 @@ package templates
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -28,6 +29,8 @@ type Keeper struct {
 type YmlQueryDescriptor struct {
 	ProtoWrapper *ProtoWrapperDescriptor `yaml:"proto_wrapper,omitempty"`
 	Cli          *CliDescriptor
+	// whether the query is deterministic, so whitelisted for Stargate queries of CosmWasm contracts
+	Deterministic bool `yaml:"deterministic"`
 }
 
 type ProtoWrapperDescriptor struct {
@@ -38,8 +41,8 @@ type ProtoWrapperDescriptor struct {
 
 type CliDescriptor struct{}
 
-func ReadYmlFile(filepath string) (QueryYml, error) {
-	content, err := os.ReadFile(filepath) // the file is inside the local directory
+func ReadYmlFile(path string) (QueryYml, error) {
+	content, err := os.ReadFile(path) // the file is inside the local directory
 	if err != nil {
 		return QueryYml{}, err
 	}
@@ -48,10 +51,22 @@ func ReadYmlFile(filepath string) (QueryYml, error) {
 	if err != nil {
 		return QueryYml{}, err
 	}
-	query.protoPath = filepath
+	query.protoPath = path
 	return query, nil
 }
 
+// ProtoFile returns the name the query.proto next to the query.yml is registered with,
+// e.g. osmosis/twap/v1beta1/query.proto for proto/osmosis/twap/v1beta1/query.yml
+func (q QueryYml) ProtoFile() string {
+	dir := filepath.ToSlash(filepath.Dir(q.protoPath))
+	return strings.TrimPrefix(dir, "proto/") + "/query.proto"
+}
+
+// ModuleName returns the name of the module of the keeper, e.g. twap
+func (q QueryYml) ModuleName() string {
+	return strings.TrimPrefix(ParseFilePathFromImportPath(q.Keeper.Path), "x/")
+}
+
 // input is of form github.com/osmosis-labs/osmosis/vXX/{PATH}
 // returns PATH
 func ParseFilePathFromImportPath(importPath string) string {
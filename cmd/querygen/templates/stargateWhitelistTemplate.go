@@ -0,0 +1,58 @@
+package templates
+
+import (
+	"sort"
+	"strings"
+)
+
+type StargateWhitelistTemplate struct {
+	Modules []StargateWhitelistModule
+}
+
+type StargateWhitelistModule struct {
+	// e.g. concentrated-liquidity
+	Name string
+	// import alias of the queryproto package, e.g. concentratedliquidityqueryproto
+	Alias          string
+	QueryprotoPath string
+	Queries        []StargateWhitelistQuery
+}
+
+type StargateWhitelistQuery struct {
+	// e.g. /osmosis.twap.v1beta1.Query/ArithmeticTwap
+	Path string
+	// Go type of queryproto, e.g. ArithmeticTwapResponse
+	Response string
+}
+
+// StargateWhitelistModuleFromQueryYml returns the queries of the query.yml marked as deterministic,
+// to be whitelisted for Stargate queries, using the client types as response.
+func StargateWhitelistModuleFromQueryYml(queryYml QueryYml, clientTemplate QueryClientTemplate) StargateWhitelistModule {
+	name := queryYml.ModuleName()
+	module := StargateWhitelistModule{
+		Name:           name,
+		Alias:          strings.ReplaceAll(name, "-", "") + "queryproto",
+		QueryprotoPath: queryYml.ClientPath + "/queryproto",
+		Queries:        []StargateWhitelistQuery{},
+	}
+	for _, query := range clientTemplate.Queries {
+		if queryYml.Queries[query.QueryName].Deterministic {
+			module.Queries = append(module.Queries, StargateWhitelistQuery{Path: query.Path, Response: query.Response})
+		}
+	}
+	return module
+}
+
+// StargateWhitelistTemplateFromModules returns the whitelist of the modules having deterministic queries.
+func StargateWhitelistTemplateFromModules(modules []StargateWhitelistModule) StargateWhitelistTemplate {
+	whitelist := StargateWhitelistTemplate{Modules: []StargateWhitelistModule{}}
+	for _, module := range modules {
+		if len(module.Queries) > 0 {
+			whitelist.Modules = append(whitelist.Modules, module)
+		}
+	}
+	sort.Slice(whitelist.Modules, func(i, j int) bool {
+		return whitelist.Modules[i].Name < whitelist.Modules[j].Name
+	})
+	return whitelist
+}
@@ -47,6 +47,10 @@ var (
 	// From: https://www.wolframalpha.com/input?i=log_2%28e%29+with+37+digits
 	logOfEbase2 = MustNewDecFromStr("1.442695040888963407359924681001892137")
 
+	// log_2(10)
+	// From: https://www.wolframalpha.com/input?i=log_2%2810%29+with+37+digits
+	logOf10base2 = MustNewDecFromStr("3.321928094887362347870319429489390176")
+
 	// log_2(1.0001)
 	// From: https://www.wolframalpha.com/input?i=log_2%281.0001%29+to+33+digits
 	tickLogOf2 = MustNewDecFromStr("0.000144262291094554178391070900057480")
@@ -449,6 +453,83 @@ func (d BigDec) ApproxRoot(root uint64) (guess BigDec, err error) {
 	return guess, nil
 }
 
+// ApproxRootWithRounding returns the positive real nth root of a decimal, rounded to the
+// precision of BigDec in the given direction, and returns `-|d|.ApproxRootWithRounding()` if input is negative.
+// Unlike ApproxRoot, the result is exact up to the last decimal: the root is computed on integers, so that
+// RoundDown returns the greatest decimal whose nth power is at most |d|, RoundUp the smallest decimal whose nth power is
+// at least |d|, and RoundBankers or RoundUnconstrained the closest decimal to the root.
+// The error is thus at most 10^-36, in the given direction.
+// Errors if root is zero.
+func (d BigDec) ApproxRootWithRounding(root uint64, roundingDirection RoundingDirection) (BigDec, error) {
+	if root == 0 {
+		return BigDec{}, errors.New("root must be positive")
+	}
+	if d.IsNegative() {
+		// rounding the root of |d| up rounds the negative result down, and conversely
+		switch roundingDirection {
+		case RoundUp:
+			roundingDirection = RoundDown
+		case RoundDown:
+			roundingDirection = RoundUp
+		}
+		absRoot, err := d.Neg().ApproxRootWithRounding(root, roundingDirection)
+		return absRoot.Neg(), err
+	}
+	if root == 1 || d.IsZero() {
+		return d.Clone(), nil
+	}
+
+	// x = d * 10^Precision is an integer, and root(x / 10^Precision) * 10^Precision = root(x * 10^(Precision * (root - 1))).
+	rootBig := new(big.Int).SetUint64(root)
+	radicand := new(big.Int).Exp(precisionReuse, new(big.Int).Sub(rootBig, oneInt), nil)
+	radicand.Mul(radicand, d.i)
+	result := integerRootFloor(radicand, root)
+
+	switch roundingDirection {
+	case RoundUp:
+		if new(big.Int).Exp(result, rootBig, nil).Cmp(radicand) != 0 {
+			result.Add(result, oneInt)
+		}
+	case RoundBankers, RoundUnconstrained:
+		// the root is closer to result + 1 when (2 * result + 1)^root < 2^root * radicand.
+		// The two sides cannot be equal, since the left one is odd and the right one is even.
+		doubledMidpoint := new(big.Int).Lsh(result, 1)
+		doubledMidpoint.Add(doubledMidpoint, oneInt)
+		doubledRadicand := new(big.Int).Lsh(radicand, uint(root))
+		if new(big.Int).Exp(doubledMidpoint, rootBig, nil).Cmp(doubledRadicand) < 0 {
+			result.Add(result, oneInt)
+		}
+	}
+
+	return BigDec{result}, nil
+}
+
+// integerRootFloor returns the greatest integer whose nth power is at most x, for a positive x,
+// using Newton's method on integers. The sequence of guesses starts above the root and decreases
+// until it reaches the floor of the root.
+func integerRootFloor(x *big.Int, root uint64) *big.Int {
+	if root == 2 {
+		return new(big.Int).Sqrt(x)
+	}
+
+	rootBig := new(big.Int).SetUint64(root)
+	rootMinusOne := new(big.Int).SetUint64(root - 1)
+
+	// 2^ceil(bitlen(x) / root) is greater than the root of x.
+	guess := new(big.Int).Lsh(oneInt, uint((uint64(x.BitLen())+root-1)/root))
+	for {
+		// next = ((root - 1) * guess + x / guess^(root - 1)) / root
+		next := new(big.Int).Exp(guess, rootMinusOne, nil)
+		next.Quo(x, next)
+		next.Add(next, new(big.Int).Mul(rootMinusOne, guess))
+		next.Quo(next, rootBig)
+		if next.Cmp(guess) >= 0 {
+			return guess
+		}
+		guess = next
+	}
+}
+
 // ApproxSqrt is a wrapper around ApproxRoot for the common special case
 // of finding the square root of a number. It returns -(sqrt(abs(d)) if input is negative.
 func (d BigDec) ApproxSqrt() (BigDec, error) {
@@ -955,6 +1036,8 @@ func (x BigDec) LogBase2() BigDec {
 
 // Natural logarithm of x.
 // Formula: ln(x) = log_2(x) / log_2(e)
+// Panics if x <= 0.
+// The absolute error is at most 10^-32, inherited from LogBase2.
 func (x BigDec) Ln() BigDec {
 	log2x := x.LogBase2()
 
@@ -963,6 +1046,79 @@ func (x BigDec) Ln() BigDec {
 	return y
 }
 
+// Log10 returns the base 10 logarithm of x.
+// Formula: log_10(x) = log_2(x) / log_2(10)
+// Panics if x <= 0.
+// The absolute error is at most 10^-32, inherited from LogBase2.
+func (x BigDec) Log10() BigDec {
+	log2x := x.LogBase2()
+
+	y := log2x.Quo(logOf10base2)
+
+	return y
+}
+
+// Exp returns e^x for any decimal x.
+// Formula: e^x = 2^(x * log_2(e)), and e^x = 1 / 2^(-x * log_2(e)) for negative x.
+// Panics if e^x does not fit in a decimal, i.e. x > ~709. Returns zero if x is so small that e^x rounds to zero.
+// The result is correct up to a factor of 10^-18, and an additive error of 10^-36 from the final rounding.
+// Meaning, result = e^x * k + r for k in [1 - 10^(-18), 1 + 10^(-18)] and |r| <= 10^(-36).
+// See Exp2 for the details of its bound.
+func Exp(x BigDec) BigDec {
+	return exp2Signed(x.Mul(logOfEbase2))
+}
+
+// PowBigDec returns base^power for a non-negative base and any decimal power.
+// Unlike Power, it supports bases in (0, 1) and negative powers.
+// Integer powers are computed by repeated multiplication.
+// Other powers are computed with the following formula:
+// base^power = 2^(power * log_2(base)), and base^power = 1 / 2^(-power * log_2(base)) when power * log_2(base) is negative.
+// Panics if the base is negative, if the base is zero and the power is not positive, or if the result
+// does not fit in a decimal.
+// The result is correct up to a factor of 10^-18 + |power| * 10^-32, and an additive error of 10^-36 from the final rounding.
+// Meaning, result = base^power * k + r for k in [1 - e, 1 + e], e = 10^(-18) + |power| * 10^(-32) and |r| <= 10^(-36).
+// The factor adds the bound of Exp2 to the absolute error of LogBase2 multiplied by the power.
+func PowBigDec(base BigDec, power BigDec) BigDec {
+	if base.IsNegative() {
+		panic(fmt.Sprintf("negative base is not supported for PowBigDec(), base was (%s)", base))
+	}
+	if power.IsZero() {
+		return OneDec()
+	}
+	if base.IsZero() {
+		if power.IsNegative() {
+			panic(fmt.Sprintf("zero base to a negative power (%s) is undefined", power))
+		}
+		return ZeroDec()
+	}
+	if base.Equal(OneDec()) {
+		return OneDec()
+	}
+	if power.IsInteger() && power.Abs().LTE(maxSupportedExponent) {
+		result := base.PowerInteger(power.Abs().TruncateInt().Uint64())
+		if power.IsNegative() {
+			return OneDec().QuoMut(result)
+		}
+		return result
+	}
+
+	return exp2Signed(power.Mul(base.LogBase2()))
+}
+
+// exp2Signed returns 2^exponent for a decimal exponent of any sign, by inverting
+// the result of exp2Unbounded for negative exponents.
+// Panics if the result does not fit in a decimal. Returns zero if the exponent is
+// smaller than -maxSupportedExponent, since the result rounds to zero.
+func exp2Signed(exponent BigDec) BigDec {
+	if !exponent.IsNegative() {
+		return exp2Unbounded(exponent)
+	}
+	if exponent.Neg().GT(maxSupportedExponent) {
+		return ZeroDec()
+	}
+	return OneDec().QuoMut(exp2Unbounded(exponent.Neg()))
+}
+
 // log_1.0001(x) "tick" base logarithm
 // Formula: log_1.0001(b) = log_2(b) / log_2(1.0001)
 func (x BigDec) TickLog() BigDec {
@@ -1019,8 +1175,7 @@ func (d BigDec) PowerIntegerMut(power uint64) BigDec {
 // Power returns a result of raising the given big dec to
 // a positive decimal power. Panics if the power is negative.
 // Panics if the base is negative. Does not mutate the receiver.
-// The max supported exponent is defined by the global maxSupportedExponent.
-// If a greater exponent is given, the function panics.
+// Panics if the result does not fit in a decimal.
 // The error is not bounded but expected to be around 10^-18, use with care.
 // See the underlying Exp2, LogBase2 and Mul for the details of their bounds.
 // WARNING: This function is broken for base < 1. The reason is that logarithm function is
@@ -1033,9 +1188,6 @@ func (d BigDec) Power(power BigDec) BigDec {
 	if power.IsNegative() {
 		panic(fmt.Sprintf("negative power is not supported for Power(), power was (%s)", power))
 	}
-	if power.Abs().GT(maxSupportedExponent) {
-		panic(fmt.Sprintf("integer exponent %s is too large, max (%s)", power, maxSupportedExponent))
-	}
 	if power.IsInteger() {
 		return d.PowerInteger(power.TruncateInt().Uint64())
 	}
@@ -1049,11 +1201,11 @@ func (d BigDec) Power(power BigDec) BigDec {
 		panic(fmt.Sprintf("Power() is not supported for base < 1, base was (%s)", d))
 	}
 	if d.Equal(twoBigDec) {
-		return Exp2(power)
+		return exp2Unbounded(power)
 	}
 
 	// d^power = exp2(power * log_2{base})
-	result := Exp2(d.LogBase2().Mul(power))
+	result := exp2Unbounded(d.LogBase2().Mul(power))
 
 	return result
 }
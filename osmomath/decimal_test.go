@@ -1400,10 +1400,22 @@ func (s *decimalTestSuite) TestPower() {
 				RoundingDir:             osmomath.RoundDown,
 			},
 		},
-		"large non-integer exponent with large non-integer base - panics": {
+		"169.137^100.7777 (large non-integer exponent with large non-integer base)": {
 			base:     osmomath.MustNewDecFromStr("169.137"),
 			exponent: osmomath.MustNewDecFromStr("100.7777"),
 
+			// https://www.wolframalpha.com/input?i=169.137%5E100.7777+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("3.603823368865677117147306506895146670").Mul(osmomath.NewBigDec(10).PowerInteger(224)),
+
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"result too large - panic": {
+			base:     osmomath.MustNewDecFromStr("169.137"),
+			exponent: osmomath.MustNewDecFromStr("150.7777"),
+
 			expectPanic: true,
 		},
 		"negative base - panic": {
@@ -1436,3 +1448,410 @@ func (s *decimalTestSuite) TestPower() {
 		})
 	}
 }
+
+func (s *decimalTestSuite) TestApproxRootWithRounding() {
+	sqrtTwoDown := osmomath.MustNewDecFromStr("1.414213562373095048801688724209698078")
+	sqrtTwoUp := osmomath.MustNewDecFromStr("1.414213562373095048801688724209698079")
+
+	tests := map[string]struct {
+		input             osmomath.BigDec
+		root              uint64
+		roundingDirection osmomath.RoundingDirection
+		expected          osmomath.BigDec
+		expectErr         bool
+	}{
+		"27 ^ (1/3), exact": {
+			input:             osmomath.NewBigDec(27),
+			root:              3,
+			roundingDirection: osmomath.RoundUp,
+			expected:          osmomath.NewBigDec(3),
+		},
+		"0.25 ^ (1/2), exact": {
+			input:             osmomath.NewDecWithPrec(25, 2),
+			root:              2,
+			roundingDirection: osmomath.RoundDown,
+			expected:          osmomath.NewDecWithPrec(5, 1),
+		},
+		// https://www.wolframalpha.com/input?i=2%5E0.5+40+digits
+		"2 ^ (1/2), round down": {
+			input:             osmomath.NewBigDec(2),
+			root:              2,
+			roundingDirection: osmomath.RoundDown,
+			expected:          sqrtTwoDown,
+		},
+		"2 ^ (1/2), round up": {
+			input:             osmomath.NewBigDec(2),
+			root:              2,
+			roundingDirection: osmomath.RoundUp,
+			expected:          sqrtTwoUp,
+		},
+		"2 ^ (1/2), round to nearest": {
+			input:             osmomath.NewBigDec(2),
+			root:              2,
+			roundingDirection: osmomath.RoundBankers,
+			expected:          sqrtTwoUp,
+		},
+		"-2 ^ (1/2), round down": {
+			input:             osmomath.NewBigDec(-2),
+			root:              2,
+			roundingDirection: osmomath.RoundDown,
+			expected:          sqrtTwoUp.Neg(),
+		},
+		"-2 ^ (1/2), round up": {
+			input:             osmomath.NewBigDec(-2),
+			root:              2,
+			roundingDirection: osmomath.RoundUp,
+			expected:          sqrtTwoDown.Neg(),
+		},
+		// https://www.wolframalpha.com/input?i=10%5E0.2+40+digits
+		"10 ^ (1/5), round down": {
+			input:             osmomath.NewBigDec(10),
+			root:              5,
+			roundingDirection: osmomath.RoundDown,
+			expected:          osmomath.MustNewDecFromStr("1.584893192461113485202101373391507013"),
+		},
+		// ApproxRoot does not converge in this case, see TestApproxRoot.
+		// https://www.wolframalpha.com/input?i=%281e-8%29%5E%281%2F3%29+40+digits
+		"1e-8 ^ (1/3), round to nearest": {
+			input:             osmomath.NewDecWithPrec(1, 8),
+			root:              3,
+			roundingDirection: osmomath.RoundUnconstrained,
+			expected:          osmomath.MustNewDecFromStr("0.002154434690031883721759293566519350"),
+		},
+		"smallest dec ^ (1/2)": {
+			input:             osmomath.SmallestDec(),
+			root:              2,
+			roundingDirection: osmomath.RoundUp,
+			expected:          osmomath.NewDecWithPrec(1, 18),
+		},
+		"zero": {
+			input:             osmomath.ZeroDec(),
+			root:              7,
+			roundingDirection: osmomath.RoundUp,
+			expected:          osmomath.ZeroDec(),
+		},
+		"root 0 - error": {
+			input:     osmomath.NewBigDec(2),
+			root:      0,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			res, err := tc.input.ApproxRootWithRounding(tc.root, tc.roundingDirection)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, res)
+		})
+	}
+}
+
+func (s *decimalTestSuite) TestLog10() {
+	var expectedErrTolerance = osmomath.MustNewDecFromStr("0.000000000000000000000000000000000100")
+
+	tests := map[string]struct {
+		initialValue osmomath.BigDec
+		expected     osmomath.BigDec
+
+		expectedPanic bool
+	}{
+		"log_10{0}; invalid; panic": {
+			initialValue:  osmomath.ZeroDec(),
+			expectedPanic: true,
+		},
+		"log_10{1} = 0": {
+			initialValue: osmomath.OneDec(),
+			expected:     osmomath.ZeroDec(),
+		},
+		"log_10{2} = 0.301029995663981195213738894724493027": {
+			initialValue: osmomath.NewBigDec(2),
+			// From: https://www.wolframalpha.com/input?i=log_10%282%29+to+36+decimals
+			expected: osmomath.MustNewDecFromStr("0.301029995663981195213738894724493027"),
+		},
+		"log_10{0.5} = -0.301029995663981195213738894724493027": {
+			initialValue: osmomath.NewDecWithPrec(5, 1),
+			expected:     osmomath.MustNewDecFromStr("-0.301029995663981195213738894724493027"),
+		},
+		"log_10{1000} = 3": {
+			initialValue: osmomath.NewBigDec(1000),
+			expected:     osmomath.NewBigDec(3),
+		},
+		"log_10{0.000001} = -6": {
+			initialValue: osmomath.NewDecWithPrec(1, 6),
+			expected:     osmomath.NewBigDec(-6),
+		},
+		"log_10{123.456} = 2.091512201627771681069399777067905795": {
+			initialValue: osmomath.NewDecWithPrec(123456, 3),
+			// From: https://www.wolframalpha.com/input?i=log_10%28123.456%29+to+36+decimals
+			expected: osmomath.MustNewDecFromStr("2.091512201627771681069399777067905795"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			osmomath.ConditionalPanic(s.T(), tc.expectedPanic, func() {
+				res := tc.initialValue.Log10()
+				require.True(osmomath.DecApproxEq(s.T(), tc.expected, res, expectedErrTolerance))
+			})
+		})
+	}
+}
+
+func (s *decimalTestSuite) TestExp() {
+	tests := map[string]struct {
+		exponent       osmomath.BigDec
+		expectedResult osmomath.BigDec
+		errTolerance   osmomath.ErrTolerance
+		expectPanic    bool
+	}{
+		"e^0 = 1": {
+			exponent:       osmomath.ZeroDec(),
+			expectedResult: osmomath.OneDec(),
+			errTolerance:   zeroAdditiveErrTolerance,
+		},
+		"e^1 = e": {
+			exponent:       osmomath.OneDec(),
+			expectedResult: osmomath.EulersNumber,
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"e^-1": {
+			exponent: osmomath.OneDec().Neg(),
+			// https://www.wolframalpha.com/input?i=e%5E-1+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("0.367879441171442321595523770161460867"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"e^0.000001": {
+			exponent: osmomath.NewDecWithPrec(1, 6),
+			// https://www.wolframalpha.com/input?i=e%5E0.000001+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("1.000001000000500000166666708333341667"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"e^10": {
+			exponent: osmomath.NewBigDec(10),
+			// https://www.wolframalpha.com/input?i=e%5E10+41+digits
+			expectedResult: osmomath.MustNewDecFromStr("22026.465794806716516957900645284244366354"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"e^-10": {
+			exponent: osmomath.NewBigDec(-10),
+			// https://www.wolframalpha.com/input?i=e%5E-10+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("0.000045399929762484851535591515560551"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"e^100": {
+			exponent: osmomath.NewBigDec(100),
+			// https://www.wolframalpha.com/input?i=e%5E100+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("2.688117141816135448412625551580013587").Mul(osmomath.NewBigDec(10).PowerInteger(43)),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"e^-50, only 15 significant digits are left after rounding": {
+			exponent: osmomath.NewBigDec(-50),
+			// https://www.wolframalpha.com/input?i=e%5E-50+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("0.000000000000000000000192874984796392"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: sdk.MustNewDecFromStr("0.00000000000001"),
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"e^-400 rounds to zero": {
+			exponent:       osmomath.NewBigDec(-400),
+			expectedResult: osmomath.ZeroDec(),
+			errTolerance:   zeroAdditiveErrTolerance,
+		},
+		"e^400, past 2^9 for the base 2 exponent": {
+			exponent: osmomath.NewBigDec(400),
+			// https://www.wolframalpha.com/input?i=e%5E400+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("5.221469689764143950588763006649649030").Mul(osmomath.NewBigDec(10).PowerInteger(173)),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"e^700": {
+			exponent: osmomath.NewBigDec(700),
+			// https://www.wolframalpha.com/input?i=e%5E700+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("1.014232054735004509455329595231267615").Mul(osmomath.NewBigDec(10).PowerInteger(304)),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"e^710, result does not fit in a decimal - panic": {
+			exponent:    osmomath.NewBigDec(710),
+			expectPanic: true,
+		},
+		"e^(10^40), exponent is larger than a uint64 - panic": {
+			exponent:    osmomath.NewBigDec(10).PowerInteger(40),
+			expectPanic: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			osmomath.ConditionalPanic(s.T(), tc.expectPanic, func() {
+				actualResult := osmomath.Exp(tc.exponent)
+				s.Require().Equal(0, tc.errTolerance.CompareBigDec(tc.expectedResult, actualResult), "expected %s, got %s", tc.expectedResult, actualResult)
+			})
+		})
+	}
+}
+
+func (s *decimalTestSuite) TestPowBigDec() {
+	tests := map[string]struct {
+		base           osmomath.BigDec
+		exponent       osmomath.BigDec
+		expectedResult osmomath.BigDec
+		expectPanic    bool
+		errTolerance   osmomath.ErrTolerance
+	}{
+		"3 ^ 2 = 9 (integer exponent)": {
+			base:           osmomath.NewBigDec(3),
+			exponent:       osmomath.NewBigDec(2),
+			expectedResult: osmomath.NewBigDec(9),
+			errTolerance:   zeroAdditiveErrTolerance,
+		},
+		"4 ^ -2 = 0.0625 (negative integer exponent)": {
+			base:           osmomath.NewBigDec(4),
+			exponent:       osmomath.NewBigDec(-2),
+			expectedResult: osmomath.MustNewDecFromStr("0.0625"),
+			errTolerance:   zeroAdditiveErrTolerance,
+		},
+		"2 ^ 0.5": {
+			base:     osmomath.NewBigDec(2),
+			exponent: osmomath.NewDecWithPrec(5, 1),
+			// https://www.wolframalpha.com/input?i=2%5E0.5+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("1.414213562373095048801688724209698079"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"0.5 ^ 0.5 (base < 1)": {
+			base:     osmomath.NewDecWithPrec(5, 1),
+			exponent: osmomath.NewDecWithPrec(5, 1),
+			// https://www.wolframalpha.com/input?i=0.5%5E0.5+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("0.707106781186547524400844362104849039"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"1.5 ^ -2.5 (negative exponent)": {
+			base:     osmomath.NewDecWithPrec(15, 1),
+			exponent: osmomath.NewDecWithPrec(-25, 1),
+			// https://www.wolframalpha.com/input?i=1.5%5E-2.5+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("0.362887369301211570103301344400872799"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"10 ^ -0.333": {
+			base:     osmomath.NewBigDec(10),
+			exponent: osmomath.NewDecWithPrec(-333, 3),
+			// https://www.wolframalpha.com/input?i=10%5E-0.333+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("0.464515275222749420123837435937498556"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"0.3 ^ 3.7 (base < 1 and exponent > 1)": {
+			base:     osmomath.NewDecWithPrec(3, 1),
+			exponent: osmomath.NewDecWithPrec(37, 1),
+			// https://www.wolframalpha.com/input?i=0.3%5E3.7+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("0.011623813746748224050318390042735422"),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"7 ^ 100.5 (large exponent)": {
+			base:     osmomath.NewBigDec(7),
+			exponent: osmomath.NewDecWithPrec(1005, 1),
+			// https://www.wolframalpha.com/input?i=7%5E100.5+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("8.557620465947324321378096657202197168").Mul(osmomath.NewBigDec(10).PowerInteger(84)),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance.MulInt64(10),
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"x ^ 0 = 1": {
+			base:           osmomath.NewDecWithPrec(3, 1),
+			exponent:       osmomath.ZeroDec(),
+			expectedResult: osmomath.OneDec(),
+			errTolerance:   zeroAdditiveErrTolerance,
+		},
+		"0 ^ 0.5 = 0": {
+			base:           osmomath.ZeroDec(),
+			exponent:       osmomath.NewDecWithPrec(5, 1),
+			expectedResult: osmomath.ZeroDec(),
+			errTolerance:   zeroAdditiveErrTolerance,
+		},
+		"0 ^ -0.5 - panic": {
+			base:        osmomath.ZeroDec(),
+			exponent:    osmomath.NewDecWithPrec(-5, 1),
+			expectPanic: true,
+		},
+		"negative base - panic": {
+			base:        osmomath.NewBigDec(-3),
+			exponent:    osmomath.NewDecWithPrec(5, 1),
+			expectPanic: true,
+		},
+		"2 ^ 1000.5, past 2^9 for the base 2 exponent": {
+			base:     osmomath.NewBigDec(2),
+			exponent: osmomath.NewDecWithPrec(10005, 1),
+			// https://www.wolframalpha.com/input?i=2%5E1000.5+37+digits
+			expectedResult: osmomath.MustNewDecFromStr("1.515342004482324461532259326246123136").Mul(osmomath.NewBigDec(10).PowerInteger(301)),
+			errTolerance: osmomath.ErrTolerance{
+				MultiplicativeTolerance: minDecTolerance,
+				RoundingDir:             osmomath.RoundUnconstrained,
+			},
+		},
+		"2 ^ -1000.5 rounds to zero": {
+			base:           osmomath.NewBigDec(2),
+			exponent:       osmomath.NewDecWithPrec(-10005, 1),
+			expectedResult: osmomath.ZeroDec(),
+			errTolerance:   zeroAdditiveErrTolerance,
+		},
+		"result too large - panic": {
+			base:        osmomath.NewBigDec(1000),
+			exponent:    osmomath.NewDecWithPrec(1105, 1),
+			expectPanic: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			osmomath.ConditionalPanic(s.T(), tc.expectPanic, func() {
+				actualResult := osmomath.PowBigDec(tc.base, tc.exponent)
+				s.Require().Equal(0, tc.errTolerance.CompareBigDec(tc.expectedResult, actualResult), "expected %s, got %s", tc.expectedResult, actualResult)
+			})
+		})
+	}
+}
@@ -63,6 +63,32 @@ func Exp2(exponent BigDec) BigDec {
 	return fractionalResult
 }
 
+// exp2Unbounded takes 2 to the power of a given non-negative decimal exponent
+// without the maxSupportedExponent cap of Exp2.
+// The exponent is reduced to its fractional part, which is computed by Exp2,
+// and the integer part is applied exactly as a left bit shift.
+// As a result, the answer has the same relative error bound as Exp2.
+// Panics if the exponent is negative or if the result does not fit in a BigDec.
+func exp2Unbounded(exponent BigDec) BigDec {
+	if exponent.IsNegative() {
+		panic(fmt.Sprintf("negative exponent %s is not supported", exponent))
+	}
+
+	integerExponent := exponent.TruncateDec()
+	// 2^integerExponent alone would overflow, avoid shifting by an arbitrarily large amount.
+	if integerExponent.GTE(NewBigDec(maxBitLen)) {
+		panic(fmt.Sprintf("exponent %s is too large, the result does not fit in a decimal", exponent))
+	}
+
+	result := Exp2(exponent.Sub(integerExponent))
+	result.i = result.i.Lsh(result.i, uint(integerExponent.TruncateInt().Uint64()))
+
+	if result.i.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return result
+}
+
 // exp2ChebyshevRationalApprox takes 2 to the power of a given decimal exponent.
 // The result is approximated by a 13 parameter Chebyshev rational approximation.
 // f(x) = h(x) / p(x) (7, 7) terms. We set the first term of p(x) to 1.
@@ -3,6 +3,7 @@ package osmomath
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,6 +17,11 @@ const (
 	RoundBankers       RoundingDirection = 3
 )
 
+// maxSDKDecBitLen is the max bit length of sdk.Dec, see the sdk's maxDecBitLen.
+const maxSDKDecBitLen = 256 + 60
+
+var sdkDecPrecisionReuse = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+
 func DivIntByU64ToBigDec(i sdk.Int, u uint64, round RoundingDirection) (BigDec, error) {
 	if u == 0 {
 		return BigDec{}, errors.New("div by zero")
@@ -42,3 +48,158 @@ func DivCoinAmtsByU64ToBigDec(coins []sdk.Coin, scales []uint64, round RoundingD
 	}
 	return result, nil
 }
+
+// quoWithRounding returns numerator / denominator, rounded to an integer in the given direction.
+// RoundUp rounds towards positive infinity, RoundDown towards negative infinity and RoundBankers
+// to the nearest integer, with ties to even.
+// The rounding is exact: it is computed from the remainder of the division.
+// Panics on any other rounding direction, or if the denominator is zero.
+func quoWithRounding(numerator, denominator *big.Int, roundingDirection RoundingDirection) *big.Int {
+	quo, rem := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if rem.Sign() == 0 {
+		if !isValidRoundingDirection(roundingDirection) {
+			panic(fmt.Sprintf("invalid rounding mode %d", int(roundingDirection)))
+		}
+		return quo
+	}
+
+	// QuoRem truncates towards zero, so the quotient is rounded away from zero
+	// when rounding up a positive quotient or rounding down a negative one.
+	isNegative := (numerator.Sign() < 0) != (denominator.Sign() < 0)
+	roundAwayFromZero := false
+	switch roundingDirection {
+	case RoundUp:
+		roundAwayFromZero = !isNegative
+	case RoundDown:
+		roundAwayFromZero = isNegative
+	case RoundBankers:
+		doubledRem := new(big.Int).Lsh(rem, 1)
+		cmp := doubledRem.CmpAbs(denominator)
+		roundAwayFromZero = cmp > 0 || (cmp == 0 && quo.Bit(0) == 1)
+	default:
+		panic(fmt.Sprintf("invalid rounding mode %d", int(roundingDirection)))
+	}
+
+	if roundAwayFromZero {
+		if isNegative {
+			return quo.Sub(quo, oneInt)
+		}
+		return quo.Add(quo, oneInt)
+	}
+	return quo
+}
+
+func isValidRoundingDirection(roundingDirection RoundingDirection) bool {
+	return roundingDirection == RoundUp || roundingDirection == RoundDown || roundingDirection == RoundBankers
+}
+
+// MulWithRounding returns d * d2, rounded at precision end in the given direction:
+// RoundUp towards positive infinity, RoundDown towards negative infinity, and RoundBankers
+// to the nearest decimal with ties to even, like Mul.
+// Panics on any other rounding direction.
+func (d BigDec) MulWithRounding(d2 BigDec, roundingDirection RoundingDirection) BigDec {
+	mul := new(big.Int).Mul(d.i, d2.i)
+	chopped := quoWithRounding(mul, precisionReuse, roundingDirection)
+
+	if chopped.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return BigDec{chopped}
+}
+
+// QuoWithRounding returns d / d2, rounded at precision end in the given direction.
+// See MulWithRounding for the rounding directions.
+// Panics on any other rounding direction, or if d2 is zero.
+func (d BigDec) QuoWithRounding(d2 BigDec, roundingDirection RoundingDirection) BigDec {
+	mul := new(big.Int).Mul(d.i, precisionReuse)
+	quo := quoWithRounding(mul, d2.i, roundingDirection)
+
+	if quo.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return BigDec{quo}
+}
+
+// IntWithRounding returns d rounded to an integer in the given direction.
+// See MulWithRounding for the rounding directions.
+func (d BigDec) IntWithRounding(roundingDirection RoundingDirection) BigInt {
+	return NewIntFromBigInt(quoWithRounding(d.i, precisionReuse, roundingDirection))
+}
+
+// SDKDecWithRounding returns the sdk.Dec representation of d, rounded in the given direction.
+// See MulWithRounding for the rounding directions.
+func (d BigDec) SDKDecWithRounding(roundingDirection RoundingDirection) sdk.Dec {
+	precisionFactor := new(big.Int).Exp(big.NewInt(10), big.NewInt(Precision-sdk.Precision), nil)
+	return sdk.NewDecFromBigIntWithPrec(quoWithRounding(d.i, precisionFactor, roundingDirection), sdk.Precision)
+}
+
+// MulDecWithRounding returns a * b, rounded at sdk.Dec precision end in the given direction.
+// See MulWithRounding for the rounding directions.
+func MulDecWithRounding(a, b sdk.Dec, roundingDirection RoundingDirection) sdk.Dec {
+	mul := new(big.Int).Mul(a.BigInt(), b.BigInt())
+	chopped := quoWithRounding(mul, sdkDecPrecisionReuse, roundingDirection)
+
+	if chopped.BitLen() > maxSDKDecBitLen {
+		panic("Int overflow")
+	}
+	return sdk.NewDecFromBigIntWithPrec(chopped, sdk.Precision)
+}
+
+// QuoDecWithRounding returns a / b, rounded at sdk.Dec precision end in the given direction.
+// See MulWithRounding for the rounding directions.
+// Panics if b is zero.
+func QuoDecWithRounding(a, b sdk.Dec, roundingDirection RoundingDirection) sdk.Dec {
+	mul := new(big.Int).Mul(a.BigInt(), sdkDecPrecisionReuse)
+	quo := quoWithRounding(mul, b.BigInt(), roundingDirection)
+
+	if quo.BitLen() > maxSDKDecBitLen {
+		panic("Int overflow")
+	}
+	return sdk.NewDecFromBigIntWithPrec(quo, sdk.Precision)
+}
+
+// DecToIntWithRounding returns d rounded to an integer in the given direction.
+// See MulWithRounding for the rounding directions.
+func DecToIntWithRounding(d sdk.Dec, roundingDirection RoundingDirection) sdk.Int {
+	return sdk.NewIntFromBigInt(quoWithRounding(d.BigInt(), sdkDecPrecisionReuse, roundingDirection))
+}
+
+// RoundingDirectionInFavorOfPool returns the rounding direction that favors the pool:
+// amounts the pool receives are rounded up, and amounts the pool gives out are rounded down.
+func RoundingDirectionInFavorOfPool(isAmountIn bool) RoundingDirection {
+	if isAmountIn {
+		return RoundUp
+	}
+	return RoundDown
+}
+
+// MulDecInFavorOfPool returns a * b, rounded in favor of the pool.
+// See RoundingDirectionInFavorOfPool.
+func MulDecInFavorOfPool(a, b sdk.Dec, isAmountIn bool) sdk.Dec {
+	return MulDecWithRounding(a, b, RoundingDirectionInFavorOfPool(isAmountIn))
+}
+
+// QuoDecInFavorOfPool returns a / b, rounded in favor of the pool.
+// See RoundingDirectionInFavorOfPool.
+func QuoDecInFavorOfPool(a, b sdk.Dec, isAmountIn bool) sdk.Dec {
+	return QuoDecWithRounding(a, b, RoundingDirectionInFavorOfPool(isAmountIn))
+}
+
+// DecToIntInFavorOfPool returns the amount rounded to an integer in favor of the pool.
+// See RoundingDirectionInFavorOfPool.
+func DecToIntInFavorOfPool(amount sdk.Dec, isAmountIn bool) sdk.Int {
+	return DecToIntWithRounding(amount, RoundingDirectionInFavorOfPool(isAmountIn))
+}
+
+// CheckInFavorOfPool checks that a rounded amount does not favor the user over the exact amount:
+// an amount the pool receives must not be below the exact amount, and an amount the pool
+// gives out must not be above it. Returns an error otherwise.
+func CheckInFavorOfPool(exact, rounded BigDec, isAmountIn bool) error {
+	if isAmountIn && rounded.LT(exact) {
+		return fmt.Errorf("amount in %s is rounded below the exact amount %s, in favor of the user", rounded, exact)
+	}
+	if !isAmountIn && rounded.GT(exact) {
+		return fmt.Errorf("amount out %s is rounded above the exact amount %s, in favor of the user", rounded, exact)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package osmomath
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -51,3 +52,163 @@ func TestDivIntByU64ToBigDec(t *testing.T) {
 		})
 	}
 }
+
+func TestMulAndQuoWithRounding(t *testing.T) {
+	// 1/3 and 2/3, with the last decimal rounded in each direction
+	oneThirdDown := MustNewDecFromStr("0.333333333333333333333333333333333333")
+	twoThirdsDown := MustNewDecFromStr("0.666666666666666666666666666666666666")
+	smallest := SmallestDec()
+
+	tests := map[string]struct {
+		roundingDirection RoundingDirection
+		quoOneThird       BigDec
+		quoMinusTwoThirds BigDec
+		// 0.5 * 10^-36 is a tie, rounded to even with RoundBankers
+		mulHalfSmallest BigDec
+		expectPanic     bool
+	}{
+		"round up": {
+			roundingDirection: RoundUp,
+			quoOneThird:       oneThirdDown.Add(smallest),
+			quoMinusTwoThirds: twoThirdsDown.Neg(),
+			mulHalfSmallest:   smallest,
+		},
+		"round down": {
+			roundingDirection: RoundDown,
+			quoOneThird:       oneThirdDown,
+			quoMinusTwoThirds: twoThirdsDown.Add(smallest).Neg(),
+			mulHalfSmallest:   ZeroDec(),
+		},
+		"round bankers": {
+			roundingDirection: RoundBankers,
+			quoOneThird:       oneThirdDown,
+			quoMinusTwoThirds: twoThirdsDown.Add(smallest).Neg(),
+			mulHalfSmallest:   ZeroDec(),
+		},
+		"round unconstrained - panic": {
+			roundingDirection: RoundUnconstrained,
+			expectPanic:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ConditionalPanic(t, tc.expectPanic, func() {
+				require.Equal(t, tc.quoOneThird, OneDec().QuoWithRounding(NewBigDec(3), tc.roundingDirection))
+				require.Equal(t, tc.quoMinusTwoThirds, NewBigDec(-2).QuoWithRounding(NewBigDec(3), tc.roundingDirection))
+				require.Equal(t, tc.mulHalfSmallest, smallest.MulWithRounding(NewDecWithPrec(5, 1), tc.roundingDirection))
+
+				// the sdk.Dec functions round the same way at their precision
+				require.Equal(t, tc.quoOneThird.SDKDecWithRounding(tc.roundingDirection), QuoDecWithRounding(sdk.OneDec(), sdk.NewDec(3), tc.roundingDirection))
+				require.Equal(t, tc.quoMinusTwoThirds.SDKDecWithRounding(tc.roundingDirection), QuoDecWithRounding(sdk.NewDec(-2), sdk.NewDec(3), tc.roundingDirection))
+			})
+		})
+	}
+}
+
+func TestDecToIntWithRounding(t *testing.T) {
+	tests := map[string]struct {
+		d                 sdk.Dec
+		roundingDirection RoundingDirection
+		expected          sdk.Int
+	}{
+		"2.5 round up":       {sdk.NewDecWithPrec(25, 1), RoundUp, sdk.NewInt(3)},
+		"2.5 round down":     {sdk.NewDecWithPrec(25, 1), RoundDown, sdk.NewInt(2)},
+		"2.5 round bankers":  {sdk.NewDecWithPrec(25, 1), RoundBankers, sdk.NewInt(2)},
+		"3.5 round bankers":  {sdk.NewDecWithPrec(35, 1), RoundBankers, sdk.NewInt(4)},
+		"-2.5 round up":      {sdk.NewDecWithPrec(-25, 1), RoundUp, sdk.NewInt(-2)},
+		"-2.5 round down":    {sdk.NewDecWithPrec(-25, 1), RoundDown, sdk.NewInt(-3)},
+		"-2.5 round bankers": {sdk.NewDecWithPrec(-25, 1), RoundBankers, sdk.NewInt(-2)},
+		"integer round up":   {sdk.NewDec(7), RoundUp, sdk.NewInt(7)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, DecToIntWithRounding(tc.d, tc.roundingDirection))
+			require.Equal(t, tc.expected.BigInt(), BigDecFromSDKDec(tc.d).IntWithRounding(tc.roundingDirection).BigInt())
+		})
+	}
+}
+
+func TestSDKDecFunctionsMatchSDK(t *testing.T) {
+	a := sdk.MustNewDecFromStr("1234.567890123456789")
+	b := sdk.MustNewDecFromStr("0.000000000000000007")
+
+	require.Equal(t, a.MulRoundUp(b), MulDecWithRounding(a, b, RoundUp))
+	require.Equal(t, a.MulTruncate(b), MulDecWithRounding(a, b, RoundDown))
+	require.Equal(t, a.Mul(b), MulDecWithRounding(a, b, RoundBankers))
+	require.Equal(t, a.QuoRoundUp(b), QuoDecWithRounding(a, b, RoundUp))
+	require.Equal(t, a.QuoTruncate(b), QuoDecWithRounding(a, b, RoundDown))
+	require.Equal(t, a.Quo(b), QuoDecWithRounding(a, b, RoundBankers))
+}
+
+func TestInFavorOfPool(t *testing.T) {
+	require.Equal(t, RoundUp, RoundingDirectionInFavorOfPool(true))
+	require.Equal(t, RoundDown, RoundingDirectionInFavorOfPool(false))
+
+	amount := sdk.MustNewDecFromStr("10.5")
+	require.Equal(t, sdk.NewInt(11), DecToIntInFavorOfPool(amount, true))
+	require.Equal(t, sdk.NewInt(10), DecToIntInFavorOfPool(amount, false))
+	require.Equal(t, sdk.MustNewDecFromStr("3.500000000000000000"), QuoDecInFavorOfPool(amount, sdk.NewDec(3), true))
+	require.Equal(t, sdk.MustNewDecFromStr("0.333333333333333334"), QuoDecInFavorOfPool(sdk.OneDec(), sdk.NewDec(3), true))
+	require.Equal(t, sdk.MustNewDecFromStr("0.333333333333333333"), QuoDecInFavorOfPool(sdk.OneDec(), sdk.NewDec(3), false))
+	require.Equal(t, sdk.MustNewDecFromStr("0.000000000000000001"), MulDecInFavorOfPool(sdk.SmallestDec(), sdk.NewDecWithPrec(1, 1), true))
+	require.Equal(t, sdk.ZeroDec(), MulDecInFavorOfPool(sdk.SmallestDec(), sdk.NewDecWithPrec(1, 1), false))
+
+	exact := MustNewDecFromStr("10.5")
+	require.NoError(t, CheckInFavorOfPool(exact, NewBigDec(11), true))
+	require.NoError(t, CheckInFavorOfPool(exact, exact, true))
+	require.Error(t, CheckInFavorOfPool(exact, NewBigDec(10), true))
+	require.NoError(t, CheckInFavorOfPool(exact, NewBigDec(10), false))
+	require.Error(t, CheckInFavorOfPool(exact, NewBigDec(11), false))
+}
+
+// checkRoundedQuotient checks that the result of rounding numerator / denominator is rounded
+// in the given direction, by less than one unit, using exact rational arithmetic.
+func checkRoundedQuotient(t *testing.T, numerator, denominator *big.Int, result *big.Int, roundingDirection RoundingDirection) {
+	exact := new(big.Rat).SetFrac(numerator, denominator)
+	resultRat := new(big.Rat).SetInt(result)
+	diff := new(big.Rat).Sub(resultRat, exact)
+
+	switch roundingDirection {
+	case RoundUp:
+		require.True(t, diff.Sign() >= 0 && diff.Cmp(big.NewRat(1, 1)) < 0, "%s / %s rounded up to %s", numerator, denominator, result)
+	case RoundDown:
+		require.True(t, diff.Sign() <= 0 && diff.Cmp(big.NewRat(-1, 1)) > 0, "%s / %s rounded down to %s", numerator, denominator, result)
+	case RoundBankers:
+		require.True(t, new(big.Rat).Abs(diff).Cmp(big.NewRat(1, 2)) <= 0, "%s / %s rounded to %s", numerator, denominator, result)
+	}
+}
+
+func FuzzMulWithRounding(f *testing.F) {
+	f.Add(int64(1), uint8(1), int64(5), uint8(1), uint8(1))
+	f.Add(int64(-7), uint8(3), int64(11), uint8(5), uint8(2))
+	f.Add(int64(123456789), uint8(20), int64(-987654321), uint8(30), uint8(3))
+
+	f.Fuzz(func(t *testing.T, a int64, aPrec uint8, b int64, bPrec uint8, direction uint8) {
+		roundingDirection := RoundingDirection(direction%3 + 1)
+		x := NewDecWithPrec(a, int64(aPrec)%(Precision+1))
+		y := NewDecWithPrec(b, int64(bPrec)%(Precision+1))
+
+		result := x.MulWithRounding(y, roundingDirection)
+		checkRoundedQuotient(t, new(big.Int).Mul(x.BigInt(), y.BigInt()), precisionInt(), result.BigInt(), roundingDirection)
+	})
+}
+
+func FuzzQuoWithRounding(f *testing.F) {
+	f.Add(int64(1), uint8(1), int64(3), uint8(1), uint8(1))
+	f.Add(int64(-2), uint8(0), int64(3), uint8(0), uint8(2))
+	f.Add(int64(123456789), uint8(20), int64(-987654321), uint8(30), uint8(3))
+
+	f.Fuzz(func(t *testing.T, a int64, aPrec uint8, b int64, bPrec uint8, direction uint8) {
+		if b == 0 {
+			return
+		}
+		roundingDirection := RoundingDirection(direction%3 + 1)
+		x := NewDecWithPrec(a, int64(aPrec)%(Precision+1))
+		y := NewDecWithPrec(b, int64(bPrec)%(Precision+1))
+
+		result := x.QuoWithRounding(y, roundingDirection)
+		checkRoundedQuotient(t, new(big.Int).Mul(x.BigInt(), precisionInt()), y.BigInt(), result.BigInt(), roundingDirection)
+	})
+}
@@ -0,0 +1,197 @@
+package osmomath_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Property-based tests of Exp, Ln, Log10, PowBigDec and ApproxRootWithRounding,
+// checking identities between them on random inputs within their documented error bounds.
+
+const numPropertyTestCases = 200
+
+// randomDec returns a random decimal in [min, max) with 18 decimals.
+func randomDec(r *rand.Rand, min, max osmomath.BigDec) osmomath.BigDec {
+	unit := osmomath.NewDecWithPrec(r.Int63n(1_000_000_000_000_000_000), 18)
+	return min.Add(max.Sub(min).Mul(unit))
+}
+
+// randomPositiveDec returns a random decimal in [10^-minExponent, 10^maxExponent), log-uniformly distributed.
+func randomPositiveDec(r *rand.Rand, minExponent, maxExponent int64) osmomath.BigDec {
+	mantissa := randomDec(r, osmomath.OneDec(), osmomath.NewBigDec(10))
+	exponent := r.Int63n(maxExponent+minExponent) - minExponent
+	if exponent < 0 {
+		return mantissa.Quo(osmomath.NewBigDec(10).PowerInteger(uint64(-exponent)))
+	}
+	return mantissa.Mul(osmomath.NewBigDec(10).PowerInteger(uint64(exponent)))
+}
+
+func TestExpLnInverseProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	// relative error of Exp, plus the additive error of Ln
+	errTolerance := osmomath.ErrTolerance{
+		MultiplicativeTolerance: minDecTolerance.MulInt64(2),
+		RoundingDir:             osmomath.RoundUnconstrained,
+	}
+
+	for i := 0; i < numPropertyTestCases; i++ {
+		x := randomPositiveDec(r, 6, 6)
+		actual := osmomath.Exp(x.Ln())
+		require.Equal(t, 0, errTolerance.CompareBigDec(x, actual), "exp(ln(%s)) = %s", x, actual)
+	}
+}
+
+func TestLnExpInverseProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	// the relative error of Exp becomes an additive error of Ln
+	errTolerance := osmomath.ErrTolerance{
+		AdditiveTolerance: minDecTolerance.MulInt64(2),
+		RoundingDir:       osmomath.RoundUnconstrained,
+	}
+
+	for i := 0; i < numPropertyTestCases; i++ {
+		y := randomDec(r, osmomath.NewBigDec(-40), osmomath.NewBigDec(40))
+		actual := osmomath.Exp(y).Ln()
+		require.Equal(t, 0, errTolerance.CompareBigDec(y, actual), "ln(exp(%s)) = %s", y, actual)
+	}
+}
+
+func TestLog10Property(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	// additive errors of the two logarithms
+	tolerance := osmomath.MustNewDecFromStr("0.00000000000000000000000000000002")
+
+	for i := 0; i < numPropertyTestCases; i++ {
+		x := randomPositiveDec(r, 6, 6)
+		k := r.Int63n(20)
+		// log_10(x * 10^k) = log_10(x) + k
+		expected := x.Log10().Add(osmomath.NewBigDec(k))
+		actual := x.Mul(osmomath.NewBigDec(10).PowerInteger(uint64(k))).Log10()
+		require.True(osmomath.DecApproxEq(t, expected, actual, tolerance))
+	}
+}
+
+func TestPowBigDecProductProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	// relative errors of the three powers
+	errTolerance := osmomath.ErrTolerance{
+		MultiplicativeTolerance: minDecTolerance.MulInt64(4),
+		RoundingDir:             osmomath.RoundUnconstrained,
+	}
+
+	for i := 0; i < numPropertyTestCases; i++ {
+		base := randomPositiveDec(r, 2, 2)
+		a := randomDec(r, osmomath.NewBigDec(-5), osmomath.NewBigDec(5))
+		b := randomDec(r, osmomath.NewBigDec(-5), osmomath.NewBigDec(5))
+
+		// base^a * base^b = base^(a + b)
+		expected := osmomath.PowBigDec(base, a.Add(b))
+		actual := osmomath.PowBigDec(base, a).Mul(osmomath.PowBigDec(base, b))
+		require.Equal(t, 0, errTolerance.CompareBigDec(expected, actual), "%s^%s * %s^%s = %s", base, a, base, b, actual)
+	}
+}
+
+// TestExpLargeExponentProperty covers exponents past 2^9 in base 2, which Exp2 alone does not support.
+func TestExpLargeExponentProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	// relative errors of the three exponentials
+	errTolerance := osmomath.ErrTolerance{
+		MultiplicativeTolerance: minDecTolerance.MulInt64(4),
+		RoundingDir:             osmomath.RoundUnconstrained,
+	}
+	// the relative error of Exp becomes an additive error of Ln
+	lnErrTolerance := osmomath.ErrTolerance{
+		AdditiveTolerance: minDecTolerance.MulInt64(2),
+		RoundingDir:       osmomath.RoundUnconstrained,
+	}
+
+	for i := 0; i < numPropertyTestCases; i++ {
+		a := randomDec(r, osmomath.NewBigDec(200), osmomath.NewBigDec(354))
+		b := randomDec(r, osmomath.NewBigDec(200), osmomath.NewBigDec(354))
+
+		// e^a * e^b = e^(a + b), with (a + b) * log_2(e) > 2^9
+		expected := osmomath.Exp(a.Add(b))
+		actual := osmomath.Exp(a).Mul(osmomath.Exp(b))
+		require.Equal(t, 0, errTolerance.CompareBigDec(expected, actual), "e^%s * e^%s = %s", a, b, actual)
+
+		// ln(e^(a + b)) = a + b
+		require.Equal(t, 0, lnErrTolerance.CompareBigDec(a.Add(b), expected.Ln()), "ln(e^%s) = %s", a.Add(b), expected.Ln())
+	}
+}
+
+// TestPowBigDecLargeExponentProperty covers |power * log_2(base)| past 2^9, which Exp2 alone does not support.
+func TestPowBigDecLargeExponentProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(8))
+	// relative errors of the three powers
+	errTolerance := osmomath.ErrTolerance{
+		MultiplicativeTolerance: minDecTolerance.MulInt64(4),
+		RoundingDir:             osmomath.RoundUnconstrained,
+	}
+
+	for i := 0; i < numPropertyTestCases; i++ {
+		base := randomPositiveDec(r, 2, 2)
+		// between 2^(2^9) and 2^(2^10) for the largest bases, and at least 1 so that no digits are lost to rounding
+		a := randomDec(r, osmomath.NewBigDec(40), osmomath.NewBigDec(75))
+		b := randomDec(r, osmomath.NewBigDec(40), osmomath.NewBigDec(75))
+		if base.LT(osmomath.OneDec()) {
+			a, b = a.Neg(), b.Neg()
+		}
+
+		// base^a * base^b = base^(a + b)
+		expected := osmomath.PowBigDec(base, a.Add(b))
+		actual := osmomath.PowBigDec(base, a).Mul(osmomath.PowBigDec(base, b))
+		require.Equal(t, 0, errTolerance.CompareBigDec(expected, actual), "%s^%s * %s^%s = %s", base, a, base, b, actual)
+	}
+}
+
+func TestPowBigDecRootProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	errTolerance := osmomath.ErrTolerance{
+		MultiplicativeTolerance: minDecTolerance.MulInt64(2),
+		RoundingDir:             osmomath.RoundUnconstrained,
+	}
+
+	for i := 0; i < numPropertyTestCases; i++ {
+		x := randomPositiveDec(r, 6, 6)
+		root := uint64(r.Int63n(9) + 2)
+
+		// x^(1/root) with ApproxRootWithRounding is exact up to the last decimal
+		expected, err := x.ApproxRootWithRounding(root, osmomath.RoundBankers)
+		require.NoError(t, err)
+		actual := osmomath.PowBigDec(x, osmomath.OneDec().QuoInt64(int64(root)))
+		require.Equal(t, 0, errTolerance.CompareBigDec(expected, actual), "%s^(1/%d) = %s", x, root, actual)
+	}
+}
+
+func TestApproxRootWithRoundingProperty(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	precision := new(big.Int).Exp(big.NewInt(10), big.NewInt(osmomath.Precision), nil)
+
+	for i := 0; i < numPropertyTestCases; i++ {
+		x := randomPositiveDec(r, 30, 30)
+		root := uint64(r.Int63n(9) + 2)
+
+		down, err := x.ApproxRootWithRounding(root, osmomath.RoundDown)
+		require.NoError(t, err)
+		up, err := x.ApproxRootWithRounding(root, osmomath.RoundUp)
+		require.NoError(t, err)
+		nearest, err := x.ApproxRootWithRounding(root, osmomath.RoundBankers)
+		require.NoError(t, err)
+
+		// down^root <= x <= up^root, computed exactly on the underlying integers
+		rootBig := new(big.Int).SetUint64(root)
+		radicand := new(big.Int).Exp(precision, new(big.Int).Sub(rootBig, big.NewInt(1)), nil)
+		radicand.Mul(radicand, x.BigInt())
+		require.True(t, new(big.Int).Exp(down.BigInt(), rootBig, nil).Cmp(radicand) <= 0, "%s^(1/%d) rounded down = %s", x, root, down)
+		require.True(t, new(big.Int).Exp(up.BigInt(), rootBig, nil).Cmp(radicand) >= 0, "%s^(1/%d) rounded up = %s", x, root, up)
+
+		// the two are at most one decimal apart, and the closest one is one of them
+		require.True(t, up.Sub(down).LTE(osmomath.SmallestDec()))
+		require.True(t, nearest.Equal(down) || nearest.Equal(up))
+	}
+}
@@ -0,0 +1,247 @@
+// Package genesisstream streams the genesis of modules with large state to and from JSON, one element
+// of their large arrays at a time, instead of holding their whole genesis in memory.
+//
+// The streamed JSON is the sorted JSON of the genesis, as written by the export command, so that the
+// streaming export is byte-identical to the sorted JSON of the exported genesis.
+package genesisstream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// HasStreamingExport is implemented by the app modules able to stream their genesis export.
+type HasStreamingExport interface {
+	// ExportGenesisTo writes the sorted JSON of the module genesis to w.
+	ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error
+}
+
+// ArrayWriter writes the elements of a streamed array, in order, by calling write with each of them.
+type ArrayWriter func(write func(proto.Message) error) error
+
+// WriteJSON writes the sorted JSON of msg to w, the arrays of msg being written by their array writers
+// instead. The streamed arrays are keyed by their JSON name, and must be left empty in msg.
+func WriteJSON(w io.Writer, cdc codec.JSONCodec, msg proto.Message, arrays map[string]ArrayWriter) error {
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return err
+	}
+	bz, err = sdk.SortJSON(bz)
+	if err != nil {
+		return err
+	}
+	fields, err := SplitObject(bz)
+	if err != nil {
+		return err
+	}
+	for name := range arrays {
+		if value, ok := fields[name]; !ok || string(value) != "[]" {
+			return fmt.Errorf("streamed array %s is not an empty array of %s", name, proto.MessageName(msg))
+		}
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	// encoding/json sorts the object keys the same way.
+	sort.Strings(names)
+
+	bw := bufio.NewWriter(w)
+	bw.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			bw.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return err
+		}
+		bw.Write(key)
+		bw.WriteByte(':')
+
+		writeArray, ok := arrays[name]
+		if !ok {
+			bw.Write(fields[name])
+			continue
+		}
+		if err := writeJSONArray(bw, cdc, writeArray); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	bw.WriteByte('}')
+	return bw.Flush()
+}
+
+func writeJSONArray(bw *bufio.Writer, cdc codec.JSONCodec, writeArray ArrayWriter) error {
+	bw.WriteByte('[')
+	first := true
+	err := writeArray(func(elem proto.Message) error {
+		bz, err := cdc.MarshalJSON(elem)
+		if err != nil {
+			return err
+		}
+		bz, err = sdk.SortJSON(bz)
+		if err != nil {
+			return err
+		}
+		if !first {
+			bw.WriteByte(',')
+		}
+		first = false
+		_, err = bw.Write(bz)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return bw.WriteByte(']')
+}
+
+// SplitObject splits a JSON object into its fields. The field values are sub-slices of bz, which are
+// neither copied nor decoded. A null object has no fields.
+func SplitObject(bz []byte) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok == nil {
+		return fields, nil
+	}
+	if tok != json.Delim('{') {
+		return nil, fmt.Errorf("expected a JSON object, got %v", tok)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a JSON object key, got %v", tok)
+		}
+		start := skipToValue(bz, int(dec.InputOffset()))
+		if err := skipValue(dec); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		fields[name] = bz[start:dec.InputOffset()]
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// skipToValue returns the offset of the value following the key ending at offset.
+func skipToValue(bz []byte, offset int) int {
+	for offset < len(bz) {
+		switch bz[offset] {
+		case ' ', '\t', '\r', '\n', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// skipValue skips the next value of the decoder, without decoding the elements of the objects and arrays.
+func skipValue(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			// the key.
+			if _, err := dec.Token(); err != nil {
+				return err
+			}
+			if err := skipValue(dec); err != nil {
+				return err
+			}
+		}
+	case json.Delim('['):
+		for dec.More() {
+			if err := skipValue(dec); err != nil {
+				return err
+			}
+		}
+	default:
+		return nil
+	}
+	// the closing delimiter.
+	_, err = dec.Token()
+	return err
+}
+
+// UnmarshalJSON unmarshals the JSON object bz into msg, leaving out the streamed arrays.
+// The streamed arrays are then read with ForEach.
+func UnmarshalJSON(cdc codec.JSONCodec, bz []byte, msg proto.Message, arrays ...string) error {
+	fields, err := SplitObject(bz)
+	if err != nil {
+		return err
+	}
+	for _, name := range arrays {
+		delete(fields, name)
+	}
+	bz, err = json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return cdc.UnmarshalJSON(bz, msg)
+}
+
+// ForEach unmarshals the elements of the array field of the JSON object bz one at a time, calling fn
+// with each of them in order. A missing or null array has no elements.
+func ForEach[T any, PT interface {
+	*T
+	proto.Message
+}](cdc codec.JSONCodec, bz []byte, field string, fn func(PT) error) error {
+	fields, err := SplitObject(bz)
+	if err != nil {
+		return err
+	}
+	array, ok := fields[field]
+	if !ok {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(array))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return fmt.Errorf("%s: expected a JSON array, got %v", field, tok)
+	}
+	for i := 0; dec.More(); i++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("%s[%d]: %w", field, i, err)
+		}
+		elem := PT(new(T))
+		if err := cdc.UnmarshalJSON(raw, elem); err != nil {
+			return fmt.Errorf("%s[%d]: %w", field, i, err)
+		}
+		if err := fn(elem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package genesisstream_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/genesisstream"
+)
+
+var cdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+func testGenesis() *banktypes.GenesisState {
+	return &banktypes.GenesisState{
+		Params: banktypes.DefaultParams(),
+		Balances: []banktypes.Balance{
+			{Address: "osmo1a", Coins: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10))},
+			// escaped like encoding/json does.
+			{Address: "osmo1<b>", Coins: sdk.NewCoins(sdk.NewInt64Coin("uion", 20), sdk.NewInt64Coin("uosmo", 30))},
+		},
+		Supply: sdk.NewCoins(sdk.NewInt64Coin("uion", 20), sdk.NewInt64Coin("uosmo", 40)),
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	genesis := testGenesis()
+	expected := sdk.MustSortJSON(cdc.MustMarshalJSON(genesis))
+
+	small := *genesis
+	small.Balances = nil
+	writeBalances := func(write func(proto.Message) error) error {
+		for i := range genesis.Balances {
+			if err := write(&genesis.Balances[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var buf bytes.Buffer
+	err := genesisstream.WriteJSON(&buf, cdc, &small, map[string]genesisstream.ArrayWriter{"balances": writeBalances})
+	require.NoError(t, err)
+	require.Equal(t, string(expected), buf.String())
+
+	// the streamed arrays must be left empty.
+	err = genesisstream.WriteJSON(&buf, cdc, genesis, map[string]genesisstream.ArrayWriter{"balances": writeBalances})
+	require.Error(t, err)
+	err = genesisstream.WriteJSON(&buf, cdc, &small, map[string]genesisstream.ArrayWriter{"unknown": writeBalances})
+	require.Error(t, err)
+
+	// errors of the array writers are returned.
+	writeErr := errors.New("write error")
+	err = genesisstream.WriteJSON(&buf, cdc, &small, map[string]genesisstream.ArrayWriter{
+		"balances": func(write func(proto.Message) error) error { return writeErr },
+	})
+	require.ErrorIs(t, err, writeErr)
+}
+
+func TestSplitObject(t *testing.T) {
+	fields, err := genesisstream.SplitObject([]byte(` { "a" : [1, {"b": [2]}] ,"c":{"d":null}, "e": "f"} `))
+	require.NoError(t, err)
+	require.Equal(t, map[string]json.RawMessage{
+		"a": json.RawMessage(`[1, {"b": [2]}]`),
+		"c": json.RawMessage(`{"d":null}`),
+		"e": json.RawMessage(`"f"`),
+	}, fields)
+
+	fields, err = genesisstream.SplitObject([]byte(`null`))
+	require.NoError(t, err)
+	require.Empty(t, fields)
+
+	_, err = genesisstream.SplitObject([]byte(`[]`))
+	require.Error(t, err)
+	_, err = genesisstream.SplitObject([]byte(`{"a": [}`))
+	require.Error(t, err)
+}
+
+func TestUnmarshalJSONAndForEach(t *testing.T) {
+	genesis := testGenesis()
+	bz := cdc.MustMarshalJSON(genesis)
+
+	var small banktypes.GenesisState
+	require.NoError(t, genesisstream.UnmarshalJSON(cdc, bz, &small, "balances"))
+	require.Empty(t, small.Balances)
+	require.Equal(t, genesis.Supply, small.Supply)
+	require.Equal(t, genesis.Params, small.Params)
+
+	balances := []banktypes.Balance{}
+	err := genesisstream.ForEach(cdc, bz, "balances", func(balance *banktypes.Balance) error {
+		balances = append(balances, *balance)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, genesis.Balances, balances)
+
+	// missing and null arrays have no elements.
+	for _, bz := range []string{`{}`, `{"balances":null}`} {
+		err = genesisstream.ForEach(cdc, []byte(bz), "balances", func(balance *banktypes.Balance) error {
+			return errors.New("unexpected element")
+		})
+		require.NoError(t, err)
+	}
+
+	// errors of fn stop the iteration.
+	fnErr := errors.New("fn error")
+	calls := 0
+	err = genesisstream.ForEach(cdc, bz, "balances", func(balance *banktypes.Balance) error {
+		calls++
+		return fnErr
+	})
+	require.ErrorIs(t, err, fnErr)
+	require.Equal(t, 1, calls)
+
+	err = genesisstream.ForEach(cdc, []byte(`{"balances":[{"address":1}]}`), "balances", func(balance *banktypes.Balance) error {
+		return nil
+	})
+	require.Error(t, err)
+}
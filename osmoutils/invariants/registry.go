@@ -0,0 +1,125 @@
+package invariants
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Cost classifies the invariants by how expensive they are to check.
+type Cost int
+
+const (
+	// Cheap invariants iterate over small collections of state, such as pools or gauges.
+	Cheap Cost = iota
+	// Expensive invariants iterate over large collections of state, such as positions or locks,
+	// and are meant to be checked offline.
+	Expensive
+)
+
+func (c Cost) String() string {
+	switch c {
+	case Cheap:
+		return "cheap"
+	case Expensive:
+		return "expensive"
+	default:
+		return fmt.Sprintf("Cost(%d)", int(c))
+	}
+}
+
+// Invariant is an invariant declared by a module to the registry.
+type Invariant struct {
+	Module string
+	Route  string
+	Cost   Cost
+	Check  sdk.Invariant
+}
+
+// Name returns the name of the invariant, e.g. lockup/locks-amount-invariant.
+func (i Invariant) Name() string {
+	return i.Module + "/" + i.Route
+}
+
+// HasInvariants is implemented by the app modules declaring invariants to the registry.
+type HasInvariants interface {
+	DeclareInvariants(r *Registry)
+}
+
+// Registry is the chain-wide registry of the invariants declared by the modules.
+// Unlike the crisis module, it lets the invariants be selected by module and cost before being checked.
+type Registry struct {
+	invariants []Invariant
+	names      map[string]bool
+}
+
+func NewRegistry() *Registry {
+	return &Registry{names: map[string]bool{}}
+}
+
+// Register declares an invariant of the module. It panics if the module already declared an invariant
+// with the same route.
+func (r *Registry) Register(module, route string, cost Cost, check sdk.Invariant) {
+	invariant := Invariant{Module: module, Route: route, Cost: cost, Check: check}
+	if r.names[invariant.Name()] {
+		panic(fmt.Sprintf("invariant %s is already registered", invariant.Name()))
+	}
+	r.names[invariant.Name()] = true
+	r.invariants = append(r.invariants, invariant)
+}
+
+// Invariants returns the registered invariants costing at most maxCost, in registration order.
+// If modules are given, only their invariants are returned.
+func (r *Registry) Invariants(maxCost Cost, modules ...string) []Invariant {
+	moduleSet := map[string]bool{}
+	for _, module := range modules {
+		moduleSet[module] = true
+	}
+
+	invariants := []Invariant{}
+	for _, invariant := range r.invariants {
+		if invariant.Cost > maxCost {
+			continue
+		}
+		if len(moduleSet) > 0 && !moduleSet[invariant.Module] {
+			continue
+		}
+		invariants = append(invariants, invariant)
+	}
+	return invariants
+}
+
+// Result is the result of checking an invariant.
+type Result struct {
+	Invariant
+	Message  string
+	Broken   bool
+	Duration time.Duration
+}
+
+// Check checks the invariants against the state of the context, in a cache context so that the writes
+// some of them make are discarded. An invariant panicking is reported as broken.
+func Check(ctx sdk.Context, invariants []Invariant) []Result {
+	results := make([]Result, 0, len(invariants))
+	for _, invariant := range invariants {
+		results = append(results, checkInvariant(ctx, invariant))
+	}
+	return results
+}
+
+func checkInvariant(ctx sdk.Context, invariant Invariant) (result Result) {
+	result.Invariant = invariant
+	start := time.Now()
+	defer func() {
+		result.Duration = time.Since(start)
+		if r := recover(); r != nil {
+			result.Message = sdk.FormatInvariant(invariant.Module, invariant.Route, fmt.Sprintf("\tpanicked: %v\n", r))
+			result.Broken = true
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	result.Message, result.Broken = invariant.Check(cacheCtx)
+	return result
+}
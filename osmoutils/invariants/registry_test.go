@@ -0,0 +1,62 @@
+package invariants_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	"github.com/osmosis-labs/osmosis/osmoutils/noapptest"
+)
+
+var testStoreKey = sdk.NewKVStoreKey("test")
+
+func setInvariant(key []byte) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		ctx.KVStore(testStoreKey).Set(key, []byte{1})
+		return "set", false
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	brokenInvariant := func(ctx sdk.Context) (string, bool) { return "broken", true }
+	panickingInvariant := func(ctx sdk.Context) (string, bool) { panic("boom") }
+
+	r := invariants.NewRegistry()
+	r.Register("a", "set", invariants.Cheap, setInvariant([]byte("a")))
+	r.Register("a", "broken", invariants.Expensive, brokenInvariant)
+	r.Register("b", "panicking", invariants.Cheap, panickingInvariant)
+
+	require.Panics(t, func() {
+		r.Register("a", "set", invariants.Expensive, brokenInvariant)
+	})
+
+	names := func(invs []invariants.Invariant) []string {
+		names := []string{}
+		for _, inv := range invs {
+			names = append(names, inv.Name())
+		}
+		return names
+	}
+	require.Equal(t, []string{"a/set", "a/broken", "b/panicking"}, names(r.Invariants(invariants.Expensive)))
+	require.Equal(t, []string{"a/set", "b/panicking"}, names(r.Invariants(invariants.Cheap)))
+	require.Equal(t, []string{"a/set", "a/broken"}, names(r.Invariants(invariants.Expensive, "a")))
+	require.Equal(t, []string{}, names(r.Invariants(invariants.Expensive, "c")))
+
+	ctx := noapptest.DefaultCtxWithStoreKeys([]sdk.StoreKey{testStoreKey})
+	results := invariants.Check(ctx, r.Invariants(invariants.Expensive))
+	require.Len(t, results, 3)
+
+	require.Equal(t, "set", results[0].Message)
+	require.False(t, results[0].Broken)
+	// writes of the invariants are discarded.
+	require.False(t, ctx.KVStore(testStoreKey).Has([]byte("a")))
+
+	require.Equal(t, "broken", results[1].Message)
+	require.True(t, results[1].Broken)
+	require.Equal(t, invariants.Expensive, results[1].Cost)
+
+	require.True(t, results[2].Broken)
+	require.Contains(t, results[2].Message, "panicked: boom")
+}
@@ -55,6 +55,24 @@ func GatherValuesFromStorePrefixWithKeyParser[T any](storeObj store.KVStore, pre
 	return gatherValuesFromIteratorWithKeyParser(iterator, parse, noStopFn)
 }
 
+// ForEachValueFromStorePrefix is the streaming counterpart of GatherValuesFromStorePrefix: it parses the values
+// under the given store prefix one at a time, calling fn with each of them instead of gathering them.
+// Returns error if the parse function or fn return an error.
+func ForEachValueFromStorePrefix[T any](storeObj store.KVStore, prefix []byte, parseValue func([]byte) (T, error), fn func(T) error) error {
+	iterator := sdk.KVStorePrefixIterator(storeObj, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		val, err := parseValue(iterator.Value())
+		if err != nil {
+			return err
+		}
+		if err := fn(val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func GetValuesUntilDerivedStop[T any](storeObj store.KVStore, keyStart []byte, stopFn func([]byte) bool, parseValue func([]byte) (T, error)) ([]T, error) {
 	// SDK iterator is broken for nil end time, and non-nil start time
 	// https://github.com/cosmos/cosmos-sdk/issues/12661
@@ -354,6 +354,82 @@ func (s *TestSuite) TestGatherValuesFromStorePrefix() {
 	}
 }
 
+func (s *TestSuite) TestForEachValueFromStorePrefix() {
+	testcases := map[string]struct {
+		prefix     []byte
+		preSetKeys []string
+		parseFn    func(b []byte) (string, error)
+		stopAt     string
+
+		expectedErr    error
+		expectedValues []string
+	}{
+		"common prefix": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			parseFn:    mockParseValue,
+
+			expectedValues: []string{"0", "1", "2"},
+		},
+		"different prefixes out of order, prefix one requested": {
+			preSetKeys: oneBtwoAoneAtwoB,
+			prefix:     []byte(prefixOne),
+			parseFn:    mockParseValue,
+
+			expectedValues: []string{"2", "0"},
+		},
+		"prefix doesn't exist, only keys with another prefix": {
+			preSetKeys: twoAB,
+			prefix:     []byte(prefixOne),
+			parseFn:    mockParseValue,
+
+			expectedValues: []string{},
+		},
+		"parse with error": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			parseFn:    mockParseValueWithError,
+
+			expectedErr:    mockError,
+			expectedValues: []string{},
+		},
+		"callback error stops the iteration": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			parseFn:    mockParseValue,
+			stopAt:     "1",
+
+			expectedErr:    mockError,
+			expectedValues: []string{"0"},
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+			for i, key := range tc.preSetKeys {
+				s.store.Set([]byte(key), []byte(fmt.Sprintf("%v", i)))
+			}
+
+			actualValues := []string{}
+			err := osmoutils.ForEachValueFromStorePrefix(s.store, tc.prefix, tc.parseFn, func(value string) error {
+				if value == tc.stopAt {
+					return mockError
+				}
+				actualValues = append(actualValues, value)
+				return nil
+			})
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+			} else {
+				s.Require().NoError(err)
+			}
+			s.Require().Equal(tc.expectedValues, actualValues)
+		})
+	}
+}
+
 func (s *TestSuite) TestGatherValuesFromStorePrefixWithKeyParser() {
 	testcases := map[string]struct {
 		prefix     []byte
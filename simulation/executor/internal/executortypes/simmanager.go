@@ -2,11 +2,13 @@ package executortypes
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/rand"
 	"os"
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -64,7 +66,9 @@ func newSimulationManager(manager module.Manager, overrideModules map[string]mod
 			appModule := manager.Modules[moduleName]
 			if simModule, ok := appModule.(simtypes.AppModuleSimulation); ok {
 				simModules[moduleName] = simModule
-			} else if simModule, ok := appModule.(module.AppModuleSimulation); ok {
+			}
+			// modules adding actions to their legacy simulation keep their weighted operations and genesis.
+			if simModule, ok := appModule.(module.AppModuleSimulation); ok {
 				legacySimModules[moduleName] = simModule
 			}
 			// cannot cast, so we continue
@@ -161,10 +165,12 @@ func (m Manager) Actions(seed int64, cdc codec.JSONCodec) []simtypes.ActionsWith
 func (m Manager) GenerateGenesisStates(simState *module.SimulationState, sim *simtypes.SimCtx) {
 	for _, moduleName := range m.moduleManager.OrderInitGenesis {
 		if simModule, ok := m.Modules[moduleName]; ok {
-			// if we define a random genesis function use it, otherwise use default genesis
+			// if we define a random genesis function use it, otherwise use the legacy or default genesis
 			if mod, ok := simModule.(simtypes.AppModuleSimulationGenesis); ok {
 				mod.SimulatorGenesisState(simState, sim)
-			} else {
+				continue
+			}
+			if _, ok := m.legacyModules[moduleName]; !ok {
 				simState.GenState[simModule.Name()] = simModule.DefaultGenesis(simState.Cdc)
 			}
 		}
@@ -173,3 +179,25 @@ func (m Manager) GenerateGenesisStates(simState *module.SimulationState, sim *si
 		}
 	}
 }
+
+// SubscribePropertyChecks subscribes the property checks of every module to their keys,
+// under the name of the module and the index of the check.
+func (m Manager) SubscribePropertyChecks(pubsub simtypes.PubSubManager) {
+	moduleNames := maps.Keys(m.moduleManager.Modules)
+	osmoutils.SortSlice(moduleNames)
+	for _, moduleName := range moduleNames {
+		simModule, ok := m.moduleManager.Modules[moduleName].(simtypes.AppModuleSimulationPropertyCheck)
+		if !ok {
+			continue
+		}
+		for i, check := range simModule.PropertyChecks() {
+			check := check
+			for _, key := range check.SubscriptionKeys() {
+				key := key
+				pubsub.Subscribe(key, fmt.Sprintf("%s/%d", moduleName, i), func(sim *simtypes.SimCtx, ctx sdk.Context, value interface{}) error {
+					return check.Check(sim, ctx, key, value)
+				})
+			}
+		}
+	}
+}
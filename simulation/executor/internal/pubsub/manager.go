@@ -1,6 +1,8 @@
 package pubsub
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"go.uber.org/multierr"
 
@@ -40,8 +42,9 @@ func (m *Manager) Publish(sim *simtypes.SimCtx, ctx sdk.Context, key string, val
 	}
 	var result error
 	for _, s := range subscriptions {
-		err := s.callback(sim, ctx, value)
-		result = multierr.Append(result, err)
+		if err := s.callback(sim, ctx, value); err != nil {
+			result = multierr.Append(result, fmt.Errorf("%s: %w", s.subscriberName, err))
+		}
 	}
 	return result
 }
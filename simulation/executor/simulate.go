@@ -159,6 +159,7 @@ func cursedInitializationLogic(
 	initialHeader.Version.Block = 11
 
 	simState := newSimulatorState(tb, simParams, initialHeader, w, validators, *config)
+	simManager.SubscribePropertyChecks(&simState.propertyChecks)
 
 	// TODO: If simulation has a param export path configured, export params here.
 
@@ -13,6 +13,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/osmosis-labs/osmosis/v16/simulation/executor/internal/pubsub"
 	"github.com/osmosis-labs/osmosis/v16/simulation/executor/internal/stats"
 	"github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
 )
@@ -47,6 +48,9 @@ type simState struct {
 	eventStats stats.EventStats
 	opCount    int
 
+	// propertyChecks are published to by the simulator, e.g. at the end of every block.
+	propertyChecks pubsub.Manager
+
 	config Config
 }
 
@@ -64,6 +68,7 @@ func newSimulatorState(tb testing.TB, simParams Params, initialHeader tmproto.He
 		w:              w,
 		eventStats:     stats.NewEventStats(),
 		opCount:        0,
+		propertyChecks: pubsub.NewPubSubManager(),
 		config:         config,
 	}
 }
@@ -123,6 +128,11 @@ func (simState *simState) SimulateBlock(simCtx *simtypes.SimCtx, blockSimulator
 
 	responseEndBlock := simState.endBlock(simCtx)
 
+	err = simState.checkBlockEndProperties(simCtx)
+	if err != nil {
+		return true, err
+	}
+
 	err = simState.prepareNextSimState(simCtx, requestBeginBlock, responseEndBlock)
 	if err != nil {
 		return true, err
@@ -145,6 +155,18 @@ func (simState *simState) endBlock(simCtx *simtypes.SimCtx) abci.ResponseEndBloc
 	return res
 }
 
+// checkBlockEndProperties runs the property checks subscribed to the end of blocks,
+// on a cached context of the state the block is about to commit.
+func (simState *simState) checkBlockEndProperties(simCtx *simtypes.SimCtx) error {
+	ctx, _ := simCtx.BaseApp().NewContext(false, simState.header).WithBlockTime(simState.header.Time).CacheContext()
+	err := simState.propertyChecks.Publish(simCtx, ctx, simtypes.BlockEndKey, simState.header.Height)
+	if err != nil {
+		simState.logWriter.PrintLogs()
+		return fmt.Errorf("property checks failed at the end of block %d: %w", simState.header.Height, err)
+	}
+	return nil
+}
+
 func (simState *simState) prepareNextSimState(simCtx *simtypes.SimCtx, req abci.RequestBeginBlock, res abci.ResponseEndBlock) error {
 	// Log the current block's header time for future lookup
 	simState.pastTimes = append(simState.pastTimes, simState.header.Time)
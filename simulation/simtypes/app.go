@@ -10,6 +10,7 @@ import (
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v16/x/tokenfactory/types"
 )
 
 type AppCreator = func(homepath string, legacyInvariantPeriod uint, baseappOptions ...func(*baseapp.BaseApp)) App
@@ -22,6 +23,7 @@ type App interface {
 	GetStakingKeeper() stakingkeeper.Keeper
 	ModuleManager() module.Manager
 	GetPoolManagerKeeper() PoolManagerKeeper
+	GetTokenFactoryKeeper() TokenFactoryKeeper
 }
 
 type AccountKeeper interface {
@@ -40,4 +42,12 @@ type BankKeeper interface {
 
 type PoolManagerKeeper interface {
 	CreatePool(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (uint64, error)
+	AllPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error)
+	RouteGetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+	RouteExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, route []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount sdk.Int) (sdk.Int, error)
+}
+
+type TokenFactoryKeeper interface {
+	GetParams(ctx sdk.Context) tokenfactorytypes.Params
+	SetParams(ctx sdk.Context, params tokenfactorytypes.Params)
 }
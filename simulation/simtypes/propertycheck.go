@@ -1,6 +1,10 @@
 package simtypes
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type SimCallbackFn func(sim *SimCtx, ctx sdk.Context, value interface{}) error
 
@@ -18,3 +22,26 @@ type PropertyCheck interface {
 	SubscriptionKeys() []string
 	Check(sim *SimCtx, ctx sdk.Context, key string, value interface{}) error
 }
+
+// BlockEndKey is published by the simulator after the EndBlock of every block, with the block height as value.
+const BlockEndKey = "block_end"
+
+var _ PropertyCheck = invariantPropertyCheck{}
+
+// NewInvariantPropertyCheck returns a property check failing when the invariant is broken at the end of a block.
+func NewInvariantPropertyCheck(invariant sdk.Invariant) PropertyCheck {
+	return invariantPropertyCheck{invariant: invariant}
+}
+
+type invariantPropertyCheck struct {
+	invariant sdk.Invariant
+}
+
+func (invariantPropertyCheck) SubscriptionKeys() []string { return []string{BlockEndKey} }
+
+func (c invariantPropertyCheck) Check(_ *SimCtx, ctx sdk.Context, _ string, value interface{}) error {
+	if msg, broken := c.invariant(ctx); broken {
+		return fmt.Errorf("invariant broken at the end of block %v: %s", value, msg)
+	}
+	return nil
+}
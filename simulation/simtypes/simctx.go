@@ -96,6 +96,10 @@ func (sim SimCtx) PoolManagerKeeper() PoolManagerKeeper {
 	return sim.app.GetPoolManagerKeeper()
 }
 
+func (sim SimCtx) TokenFactoryKeeper() TokenFactoryKeeper {
+	return sim.app.GetTokenFactoryKeeper()
+}
+
 // randManager is built to give API's for randomness access
 // which allow the caller to avoid "butterfly effects".
 // e.g. in the Simulator, I don't want adding one new rand call to a message
@@ -72,6 +72,25 @@ func (sim *SimCtx) deliverTx(tx sdk.Tx, msg sdk.Msg, msgName string) (simulation
 	return opMsg, nil, results.Data, nil
 }
 
+// CheckMsg runs the handler of the message on a cached context, and returns its error.
+// Generators whose messages depend on more state than they check use it to only return
+// messages that succeed, as failing to deliver a message halts the simulation.
+// Panics are returned as errors, as baseapp does when delivering the message.
+func (sim *SimCtx) CheckMsg(ctx sdk.Context, msg sdk.Msg) (err error) {
+	handler := sim.BaseApp().MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return fmt.Errorf("no message handler for %s", sdk.MsgTypeURL(msg))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic running %s: %v", sdk.MsgTypeURL(msg), r)
+		}
+	}()
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = handler(cacheCtx, msg)
+	return err
+}
+
 // GenTx generates a signed mock transaction.
 // TODO: Surely theres proper API's in the SDK for this?
 // (This was copied from SDK simapp, and deleted the egregiously non-deterministic memo handling)
@@ -0,0 +1,236 @@
+package ibc_hooks_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	minttypes "github.com/osmosis-labs/osmosis/v16/x/mint/types"
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+// setupPendingAsyncAck simulates the receive of a packet on chain A whose ack was deferred by contract,
+// funding the contract with the tokens received with the packet.
+func (suite *HooksTestSuite) setupPendingAsyncAck(contract sdk.AccAddress, prevSequence uint64) channeltypes.Packet {
+	app := suite.chainA.GetOsmosisApp()
+	ctx := suite.chainA.GetContext()
+
+	packet := suite.makeMockPacket(contract.String(), "", prevSequence)
+	app.IBCKeeper.ChannelKeeper.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+	funds := sdk.NewCoins(sdk.NewCoin(osmoutils.MustExtractDenomFromPacketOnRecv(packet), sdk.OneInt()))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, contract, funds))
+
+	transferAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err := app.IBCHooksKeeper.StorePendingAsyncAck(ctx, contract.String(), packet, transferAck.Acknowledgement())
+	suite.Require().NoError(err)
+	return packet
+}
+
+func (suite *HooksTestSuite) TestEmitIBCAck() {
+	accounts := apptesting.CreateRandomAccounts(2)
+	contract := accounts[0]
+	tests := map[string]struct {
+		sender         sdk.AccAddress
+		sequence       uint64
+		errMsg         string
+		removeFunds    bool
+		expectedErr    error
+		expectedResult bool
+	}{
+		"result ack": {
+			sender:         contract,
+			expectedResult: true,
+		},
+		"error ack returns the funds": {
+			sender: contract,
+			errMsg: "swap failed",
+		},
+		"error ack without funds": {
+			sender:      contract,
+			errMsg:      "swap failed",
+			removeFunds: true,
+			expectedErr: types.ErrCannotReturnFunds,
+		},
+		"not the contract": {
+			sender:      accounts[1],
+			expectedErr: types.ErrAsyncAckUnauthorized,
+		},
+		"no pending ack": {
+			sender:      contract,
+			sequence:    2,
+			expectedErr: types.ErrAsyncAckNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			app := suite.chainA.GetOsmosisApp()
+			packet := suite.setupPendingAsyncAck(contract, 0)
+			denom := osmoutils.MustExtractDenomFromPacketOnRecv(packet)
+			if tc.removeFunds {
+				err := app.BankKeeper.SendCoins(suite.chainA.GetContext(), contract, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(denom, sdk.OneInt())))
+				suite.Require().NoError(err)
+			}
+			sequence := packet.GetSequence()
+			if tc.sequence != 0 {
+				sequence = tc.sequence
+			}
+
+			ctx, _ := suite.chainA.GetContext().CacheContext()
+			err := app.IBCHooksKeeper.EmitIBCAck(ctx, tc.sender.String(), packet.GetDestChannel(), sequence, []byte(`"done"`), tc.errMsg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			_, found := app.IBCHooksKeeper.GetPendingAsyncAck(ctx, packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().False(found)
+
+			ackCommitment, found := app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().True(found)
+
+			expectedAck := osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrAsyncAckError, tc.errMsg)
+			if tc.expectedResult {
+				bz, err := json.Marshal(types.ContractAck{ContractResult: []byte(`"done"`), IbcAck: channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()})
+				suite.Require().NoError(err)
+				expectedAck = channeltypes.NewResultAcknowledgement(bz)
+			}
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(expectedAck.Acknowledgement()), ackCommitment)
+
+			// The funds are burned on error acks, as the tokens are vouchers of chain B's native denom
+			expectedBalance := sdk.OneInt()
+			if !tc.expectedResult {
+				expectedBalance = sdk.ZeroInt()
+			}
+			suite.Require().Equal(expectedBalance, app.BankKeeper.GetBalance(ctx, contract, denom).Amount)
+		})
+	}
+}
+
+func (suite *HooksTestSuite) TestExpireAsyncAcks() {
+	app := suite.chainA.GetOsmosisApp()
+	contract := apptesting.CreateRandomAccounts(1)[0]
+
+	packet := suite.setupPendingAsyncAck(contract, 0)
+	packetWithoutFunds := suite.setupPendingAsyncAck(contract, 1)
+	denom := osmoutils.MustExtractDenomFromPacketOnRecv(packet)
+	// The contract only holds the funds of one of the packets
+	err := app.BankKeeper.SendCoins(suite.chainA.GetContext(), contract, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin(denom, sdk.OneInt())))
+	suite.Require().NoError(err)
+
+	// Nothing expires before the timeout
+	ctx := suite.chainA.GetContext()
+	app.IBCHooksKeeper.ExpireAsyncAcks(ctx.WithBlockTime(ctx.BlockTime().Add(types.AsyncAckTimeout - 1)))
+	_, found := app.IBCHooksKeeper.GetPendingAsyncAck(ctx, packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.AsyncAckTimeout))
+	app.IBCHooksKeeper.ExpireAsyncAcks(ctx)
+
+	for _, p := range []channeltypes.Packet{packet, packetWithoutFunds} {
+		_, found := app.IBCHooksKeeper.GetPendingAsyncAck(ctx, p.GetDestChannel(), p.GetSequence())
+		suite.Require().False(found)
+	}
+
+	// The packet with funds is acked with an error and its funds are burned
+	ackCommitment, found := app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	errorAck := osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrAsyncAckError, "async ack timed out")
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(errorAck.Acknowledgement()), ackCommitment)
+	suite.Require().True(app.BankKeeper.GetBalance(ctx, contract, denom).IsZero())
+
+	// The funds of the other packet cannot be returned, so it is acked with a result
+	ackCommitment, found = app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packetWithoutFunds.GetDestPort(), packetWithoutFunds.GetDestChannel(), packetWithoutFunds.GetSequence())
+	suite.Require().True(found)
+	bz, err := json.Marshal(types.ContractAck{IbcAck: channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(channeltypes.NewResultAcknowledgement(bz).Acknowledgement()), ackCommitment)
+}
+
+func (suite *HooksTestSuite) TestAsyncAckThroughWasmHook() {
+	// The contract responds with {"is_async_ack": true}, so the wasm hook returns a nil ack
+	suite.chainA.StoreContractCode(&suite.Suite, "./bytecode/async_ack.wasm")
+	contract := suite.chainA.InstantiateContract(&suite.Suite, "{}", 1)
+	appA := suite.chainA.GetOsmosisApp()
+	appB := suite.chainB.GetOsmosisApp()
+	sender := suite.chainB.SenderAccount.GetAddress()
+
+	memo := fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {"async": {}}}}`, contract)
+	transferMsg := NewMsgTransfer(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)), sender.String(), contract.String(), suite.GetSenderChannel(ChainB, ChainA), memo)
+	sendResult, err := suite.chainB.SendMsgsNoCheck(transferMsg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(sendResult.GetEvents())
+	suite.Require().NoError(err)
+	senderBalance := appB.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// No ack is written when the packet is received, and the contract holds the funds until the ack is emitted
+	receiveResult := suite.RelayPacketNoAck(packet, BtoA)
+	_, err = ibctesting.ParseAckFromEvents(receiveResult.GetEvents())
+	suite.Require().Error(err)
+
+	ctx := suite.chainA.GetContext()
+	pending, found := appA.IBCHooksKeeper.GetPendingAsyncAck(ctx, packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(contract.String(), pending.Contract)
+	_, found = appA.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+	denom := osmoutils.MustExtractDenomFromPacketOnRecv(packet)
+	suite.Require().Equal(sdk.NewInt(1000), appA.BankKeeper.GetBalance(ctx, contract, denom).Amount)
+
+	// The contract never emits the ack, so an error ack is written at the end of the first block after the timeout
+	suite.coordinator.IncrementTimeBy(types.AsyncAckTimeout)
+	suite.chainA.NextBlock()
+	suite.chainA.NextBlock()
+
+	ctx = suite.chainA.GetContext()
+	_, found = appA.IBCHooksKeeper.GetPendingAsyncAck(ctx, packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+	errorAck := osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrAsyncAckError, "async ack timed out")
+	ackCommitment, found := appA.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(errorAck.Acknowledgement()), ackCommitment)
+	suite.Require().True(appA.BankKeeper.GetBalance(ctx, contract, denom).IsZero())
+
+	// The sender is refunded once the error ack is relayed
+	err = suite.pathAB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+	err = suite.pathAB.EndpointB.AcknowledgePacket(packet, errorAck.Acknowledgement())
+	suite.Require().NoError(err)
+	suite.Require().Equal(senderBalance.AddAmount(sdk.NewInt(1000)), appB.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, sdk.DefaultBondDenom))
+}
+
+func (suite *HooksTestSuite) TestAsyncAckGenesis() {
+	contract := apptesting.CreateRandomAccounts(1)[0]
+	packets := []channeltypes.Packet{suite.setupPendingAsyncAck(contract, 0), suite.setupPendingAsyncAck(contract, 1)}
+
+	app := suite.chainA.GetOsmosisApp()
+	genesis := app.IBCHooksKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Len(genesis.PendingAsyncAcks, 2)
+	suite.Require().NoError(genesis.Validate())
+
+	// Import the pending async acks on a fresh chain
+	suite.SetupTest()
+	app = suite.chainA.GetOsmosisApp()
+	ctx := suite.chainA.GetContext()
+	app.IBCHooksKeeper.InitGenesis(ctx, *genesis)
+	suite.Require().Equal(genesis, app.IBCHooksKeeper.ExportGenesis(ctx))
+
+	// The timeout queue is restored, so the imported acks still expire
+	ctx = ctx.WithBlockTime(genesis.PendingAsyncAcks[0].Timeout)
+	app.IBCHooksKeeper.ExpireAsyncAcks(ctx)
+	for _, packet := range packets {
+		_, found := app.IBCHooksKeeper.GetPendingAsyncAck(ctx, packet.GetDestChannel(), packet.GetSequence())
+		suite.Require().False(found)
+		_, found = app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		suite.Require().True(found)
+	}
+}
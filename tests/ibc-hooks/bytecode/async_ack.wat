@@ -0,0 +1,33 @@
+;; Contract deferring the ack of every packet routed to it by the wasm hook, built with
+;; `wat2wasm async_ack.wat -o async_ack.wasm`. Execute responds with {"is_async_ack":true}
+;; as data, so the ack is written once the async ack times out.
+(module
+  (memory (export "memory") 17)
+  ;; bump allocator of the regions the VM writes the call arguments to.
+  (global $heap (mut i32) (i32.const 1024))
+
+  (func (export "interface_version_8"))
+
+  ;; allocate returns a region of the given capacity: offset, capacity and length as u32.
+  ;; Regions are 4 bytes aligned, as the VM reads them through aligned pointers.
+  (func (export "allocate") (param $size i32) (result i32) (local $region i32)
+    (local.set $region (global.get $heap))
+    (global.set $heap (i32.and (i32.add (local.get $region) (i32.add (i32.const 15) (local.get $size))) (i32.const -4)))
+    (i32.store (local.get $region) (i32.add (local.get $region) (i32.const 12)))
+    (i32.store offset=4 (local.get $region) (local.get $size))
+    (i32.store offset=8 (local.get $region) (i32.const 0))
+    (local.get $region))
+
+  (func (export "deallocate") (param i32))
+
+  (func (export "instantiate") (param i32 i32 i32) (result i32)
+    (i32.const 16))
+
+  (func (export "execute") (param i32 i32 i32) (result i32)
+    (i32.const 32))
+
+  ;; regions of the responses.
+  (data (i32.const 16) "\40\00\00\00\3e\00\00\00\3e\00\00\00")
+  (data (i32.const 32) "\00\01\00\00\58\00\00\00\58\00\00\00")
+  (data (i32.const 64) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":null}}")
+  (data (i32.const 256) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":\"eyJpc19hc3luY19hY2siOnRydWV9\"}}"))
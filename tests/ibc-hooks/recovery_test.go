@@ -0,0 +1,238 @@
+package ibc_hooks_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	minttypes "github.com/osmosis-labs/osmosis/v16/x/mint/types"
+	ibchookskeeper "github.com/osmosis-labs/osmosis/x/ibc-hooks/keeper"
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+// strandFunds sends funds to the intermediary sender of chain B's sender on chain A, as a contract executed
+// through the wasm hooks would, and records them.
+func (suite *HooksTestSuite) strandFunds(funds sdk.Coins) sdk.AccAddress {
+	app := suite.chainA.GetOsmosisApp()
+	ctx := suite.chainA.GetContext()
+
+	channel := suite.pathAB.EndpointA.ChannelID
+	originalSender := suite.chainB.SenderAccount.GetAddress().String()
+	intermediaryBech32, err := ibchookskeeper.DeriveIntermediateSender(channel, originalSender, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	suite.Require().NoError(err)
+	intermediary := sdk.MustAccAddressFromBech32(intermediaryBech32)
+
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, intermediary, funds))
+	for _, coin := range funds {
+		app.IBCHooksKeeper.RecordStrandedFunds(ctx, channel, originalSender, intermediary, coin.Denom)
+	}
+	return intermediary
+}
+
+func (suite *HooksTestSuite) TestRecordStrandedFunds() {
+	app := suite.chainA.GetOsmosisApp()
+	channel := suite.pathAB.EndpointA.ChannelID
+	originalSender := suite.chainB.SenderAccount.GetAddress().String()
+
+	funds := sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100)))
+	intermediary := suite.strandFunds(funds)
+
+	stranded, found := app.IBCHooksKeeper.GetStrandedFunds(suite.chainA.GetContext(), channel, originalSender)
+	suite.Require().True(found)
+	suite.Require().Equal(types.StrandedFunds{
+		Channel:            channel,
+		OriginalSender:     originalSender,
+		IntermediarySender: intermediary.String(),
+		Amount:             funds,
+	}, stranded)
+	suite.Require().Len(app.IBCHooksKeeper.GetAllStrandedFunds(suite.chainA.GetContext()), 1)
+
+	// Only the recorded denom is updated
+	otherFunds := sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(50)))
+	suite.strandFunds(otherFunds)
+	err := app.BankKeeper.SendCoins(suite.chainA.GetContext(), intermediary, suite.chainA.SenderAccount.GetAddress(), funds)
+	suite.Require().NoError(err)
+	app.IBCHooksKeeper.RecordStrandedFunds(suite.chainA.GetContext(), channel, originalSender, intermediary, "uion")
+	stranded, found = app.IBCHooksKeeper.GetStrandedFunds(suite.chainA.GetContext(), channel, originalSender)
+	suite.Require().True(found)
+	suite.Require().Equal(funds.Add(otherFunds...), stranded.Amount)
+
+	// Recording the denoms the intermediary sender holds no funds of removes the record
+	err = app.BankKeeper.SendCoins(suite.chainA.GetContext(), intermediary, suite.chainA.SenderAccount.GetAddress(), otherFunds)
+	suite.Require().NoError(err)
+	app.IBCHooksKeeper.RecordStrandedFunds(suite.chainA.GetContext(), channel, originalSender, intermediary, "uosmo")
+	app.IBCHooksKeeper.RecordStrandedFunds(suite.chainA.GetContext(), channel, originalSender, intermediary, "uion")
+	_, found = app.IBCHooksKeeper.GetStrandedFunds(suite.chainA.GetContext(), channel, originalSender)
+	suite.Require().False(found)
+
+	// Recovering without funds fails
+	ctx, _ := suite.chainA.GetContext().CacheContext()
+	_, err = app.IBCHooksKeeper.RecoverStrandedFunds(ctx, channel, originalSender, intermediary, nil, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().ErrorIs(err, types.ErrNoStrandedFunds)
+}
+
+func (suite *HooksTestSuite) TestRecoverStrandedFundsToLocalAddress() {
+	app := suite.chainA.GetOsmosisApp()
+	receiver := apptesting.CreateRandomAccounts(1)[0]
+
+	funds := sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100)))
+	intermediary := suite.strandFunds(funds)
+
+	// Funds sent to the intermediary sender without being recorded are not recovered
+	unrecorded := sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(50)))
+	suite.Require().NoError(app.BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, unrecorded))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), minttypes.ModuleName, intermediary, unrecorded))
+
+	ackBytes := suite.receivePacket(receiver.String(), fmt.Sprintf(`{"%s": {"receiver": "%s"}}`, types.RecoverMemoKey, receiver))
+	var ack map[string]string
+	suite.Require().NoError(json.Unmarshal(ackBytes, &ack))
+	suite.Require().NotContains(ack, "error")
+
+	// The receiver gets the stranded funds and the funds of the recovery packet
+	localDenom := osmoutils.MustExtractDenomFromPacketOnRecv(suite.makeMockPacket("", "", 0))
+	expected := funds.Add(sdk.NewCoin(localDenom, sdk.OneInt()))
+	ctx := suite.chainA.GetContext()
+	suite.Require().Equal(expected, app.BankKeeper.GetAllBalances(ctx, receiver))
+	suite.Require().Equal(unrecorded, app.BankKeeper.GetAllBalances(ctx, intermediary))
+
+	_, found := app.IBCHooksKeeper.GetStrandedFunds(ctx, suite.pathAB.EndpointA.ChannelID, suite.chainB.SenderAccount.GetAddress().String())
+	suite.Require().False(found)
+}
+
+// recoverStrandedFundsOverIBC strands funds at the intermediary sender of chain B's sender on chain A, and claims
+// them back over IBC. It returns the intermediary sender and the first transfer sending the funds back.
+func (suite *HooksTestSuite) recoverStrandedFundsOverIBC() (sdk.AccAddress, channeltypes.Packet) {
+	intermediary := suite.strandFunds(sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100))))
+
+	// The packet is not acknowledged on chain B, as receivePacket does, since chain A sends a packet on the
+	// same port, channel and sequence when recovering the funds.
+	packet := suite.makeMockPacket("", fmt.Sprintf(`{"%s": {}}`, types.RecoverMemoKey), 0)
+	channelCap := suite.chainB.GetChannelCapability(suite.pathAB.EndpointB.ChannelConfig.PortID, suite.pathAB.EndpointB.ChannelID)
+	err := suite.chainB.GetOsmosisApp().HooksICS4Wrapper.SendPacket(suite.chainB.GetContext(), channelCap, packet)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.pathAB.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.pathAB.EndpointA.UpdateClient())
+	res, err := suite.pathAB.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ackBytes, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	var ack map[string]string
+	suite.Require().NoError(json.Unmarshal(ackBytes, &ack))
+	suite.Require().NotContains(ack, "error")
+
+	recoveryPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return intermediary, recoveryPacket
+}
+
+func (suite *HooksTestSuite) TestRecoverStrandedFundsOverIBC() {
+	app := suite.chainA.GetOsmosisApp()
+
+	localDenom := osmoutils.MustExtractDenomFromPacketOnRecv(suite.makeMockPacket("", "", 0))
+	intermediary, _ := suite.recoverStrandedFundsOverIBC()
+
+	// The funds are sent back over the channel: uosmo is escrowed and the voucher of chain B's denom is burned
+	ctx := suite.chainA.GetContext()
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, intermediary).IsZero())
+	escrow := transfertypes.GetEscrowAddress(suite.pathAB.EndpointA.ChannelConfig.PortID, suite.pathAB.EndpointA.ChannelID)
+	suite.Require().Equal(sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, escrow, "uosmo").Amount)
+	suite.Require().True(app.BankKeeper.GetSupply(ctx, localDenom).IsZero())
+}
+
+func (suite *HooksTestSuite) TestFailedRecoveryOverIBC() {
+	tests := map[string]struct {
+		fail func(packet channeltypes.Packet)
+	}{
+		"error ack": {
+			fail: func(packet channeltypes.Packet) {
+				// Chain B can't fail to receive the transfer, so the error ack is passed to the transfer stack directly
+				app := suite.chainA.GetOsmosisApp()
+				cbs, ok := app.IBCKeeper.Router.GetRoute(transfertypes.ModuleName)
+				suite.Require().True(ok)
+				errorAck := osmoutils.NewEmitErrorAcknowledgement(suite.chainA.GetContext(), types.ErrRecovery)
+				err := cbs.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, errorAck.Acknowledgement(), suite.chainA.SenderAccount.GetAddress())
+				suite.Require().NoError(err)
+			},
+		},
+		"timeout": {
+			fail: func(packet channeltypes.Packet) {
+				suite.coordinator.IncrementTimeBy(types.RecoveryTransferTimeout)
+				suite.chainB.NextBlock()
+				suite.Require().NoError(suite.pathAB.EndpointA.UpdateClient())
+				suite.Require().NoError(suite.pathAB.EndpointA.TimeoutPacket(packet))
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			app := suite.chainA.GetOsmosisApp()
+			intermediary, packet := suite.recoverStrandedFundsOverIBC()
+			channel := suite.pathAB.EndpointA.ChannelID
+			originalSender := suite.chainB.SenderAccount.GetAddress().String()
+			_, found := app.IBCHooksKeeper.GetStrandedFunds(suite.chainA.GetContext(), channel, originalSender)
+			suite.Require().False(found)
+
+			tc.fail(packet)
+
+			// The funds of the failed transfer are refunded to the intermediary sender, and can be claimed again
+			var data transfertypes.FungibleTokenPacketData
+			suite.Require().NoError(json.Unmarshal(packet.GetData(), &data))
+			amount, ok := sdk.NewIntFromString(data.Amount)
+			suite.Require().True(ok)
+			refunded := sdk.NewCoins(sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount))
+
+			ctx := suite.chainA.GetContext()
+			suite.Require().Equal(refunded, app.BankKeeper.GetAllBalances(ctx, intermediary))
+			stranded, found := app.IBCHooksKeeper.GetStrandedFunds(ctx, channel, originalSender)
+			suite.Require().True(found)
+			suite.Require().Equal(types.StrandedFunds{
+				Channel:            channel,
+				OriginalSender:     originalSender,
+				IntermediarySender: intermediary.String(),
+				Amount:             refunded,
+			}, stranded)
+		})
+	}
+}
+
+func (suite *HooksTestSuite) TestStrandedFundsGenesis() {
+	funds := sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100)))
+	suite.strandFunds(funds)
+
+	app := suite.chainA.GetOsmosisApp()
+	genesis := app.IBCHooksKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Len(genesis.StrandedFunds, 1)
+	suite.Require().NoError(genesis.Validate())
+
+	// Import the stranded funds on a fresh chain
+	suite.SetupTest()
+	app = suite.chainA.GetOsmosisApp()
+	ctx := suite.chainA.GetContext()
+	app.IBCHooksKeeper.InitGenesis(ctx, *genesis)
+	suite.Require().Equal(genesis, app.IBCHooksKeeper.ExportGenesis(ctx))
+	stranded, found := app.IBCHooksKeeper.GetStrandedFunds(ctx, genesis.StrandedFunds[0].Channel, genesis.StrandedFunds[0].OriginalSender)
+	suite.Require().True(found)
+	suite.Require().Equal(funds, stranded.Amount)
+}
+
+func (suite *HooksTestSuite) TestRecoverToInvalidReceiver() {
+	intermediary := suite.strandFunds(sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100))))
+
+	ackBytes := suite.receivePacket("", fmt.Sprintf(`{"%s": {"receiver": "invalid"}}`, types.RecoverMemoKey))
+	var ack map[string]string
+	suite.Require().NoError(json.Unmarshal(ackBytes, &ack))
+	suite.Require().Contains(ack, "error")
+
+	// The stranded funds stay at the intermediary sender
+	balance := suite.chainA.GetOsmosisApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), intermediary)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100))), balance)
+}
@@ -1,6 +1,9 @@
 package bindings
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type OsmosisMsg struct {
 	/// Contracts can create denoms, namespaced under the contract's address.
@@ -15,6 +18,16 @@ type OsmosisMsg struct {
 	/// that they are the admin of.
 	/// Currently, the burn from address must be the admin contract.
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
+	/// Swap over one or more pools, through the poolmanager.
+	Swap *SwapMsg `json:"swap,omitempty"`
+	/// Lock tokens owned by the contract in the lockup module.
+	LockTokens *LockTokens `json:"lock_tokens,omitempty"`
+	/// Begin unlocking a lock owned by the contract.
+	BeginUnlocking *BeginUnlocking `json:"begin_unlocking,omitempty"`
+	/// Create a concentrated liquidity position owned by the contract.
+	CreatePosition *CreatePosition `json:"create_position,omitempty"`
+	/// Withdraw liquidity from a concentrated liquidity position owned by the contract.
+	WithdrawPosition *WithdrawPosition `json:"withdraw_position,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -46,3 +59,75 @@ type BurnTokens struct {
 	// BurnFromAddress must be set to "" for now.
 	BurnFromAddress string `json:"burn_from_address"`
 }
+
+// SwapMsg swaps tokens of DenomIn owned by the contract over the given route.
+// The data of its response is a JSON encoded SwapResponse.
+type SwapMsg struct {
+	DenomIn string              `json:"denom_in"`
+	Route   []SwapStep          `json:"route"`
+	Amount  SwapAmountWithLimit `json:"amount"`
+}
+
+// SwapResponse is the amount computed by a swap: the amount of tokens out of an
+// exact amount in swap, or the amount of tokens in of an exact amount out swap.
+type SwapResponse struct {
+	Amount sdk.Int `json:"amount"`
+}
+
+// LockTokens locks coins owned by the contract for the given duration.
+// The data of its response is a JSON encoded LockTokensResponse.
+type LockTokens struct {
+	DurationSeconds uint64            `json:"duration_seconds"`
+	Coins           wasmvmtypes.Coins `json:"coins"`
+}
+
+type LockTokensResponse struct {
+	LockId uint64 `json:"lock_id"`
+}
+
+// BeginUnlocking begins unlocking the given coins of a lock owned by the contract.
+// If Coins is empty, the whole lock begins unlocking.
+// The data of its response is a JSON encoded BeginUnlockingResponse.
+type BeginUnlocking struct {
+	LockId uint64            `json:"lock_id"`
+	Coins  wasmvmtypes.Coins `json:"coins"`
+}
+
+// BeginUnlockingResponse returns the id of the unlocking lock,
+// which differs from the original lock id on partial unlocks.
+type BeginUnlockingResponse struct {
+	UnlockingLockId uint64 `json:"unlocking_lock_id"`
+}
+
+// CreatePosition creates a concentrated liquidity position owned by the contract,
+// with tokens provided by the contract.
+// The data of its response is a JSON encoded CreatePositionResponse.
+type CreatePosition struct {
+	PoolId          uint64            `json:"pool_id"`
+	LowerTick       int64             `json:"lower_tick"`
+	UpperTick       int64             `json:"upper_tick"`
+	TokensProvided  wasmvmtypes.Coins `json:"tokens_provided"`
+	TokenMinAmount0 sdk.Int           `json:"token_min_amount0"`
+	TokenMinAmount1 sdk.Int           `json:"token_min_amount1"`
+}
+
+type CreatePositionResponse struct {
+	PositionId uint64  `json:"position_id"`
+	Amount0    sdk.Int `json:"amount0"`
+	Amount1    sdk.Int `json:"amount1"`
+	Liquidity  sdk.Dec `json:"liquidity"`
+	LowerTick  int64   `json:"lower_tick"`
+	UpperTick  int64   `json:"upper_tick"`
+}
+
+// WithdrawPosition withdraws liquidity from a concentrated liquidity position owned by the contract.
+// The data of its response is a JSON encoded WithdrawPositionResponse.
+type WithdrawPosition struct {
+	PositionId      uint64  `json:"position_id"`
+	LiquidityAmount sdk.Dec `json:"liquidity_amount"`
+}
+
+type WithdrawPositionResponse struct {
+	Amount0 sdk.Int `json:"amount0"`
+	Amount1 sdk.Int `json:"amount1"`
+}
@@ -1,5 +1,10 @@
 package bindings
 
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // OsmosisQuery contains osmosis custom queries.
 // See https://github.com/osmosis-labs/osmosis-bindings/blob/main/packages/bindings/src/query.rs
 type OsmosisQuery struct {
@@ -8,6 +13,22 @@ type OsmosisQuery struct {
 	FullDenom *FullDenom `json:"full_denom,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// Returns the version of the custom bindings supported by the chain.
+	BindingsVersion *BindingsVersion `json:"bindings_version,omitempty"`
+	/// Returns the type and the liquidity of a pool.
+	Pool *Pool `json:"pool,omitempty"`
+	/// Returns the spot price of a pool.
+	SpotPrice *SpotPrice `json:"spot_price,omitempty"`
+	/// Estimates the result of a swap over one or more pools, without executing it.
+	EstimateSwap *EstimateSwap `json:"estimate_swap,omitempty"`
+	/// Returns the arithmetic time weighted average price of a pool.
+	ArithmeticTwap *Twap `json:"arithmetic_twap,omitempty"`
+	/// Returns the geometric time weighted average price of a pool.
+	GeometricTwap *Twap `json:"geometric_twap,omitempty"`
+	/// Returns a lock of the lockup module.
+	Lock *Lock `json:"lock,omitempty"`
+	/// Returns a concentrated liquidity position.
+	Position *Position `json:"position,omitempty"`
 }
 
 type FullDenom struct {
@@ -26,3 +47,83 @@ type DenomAdminResponse struct {
 type FullDenomResponse struct {
 	Denom string `json:"denom"`
 }
+
+type BindingsVersion struct{}
+
+type BindingsVersionResponse struct {
+	Version uint32 `json:"version"`
+}
+
+type Pool struct {
+	PoolId uint64 `json:"pool_id"`
+}
+
+type PoolResponse struct {
+	PoolId   uint64            `json:"pool_id"`
+	PoolType string            `json:"pool_type"`
+	Assets   wasmvmtypes.Coins `json:"assets"`
+}
+
+// SpotPrice returns the price of the base asset, in units of the quote asset.
+type SpotPrice struct {
+	PoolId          uint64 `json:"pool_id"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+}
+
+type SpotPriceResponse struct {
+	Price sdk.Dec `json:"price"`
+}
+
+// EstimateSwap estimates a swap of DenomIn over the given route.
+// Its response is a SwapResponse.
+type EstimateSwap struct {
+	DenomIn string     `json:"denom_in"`
+	Route   []SwapStep `json:"route"`
+	Amount  SwapAmount `json:"amount"`
+}
+
+// Twap returns the time weighted average price of the base asset, in units of the quote asset,
+// between StartTime and EndTime, in nanoseconds since the unix epoch.
+// If EndTime is not set, the twap is computed until the current block time.
+type Twap struct {
+	PoolId          uint64  `json:"pool_id"`
+	BaseAssetDenom  string  `json:"base_asset_denom"`
+	QuoteAssetDenom string  `json:"quote_asset_denom"`
+	StartTime       uint64  `json:"start_time,string"`
+	EndTime         *uint64 `json:"end_time,string,omitempty"`
+}
+
+type TwapResponse struct {
+	Twap sdk.Dec `json:"twap"`
+}
+
+type Lock struct {
+	LockId uint64 `json:"lock_id"`
+}
+
+// LockResponse describes a lock. EndTime is in nanoseconds since the unix epoch,
+// and is zero if the lock is not unlocking.
+type LockResponse struct {
+	LockId          uint64            `json:"lock_id"`
+	Owner           string            `json:"owner"`
+	DurationSeconds uint64            `json:"duration_seconds"`
+	EndTime         uint64            `json:"end_time,string"`
+	Coins           wasmvmtypes.Coins `json:"coins"`
+}
+
+type Position struct {
+	PositionId uint64 `json:"position_id"`
+}
+
+// PositionResponse describes a concentrated liquidity position.
+// JoinTime is in nanoseconds since the unix epoch.
+type PositionResponse struct {
+	PositionId uint64  `json:"position_id"`
+	Owner      string  `json:"owner"`
+	PoolId     uint64  `json:"pool_id"`
+	LowerTick  int64   `json:"lower_tick"`
+	UpperTick  int64   `json:"upper_tick"`
+	JoinTime   uint64  `json:"join_time,string"`
+	Liquidity  sdk.Dec `json:"liquidity"`
+}
@@ -0,0 +1,38 @@
+package bindings
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// Version is the version of the custom bindings.
+// The JSON format of the bindings doesn't depend on the chain's protobuf types, and
+// is only ever extended within a version. Any breaking change to an existing binding
+// bumps the version, which contracts can check with the BindingsVersion query.
+const Version uint32 = 1
+
+// SwapStep is a hop of a swap route, swapping through PoolId to DenomOut.
+type SwapStep struct {
+	PoolId   uint64 `json:"pool_id"`
+	DenomOut string `json:"denom_out"`
+}
+
+// SwapAmount is the amount of an estimated swap. Exactly one of In or Out must be set.
+type SwapAmount struct {
+	In  *sdk.Int `json:"in,omitempty"`
+	Out *sdk.Int `json:"out,omitempty"`
+}
+
+// SwapAmountWithLimit is the amount of a swap, along with its slippage limit.
+// Exactly one of ExactIn or ExactOut must be set.
+type SwapAmountWithLimit struct {
+	ExactIn  *ExactIn  `json:"exact_in,omitempty"`
+	ExactOut *ExactOut `json:"exact_out,omitempty"`
+}
+
+type ExactIn struct {
+	Input     sdk.Int `json:"input"`
+	MinOutput sdk.Int `json:"min_output"`
+}
+
+type ExactOut struct {
+	MaxInput sdk.Int `json:"max_input"`
+	Output   sdk.Int `json:"output"`
+}
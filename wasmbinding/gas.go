@@ -0,0 +1,53 @@
+package wasmbinding
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Gas costs of the custom bindings, other than the tokenfactory ones.
+// Each binding is charged exactly its gas cost, whatever the state it reads or writes,
+// and fails if it would consume more than that. This keeps the gas used by contracts
+// independent from the state of the pools, locks and positions they interact with.
+//
+// The cost of a swap hop covers a concentrated liquidity swap crossing about 45 initialized ticks,
+// each crossing consuming about 5_000 gas. Swaps crossing more ticks fail with an out of gas error,
+// and must be split into smaller swaps.
+const (
+	GasCostPool             sdk.Gas = 50_000
+	GasCostSpotPrice        sdk.Gas = 50_000
+	GasCostTwap             sdk.Gas = 100_000
+	GasCostLock             sdk.Gas = 20_000
+	GasCostPosition         sdk.Gas = 20_000
+	GasCostSwapBase         sdk.Gas = 50_000
+	GasCostSwapPerHop       sdk.Gas = 300_000
+	GasCostLockTokens       sdk.Gas = 200_000
+	GasCostBeginUnlocking   sdk.Gas = 200_000
+	GasCostCreatePosition   sdk.Gas = 500_000
+	GasCostWithdrawPosition sdk.Gas = 500_000
+)
+
+// swapGasCost returns the gas cost of estimating or executing a swap over the given number of hops.
+func swapGasCost(hops int) sdk.Gas {
+	return GasCostSwapBase + GasCostSwapPerHop*sdk.Gas(hops)
+}
+
+// runWithGasCost charges exactly gasCost to the gas meter of ctx, and runs f with
+// a dedicated gas meter limited to gasCost.
+// It returns an out of gas error if f consumes more than gasCost.
+func runWithGasCost(ctx sdk.Context, gasCost sdk.Gas, descriptor string, f func(ctx sdk.Context) error) (err error) {
+	ctx.GasMeter().ConsumeGas(gasCost, descriptor)
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "%s exceeded its gas cost of %d, out of gas in location: %s", descriptor, gasCost, outOfGas.Descriptor)
+		}
+	}()
+
+	return f(ctx.WithGasMeter(sdk.NewGasMeter(gasCost)))
+}
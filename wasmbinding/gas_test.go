@@ -0,0 +1,29 @@
+package wasmbinding
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunWithGasCost(t *testing.T) {
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	// the binding is charged its flat cost however much gas it uses within it
+	err := runWithGasCost(ctx, 1000, "test", func(ctx sdk.Context) error {
+		ctx.GasMeter().ConsumeGas(999, "test")
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.Gas(1000), ctx.GasMeter().GasConsumed())
+
+	// exceeding the flat cost fails the binding rather than panicking
+	err = runWithGasCost(ctx, 1000, "test", func(ctx sdk.Context) error {
+		ctx.GasMeter().ConsumeGas(1001, "test")
+		return nil
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	require.Equal(t, sdk.Gas(2000), ctx.GasMeter().GasConsumed())
+}
@@ -2,6 +2,7 @@ package wasmbinding
 
 import (
 	"encoding/json"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -10,26 +11,44 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	"github.com/osmosis-labs/osmosis/v16/wasmbinding/bindings"
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v16/x/lockup/keeper"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v16/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v16/x/tokenfactory/types"
 )
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(
+	bank *bankkeeper.BaseKeeper,
+	tokenFactory *tokenfactorykeeper.Keeper,
+	poolManager *poolmanager.Keeper,
+	lockup *lockupkeeper.Keeper,
+	concentratedLiquidity *concentratedliquidity.Keeper,
+) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
-			wrapped:      old,
-			bank:         bank,
-			tokenFactory: tokenFactory,
+			wrapped:               old,
+			bank:                  bank,
+			tokenFactory:          tokenFactory,
+			poolManager:           poolManager,
+			lockup:                lockup,
+			concentratedLiquidity: concentratedLiquidity,
 		}
 	}
 }
 
 type CustomMessenger struct {
-	wrapped      wasmkeeper.Messenger
-	bank         *bankkeeper.BaseKeeper
-	tokenFactory *tokenfactorykeeper.Keeper
+	wrapped               wasmkeeper.Messenger
+	bank                  *bankkeeper.BaseKeeper
+	tokenFactory          *tokenfactorykeeper.Keeper
+	poolManager           *poolmanager.Keeper
+	lockup                *lockupkeeper.Keeper
+	concentratedLiquidity *concentratedliquidity.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -55,6 +74,21 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.BurnTokens != nil {
 			return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
 		}
+		if contractMsg.Swap != nil {
+			return withResponseData(PerformSwap(m.poolManager, ctx, contractAddr, contractMsg.Swap))
+		}
+		if contractMsg.LockTokens != nil {
+			return withResponseData(PerformLockTokens(m.lockup, ctx, contractAddr, contractMsg.LockTokens))
+		}
+		if contractMsg.BeginUnlocking != nil {
+			return withResponseData(PerformBeginUnlocking(m.lockup, ctx, contractAddr, contractMsg.BeginUnlocking))
+		}
+		if contractMsg.CreatePosition != nil {
+			return withResponseData(PerformCreatePosition(m.concentratedLiquidity, ctx, contractAddr, contractMsg.CreatePosition))
+		}
+		if contractMsg.WithdrawPosition != nil {
+			return withResponseData(PerformWithdrawPosition(m.concentratedLiquidity, ctx, contractAddr, contractMsg.WithdrawPosition))
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -193,6 +227,191 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	return nil
 }
 
+// withResponseData JSON marshals the response of a custom message as its data, unless it failed.
+func withResponseData[T any](res *T, err error) ([]sdk.Event, [][]byte, error) {
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "failed to JSON marshal %T response", res)
+	}
+
+	return nil, [][]byte{bz}, nil
+}
+
+// PerformSwap swaps tokens owned by the contract through the poolmanager.
+func PerformSwap(pm *poolmanager.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapMsg) (*bindings.SwapResponse, error) {
+	if err := validateSwapRoute(swap.DenomIn, swap.Route); err != nil {
+		return nil, err
+	}
+	if (swap.Amount.ExactIn == nil) == (swap.Amount.ExactOut == nil) {
+		return nil, wasmvmtypes.InvalidRequest{Err: "exactly one of the exact in and exact out amounts must be set"}
+	}
+
+	msgServer := poolmanager.NewMsgServerImpl(pm)
+	var amount sdk.Int
+	err := runWithGasCost(ctx, swapGasCost(len(swap.Route)), "swap binding", func(ctx sdk.Context) error {
+		if exactIn := swap.Amount.ExactIn; exactIn != nil {
+			msg := &poolmanagertypes.MsgSwapExactAmountIn{
+				Sender:            contractAddr.String(),
+				Routes:            toSwapAmountInRoutes(swap.Route),
+				TokenIn:           sdk.Coin{Denom: swap.DenomIn, Amount: exactIn.Input},
+				TokenOutMinAmount: exactIn.MinOutput,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(ctx), msg)
+			if err != nil {
+				return err
+			}
+			amount = res.TokenOutAmount
+			return nil
+		}
+
+		exactOut := swap.Amount.ExactOut
+		msg := &poolmanagertypes.MsgSwapExactAmountOut{
+			Sender:           contractAddr.String(),
+			Routes:           toSwapAmountOutRoutes(swap.DenomIn, swap.Route),
+			TokenInMaxAmount: exactOut.MaxInput,
+			TokenOut:         sdk.Coin{Denom: denomOut(swap.Route), Amount: exactOut.Output},
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		res, err := msgServer.SwapExactAmountOut(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		amount = res.TokenInAmount
+		return nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "perform swap")
+	}
+	return &bindings.SwapResponse{Amount: amount}, nil
+}
+
+// PerformLockTokens locks tokens owned by the contract.
+func PerformLockTokens(l *lockupkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, lock *bindings.LockTokens) (*bindings.LockTokensResponse, error) {
+	coins, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(lock.Coins)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := lockuptypes.NewMsgLockTokens(contractAddr, time.Duration(lock.DurationSeconds)*time.Second, coins)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := lockupkeeper.NewMsgServerImpl(l)
+	var lockId uint64
+	err = runWithGasCost(ctx, GasCostLockTokens, "lock tokens binding", func(ctx sdk.Context) error {
+		res, err := msgServer.LockTokens(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		lockId = res.ID
+		return nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "perform lock tokens")
+	}
+	return &bindings.LockTokensResponse{LockId: lockId}, nil
+}
+
+// PerformBeginUnlocking begins unlocking a lock owned by the contract.
+func PerformBeginUnlocking(l *lockupkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, unlock *bindings.BeginUnlocking) (*bindings.BeginUnlockingResponse, error) {
+	coins, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(unlock.Coins)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := lockuptypes.NewMsgBeginUnlocking(contractAddr, unlock.LockId, coins)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := lockupkeeper.NewMsgServerImpl(l)
+	var unlockingLockId uint64
+	err = runWithGasCost(ctx, GasCostBeginUnlocking, "begin unlocking binding", func(ctx sdk.Context) error {
+		res, err := msgServer.BeginUnlocking(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		unlockingLockId = res.UnlockingLockID
+		return nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "perform begin unlocking")
+	}
+	return &bindings.BeginUnlockingResponse{UnlockingLockId: unlockingLockId}, nil
+}
+
+// PerformCreatePosition creates a concentrated liquidity position owned by the contract.
+func PerformCreatePosition(cl *concentratedliquidity.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, create *bindings.CreatePosition) (*bindings.CreatePositionResponse, error) {
+	tokensProvided, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(create.TokensProvided)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &cltypes.MsgCreatePosition{
+		PoolId:          create.PoolId,
+		Sender:          contractAddr.String(),
+		LowerTick:       create.LowerTick,
+		UpperTick:       create.UpperTick,
+		TokensProvided:  tokensProvided,
+		TokenMinAmount0: create.TokenMinAmount0,
+		TokenMinAmount1: create.TokenMinAmount1,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := concentratedliquidity.NewMsgServerImpl(cl)
+	var res *cltypes.MsgCreatePositionResponse
+	err = runWithGasCost(ctx, GasCostCreatePosition, "create position binding", func(ctx sdk.Context) (err error) {
+		res, err = msgServer.CreatePosition(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "perform create position")
+	}
+	return &bindings.CreatePositionResponse{
+		PositionId: res.PositionId,
+		Amount0:    res.Amount0,
+		Amount1:    res.Amount1,
+		Liquidity:  res.LiquidityCreated,
+		LowerTick:  res.LowerTick,
+		UpperTick:  res.UpperTick,
+	}, nil
+}
+
+// PerformWithdrawPosition withdraws liquidity from a concentrated liquidity position owned by the contract.
+func PerformWithdrawPosition(cl *concentratedliquidity.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, withdraw *bindings.WithdrawPosition) (*bindings.WithdrawPositionResponse, error) {
+	msg := &cltypes.MsgWithdrawPosition{
+		PositionId:      withdraw.PositionId,
+		Sender:          contractAddr.String(),
+		LiquidityAmount: withdraw.LiquidityAmount,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := concentratedliquidity.NewMsgServerImpl(cl)
+	var res *cltypes.MsgWithdrawPositionResponse
+	err := runWithGasCost(ctx, GasCostWithdrawPosition, "withdraw position binding", func(ctx sdk.Context) (err error) {
+		res, err = msgServer.WithdrawPosition(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "perform withdraw position")
+	}
+	return &bindings.WithdrawPositionResponse{Amount0: res.Amount0, Amount1: res.Amount1}, nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
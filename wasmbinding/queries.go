@@ -2,21 +2,41 @@ package wasmbinding
 
 import (
 	"fmt"
+	"time"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v16/wasmbinding/bindings"
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v16/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v16/x/tokenfactory/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/twap"
 )
 
 type QueryPlugin struct {
-	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	tokenFactoryKeeper          *tokenfactorykeeper.Keeper
+	poolManagerKeeper           *poolmanager.Keeper
+	twapKeeper                  *twap.Keeper
+	lockupKeeper                *lockupkeeper.Keeper
+	concentratedLiquidityKeeper *concentratedliquidity.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(
+	tfk *tokenfactorykeeper.Keeper,
+	pmk *poolmanager.Keeper,
+	tk *twap.Keeper,
+	lk *lockupkeeper.Keeper,
+	clk *concentratedliquidity.Keeper,
+) *QueryPlugin {
 	return &QueryPlugin{
-		tokenFactoryKeeper: tfk,
+		tokenFactoryKeeper:          tfk,
+		poolManagerKeeper:           pmk,
+		twapKeeper:                  tk,
+		lockupKeeper:                lk,
+		concentratedLiquidityKeeper: clk,
 	}
 }
 
@@ -29,3 +49,144 @@ func (qp QueryPlugin) GetDenomAdmin(ctx sdk.Context, denom string) (*bindings.De
 
 	return &bindings.DenomAdminResponse{Admin: metadata.Admin}, nil
 }
+
+// GetPool is a query to get the type and the liquidity of a pool.
+func (qp QueryPlugin) GetPool(ctx sdk.Context, pool *bindings.Pool) (*bindings.PoolResponse, error) {
+	res := &bindings.PoolResponse{PoolId: pool.PoolId}
+	err := runWithGasCost(ctx, GasCostPool, "pool binding", func(ctx sdk.Context) error {
+		poolI, err := qp.poolManagerKeeper.GetPool(ctx, pool.PoolId)
+		if err != nil {
+			return err
+		}
+		assets, err := qp.poolManagerKeeper.GetTotalPoolLiquidity(ctx, pool.PoolId)
+		if err != nil {
+			return err
+		}
+		res.PoolType = poolI.GetType().String()
+		res.Assets = ConvertSdkCoinsToWasmCoins(assets)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSpotPrice is a query to get the spot price of a pool.
+func (qp QueryPlugin) GetSpotPrice(ctx sdk.Context, spotPrice *bindings.SpotPrice) (*bindings.SpotPriceResponse, error) {
+	var price sdk.Dec
+	err := runWithGasCost(ctx, GasCostSpotPrice, "spot price binding", func(ctx sdk.Context) (err error) {
+		price, err = qp.poolManagerKeeper.RouteCalculateSpotPrice(ctx, spotPrice.PoolId, spotPrice.QuoteAssetDenom, spotPrice.BaseAssetDenom)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bindings.SpotPriceResponse{Price: price}, nil
+}
+
+// EstimateSwap is a query to estimate the result of a swap, without executing it.
+func (qp QueryPlugin) EstimateSwap(ctx sdk.Context, estimate *bindings.EstimateSwap) (*bindings.SwapResponse, error) {
+	if err := validateSwapRoute(estimate.DenomIn, estimate.Route); err != nil {
+		return nil, err
+	}
+	if (estimate.Amount.In == nil) == (estimate.Amount.Out == nil) {
+		return nil, wasmvmtypes.InvalidRequest{Err: "exactly one of the in and out amounts must be set"}
+	}
+
+	var amount sdk.Int
+	err := runWithGasCost(ctx, swapGasCost(len(estimate.Route)), "estimate swap binding", func(ctx sdk.Context) (err error) {
+		if estimate.Amount.In != nil {
+			tokenIn := sdk.Coin{Denom: estimate.DenomIn, Amount: *estimate.Amount.In}
+			amount, err = qp.poolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(ctx, toSwapAmountInRoutes(estimate.Route), tokenIn)
+			return err
+		}
+		tokenOut := sdk.Coin{Denom: denomOut(estimate.Route), Amount: *estimate.Amount.Out}
+		amount, err = qp.poolManagerKeeper.MultihopEstimateInGivenExactAmountOut(ctx, toSwapAmountOutRoutes(estimate.DenomIn, estimate.Route), tokenOut)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bindings.SwapResponse{Amount: amount}, nil
+}
+
+// GetTwap is a query to get the arithmetic or geometric twap of a pool.
+func (qp QueryPlugin) GetTwap(ctx sdk.Context, twapQuery *bindings.Twap, geometric bool) (*bindings.TwapResponse, error) {
+	startTime := time.Unix(0, int64(twapQuery.StartTime)).UTC()
+	endTime := ctx.BlockTime()
+	if twapQuery.EndTime != nil {
+		endTime = time.Unix(0, int64(*twapQuery.EndTime)).UTC()
+	}
+
+	getTwap := qp.twapKeeper.GetArithmeticTwap
+	if geometric {
+		getTwap = qp.twapKeeper.GetGeometricTwap
+	}
+
+	var twapValue sdk.Dec
+	err := runWithGasCost(ctx, GasCostTwap, "twap binding", func(ctx sdk.Context) (err error) {
+		twapValue, err = getTwap(ctx, twapQuery.PoolId, twapQuery.BaseAssetDenom, twapQuery.QuoteAssetDenom, startTime, endTime)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bindings.TwapResponse{Twap: twapValue}, nil
+}
+
+// GetLock is a query to get a lock of the lockup module.
+func (qp QueryPlugin) GetLock(ctx sdk.Context, lock *bindings.Lock) (*bindings.LockResponse, error) {
+	var res *bindings.LockResponse
+	err := runWithGasCost(ctx, GasCostLock, "lock binding", func(ctx sdk.Context) error {
+		periodLock, err := qp.lockupKeeper.GetLockByID(ctx, lock.LockId)
+		if err != nil {
+			return err
+		}
+		res = &bindings.LockResponse{
+			LockId:          periodLock.ID,
+			Owner:           periodLock.Owner,
+			DurationSeconds: uint64(periodLock.Duration / time.Second),
+			EndTime:         toUnixNano(periodLock.EndTime),
+			Coins:           ConvertSdkCoinsToWasmCoins(periodLock.Coins),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetPosition is a query to get a concentrated liquidity position.
+func (qp QueryPlugin) GetPosition(ctx sdk.Context, position *bindings.Position) (*bindings.PositionResponse, error) {
+	var res *bindings.PositionResponse
+	err := runWithGasCost(ctx, GasCostPosition, "position binding", func(ctx sdk.Context) error {
+		clPosition, err := qp.concentratedLiquidityKeeper.GetPosition(ctx, position.PositionId)
+		if err != nil {
+			return err
+		}
+		res = &bindings.PositionResponse{
+			PositionId: clPosition.PositionId,
+			Owner:      clPosition.Address,
+			PoolId:     clPosition.PoolId,
+			LowerTick:  clPosition.LowerTick,
+			UpperTick:  clPosition.UpperTick,
+			JoinTime:   toUnixNano(clPosition.JoinTime),
+			Liquidity:  clPosition.Liquidity,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// toUnixNano returns the time in nanoseconds since the unix epoch, or zero for the zero time.
+func toUnixNano(t time.Time) uint64 {
+	if t.IsZero() || t.Before(time.Unix(0, 0)) {
+		return 0
+	}
+	return uint64(t.UnixNano())
+}
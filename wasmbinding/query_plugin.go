@@ -86,12 +86,50 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.BindingsVersion != nil:
+			return marshalQueryResponse(&bindings.BindingsVersionResponse{Version: bindings.Version}, nil)
+
+		case contractQuery.Pool != nil:
+			return marshalQueryResponse(qp.GetPool(ctx, contractQuery.Pool))
+
+		case contractQuery.SpotPrice != nil:
+			return marshalQueryResponse(qp.GetSpotPrice(ctx, contractQuery.SpotPrice))
+
+		case contractQuery.EstimateSwap != nil:
+			return marshalQueryResponse(qp.EstimateSwap(ctx, contractQuery.EstimateSwap))
+
+		case contractQuery.ArithmeticTwap != nil:
+			return marshalQueryResponse(qp.GetTwap(ctx, contractQuery.ArithmeticTwap, false))
+
+		case contractQuery.GeometricTwap != nil:
+			return marshalQueryResponse(qp.GetTwap(ctx, contractQuery.GeometricTwap, true))
+
+		case contractQuery.Lock != nil:
+			return marshalQueryResponse(qp.GetLock(ctx, contractQuery.Lock))
+
+		case contractQuery.Position != nil:
+			return marshalQueryResponse(qp.GetPosition(ctx, contractQuery.Position))
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown osmosis query variant"}
 		}
 	}
 }
 
+// marshalQueryResponse JSON marshals the response of a custom query, unless the query failed.
+func marshalQueryResponse[T any](res *T, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to JSON marshal %T response: %w", res, err)
+	}
+
+	return bz, nil
+}
+
 // ConvertProtoToJsonMarshal  unmarshals the given bytes into a proto message and then marshals it to json.
 // This is done so that clients calling stargate queries do not need to define their own proto unmarshalers,
 // being able to use response directly by json marshalling, which is supported in cosmwasm.
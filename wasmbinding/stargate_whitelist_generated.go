@@ -0,0 +1,37 @@
+package wasmbinding
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT THE `deterministic` QUERIES OF THE query.yml FILES
+
+import (
+	concentratedliquidityqueryproto "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client/queryproto"
+	downtimedetectorqueryproto "github.com/osmosis-labs/osmosis/v16/x/downtime-detector/client/queryproto"
+	poolmanagerqueryproto "github.com/osmosis-labs/osmosis/v16/x/poolmanager/client/queryproto"
+	twapqueryproto "github.com/osmosis-labs/osmosis/v16/x/twap/client/queryproto"
+)
+
+// setGeneratedWhitelistedQueries whitelists the queries marked as deterministic in the query.yml files.
+func setGeneratedWhitelistedQueries() {
+	// concentrated-liquidity
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", &concentratedliquidityqueryproto.ClaimableSpreadRewardsResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/Params", &concentratedliquidityqueryproto.ParamsResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/PositionById", &concentratedliquidityqueryproto.PositionByIdResponse{})
+
+	// downtime-detector
+	setWhitelistedQuery("/osmosis.downtimedetector.v1beta1.Query/RecoveredSinceDowntimeOfLength", &downtimedetectorqueryproto.RecoveredSinceDowntimeOfLengthResponse{})
+
+	// poolmanager
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn", &poolmanagerqueryproto.EstimateSwapExactAmountInResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut", &poolmanagerqueryproto.EstimateSwapExactAmountOutResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn", &poolmanagerqueryproto.EstimateSwapExactAmountInResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut", &poolmanagerqueryproto.EstimateSwapExactAmountOutResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/NumPools", &poolmanagerqueryproto.NumPoolsResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/Pool", &poolmanagerqueryproto.PoolResponse{})
+
+	// twap
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwap", &twapqueryproto.ArithmeticTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapqueryproto.ArithmeticTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapqueryproto.GeometricTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapqueryproto.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapqueryproto.ParamsResponse{})
+}
@@ -0,0 +1,56 @@
+package wasmbinding
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	"github.com/osmosis-labs/osmosis/v16/wasmbinding/bindings"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+// MaxSwapHops is the maximum number of hops of a swap route in the custom bindings.
+const MaxSwapHops = 10
+
+func validateSwapRoute(denomIn string, route []bindings.SwapStep) error {
+	if denomIn == "" {
+		return wasmvmtypes.InvalidRequest{Err: "swap route has no denom in"}
+	}
+	if len(route) == 0 {
+		return wasmvmtypes.InvalidRequest{Err: "swap route is empty"}
+	}
+	if len(route) > MaxSwapHops {
+		return wasmvmtypes.InvalidRequest{Err: "swap route has too many hops"}
+	}
+	return nil
+}
+
+// toSwapAmountInRoutes converts a swap route to the poolmanager routes of an exact amount in swap.
+func toSwapAmountInRoutes(route []bindings.SwapStep) []poolmanagertypes.SwapAmountInRoute {
+	routes := make([]poolmanagertypes.SwapAmountInRoute, 0, len(route))
+	for _, step := range route {
+		routes = append(routes, poolmanagertypes.SwapAmountInRoute{
+			PoolId:        step.PoolId,
+			TokenOutDenom: step.DenomOut,
+		})
+	}
+	return routes
+}
+
+// toSwapAmountOutRoutes converts a swap route to the poolmanager routes of an exact amount out swap,
+// where each hop is given the denom it swaps in instead of the denom it swaps out.
+func toSwapAmountOutRoutes(denomIn string, route []bindings.SwapStep) []poolmanagertypes.SwapAmountOutRoute {
+	routes := make([]poolmanagertypes.SwapAmountOutRoute, 0, len(route))
+	tokenInDenom := denomIn
+	for _, step := range route {
+		routes = append(routes, poolmanagertypes.SwapAmountOutRoute{
+			PoolId:       step.PoolId,
+			TokenInDenom: tokenInDenom,
+		})
+		tokenInDenom = step.DenomOut
+	}
+	return routes
+}
+
+// denomOut returns the denom swapped out of a swap route.
+func denomOut(route []bindings.SwapStep) string {
+	return route[len(route)-1].DenomOut
+}
@@ -0,0 +1,266 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v16/wasmbinding"
+	"github.com/osmosis-labs/osmosis/v16/wasmbinding/bindings"
+	cl "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+type BindingsTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestBindingsTestSuite(t *testing.T) {
+	suite.Run(t, new(BindingsTestSuite))
+}
+
+func (s *BindingsTestSuite) SetupTest() {
+	s.Setup()
+}
+
+// query runs a custom query, checking that it consumes exactly the expected gas.
+func (s *BindingsTestSuite) query(request string, expectedGas sdk.Gas, response interface{}) error {
+	qp := wasmbinding.NewQueryPlugin(s.App.TokenFactoryKeeper, s.App.PoolManagerKeeper, s.App.TwapKeeper, s.App.LockupKeeper, s.App.ConcentratedLiquidityKeeper)
+	ctx := s.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	bz, err := wasmbinding.CustomQuerier(qp)(ctx, []byte(request))
+	s.Require().Equal(expectedGas, ctx.GasMeter().GasConsumed())
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, response)
+}
+
+// execute dispatches a custom message from the contract, checking that it consumes exactly the expected gas.
+func (s *BindingsTestSuite) execute(contract sdk.AccAddress, msg string, expectedGas sdk.Gas, response interface{}) error {
+	messenger := wasmbinding.CustomMessageDecorator(s.App.BankKeeper, s.App.TokenFactoryKeeper, s.App.PoolManagerKeeper, s.App.LockupKeeper, s.App.ConcentratedLiquidityKeeper)(nil)
+	ctx := s.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	_, data, err := messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: []byte(msg)})
+	s.Require().Equal(expectedGas, ctx.GasMeter().GasConsumed())
+	if err != nil {
+		return err
+	}
+	s.Require().Len(data, 1)
+	return json.Unmarshal(data[0], response)
+}
+
+func (s *BindingsTestSuite) TestBindingsVersion() {
+	var res bindings.BindingsVersionResponse
+	err := s.query(`{"bindings_version":{}}`, 0, &res)
+	s.Require().NoError(err)
+	s.Require().Equal(bindings.Version, res.Version)
+}
+
+func (s *BindingsTestSuite) TestPoolBindings() {
+	poolId := s.PrepareBalancerPool()
+	contract := s.TestAccs[1]
+	s.FundAcc(contract, sdk.NewCoins(sdk.NewInt64Coin(apptesting.FOO, 1_000_000)))
+
+	var poolRes bindings.PoolResponse
+	err := s.query(fmt.Sprintf(`{"pool":{"pool_id":%d}}`, poolId), wasmbinding.GasCostPool, &poolRes)
+	s.Require().NoError(err)
+	s.Require().Equal("Balancer", poolRes.PoolType)
+	liquidity, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(wasmbinding.ConvertSdkCoinsToWasmCoins(liquidity), poolRes.Assets)
+
+	var spotPriceRes bindings.SpotPriceResponse
+	err = s.query(fmt.Sprintf(`{"spot_price":{"pool_id":%d,"base_asset_denom":"%s","quote_asset_denom":"%s"}}`, poolId, apptesting.FOO, apptesting.BAR), wasmbinding.GasCostSpotPrice, &spotPriceRes)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 1), spotPriceRes.Price)
+
+	// the twap since pool creation is the constant spot price
+	startTime := s.Ctx.BlockTime()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	var twapRes bindings.TwapResponse
+	err = s.query(fmt.Sprintf(`{"arithmetic_twap":{"pool_id":%d,"base_asset_denom":"%s","quote_asset_denom":"%s","start_time":"%d"}}`, poolId, apptesting.FOO, apptesting.BAR, startTime.UnixNano()), wasmbinding.GasCostTwap, &twapRes)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 1), twapRes.Twap)
+	err = s.query(fmt.Sprintf(`{"geometric_twap":{"pool_id":%d,"base_asset_denom":"%s","quote_asset_denom":"%s","start_time":"%d","end_time":"%d"}}`, poolId, apptesting.FOO, apptesting.BAR, startTime.UnixNano(), s.Ctx.BlockTime().UnixNano()), wasmbinding.GasCostTwap, &twapRes)
+	s.Require().NoError(err)
+	s.Require().True(twapRes.Twap.Sub(sdk.NewDecWithPrec(5, 1)).Abs().LTE(sdk.NewDecWithPrec(1, 12)))
+
+	for _, tc := range []struct {
+		desc     string
+		estimate string
+		swap     string
+	}{
+		{
+			desc:     "exact in",
+			estimate: `{"in":"1000"}`,
+			swap:     `{"exact_in":{"input":"1000","min_output":"1"}}`,
+		},
+		{
+			desc:     "exact out",
+			estimate: `{"out":"1000"}`,
+			swap:     `{"exact_out":{"max_input":"1000000","output":"1000"}}`,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			route := fmt.Sprintf(`[{"pool_id":%d,"denom_out":"%s"}]`, poolId, apptesting.BAR)
+
+			var estimateRes bindings.SwapResponse
+			err := s.query(fmt.Sprintf(`{"estimate_swap":{"denom_in":"%s","route":%s,"amount":%s}}`, apptesting.FOO, route, tc.estimate), wasmbinding.GasCostSwapBase+wasmbinding.GasCostSwapPerHop, &estimateRes)
+			s.Require().NoError(err)
+			s.Require().True(estimateRes.Amount.IsPositive())
+
+			var swapRes bindings.SwapResponse
+			err = s.execute(contract, fmt.Sprintf(`{"swap":{"denom_in":"%s","route":%s,"amount":%s}}`, apptesting.FOO, route, tc.swap), wasmbinding.GasCostSwapBase+wasmbinding.GasCostSwapPerHop, &swapRes)
+			s.Require().NoError(err)
+			s.Require().Equal(estimateRes.Amount, swapRes.Amount)
+		})
+	}
+
+	// invalid requests
+	err = s.query(fmt.Sprintf(`{"estimate_swap":{"denom_in":"%s","route":[],"amount":{"in":"1000"}}}`, apptesting.FOO), 0, nil)
+	s.Require().Error(err)
+	err = s.query(fmt.Sprintf(`{"estimate_swap":{"denom_in":"%s","route":[{"pool_id":%d,"denom_out":"%s"}],"amount":{"in":"1000","out":"1000"}}}`, apptesting.FOO, poolId, apptesting.BAR), 0, nil)
+	s.Require().Error(err)
+	err = s.query(`{"pool":{"pool_id":1000}}`, wasmbinding.GasCostPool, nil)
+	s.Require().Error(err)
+}
+
+// TestConcentratedSwapGasCost checks that the flat gas cost of a hop covers a concentrated liquidity swap
+// crossing many initialized ticks, and that a swap crossing more ticks than it covers fails rather than
+// being undercharged.
+func (s *BindingsTestSuite) TestConcentratedSwapGasCost() {
+	for _, tc := range []struct {
+		desc          string
+		ticksToCross  int
+		expectedError error
+	}{
+		{
+			desc:         "40 initialized ticks",
+			ticksToCross: 40,
+		},
+		{
+			desc:          "80 initialized ticks",
+			ticksToCross:  80,
+			expectedError: sdkerrors.ErrOutOfGas,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.CreateFullRangePosition(pool, sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000_000), sdk.NewInt64Coin(apptesting.USDC, 5_000_000_000)))
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// nested positions around the current tick, whose lower ticks are all crossed by the swap
+			currentTick := pool.GetCurrentTick() / int64(pool.GetTickSpacing()) * int64(pool.GetTickSpacing())
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			for i := 1; i <= tc.ticksToCross; i++ {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000), sdk.NewInt64Coin(apptesting.USDC, 5_000_000))
+				s.FundAcc(s.TestAccs[0], coins)
+				_, err := msgServer.CreatePosition(sdk.WrapSDKContext(s.Ctx), &cltypes.MsgCreatePosition{
+					PoolId:          pool.GetId(),
+					Sender:          s.TestAccs[0].String(),
+					LowerTick:       currentTick - int64(i)*int64(pool.GetTickSpacing()),
+					UpperTick:       currentTick + int64(i)*int64(pool.GetTickSpacing()),
+					TokensProvided:  coins,
+					TokenMinAmount0: sdk.ZeroInt(),
+					TokenMinAmount1: sdk.ZeroInt(),
+				})
+				s.Require().NoError(err)
+			}
+
+			contract := s.TestAccs[1]
+			s.FundAcc(contract, sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 100_000)))
+			var swapRes bindings.SwapResponse
+			err = s.execute(contract, fmt.Sprintf(`{"swap":{"denom_in":"%s","route":[{"pool_id":%d,"denom_out":"%s"}],"amount":{"exact_in":{"input":"100000","min_output":"1"}}}}`,
+				apptesting.ETH, pool.GetId(), apptesting.USDC), wasmbinding.GasCostSwapBase+wasmbinding.GasCostSwapPerHop, &swapRes)
+
+			pool, poolErr := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(poolErr)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(int64(100_000), s.App.BankKeeper.GetBalance(s.Ctx, contract, apptesting.ETH).Amount.Int64())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(swapRes.Amount.IsPositive())
+			s.Require().Less(pool.GetCurrentTick(), currentTick-int64(tc.ticksToCross)*int64(pool.GetTickSpacing()))
+		})
+	}
+}
+
+func (s *BindingsTestSuite) TestLockBindings() {
+	contract := s.TestAccs[1]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(apptesting.FOO, 1_000_000))
+	s.FundAcc(contract, coins)
+
+	var lockRes bindings.LockTokensResponse
+	err := s.execute(contract, fmt.Sprintf(`{"lock_tokens":{"duration_seconds":86400,"coins":[{"denom":"%s","amount":"1000000"}]}}`, apptesting.FOO), wasmbinding.GasCostLockTokens, &lockRes)
+	s.Require().NoError(err)
+
+	var lock bindings.LockResponse
+	err = s.query(fmt.Sprintf(`{"lock":{"lock_id":%d}}`, lockRes.LockId), wasmbinding.GasCostLock, &lock)
+	s.Require().NoError(err)
+	s.Require().Equal(bindings.LockResponse{
+		LockId:          lockRes.LockId,
+		Owner:           contract.String(),
+		DurationSeconds: 86400,
+		Coins:           wasmbinding.ConvertSdkCoinsToWasmCoins(coins),
+	}, lock)
+
+	// only the owner can unlock
+	var unlockRes bindings.BeginUnlockingResponse
+	err = s.execute(s.TestAccs[2], fmt.Sprintf(`{"begin_unlocking":{"lock_id":%d,"coins":[]}}`, lockRes.LockId), wasmbinding.GasCostBeginUnlocking, &unlockRes)
+	s.Require().Error(err)
+
+	err = s.execute(contract, fmt.Sprintf(`{"begin_unlocking":{"lock_id":%d,"coins":[]}}`, lockRes.LockId), wasmbinding.GasCostBeginUnlocking, &unlockRes)
+	s.Require().NoError(err)
+	s.Require().Equal(lockRes.LockId, unlockRes.UnlockingLockId)
+
+	err = s.query(fmt.Sprintf(`{"lock":{"lock_id":%d}}`, lockRes.LockId), wasmbinding.GasCostLock, &lock)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(s.Ctx.BlockTime().Add(24*time.Hour).UnixNano()), lock.EndTime)
+}
+
+func (s *BindingsTestSuite) TestPositionBindings() {
+	pool := s.PrepareConcentratedPool()
+	contract := s.TestAccs[1]
+	s.FundAcc(contract, sdk.NewCoins(sdk.NewInt64Coin(apptesting.ETH, 1_000_000), sdk.NewInt64Coin(apptesting.USDC, 5_000_000_000)))
+
+	var createRes bindings.CreatePositionResponse
+	err := s.execute(contract, fmt.Sprintf(`{"create_position":{"pool_id":%d,"lower_tick":%d,"upper_tick":%d,"tokens_provided":[{"denom":"%s","amount":"1000000"},{"denom":"%s","amount":"5000000000"}],"token_min_amount0":"0","token_min_amount1":"0"}}`,
+		pool.GetId(), apptesting.DefaultLowerTick, apptesting.DefaultUpperTick, apptesting.ETH, apptesting.USDC), wasmbinding.GasCostCreatePosition, &createRes)
+	s.Require().NoError(err)
+	s.Require().True(createRes.Amount0.IsPositive() && createRes.Amount0.LTE(sdk.NewInt(1_000_000)))
+	s.Require().True(createRes.Amount1.IsPositive() && createRes.Amount1.LTE(sdk.NewInt(5_000_000_000)))
+
+	var position bindings.PositionResponse
+	err = s.query(fmt.Sprintf(`{"position":{"position_id":%d}}`, createRes.PositionId), wasmbinding.GasCostPosition, &position)
+	s.Require().NoError(err)
+	s.Require().Equal(bindings.PositionResponse{
+		PositionId: createRes.PositionId,
+		Owner:      contract.String(),
+		PoolId:     pool.GetId(),
+		LowerTick:  apptesting.DefaultLowerTick,
+		UpperTick:  apptesting.DefaultUpperTick,
+		JoinTime:   uint64(s.Ctx.BlockTime().UnixNano()),
+		Liquidity:  createRes.Liquidity,
+	}, position)
+
+	var withdrawRes bindings.WithdrawPositionResponse
+	withdrawMsg := fmt.Sprintf(`{"withdraw_position":{"position_id":%d,"liquidity_amount":"%s"}}`, createRes.PositionId, createRes.Liquidity.QuoInt64(2))
+	err = s.execute(s.TestAccs[2], withdrawMsg, wasmbinding.GasCostWithdrawPosition, &withdrawRes)
+	s.Require().Error(err)
+	err = s.execute(contract, withdrawMsg, wasmbinding.GasCostWithdrawPosition, &withdrawRes)
+	s.Require().NoError(err)
+	s.Require().True(withdrawRes.Amount0.IsPositive())
+	s.Require().True(withdrawRes.Amount1.IsPositive())
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v16/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v16/wasmbinding/bindings"
+	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v16/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v16/x/tokenfactory/types"
 
 	"github.com/stretchr/testify/require"
 )
@@ -275,6 +277,38 @@ func TestMint(t *testing.T) {
 	}
 }
 
+func TestMintLimits(t *testing.T) {
+	apptesting.SkipIfWSL(t)
+	creator := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, creator)
+
+	validDenom := bindings.CreateDenom{
+		Subdenom: "MOON",
+	}
+	err := wasmbinding.PerformCreateDenom(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &validDenom)
+	require.NoError(t, err)
+	validDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), validDenom.Subdenom)
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*osmosis.TokenFactoryKeeper)
+	_, err = msgServer.SetMintLimits(sdk.WrapSDKContext(ctx), tokenfactorytypes.NewMsgSetMintLimits(creator.String(), validDenomStr, tokenfactorytypes.MintLimits{
+		MaxSupply: sdk.NewInt(100),
+	}))
+	require.NoError(t, err)
+
+	mint := func(amount int64) error {
+		return wasmbinding.PerformMint(osmosis.TokenFactoryKeeper, osmosis.BankKeeper, ctx, creator, &bindings.MintTokens{
+			Denom:         validDenomStr,
+			Amount:        sdk.NewInt(amount),
+			MintToAddress: creator.String(),
+		})
+	}
+
+	// mints through the binding are subject to the max supply of the denom
+	require.NoError(t, mint(60))
+	require.ErrorIs(t, mint(41), tokenfactorytypes.ErrMaxSupplyExceeded)
+	require.NoError(t, mint(40))
+}
+
 func TestBurn(t *testing.T) {
 	apptesting.SkipIfWSL(t)
 	creator := RandomAccountAddress()
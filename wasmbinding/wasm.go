@@ -9,20 +9,28 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v16/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v16/x/tokenfactory/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/twap"
 )
 
 func RegisterCustomPlugins(
 	bank *bankkeeper.BaseKeeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
+	poolManager *poolmanager.Keeper,
+	twapKeeper *twap.Keeper,
+	lockup *lockupkeeper.Keeper,
+	concentratedLiquidity *concentratedliquidity.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(tokenFactory)
+	wasmQueryPlugin := NewQueryPlugin(tokenFactory, poolManager, twapKeeper, lockup, concentratedLiquidity)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(bank, tokenFactory),
+		CustomMessageDecorator(bank, tokenFactory, poolManager, lockup, concentratedLiquidity),
 	)
 
 	return []wasm.Option{
@@ -61,7 +61,7 @@ func (q Querier) PositionById(ctx sdk.Context, req clquery.PositionByIdRequest)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	asset0, asset1, err := cl.CalculateUnderlyingAssetsFromPosition(ctx, position, positionPool)
+	asset0, asset1, err := q.Keeper.CalculateUnderlyingAssetsFromPosition(ctx, position, positionPool)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
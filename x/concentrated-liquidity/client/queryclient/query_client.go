@@ -0,0 +1,327 @@
+package queryclient
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/concentrated-liquidity/query.yml`
+
+import (
+	context "context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client/queryproto"
+)
+
+// Paths of the queries, used by gRPC, ABCI and Stargate queries.
+const (
+	CFMMPoolIdLinkFromConcentratedPoolIdPath = "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId"
+	ClaimableIncentivesPath                  = "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives"
+	ClaimableSpreadRewardsPath               = "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards"
+	IncentiveRecordsPath                     = "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords"
+	LiquidityNetInDirectionPath              = "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection"
+	LiquidityPerTickRangePath                = "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange"
+	ParamsPath                               = "/osmosis.concentratedliquidity.v1beta1.Query/Params"
+	PoolAccumulatorRewardsPath               = "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards"
+	PoolsPath                                = "/osmosis.concentratedliquidity.v1beta1.Query/Pools"
+	PositionByIdPath                         = "/osmosis.concentratedliquidity.v1beta1.Query/PositionById"
+	TickAccumulatorTrackersPath              = "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers"
+	UserPositionsPath                        = "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions"
+)
+
+// Client is a typed client of the queries, over a gRPC connection to a node or a client.Context.
+type Client struct {
+	q queryproto.QueryClient
+}
+
+func NewClient(conn gogogrpc.ClientConn) Client {
+	return Client{q: queryproto.NewQueryClient(conn)}
+}
+
+func (c Client) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context,
+	req queryproto.CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption,
+) (*queryproto.CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	return c.q.CFMMPoolIdLinkFromConcentratedPoolId(ctx, &req, opts...)
+}
+
+// NewCFMMPoolIdLinkFromConcentratedPoolIdABCIRequest returns the ABCI query of CFMMPoolIdLinkFromConcentratedPoolId at the given height, 0 being the latest one.
+func NewCFMMPoolIdLinkFromConcentratedPoolIdABCIRequest(req queryproto.CFMMPoolIdLinkFromConcentratedPoolIdRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: CFMMPoolIdLinkFromConcentratedPoolIdPath, Data: bz, Height: height}, nil
+}
+
+// DecodeCFMMPoolIdLinkFromConcentratedPoolIdResponse decodes the response of CFMMPoolIdLinkFromConcentratedPoolId, e.g. the value of its ABCI query.
+func DecodeCFMMPoolIdLinkFromConcentratedPoolIdResponse(bz []byte) (*queryproto.CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	res := &queryproto.CFMMPoolIdLinkFromConcentratedPoolIdResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) ClaimableIncentives(ctx context.Context,
+	req queryproto.ClaimableIncentivesRequest, opts ...grpc.CallOption,
+) (*queryproto.ClaimableIncentivesResponse, error) {
+	return c.q.ClaimableIncentives(ctx, &req, opts...)
+}
+
+// NewClaimableIncentivesABCIRequest returns the ABCI query of ClaimableIncentives at the given height, 0 being the latest one.
+func NewClaimableIncentivesABCIRequest(req queryproto.ClaimableIncentivesRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: ClaimableIncentivesPath, Data: bz, Height: height}, nil
+}
+
+// DecodeClaimableIncentivesResponse decodes the response of ClaimableIncentives, e.g. the value of its ABCI query.
+func DecodeClaimableIncentivesResponse(bz []byte) (*queryproto.ClaimableIncentivesResponse, error) {
+	res := &queryproto.ClaimableIncentivesResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) ClaimableSpreadRewards(ctx context.Context,
+	req queryproto.ClaimableSpreadRewardsRequest, opts ...grpc.CallOption,
+) (*queryproto.ClaimableSpreadRewardsResponse, error) {
+	return c.q.ClaimableSpreadRewards(ctx, &req, opts...)
+}
+
+// NewClaimableSpreadRewardsABCIRequest returns the ABCI query of ClaimableSpreadRewards at the given height, 0 being the latest one.
+func NewClaimableSpreadRewardsABCIRequest(req queryproto.ClaimableSpreadRewardsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: ClaimableSpreadRewardsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeClaimableSpreadRewardsResponse decodes the response of ClaimableSpreadRewards, e.g. the value of its ABCI query.
+func DecodeClaimableSpreadRewardsResponse(bz []byte) (*queryproto.ClaimableSpreadRewardsResponse, error) {
+	res := &queryproto.ClaimableSpreadRewardsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) IncentiveRecords(ctx context.Context,
+	req queryproto.IncentiveRecordsRequest, opts ...grpc.CallOption,
+) (*queryproto.IncentiveRecordsResponse, error) {
+	return c.q.IncentiveRecords(ctx, &req, opts...)
+}
+
+// NewIncentiveRecordsABCIRequest returns the ABCI query of IncentiveRecords at the given height, 0 being the latest one.
+func NewIncentiveRecordsABCIRequest(req queryproto.IncentiveRecordsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: IncentiveRecordsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeIncentiveRecordsResponse decodes the response of IncentiveRecords, e.g. the value of its ABCI query.
+func DecodeIncentiveRecordsResponse(bz []byte) (*queryproto.IncentiveRecordsResponse, error) {
+	res := &queryproto.IncentiveRecordsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) LiquidityNetInDirection(ctx context.Context,
+	req queryproto.LiquidityNetInDirectionRequest, opts ...grpc.CallOption,
+) (*queryproto.LiquidityNetInDirectionResponse, error) {
+	return c.q.LiquidityNetInDirection(ctx, &req, opts...)
+}
+
+// NewLiquidityNetInDirectionABCIRequest returns the ABCI query of LiquidityNetInDirection at the given height, 0 being the latest one.
+func NewLiquidityNetInDirectionABCIRequest(req queryproto.LiquidityNetInDirectionRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: LiquidityNetInDirectionPath, Data: bz, Height: height}, nil
+}
+
+// DecodeLiquidityNetInDirectionResponse decodes the response of LiquidityNetInDirection, e.g. the value of its ABCI query.
+func DecodeLiquidityNetInDirectionResponse(bz []byte) (*queryproto.LiquidityNetInDirectionResponse, error) {
+	res := &queryproto.LiquidityNetInDirectionResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) LiquidityPerTickRange(ctx context.Context,
+	req queryproto.LiquidityPerTickRangeRequest, opts ...grpc.CallOption,
+) (*queryproto.LiquidityPerTickRangeResponse, error) {
+	return c.q.LiquidityPerTickRange(ctx, &req, opts...)
+}
+
+// NewLiquidityPerTickRangeABCIRequest returns the ABCI query of LiquidityPerTickRange at the given height, 0 being the latest one.
+func NewLiquidityPerTickRangeABCIRequest(req queryproto.LiquidityPerTickRangeRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: LiquidityPerTickRangePath, Data: bz, Height: height}, nil
+}
+
+// DecodeLiquidityPerTickRangeResponse decodes the response of LiquidityPerTickRange, e.g. the value of its ABCI query.
+func DecodeLiquidityPerTickRangeResponse(bz []byte) (*queryproto.LiquidityPerTickRangeResponse, error) {
+	res := &queryproto.LiquidityPerTickRangeResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) Params(ctx context.Context,
+	req queryproto.ParamsRequest, opts ...grpc.CallOption,
+) (*queryproto.ParamsResponse, error) {
+	return c.q.Params(ctx, &req, opts...)
+}
+
+// NewParamsABCIRequest returns the ABCI query of Params at the given height, 0 being the latest one.
+func NewParamsABCIRequest(req queryproto.ParamsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: ParamsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeParamsResponse decodes the response of Params, e.g. the value of its ABCI query.
+func DecodeParamsResponse(bz []byte) (*queryproto.ParamsResponse, error) {
+	res := &queryproto.ParamsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) PoolAccumulatorRewards(ctx context.Context,
+	req queryproto.PoolAccumulatorRewardsRequest, opts ...grpc.CallOption,
+) (*queryproto.PoolAccumulatorRewardsResponse, error) {
+	return c.q.PoolAccumulatorRewards(ctx, &req, opts...)
+}
+
+// NewPoolAccumulatorRewardsABCIRequest returns the ABCI query of PoolAccumulatorRewards at the given height, 0 being the latest one.
+func NewPoolAccumulatorRewardsABCIRequest(req queryproto.PoolAccumulatorRewardsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: PoolAccumulatorRewardsPath, Data: bz, Height: height}, nil
+}
+
+// DecodePoolAccumulatorRewardsResponse decodes the response of PoolAccumulatorRewards, e.g. the value of its ABCI query.
+func DecodePoolAccumulatorRewardsResponse(bz []byte) (*queryproto.PoolAccumulatorRewardsResponse, error) {
+	res := &queryproto.PoolAccumulatorRewardsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) Pools(ctx context.Context,
+	req queryproto.PoolsRequest, opts ...grpc.CallOption,
+) (*queryproto.PoolsResponse, error) {
+	return c.q.Pools(ctx, &req, opts...)
+}
+
+// NewPoolsABCIRequest returns the ABCI query of Pools at the given height, 0 being the latest one.
+func NewPoolsABCIRequest(req queryproto.PoolsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: PoolsPath, Data: bz, Height: height}, nil
+}
+
+// DecodePoolsResponse decodes the response of Pools, e.g. the value of its ABCI query.
+func DecodePoolsResponse(bz []byte) (*queryproto.PoolsResponse, error) {
+	res := &queryproto.PoolsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) PositionById(ctx context.Context,
+	req queryproto.PositionByIdRequest, opts ...grpc.CallOption,
+) (*queryproto.PositionByIdResponse, error) {
+	return c.q.PositionById(ctx, &req, opts...)
+}
+
+// NewPositionByIdABCIRequest returns the ABCI query of PositionById at the given height, 0 being the latest one.
+func NewPositionByIdABCIRequest(req queryproto.PositionByIdRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: PositionByIdPath, Data: bz, Height: height}, nil
+}
+
+// DecodePositionByIdResponse decodes the response of PositionById, e.g. the value of its ABCI query.
+func DecodePositionByIdResponse(bz []byte) (*queryproto.PositionByIdResponse, error) {
+	res := &queryproto.PositionByIdResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) TickAccumulatorTrackers(ctx context.Context,
+	req queryproto.TickAccumulatorTrackersRequest, opts ...grpc.CallOption,
+) (*queryproto.TickAccumulatorTrackersResponse, error) {
+	return c.q.TickAccumulatorTrackers(ctx, &req, opts...)
+}
+
+// NewTickAccumulatorTrackersABCIRequest returns the ABCI query of TickAccumulatorTrackers at the given height, 0 being the latest one.
+func NewTickAccumulatorTrackersABCIRequest(req queryproto.TickAccumulatorTrackersRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: TickAccumulatorTrackersPath, Data: bz, Height: height}, nil
+}
+
+// DecodeTickAccumulatorTrackersResponse decodes the response of TickAccumulatorTrackers, e.g. the value of its ABCI query.
+func DecodeTickAccumulatorTrackersResponse(bz []byte) (*queryproto.TickAccumulatorTrackersResponse, error) {
+	res := &queryproto.TickAccumulatorTrackersResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) UserPositions(ctx context.Context,
+	req queryproto.UserPositionsRequest, opts ...grpc.CallOption,
+) (*queryproto.UserPositionsResponse, error) {
+	return c.q.UserPositions(ctx, &req, opts...)
+}
+
+// NewUserPositionsABCIRequest returns the ABCI query of UserPositions at the given height, 0 being the latest one.
+func NewUserPositionsABCIRequest(req queryproto.UserPositionsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: UserPositionsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeUserPositionsResponse decodes the response of UserPositions, e.g. the value of its ABCI query.
+func DecodeUserPositionsResponse(bz []byte) (*queryproto.UserPositionsResponse, error) {
+	res := &queryproto.UserPositionsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
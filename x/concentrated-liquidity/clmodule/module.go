@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -16,6 +17,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/genesisstream"
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 	"github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client/cli"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/client/queryproto"
@@ -30,8 +33,9 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule                 = AppModule{}
+	_ module.AppModuleBasic            = AppModuleBasic{}
+	_ genesisstream.HasStreamingExport = AppModule{}
 )
 
 type AppModuleBasic struct {
@@ -103,6 +107,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 }
 
+// DeclareInvariants declares the concentrated liquidity invariants to the invariant registry.
+func (am AppModule) DeclareInvariants(r *invariants.Registry) {
+	clkeeper.DeclareInvariants(r, am.keeper)
+}
+
 func (am AppModule) Route() sdk.Route {
 	return sdk.Route{}
 }
@@ -120,11 +129,10 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // InitGenesis performs genesis initialization for the cl module.
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
-	var genState genesis.GenesisState
-	// Initialize global index to index in genesis state
-	cdc.MustUnmarshalJSON(gs, &genState)
-
-	am.keeper.InitGenesis(ctx, genState)
+	// the pools and positions are unmarshalled one at a time.
+	if err := am.keeper.InitGenesisFromJSON(ctx, cdc, gs); err != nil {
+		panic(err)
+	}
 
 	return []abci.ValidatorUpdate{}
 }
@@ -136,6 +144,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// ExportGenesisTo writes the exported genesis state as sorted JSON to w, streaming the pools and positions.
+func (am AppModule) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	return am.keeper.ExportGenesisTo(ctx, cdc, w)
+}
+
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
@@ -160,7 +173,18 @@ func (am AppModule) Actions() []simtypes.Action {
 		simtypes.NewMsgBasedAction("CreateConcentratedPool", am.keeper, simulation.RandomMsgCreateConcentratedPool),
 		simtypes.NewMsgBasedAction("CreatePosition", am.keeper, simulation.RandMsgCreatePosition),
 		simtypes.NewMsgBasedAction("WithdrawPosition", am.keeper, simulation.RandMsgWithdrawPosition),
+		simtypes.NewMsgBasedAction("AddToPosition", am.keeper, simulation.RandMsgAddToPosition),
 		simtypes.NewMsgBasedAction("CollectSpreadRewards", am.keeper, simulation.RandMsgCollectSpreadRewards),
 		simtypes.NewMsgBasedAction("CollectIncentives", am.keeper, simulation.RandMsgCollectIncentives),
 	}
 }
+
+// PropertyChecks checks the balances and accumulators of the concentrated liquidity pools at the end of every block.
+func (am AppModule) PropertyChecks() []simtypes.PropertyCheck {
+	return []simtypes.PropertyCheck{
+		simtypes.NewInvariantPropertyCheck(clkeeper.PoolAccumulatorsInvariant(am.keeper)),
+		simtypes.NewInvariantPropertyCheck(clkeeper.PoolBalancesInvariant(am.keeper)),
+		simtypes.NewInvariantPropertyCheck(clkeeper.SpreadRewardsInvariant(am.keeper)),
+		simtypes.NewInvariantPropertyCheck(clkeeper.IncentivesInvariant(am.keeper)),
+	}
+}
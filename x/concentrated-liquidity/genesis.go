@@ -1,95 +1,159 @@
 package concentrated_liquidity
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/osmoutils/genesisstream"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
 	types "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types/genesis"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+// JSON names of the genesis arrays streamed by InitGenesisFromJSON and ExportGenesisTo.
+const (
+	poolDataJSONName     = "pool_data"
+	positionDataJSONName = "position_data"
 )
 
 // InitGenesis initializes the concentrated-liquidity module with the provided genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 	k.SetNextPositionId(ctx, genState.NextPositionId)
+	k.EnableDirectedRounding(ctx)
+	k.EnableSwapFixes(ctx)
 	// Initialize pools
-	var unpacker codectypes.AnyUnpacker = k.cdc
 	seenPoolIds := map[uint64]struct{}{}
 	for _, poolData := range genState.PoolData {
-		var pool types.ConcentratedPoolExtension
-		err := unpacker.UnpackAny(poolData.Pool, &pool)
+		poolId, err := k.initPoolData(ctx, poolData)
 		if err != nil {
 			panic(err)
 		}
-		err = k.setPool(ctx, pool)
-		if err != nil {
-			panic(err)
-		}
-
-		poolId := pool.GetId()
-		poolTicks := poolData.Ticks
-		for _, tick := range poolTicks {
-			k.SetTickInfo(ctx, poolId, tick.TickIndex, &tick.Info)
-		}
 		seenPoolIds[poolId] = struct{}{}
+	}
 
-		// set up spread reward accumulators
-		store := ctx.KVStore(k.storeKey)
-		err = accum.MakeAccumulatorWithValueAndShare(store, poolData.SpreadRewardAccumulator.Name, poolData.SpreadRewardAccumulator.AccumContent.AccumValue, poolData.SpreadRewardAccumulator.AccumContent.TotalShares)
-		if err != nil {
+	// set positions for pool
+	for _, positionWrapper := range genState.PositionData {
+		if err := k.initPositionData(ctx, positionWrapper, seenPoolIds); err != nil {
 			panic(err)
 		}
+	}
+}
 
-		// set up incentive accumulators
-		for _, incentiveAccum := range poolData.IncentivesAccumulators {
-			err = accum.MakeAccumulatorWithValueAndShare(store, incentiveAccum.GetName(), incentiveAccum.AccumContent.AccumValue, incentiveAccum.AccumContent.TotalShares)
-			if err != nil {
-				panic(err)
-			}
-		}
+// InitGenesisFromJSON initializes the concentrated-liquidity module with the provided JSON genesis state,
+// unmarshalling its pools and positions one at a time instead of holding all of them in memory.
+func (k Keeper) InitGenesisFromJSON(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) error {
+	var genState genesis.GenesisState
+	if err := genesisstream.UnmarshalJSON(cdc, bz, &genState, poolDataJSONName, positionDataJSONName); err != nil {
+		return err
+	}
+	k.InitGenesis(ctx, genState)
 
-		// set incentive records
-		err = k.setMultipleIncentiveRecords(ctx, poolData.IncentiveRecords)
+	seenPoolIds := map[uint64]struct{}{}
+	err := genesisstream.ForEach(cdc, bz, poolDataJSONName, func(poolData *genesis.PoolData) error {
+		poolId, err := k.initPoolData(ctx, *poolData)
 		if err != nil {
-			panic(err)
+			return err
 		}
+		seenPoolIds[poolId] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	// set positions for pool
-	for _, positionWrapper := range genState.PositionData {
-		if _, ok := seenPoolIds[positionWrapper.Position.PoolId]; !ok {
-			panic(fmt.Sprintf("found position with pool id (%d) but there is no pool with such id that exists", positionWrapper.Position.PoolId))
-		}
+	return genesisstream.ForEach(cdc, bz, positionDataJSONName, func(positionData *genesis.PositionData) error {
+		return k.initPositionData(ctx, *positionData, seenPoolIds)
+	})
+}
 
-		err := k.SetPosition(ctx, positionWrapper.Position.PoolId, sdk.MustAccAddressFromBech32(positionWrapper.Position.Address), positionWrapper.Position.LowerTick, positionWrapper.Position.UpperTick, positionWrapper.Position.JoinTime, positionWrapper.Position.Liquidity, positionWrapper.Position.PositionId, positionWrapper.LockId)
-		if err != nil {
-			panic(err)
-		}
+// initPoolData sets the pool, its ticks, accumulators and incentive records, returning the pool id.
+func (k Keeper) initPoolData(ctx sdk.Context, poolData genesis.PoolData) (uint64, error) {
+	var unpacker codectypes.AnyUnpacker = k.cdc
+	var pool types.ConcentratedPoolExtension
+	err := unpacker.UnpackAny(poolData.Pool, &pool)
+	if err != nil {
+		return 0, err
+	}
+	err = k.setPool(ctx, pool)
+	if err != nil {
+		return 0, err
+	}
 
-		// set individual spread reward accumulator state position
-		spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, positionWrapper.Position.PoolId)
-		if err != nil {
-			panic(err)
-		}
-		spreadRewardPositionKey := types.KeySpreadRewardPositionAccumulator(positionWrapper.Position.PositionId)
+	poolId := pool.GetId()
+	poolTicks := poolData.Ticks
+	for _, tick := range poolTicks {
+		k.SetTickInfo(ctx, poolId, tick.TickIndex, &tick.Info)
+	}
 
-		k.initOrUpdateAccumPosition(ctx, spreadRewardAccumObject, positionWrapper.SpreadRewardAccumRecord.AccumValuePerShare, spreadRewardPositionKey, positionWrapper.SpreadRewardAccumRecord.NumShares, positionWrapper.SpreadRewardAccumRecord.UnclaimedRewardsTotal, positionWrapper.SpreadRewardAccumRecord.Options)
+	// set up spread reward accumulators
+	store := ctx.KVStore(k.storeKey)
+	err = accum.MakeAccumulatorWithValueAndShare(store, poolData.SpreadRewardAccumulator.Name, poolData.SpreadRewardAccumulator.AccumContent.AccumValue, poolData.SpreadRewardAccumulator.AccumContent.TotalShares)
+	if err != nil {
+		return 0, err
+	}
 
-		positionName := string(types.KeyPositionId(positionWrapper.Position.PositionId))
-		uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, positionWrapper.Position.PoolId)
+	// set up incentive accumulators
+	for _, incentiveAccum := range poolData.IncentivesAccumulators {
+		err = accum.MakeAccumulatorWithValueAndShare(store, incentiveAccum.GetName(), incentiveAccum.AccumContent.AccumValue, incentiveAccum.AccumContent.TotalShares)
 		if err != nil {
-			panic(err)
+			return 0, err
 		}
+	}
 
-		for uptimeIndex, uptimeRecord := range positionWrapper.UptimeAccumRecords {
-			k.initOrUpdateAccumPosition(ctx, uptimeAccumulators[uptimeIndex], uptimeRecord.AccumValuePerShare, positionName, uptimeRecord.NumShares, uptimeRecord.UnclaimedRewardsTotal, uptimeRecord.Options)
-		}
+	// set incentive records
+	err = k.setMultipleIncentiveRecords(ctx, poolData.IncentiveRecords)
+	if err != nil {
+		return 0, err
 	}
+	return poolId, nil
+}
+
+// initPositionData sets the position and its accumulator records. The pool of the position must be
+// one of the seen pools.
+func (k Keeper) initPositionData(ctx sdk.Context, positionWrapper genesis.PositionData, seenPoolIds map[uint64]struct{}) error {
+	if _, ok := seenPoolIds[positionWrapper.Position.PoolId]; !ok {
+		return fmt.Errorf("found position with pool id (%d) but there is no pool with such id that exists", positionWrapper.Position.PoolId)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(positionWrapper.Position.Address)
+	if err != nil {
+		return err
+	}
+	err = k.SetPosition(ctx, positionWrapper.Position.PoolId, owner, positionWrapper.Position.LowerTick, positionWrapper.Position.UpperTick, positionWrapper.Position.JoinTime, positionWrapper.Position.Liquidity, positionWrapper.Position.PositionId, positionWrapper.LockId)
+	if err != nil {
+		return err
+	}
+
+	// set individual spread reward accumulator state position
+	spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, positionWrapper.Position.PoolId)
+	if err != nil {
+		return err
+	}
+	spreadRewardPositionKey := types.KeySpreadRewardPositionAccumulator(positionWrapper.Position.PositionId)
+
+	k.initOrUpdateAccumPosition(ctx, spreadRewardAccumObject, positionWrapper.SpreadRewardAccumRecord.AccumValuePerShare, spreadRewardPositionKey, positionWrapper.SpreadRewardAccumRecord.NumShares, positionWrapper.SpreadRewardAccumRecord.UnclaimedRewardsTotal, positionWrapper.SpreadRewardAccumRecord.Options)
+
+	positionName := string(types.KeyPositionId(positionWrapper.Position.PositionId))
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, positionWrapper.Position.PoolId)
+	if err != nil {
+		return err
+	}
+
+	for uptimeIndex, uptimeRecord := range positionWrapper.UptimeAccumRecords {
+		k.initOrUpdateAccumPosition(ctx, uptimeAccumulators[uptimeIndex], uptimeRecord.AccumValuePerShare, positionName, uptimeRecord.NumShares, uptimeRecord.UnclaimedRewardsTotal, uptimeRecord.Options)
+	}
+	return nil
 }
 
 // ExportGenesis returns the concentrated-liquidity module's exported genesis state.
@@ -100,136 +164,177 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 	}
 
 	poolData := make([]genesis.PoolData, 0, len(pools))
-
 	for _, poolI := range pools {
-		poolI := poolI
-		any, err := codectypes.NewAnyWithValue(poolI)
+		data, err := k.exportPoolData(ctx, poolI)
 		if err != nil {
 			panic(err)
 		}
-		anyCopy := *any
+		poolData = append(poolData, data)
+	}
 
-		ticks, err := k.GetAllInitializedTicksForPool(ctx, poolI.GetId())
-		if err != nil {
-			panic(err)
-		}
-		accumObject, err := k.GetSpreadRewardAccumulator(ctx, poolI.GetId())
-		if err != nil {
-			panic(err)
-		}
+	positions, err := k.getAllPositions(ctx)
+	if err != nil {
+		panic(err)
+	}
 
-		totalShares, err := accumObject.GetTotalShares()
+	positionData := make([]genesis.PositionData, 0, len(positions))
+	for _, position := range positions {
+		data, err := k.exportPositionData(ctx, position)
 		if err != nil {
 			panic(err)
 		}
+		positionData = append(positionData, data)
+	}
 
-		spreadRewardAccumObject := genesis.AccumObject{
-			Name: types.KeySpreadRewardPoolAccumulator(poolI.GetId()),
-			AccumContent: &accum.AccumulatorContent{
-				AccumValue:  accumObject.GetValue(),
-				TotalShares: totalShares,
-			},
-		}
-
-		poolId := poolI.GetId()
-		incentiveRecordsForPool, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
-		if err != nil {
-			panic(err)
-		}
+	return &genesis.GenesisState{
+		Params:         k.GetParams(ctx),
+		PoolData:       poolData,
+		PositionData:   positionData,
+		NextPositionId: k.GetNextPositionId(ctx),
+	}
+}
 
-		incentivesAccum, err := k.GetUptimeAccumulators(ctx, poolId)
-		if err != nil {
-			panic(err)
-		}
+// ExportGenesisTo writes the concentrated-liquidity module's exported genesis state as sorted JSON to w,
+// exporting its pools and positions one at a time instead of holding all of them in memory.
+func (k Keeper) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	genState := &genesis.GenesisState{
+		Params:         k.GetParams(ctx),
+		NextPositionId: k.GetNextPositionId(ctx),
+	}
+	store := ctx.KVStore(k.storeKey)
+
+	return genesisstream.WriteJSON(w, cdc, genState, map[string]genesisstream.ArrayWriter{
+		poolDataJSONName: func(write func(proto.Message) error) error {
+			return osmoutils.ForEachValueFromStorePrefix(store, types.PoolPrefix, k.parsePoolFromBz, func(pool poolmanagertypes.PoolI) error {
+				data, err := k.exportPoolData(ctx, pool)
+				if err != nil {
+					return err
+				}
+				return write(&data)
+			})
+		},
+		positionDataJSONName: func(write func(proto.Message) error) error {
+			return osmoutils.ForEachValueFromStorePrefix(store, types.PositionIdPrefix, ParsePositionFromBz, func(position model.Position) error {
+				data, err := k.exportPositionData(ctx, position)
+				if err != nil {
+					return err
+				}
+				return write(&data)
+			})
+		},
+	})
+}
 
-		incentivesAccumObject := make([]genesis.AccumObject, len(incentivesAccum))
-		for i, incentiveAccum := range incentivesAccum {
-			incentiveAccumTotalShares, err := incentiveAccum.GetTotalShares()
-			if err != nil {
-				panic(err)
-			}
-			genesisAccum := genesis.AccumObject{
-				Name: incentiveAccum.GetName(),
-				AccumContent: &accum.AccumulatorContent{
-					AccumValue:  incentiveAccum.GetValue(),
-					TotalShares: incentiveAccumTotalShares,
-				},
-			}
-			incentivesAccumObject[i] = genesisAccum
-		}
+// exportPoolData returns the pool along with its ticks, accumulators and incentive records.
+func (k Keeper) exportPoolData(ctx sdk.Context, poolI poolmanagertypes.PoolI) (genesis.PoolData, error) {
+	any, err := codectypes.NewAnyWithValue(poolI)
+	if err != nil {
+		return genesis.PoolData{}, err
+	}
 
-		poolData = append(poolData, genesis.PoolData{
-			Pool:                    &anyCopy,
-			Ticks:                   ticks,
-			SpreadRewardAccumulator: spreadRewardAccumObject,
-			IncentivesAccumulators:  incentivesAccumObject,
-			IncentiveRecords:        incentiveRecordsForPool,
-		})
+	ticks, err := k.GetAllInitializedTicksForPool(ctx, poolI.GetId())
+	if err != nil {
+		return genesis.PoolData{}, err
+	}
+	accumObject, err := k.GetSpreadRewardAccumulator(ctx, poolI.GetId())
+	if err != nil {
+		return genesis.PoolData{}, err
 	}
 
-	positions, err := k.getAllPositions(ctx)
+	totalShares, err := accumObject.GetTotalShares()
 	if err != nil {
-		panic(err)
+		return genesis.PoolData{}, err
 	}
 
-	positionData := make([]genesis.PositionData, 0, len(positions))
-	for _, position := range positions {
-		position, err := k.GetPosition(ctx, position.PositionId)
-		if err != nil {
-			panic(err)
-		}
+	spreadRewardAccumObject := genesis.AccumObject{
+		Name: types.KeySpreadRewardPoolAccumulator(poolI.GetId()),
+		AccumContent: &accum.AccumulatorContent{
+			AccumValue:  accumObject.GetValue(),
+			TotalShares: totalShares,
+		},
+	}
 
-		lockId, err := k.GetLockIdFromPositionId(ctx, position.PositionId)
-		if err != nil {
-			if errors.Is(err, types.PositionIdToLockNotFoundError{PositionId: position.PositionId}) {
-				lockId = 0
-			} else {
-				panic(err)
-			}
-		}
+	poolId := poolI.GetId()
+	incentiveRecordsForPool, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
+	if err != nil {
+		return genesis.PoolData{}, err
+	}
+
+	incentivesAccum, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return genesis.PoolData{}, err
+	}
 
-		// Retrieve spread reward accumulator state for position
-		spreadRewardPositionKey := types.KeySpreadRewardPositionAccumulator(position.PositionId)
-		spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
+	incentivesAccumObject := make([]genesis.AccumObject, len(incentivesAccum))
+	for i, incentiveAccum := range incentivesAccum {
+		incentiveAccumTotalShares, err := incentiveAccum.GetTotalShares()
 		if err != nil {
-			panic(err)
+			return genesis.PoolData{}, err
 		}
-		spreadRewardAccumPositionRecord, err := spreadRewardAccumObject.GetPosition(spreadRewardPositionKey)
-		if err != nil {
-			panic(err)
+		genesisAccum := genesis.AccumObject{
+			Name: incentiveAccum.GetName(),
+			AccumContent: &accum.AccumulatorContent{
+				AccumValue:  incentiveAccum.GetValue(),
+				TotalShares: incentiveAccumTotalShares,
+			},
 		}
+		incentivesAccumObject[i] = genesisAccum
+	}
 
-		// Retrieve uptime incentive accumulator state for position
-		positionName := string(types.KeyPositionId(position.PositionId))
-		uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, position.PoolId)
-		if err != nil {
-			panic(err)
+	return genesis.PoolData{
+		Pool:                    any,
+		Ticks:                   ticks,
+		SpreadRewardAccumulator: spreadRewardAccumObject,
+		IncentivesAccumulators:  incentivesAccumObject,
+		IncentiveRecords:        incentiveRecordsForPool,
+	}, nil
+}
+
+// exportPositionData returns the position along with its lock id and accumulator records.
+func (k Keeper) exportPositionData(ctx sdk.Context, position model.Position) (genesis.PositionData, error) {
+	lockId, err := k.GetLockIdFromPositionId(ctx, position.PositionId)
+	if err != nil {
+		if errors.Is(err, types.PositionIdToLockNotFoundError{PositionId: position.PositionId}) {
+			lockId = 0
+		} else {
+			return genesis.PositionData{}, err
 		}
+	}
 
-		uptimeAccumObject := make([]accum.Record, len(uptimeAccumulators))
-		for uptimeIndex := range types.SupportedUptimes {
-			accumRecord, err := uptimeAccumulators[uptimeIndex].GetPosition(positionName)
-			if err != nil {
-				panic(err)
-			}
+	// Retrieve spread reward accumulator state for position
+	spreadRewardPositionKey := types.KeySpreadRewardPositionAccumulator(position.PositionId)
+	spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
+	if err != nil {
+		return genesis.PositionData{}, err
+	}
+	spreadRewardAccumPositionRecord, err := spreadRewardAccumObject.GetPosition(spreadRewardPositionKey)
+	if err != nil {
+		return genesis.PositionData{}, err
+	}
 
-			uptimeAccumObject[uptimeIndex] = accumRecord
+	// Retrieve uptime incentive accumulator state for position
+	positionName := string(types.KeyPositionId(position.PositionId))
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, position.PoolId)
+	if err != nil {
+		return genesis.PositionData{}, err
+	}
+
+	uptimeAccumObject := make([]accum.Record, len(uptimeAccumulators))
+	for uptimeIndex := range types.SupportedUptimes {
+		accumRecord, err := uptimeAccumulators[uptimeIndex].GetPosition(positionName)
+		if err != nil {
+			return genesis.PositionData{}, err
 		}
 
-		positionData = append(positionData, genesis.PositionData{
-			LockId:                  lockId,
-			Position:                &position,
-			SpreadRewardAccumRecord: spreadRewardAccumPositionRecord,
-			UptimeAccumRecords:      uptimeAccumObject,
-		})
+		uptimeAccumObject[uptimeIndex] = accumRecord
 	}
 
-	return &genesis.GenesisState{
-		Params:         k.GetParams(ctx),
-		PoolData:       poolData,
-		PositionData:   positionData,
-		NextPositionId: k.GetNextPositionId(ctx),
-	}
+	return genesis.PositionData{
+		LockId:                  lockId,
+		Position:                &position,
+		SpreadRewardAccumRecord: spreadRewardAccumPositionRecord,
+		UptimeAccumRecords:      uptimeAccumObject,
+	}, nil
 }
 
 // initOrUpdateAccumPosition creates a new position or override an existing position
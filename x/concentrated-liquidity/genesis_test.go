@@ -1,6 +1,7 @@
 package concentrated_liquidity_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -838,6 +839,19 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 
 			// Validate next position id.
 			s.Require().Equal(tc.genesis.NextPositionId, actualExported.NextPositionId)
+
+			// The streamed export is the sorted JSON of the export.
+			cdc := s.App.AppCodec()
+			expectedJSON := sdk.MustSortJSON(cdc.MustMarshalJSON(actualExported))
+			var streamed bytes.Buffer
+			s.Require().NoError(clKeeper.ExportGenesisTo(ctx, cdc, &streamed))
+			s.Require().Equal(string(expectedJSON), streamed.String())
+
+			// The streamed import initializes the same state.
+			s.SetupTest()
+			s.Require().NoError(s.App.ConcentratedLiquidityKeeper.InitGenesisFromJSON(s.Ctx, cdc, streamed.Bytes()))
+			reExported := s.App.ConcentratedLiquidityKeeper.ExportGenesis(s.Ctx)
+			s.Require().Equal(string(expectedJSON), string(sdk.MustSortJSON(cdc.MustMarshalJSON(reExported))))
 		})
 	}
 }
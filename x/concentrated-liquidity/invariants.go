@@ -0,0 +1,215 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+const (
+	poolAccumulatorsInvariantName = "pool-accumulators-exist"
+	poolBalancesInvariantName     = "pool-balances-cover-positions"
+	spreadRewardsInvariantName    = "spread-rewards-cover-unclaimed"
+	incentivesInvariantName       = "incentives-cover-unclaimed"
+)
+
+// DeclareInvariants declares all concentrated liquidity invariants to the invariant registry.
+func DeclareInvariants(r *invariants.Registry, k Keeper) {
+	r.Register(types.ModuleName, poolAccumulatorsInvariantName, invariants.Cheap, PoolAccumulatorsInvariant(k))
+	r.Register(types.ModuleName, poolBalancesInvariantName, invariants.Expensive, PoolBalancesInvariant(k))
+	r.Register(types.ModuleName, spreadRewardsInvariantName, invariants.Expensive, SpreadRewardsInvariant(k))
+	r.Register(types.ModuleName, incentivesInvariantName, invariants.Expensive, IncentivesInvariant(k))
+}
+
+// PoolAccumulatorsInvariant checks that every pool has its spread reward accumulator
+// and an uptime accumulator per supported uptime.
+func PoolAccumulatorsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := k.GetPools(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolAccumulatorsInvariantName,
+				fmt.Sprintf("\tpool retrieval failed: %s\n", err)), true
+		}
+
+		for _, pool := range pools {
+			if _, err := k.GetSpreadRewardAccumulator(ctx, pool.GetId()); err != nil {
+				return sdk.FormatInvariant(types.ModuleName, poolAccumulatorsInvariantName,
+					fmt.Sprintf("\tpool id %d spread reward accumulator: %s\n", pool.GetId(), err)), true
+			}
+			uptimeAccums, err := k.GetUptimeAccumulators(ctx, pool.GetId())
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, poolAccumulatorsInvariantName,
+					fmt.Sprintf("\tpool id %d uptime accumulators: %s\n", pool.GetId(), err)), true
+			}
+			if len(uptimeAccums) != len(types.SupportedUptimes) {
+				return sdk.FormatInvariant(types.ModuleName, poolAccumulatorsInvariantName,
+					fmt.Sprintf("\tpool id %d has %d uptime accumulators, expected %d\n",
+						pool.GetId(), len(uptimeAccums), len(types.SupportedUptimes))), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolAccumulatorsInvariantName,
+			"\tall pools have their accumulators\n"), false
+	}
+}
+
+// PoolBalancesInvariant checks that the balance of every pool covers the amounts its positions
+// would get by withdrawing all their liquidity.
+func PoolBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		positionsByPool, err := k.getPositionsByPool(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolBalancesInvariantName,
+				fmt.Sprintf("\tposition retrieval failed: %s\n", err)), true
+		}
+
+		for _, pool := range positionsByPool.pools {
+			expectedCoins := sdk.NewCoins()
+			for _, position := range positionsByPool.positions[pool.GetId()] {
+				if position.Liquidity.IsZero() {
+					continue
+				}
+				// withdrawing rounds down, in favor of the pool.
+				amount0, amount1, err := k.calcActualAmounts(ctx, pool, position.LowerTick, position.UpperTick, position.Liquidity.Neg())
+				if err != nil {
+					return sdk.FormatInvariant(types.ModuleName, poolBalancesInvariantName,
+						fmt.Sprintf("\tposition id %d amounts: %s\n", position.PositionId, err)), true
+				}
+				expectedCoins = expectedCoins.Add(
+					sdk.NewCoin(pool.GetToken0(), amount0.Abs().TruncateInt()),
+					sdk.NewCoin(pool.GetToken1(), amount1.Abs().TruncateInt()),
+				)
+			}
+
+			actualCoins := k.bankKeeper.GetAllBalances(ctx, pool.GetAddress())
+			if !actualCoins.IsAllGTE(expectedCoins) {
+				return sdk.FormatInvariant(types.ModuleName, poolBalancesInvariantName,
+					fmt.Sprintf("\tpool id %d\n\tpositions amounts: %s\n\tpool balance: %s\n",
+						pool.GetId(), expectedCoins, actualCoins)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolBalancesInvariantName,
+			"\tall pool balances cover the amounts of their positions\n"), false
+	}
+}
+
+// SpreadRewardsInvariant checks that the spread rewards balance of every pool covers the spread rewards
+// claimable by its positions.
+func SpreadRewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		positionsByPool, err := k.getPositionsByPool(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, spreadRewardsInvariantName,
+				fmt.Sprintf("\tposition retrieval failed: %s\n", err)), true
+		}
+
+		for _, pool := range positionsByPool.pools {
+			claimableCoins := sdk.NewCoins()
+			for _, position := range positionsByPool.positions[pool.GetId()] {
+				claimable, err := k.GetClaimableSpreadRewards(ctx, position.PositionId)
+				if err != nil {
+					return sdk.FormatInvariant(types.ModuleName, spreadRewardsInvariantName,
+						fmt.Sprintf("\tposition id %d claimable spread rewards: %s\n", position.PositionId, err)), true
+				}
+				claimableCoins = claimableCoins.Add(claimable...)
+			}
+
+			actualCoins := k.bankKeeper.GetAllBalances(ctx, pool.GetSpreadRewardsAddress())
+			if !actualCoins.IsAllGTE(claimableCoins) {
+				return sdk.FormatInvariant(types.ModuleName, spreadRewardsInvariantName,
+					fmt.Sprintf("\tpool id %d\n\tclaimable spread rewards: %s\n\tspread rewards balance: %s\n",
+						pool.GetId(), claimableCoins, actualCoins)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, spreadRewardsInvariantName,
+			"\tall spread rewards balances cover the claimable spread rewards\n"), false
+	}
+}
+
+// IncentivesInvariant checks that the incentives balance of every pool covers the incentives claimable
+// and forfeited by its positions, and the amounts remaining in its incentive records.
+// The uptime accumulators are first updated to the block time, moving the emitted incentives from the
+// records to the accumulators.
+func IncentivesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		positionsByPool, err := k.getPositionsByPool(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, incentivesInvariantName,
+				fmt.Sprintf("\tposition retrieval failed: %s\n", err)), true
+		}
+
+		for _, pool := range positionsByPool.pools {
+			// the update is discarded along with the cache context.
+			cacheCtx, _ := ctx.CacheContext()
+			if err := k.updatePoolUptimeAccumulatorsToNow(cacheCtx, pool.GetId()); err != nil {
+				return sdk.FormatInvariant(types.ModuleName, incentivesInvariantName,
+					fmt.Sprintf("\tpool id %d uptime accumulators update: %s\n", pool.GetId(), err)), true
+			}
+
+			expectedCoins := sdk.NewCoins()
+			for _, position := range positionsByPool.positions[pool.GetId()] {
+				claimable, forfeited, err := k.GetClaimableIncentives(cacheCtx, position.PositionId)
+				if err != nil {
+					return sdk.FormatInvariant(types.ModuleName, incentivesInvariantName,
+						fmt.Sprintf("\tposition id %d claimable incentives: %s\n", position.PositionId, err)), true
+				}
+				expectedCoins = expectedCoins.Add(claimable...).Add(forfeited...)
+			}
+
+			records, err := k.GetAllIncentiveRecordsForPool(cacheCtx, pool.GetId())
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, incentivesInvariantName,
+					fmt.Sprintf("\tpool id %d incentive records: %s\n", pool.GetId(), err)), true
+			}
+			for _, record := range records {
+				expectedCoins = expectedCoins.Add(sdk.NewCoin(record.IncentiveDenom, record.IncentiveRecordBody.RemainingAmount.TruncateInt()))
+			}
+
+			actualCoins := k.bankKeeper.GetAllBalances(ctx, pool.GetIncentivesAddress())
+			if !actualCoins.IsAllGTE(expectedCoins) {
+				return sdk.FormatInvariant(types.ModuleName, incentivesInvariantName,
+					fmt.Sprintf("\tpool id %d\n\tunclaimed and remaining incentives: %s\n\tincentives balance: %s\n",
+						pool.GetId(), expectedCoins, actualCoins)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, incentivesInvariantName,
+			"\tall incentives balances cover the unclaimed and remaining incentives\n"), false
+	}
+}
+
+// positionsByPool are the pools with their positions.
+type positionsByPool struct {
+	pools     []types.ConcentratedPoolExtension
+	positions map[uint64][]model.Position
+}
+
+func (k Keeper) getPositionsByPool(ctx sdk.Context) (positionsByPool, error) {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		return positionsByPool{}, err
+	}
+	positions, err := k.getAllPositions(ctx)
+	if err != nil {
+		return positionsByPool{}, err
+	}
+
+	result := positionsByPool{positions: map[uint64][]model.Position{}}
+	for _, pool := range pools {
+		concentratedPool, ok := pool.(types.ConcentratedPoolExtension)
+		if !ok {
+			return positionsByPool{}, fmt.Errorf("pool id %d is not a concentrated pool", pool.GetId())
+		}
+		result.pools = append(result.pools, concentratedPool)
+	}
+	for _, position := range positions {
+		result.positions[position.PoolId] = append(result.positions[position.PoolId], position)
+	}
+	return result, nil
+}
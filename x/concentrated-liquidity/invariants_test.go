@@ -0,0 +1,96 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	cl "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestInvariants() {
+	const incentiveDenom = "incentive"
+
+	tests := map[string]struct {
+		// breaks the state of the pool.
+		breakState       func(pool types.ConcentratedPoolExtension)
+		brokenInvariants []string
+	}{
+		"invariants hold": {},
+		"pool balance below positions amounts": {
+			breakState: func(pool types.ConcentratedPoolExtension) {
+				s.sendAllFrom(pool.GetAddress(), ETH)
+			},
+			brokenInvariants: []string{"concentratedliquidity/pool-balances-cover-positions"},
+		},
+		"spread rewards balance below claimable spread rewards": {
+			breakState: func(pool types.ConcentratedPoolExtension) {
+				s.sendAllFrom(pool.GetSpreadRewardsAddress(), ETH)
+			},
+			brokenInvariants: []string{"concentratedliquidity/spread-rewards-cover-unclaimed"},
+		},
+		"incentives balance below unclaimed incentives": {
+			breakState: func(pool types.ConcentratedPoolExtension) {
+				s.sendAllFrom(pool.GetIncentivesAddress(), incentiveDenom)
+			},
+			brokenInvariants: []string{"concentratedliquidity/incentives-cover-unclaimed"},
+		},
+		"missing uptime accumulators": {
+			breakState: func(pool types.ConcentratedPoolExtension) {
+				// accumulators are stored under accum/acc/{name}.
+				store := s.Ctx.KVStore(s.App.GetKey(types.StoreKey))
+				store.Delete([]byte("accum/acc/" + types.KeyUptimeAccumulator(pool.GetId(), 0)))
+			},
+			// the incentives cannot be computed either.
+			brokenInvariants: []string{"concentratedliquidity/pool-accumulators-exist", "concentratedliquidity/incentives-cover-unclaimed"},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultSpreadFactor)
+			s.SetupDefaultPosition(pool.GetId())
+			s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+
+			// accrue spread rewards.
+			swapCoin := sdk.NewCoin(ETH, sdk.NewInt(1_000_000))
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(swapCoin))
+			_, err := s.clk.SwapExactAmountIn(s.Ctx, s.TestAccs[0], pool, swapCoin, USDC, sdk.OneInt(), DefaultSpreadFactor)
+			s.Require().NoError(err)
+
+			// emit half of an incentive.
+			incentiveCoin := sdk.NewCoin(incentiveDenom, sdk.NewInt(1_000_000))
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(incentiveCoin))
+			_, err = s.clk.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[1], incentiveCoin, sdk.NewDec(1_000), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+			s.Require().NoError(err)
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(500 * time.Second))
+
+			if tc.breakState != nil {
+				tc.breakState(pool)
+			}
+
+			r := invariants.NewRegistry()
+			cl.DeclareInvariants(r, *s.clk)
+			results := invariants.Check(s.Ctx, r.Invariants(invariants.Expensive))
+			s.Require().Len(results, 4)
+			var brokenInvariants []string
+			for _, result := range results {
+				if result.Broken {
+					brokenInvariants = append(brokenInvariants, result.Name())
+				}
+			}
+			s.Require().Equal(tc.brokenInvariants, brokenInvariants)
+		})
+	}
+}
+
+// sendAllFrom sends the whole balance of the denom of the address to another account.
+func (s *KeeperTestSuite) sendAllFrom(addr sdk.AccAddress, denom string) {
+	balance := s.App.BankKeeper.GetBalance(s.Ctx, addr, denom)
+	s.Require().True(balance.IsPositive())
+	err := s.App.BankKeeper.SendCoins(s.Ctx, addr, s.TestAccs[2], sdk.NewCoins(balance))
+	s.Require().NoError(err)
+}
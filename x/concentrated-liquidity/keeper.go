@@ -93,6 +93,34 @@ func (k Keeper) SetNextPositionId(ctx sdk.Context, positionId uint64) {
 	osmoutils.MustSet(store, types.KeyNextGlobalPositionId, &gogotypes.UInt64Value{Value: positionId})
 }
 
+// EnableDirectedRounding makes the concentrated liquidity math round in favor of the pool with directed rounding.
+// It is enabled by the v17 upgrade handler, and at genesis.
+func (k Keeper) EnableDirectedRounding(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyDirectedRoundingEnabled, []byte{1})
+}
+
+// useLegacyRounding returns true if the concentrated liquidity math uses the rounding of the blocks before the v17
+// upgrade, which enables directed rounding.
+func (k Keeper) useLegacyRounding(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return !store.Has(types.KeyDirectedRoundingEnabled)
+}
+
+// EnableSwapFixes makes swaps compute the current tick from the sqrt price rather than its rounded square, and stop
+// on an amount remaining too small to move the sqrt price, in both swap directions.
+// It is enabled by the v17 upgrade handler, and at genesis.
+func (k Keeper) EnableSwapFixes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeySwapFixesEnabled, []byte{1})
+}
+
+// swapFixesEnabled returns true if swaps use the fixes enabled by the v17 upgrade.
+func (k Keeper) swapFixesEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeySwapFixesEnabled)
+}
+
 // Set the concentrated-liquidity listeners.
 func (k *Keeper) SetListeners(listeners types.ConcentratedLiquidityListeners) *Keeper {
 	if k.listeners != nil {
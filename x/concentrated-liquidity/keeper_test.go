@@ -402,6 +402,36 @@ func (s *KeeperTestSuite) TestValidatePermissionlessPoolCreationEnabled() {
 	s.Require().Error(s.App.ConcentratedLiquidityKeeper.ValidatePermissionlessPoolCreationEnabled(s.Ctx))
 }
 
+func (s *KeeperTestSuite) TestEnableDirectedRounding() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+
+	// A position between the sqrt prices 2 and 4, above the current price, holds liquidity / 4 of token0.
+	// 10^-18 / 4 is bankers rounded to 0 by the legacy rounding, and rounded up to 10^-18 by directed rounding.
+	position := model.Position{
+		PoolId:    pool.GetId(),
+		LowerTick: 3000000,
+		UpperTick: 9600000,
+		Liquidity: sdk.SmallestDec(),
+	}
+
+	// Directed rounding is enabled at genesis.
+	asset0, _, err := s.clk.CalculateUnderlyingAssetsFromPosition(s.Ctx, position, pool)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneInt().String(), asset0.Amount.String())
+
+	// Without the flag, as in the state before the v17 upgrade, the legacy rounding is used.
+	s.Ctx.KVStore(s.App.GetKey(types.StoreKey)).Delete(types.KeyDirectedRoundingEnabled)
+	asset0, _, err = s.clk.CalculateUnderlyingAssetsFromPosition(s.Ctx, position, pool)
+	s.Require().NoError(err)
+	s.Require().True(asset0.Amount.IsZero())
+
+	s.clk.EnableDirectedRounding(s.Ctx)
+	asset0, _, err = s.clk.CalculateUnderlyingAssetsFromPosition(s.Ctx, position, pool)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneInt().String(), asset0.Amount.String())
+}
+
 // runFungifySetup Sets up a pool with `poolSpreadFactor`, prepares `numPositions` default positions on it (all identical), and sets
 // up the passed in incentive records such that they emit on the pool. It also sets the largest authorized uptime to be `fullChargeDuration`.
 //
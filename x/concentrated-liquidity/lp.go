@@ -419,7 +419,7 @@ func (k Keeper) UpdatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	}
 
 	// calculate the actual amounts of tokens 0 and 1 that were added or removed from the pool.
-	actualAmount0, actualAmount1, err := pool.CalcActualAmounts(ctx, lowerTick, upperTick, liquidityDelta)
+	actualAmount0, actualAmount1, err := k.calcActualAmounts(ctx, pool, lowerTick, upperTick, liquidityDelta)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
@@ -71,22 +71,20 @@ func CalcAmount0Delta(liq, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec
 	// we would want the user to put in 1000001 uusdc rather than 1000000 uusdc to ensure we are charging enough for the amount they are removing
 	// additionally, without rounding, there exists cases where the swapState.amountSpecifiedRemaining.GT(sdk.ZeroDec()) for loop within
 	// the CalcOut/In functions never actually reach zero due to dust that would have never gotten counted towards the amount (numbers after the 10^6 place)
+	//
+	// The numerator is rounded in favor of the pool, and the denominator in the opposite direction,
+	// so that the quotient is rounded in favor of the pool:
+	// - up when calculating amountIn during swap, or adding liquidity (request user to provide more tokens in in favor of the pool)
+	// - down when calculating amount out during swap, or withdrawing liquidity
+	magnitudeRoundingDir := osmomath.RoundingDirectionInFavorOfPool(roundUp)
+	roundingDir := signedRoundingDirection(magnitudeRoundingDir, liq)
+	numerator := osmomath.MulDecWithRounding(liq, diff, roundingDir)
+	denom := osmomath.MulDecWithRounding(sqrtPriceA, sqrtPriceB, oppositeRoundingDirection(magnitudeRoundingDir))
+	amount0 := osmomath.QuoDecWithRounding(numerator, denom, roundingDir)
 	if roundUp {
-		// Note that we do MulTruncate so that the denominator is smaller as this is
-		// the case where we want to round up to favor the pool.
-		// Examples include:
-		// - calculating amountIn during swap
-		// - adding liquidity (request user to provide more tokens in in favor of the pool)
-		// The denominator is truncated to get a higher final amount.
-		denom := sqrtPriceA.MulTruncate(sqrtPriceB)
-		return liq.Mul(diff).QuoMut(denom).Ceil()
+		return amount0.Ceil()
 	}
-	// These are truncated at precision end to round in favor of the pool when:
-	// - calculating amount out during swap
-	// - withdrawing liquidity
-	// The denominator is rounded up to get a smaller final amount.
-	denom := sqrtPriceA.MulRoundUp(sqrtPriceB)
-	return liq.MulTruncate(diff).QuoTruncateMut(denom)
+	return amount0
 }
 
 // CalcAmount1 takes the asset with the smaller liquidity in the pool as well as the sqrtpCur and the nextPrice and calculates the amount of asset 1
@@ -106,19 +104,16 @@ func CalcAmount1Delta(liq, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec
 	// we would want the used to put in 1000001 uusdc rather than 1000000 uusdc to ensure we are charging enough for the amount they are removing
 	// additionally, without rounding, there exists cases where the swapState.amountSpecifiedRemaining.GT(sdk.ZeroDec()) for loop within
 	// the CalcOut/In functions never actually reach zero due to dust that would have never gotten counted towards the amount (numbers after the 10^6 place)
+	//
+	// This is rounded in favor of the pool:
+	// - up when calculating amountIn during swap, or adding liquidity (request user to provide more tokens in in favor of the pool)
+	// - down when calculating amount out during swap, or withdrawing liquidity
+	roundingDir := signedRoundingDirection(osmomath.RoundingDirectionInFavorOfPool(roundUp), liq)
+	amount1 := osmomath.MulDecWithRounding(liq, diff, roundingDir)
 	if roundUp {
-		// Note that we do MulRoundUp so that the end result is larger as this is
-		// the case where we want to round up to favor the pool.
-		// Examples include:
-		// - calculating amountIn during swap
-		// - adding liquidity (request user to provide more tokens in in favor of the pool)
-		return liq.Mul(diff).Ceil()
+		return amount1.Ceil()
 	}
-	// This is truncated at precision end to round in favor of the pool when:
-	// - calculating amount out during swap
-	// - withdrawing liquidity
-	// The denominator is rounded up to get a higher final amount.
-	return liq.MulTruncate(diff)
+	return amount1
 }
 
 // GetNextSqrtPriceFromAmount0InRoundingUp utilizes sqrtPriceCurrent, liquidity, and amount of denom0 that still needs
@@ -131,9 +126,10 @@ func GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPriceCurrent, liquidity, amount
 		return sqrtPriceCurrent
 	}
 
-	product := amountZeroRemainingIn.Mul(sqrtPriceCurrent)
+	product := osmomath.MulDecWithRounding(amountZeroRemainingIn, sqrtPriceCurrent, osmomath.RoundDown)
 	denominator := product.AddMut(liquidity)
-	return liquidity.Mul(sqrtPriceCurrent).QuoRoundupMut(denominator)
+	numerator := osmomath.MulDecWithRounding(liquidity, sqrtPriceCurrent, osmomath.RoundUp)
+	return osmomath.QuoDecWithRounding(numerator, denominator, osmomath.RoundUp)
 }
 
 // GetNextSqrtPriceFromAmount0OutRoundingUp utilizes sqrtPriceCurrent, liquidity, and amount of denom0 that still needs
@@ -146,9 +142,10 @@ func GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPriceCurrent, liquidity, amoun
 		return sqrtPriceCurrent
 	}
 
-	product := amountZeroRemainingOut.Mul(sqrtPriceCurrent)
+	product := osmomath.MulDecWithRounding(amountZeroRemainingOut, sqrtPriceCurrent, osmomath.RoundUp)
 	denominator := liquidity.Sub(product)
-	return liquidity.Mul(sqrtPriceCurrent).QuoRoundupMut(denominator)
+	numerator := osmomath.MulDecWithRounding(liquidity, sqrtPriceCurrent, osmomath.RoundUp)
+	return osmomath.QuoDecWithRounding(numerator, denominator, osmomath.RoundUp)
 }
 
 // GetNextSqrtPriceFromAmount1InRoundingDown utilizes the current sqrtPriceCurrent, liquidity, and amount of denom1 that still needs
@@ -157,7 +154,7 @@ func GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPriceCurrent, liquidity, amoun
 // avoid overpaying out of the pool. Therefore, we round down.
 // sqrt_next = sqrt_cur + token_in / liq
 func GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPriceCurrent, liquidity, amountOneRemainingIn sdk.Dec) (sqrtPriceNext sdk.Dec) {
-	return sqrtPriceCurrent.Add(amountOneRemainingIn.QuoTruncate(liquidity))
+	return sqrtPriceCurrent.Add(osmomath.QuoDecWithRounding(amountOneRemainingIn, liquidity, osmomath.RoundDown))
 }
 
 // GetNextSqrtPriceFromAmount1OutRoundingDown utilizes the current sqrtPriceCurrent, liquidity, and amount of denom1 that still needs
@@ -166,7 +163,7 @@ func GetNextSqrtPriceFromAmount1InRoundingDown(sqrtPriceCurrent, liquidity, amou
 // so that we get the desired output amount out.
 // sqrt_next = sqrt_cur - token_out / liq
 func GetNextSqrtPriceFromAmount1OutRoundingDown(sqrtPriceCurrent, liquidity, amountOneRemainingOut sdk.Dec) (sqrtPriceNext sdk.Dec) {
-	return sqrtPriceCurrent.Sub(amountOneRemainingOut.QuoRoundUp(liquidity))
+	return sqrtPriceCurrent.Sub(osmomath.QuoDecWithRounding(amountOneRemainingOut, liquidity, osmomath.RoundUp))
 }
 
 // GetLiquidityFromAmounts takes the current sqrtPrice and the sqrtPrice for the upper and lower ticks as well as the amounts of asset0 and asset1
@@ -196,10 +193,28 @@ func GetLiquidityFromAmounts(sqrtPrice, sqrtPriceA, sqrtPriceB sdk.Dec, amount0,
 
 // SquareRoundUp squares and rounds up at precision end.
 func SquareRoundUp(sqrtPrice sdk.Dec) sdk.Dec {
-	return sqrtPrice.MulRoundUp(sqrtPrice)
+	return osmomath.MulDecWithRounding(sqrtPrice, sqrtPrice, osmomath.RoundUp)
 }
 
 // SquareTruncate squares and truncates at precision end.
 func SquareTruncate(sqrtPrice sdk.Dec) sdk.Dec {
-	return sqrtPrice.MulTruncate(sqrtPrice)
+	return osmomath.MulDecWithRounding(sqrtPrice, sqrtPrice, osmomath.RoundDown)
+}
+
+// signedRoundingDirection returns the rounding direction of an amount proportional to the liquidity,
+// given the rounding direction of its magnitude. The amount is negative when liquidity is removed,
+// in which case rounding its magnitude down rounds the amount up, and conversely.
+func signedRoundingDirection(magnitudeRoundingDir osmomath.RoundingDirection, liquidity sdk.Dec) osmomath.RoundingDirection {
+	if liquidity.IsNegative() {
+		return oppositeRoundingDirection(magnitudeRoundingDir)
+	}
+	return magnitudeRoundingDir
+}
+
+// oppositeRoundingDirection returns RoundDown for RoundUp and conversely.
+func oppositeRoundingDirection(roundingDir osmomath.RoundingDirection) osmomath.RoundingDirection {
+	if roundingDir == osmomath.RoundUp {
+		return osmomath.RoundDown
+	}
+	return osmomath.RoundUp
 }
@@ -1,9 +1,11 @@
 package math_test
 
 import (
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -504,3 +506,55 @@ func (suite *ConcentratedMathTestSuite) TestGetNextSqrtPriceFromAmount1OutRoundi
 	}
 	suite.runSqrtRoundingTestCase("TestGetNextSqrtPriceFromAmount1OutRoundingDown", math.GetNextSqrtPriceFromAmount1OutRoundingDown, tests)
 }
+
+// fuzzSqrtPrice maps a fuzzed integer to a sqrt price in [10^-6, 1.8 * 10^10], within the supported sqrt prices.
+func fuzzSqrtPrice(i uint64) sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(new(big.Int).SetUint64(i), 9).Add(sdk.NewDecWithPrec(1, 6))
+}
+
+// exactAmountBound returns the exact amount bound that a rounded amount must respect to be in favor of the pool:
+// the exact amount rounded up at BigDec precision end for an amount in, and rounded down for an amount out.
+// Since a rounded sdk.Dec amount is a multiple of 10^-36, it is at least (resp. at most) the exact amount if and only if
+// it is at least (resp. at most) this bound.
+func exactAmountBound(numerator, denominator osmomath.BigDec, isAmountIn bool) osmomath.BigDec {
+	return numerator.QuoWithRounding(denominator, osmomath.RoundingDirectionInFavorOfPool(isAmountIn))
+}
+
+// FuzzCalcAmountDeltaInFavorOfPool checks that the amounts of both assets computed for a liquidity delta
+// over a range of sqrt prices are rounded in favor of the pool, for liquidity that is added and removed.
+// Removed liquidity is only ever paired with rounding down, as when withdrawing a position.
+func FuzzCalcAmountDeltaInFavorOfPool(f *testing.F) {
+	f.Add(uint64(1_000_000_000_000), uint64(70_710_678_118), uint64(74_161_984_871), true, false)
+	f.Add(uint64(3_035_764_687_503), uint64(1), uint64(18_000_000_000_000_000_000), false, true)
+	f.Add(uint64(1), uint64(999_999_999), uint64(1_000_000_001), true, true)
+
+	f.Fuzz(func(t *testing.T, liquidityInt uint64, sqrtPriceAInt uint64, sqrtPriceBInt uint64, roundUp bool, isRemoved bool) {
+		if liquidityInt == 0 || sqrtPriceAInt == sqrtPriceBInt {
+			return
+		}
+		liquidity := sdk.NewDecFromBigIntWithPrec(new(big.Int).SetUint64(liquidityInt), 6)
+		sqrtPriceA, sqrtPriceB := fuzzSqrtPrice(sqrtPriceAInt), fuzzSqrtPrice(sqrtPriceBInt)
+		if sqrtPriceA.GT(sqrtPriceB) {
+			sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+		}
+		if isRemoved {
+			liquidity = liquidity.Neg()
+			roundUp = false
+		}
+
+		// the amounts are negative when liquidity is removed, their magnitude is what must favor the pool
+		liquidityBigDec := osmomath.BigDecFromSDKDec(liquidity.Abs())
+		diff := osmomath.BigDecFromSDKDec(sqrtPriceB.Sub(sqrtPriceA))
+		product := osmomath.BigDecFromSDKDec(sqrtPriceA).Mul(osmomath.BigDecFromSDKDec(sqrtPriceB))
+
+		// amount0 = liquidity * (sqrtPriceB - sqrtPriceA) / (sqrtPriceA * sqrtPriceB)
+		amount0 := math.CalcAmount0Delta(liquidity, sqrtPriceA, sqrtPriceB, roundUp)
+		exactAmount0 := exactAmountBound(liquidityBigDec.Mul(diff), product, roundUp)
+		require.NoError(t, osmomath.CheckInFavorOfPool(exactAmount0, osmomath.BigDecFromSDKDec(amount0.Abs()), roundUp))
+
+		// amount1 = liquidity * (sqrtPriceB - sqrtPriceA)
+		amount1 := math.CalcAmount1Delta(liquidity, sqrtPriceA, sqrtPriceB, roundUp)
+		exactAmount1 := liquidityBigDec.Mul(diff)
+		require.NoError(t, osmomath.CheckInFavorOfPool(exactAmount1, osmomath.BigDecFromSDKDec(amount1.Abs()), roundUp))
+	})
+}
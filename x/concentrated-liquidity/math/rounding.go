@@ -0,0 +1,82 @@
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Rounding computes the amounts and sqrt prices whose rounding changed when the concentrated liquidity math moved
+// to directed rounding in the v17 upgrade. If Legacy is set, they are computed with the rounding used before the
+// upgrade, so that the blocks before it are processed identically, e.g. when replaying them.
+// GetNextSqrtPriceFromAmount1InRoundingDown, GetNextSqrtPriceFromAmount1OutRoundingDown, SquareRoundUp and
+// SquareTruncate give the same results with both roundings for their non negative inputs.
+type Rounding struct {
+	Legacy bool
+}
+
+// CalcAmount0Delta is CalcAmount0Delta with the rounding r.
+func (r Rounding) CalcAmount0Delta(liq, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	if !r.Legacy {
+		return CalcAmount0Delta(liq, sqrtPriceA, sqrtPriceB, roundUp)
+	}
+
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	// The numerator is bankers rounded, and truncated towards zero when rounding down.
+	if roundUp {
+		denom := sqrtPriceA.MulTruncate(sqrtPriceB)
+		return liq.Mul(diff).QuoMut(denom).Ceil()
+	}
+	denom := sqrtPriceA.MulRoundUp(sqrtPriceB)
+	return liq.MulTruncate(diff).QuoTruncateMut(denom)
+}
+
+// CalcAmount1Delta is CalcAmount1Delta with the rounding r.
+func (r Rounding) CalcAmount1Delta(liq, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	if !r.Legacy {
+		return CalcAmount1Delta(liq, sqrtPriceA, sqrtPriceB, roundUp)
+	}
+
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	// The product is bankers rounded before being rounded up, and truncated towards zero when rounding down.
+	if roundUp {
+		return liq.Mul(diff).Ceil()
+	}
+	return liq.MulTruncate(diff)
+}
+
+// GetNextSqrtPriceFromAmount0InRoundingUp is GetNextSqrtPriceFromAmount0InRoundingUp with the rounding r.
+func (r Rounding) GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPriceCurrent, liquidity, amountZeroRemainingIn sdk.Dec) (sqrtPriceNext sdk.Dec) {
+	if !r.Legacy {
+		return GetNextSqrtPriceFromAmount0InRoundingUp(sqrtPriceCurrent, liquidity, amountZeroRemainingIn)
+	}
+
+	if amountZeroRemainingIn.Equal(sdk.ZeroDec()) {
+		return sqrtPriceCurrent
+	}
+
+	// The product and the numerator are bankers rounded.
+	product := amountZeroRemainingIn.Mul(sqrtPriceCurrent)
+	denominator := product.AddMut(liquidity)
+	return liquidity.Mul(sqrtPriceCurrent).QuoRoundupMut(denominator)
+}
+
+// GetNextSqrtPriceFromAmount0OutRoundingUp is GetNextSqrtPriceFromAmount0OutRoundingUp with the rounding r.
+func (r Rounding) GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPriceCurrent, liquidity, amountZeroRemainingOut sdk.Dec) (sqrtPriceNext sdk.Dec) {
+	if !r.Legacy {
+		return GetNextSqrtPriceFromAmount0OutRoundingUp(sqrtPriceCurrent, liquidity, amountZeroRemainingOut)
+	}
+
+	if amountZeroRemainingOut.Equal(sdk.ZeroDec()) {
+		return sqrtPriceCurrent
+	}
+
+	// The product and the numerator are bankers rounded.
+	product := amountZeroRemainingOut.Mul(sqrtPriceCurrent)
+	denominator := liquidity.Sub(product)
+	return liquidity.Mul(sqrtPriceCurrent).QuoRoundupMut(denominator)
+}
@@ -0,0 +1,108 @@
+package math_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
+)
+
+// TestRounding pins the results of the legacy and directed roundings at the boundary cases where they differ,
+// and at the ones of the functions whose results they do not change.
+func (suite *ConcentratedMathTestSuite) TestRounding() {
+	oneUnit := sdk.SmallestDec()
+	tests := map[string]struct {
+		compute          func(rounding math.Rounding) sdk.Dec
+		expectedLegacy   sdk.Dec
+		expectedDirected sdk.Dec
+	}{
+		// 10^-18 * (2 - 1) / (1 * 2) = 5 * 10^-19 is bankers rounded to 0 by the legacy rounding
+		"amount0 in: tie at precision end": {
+			compute: func(r math.Rounding) sdk.Dec {
+				return r.CalcAmount0Delta(oneUnit, sdk.OneDec(), sdk.NewDec(2), true)
+			},
+			expectedLegacy:   sdk.ZeroDec(),
+			expectedDirected: sdk.OneDec(),
+		},
+		"amount0 out: tie at precision end": {
+			compute: func(r math.Rounding) sdk.Dec {
+				return r.CalcAmount0Delta(oneUnit, sdk.OneDec(), sdk.NewDec(2), false)
+			},
+			expectedLegacy:   sdk.ZeroDec(),
+			expectedDirected: sdk.ZeroDec(),
+		},
+		// 10^-18 * 0.1 = 10^-19 is bankers rounded to 0 by the legacy rounding
+		"amount1 in: below half of the precision end": {
+			compute: func(r math.Rounding) sdk.Dec {
+				return r.CalcAmount1Delta(oneUnit, sdk.OneDec(), sdk.MustNewDecFromStr("1.1"), true)
+			},
+			expectedLegacy:   sdk.ZeroDec(),
+			expectedDirected: sdk.OneDec(),
+		},
+		"amount1 out: removed liquidity": {
+			compute: func(r math.Rounding) sdk.Dec {
+				return r.CalcAmount1Delta(oneUnit.Neg(), sdk.OneDec(), sdk.MustNewDecFromStr("1.1"), false)
+			},
+			expectedLegacy:   sdk.ZeroDec(),
+			expectedDirected: sdk.ZeroDec(),
+		},
+		// the numerator is rounded up rather than bankers rounded
+		"next sqrt price from amount0 in": {
+			compute: func(r math.Rounding) sdk.Dec {
+				return r.GetNextSqrtPriceFromAmount0InRoundingUp(sdk.MustNewDecFromStr("95.239269943977751033"), sdk.MustNewDecFromStr("784768.958558671279211059"), sdk.MustNewDecFromStr("646.671007516665427993"))
+			},
+			expectedLegacy:   sdk.MustNewDecFromStr("88.308815820858552621"),
+			expectedDirected: sdk.MustNewDecFromStr("88.308815820858552622"),
+		},
+		// the product is rounded up rather than bankers rounded, which lowers the denominator
+		"next sqrt price from amount0 out": {
+			compute: func(r math.Rounding) sdk.Dec {
+				return r.GetNextSqrtPriceFromAmount0OutRoundingUp(sdk.MustNewDecFromStr("75.669015452535804747"), sdk.MustNewDecFromStr("57423.150064726334180033"), sdk.MustNewDecFromStr("730.259334967971195799"))
+			},
+			expectedLegacy:   sdk.MustNewDecFromStr("2006.864622513293311261"),
+			expectedDirected: sdk.MustNewDecFromStr("2006.864622513293311262"),
+		},
+		"next sqrt price from amount0 in: zero amount": {
+			compute: func(r math.Rounding) sdk.Dec {
+				return r.GetNextSqrtPriceFromAmount0InRoundingUp(sdk.OneDec(), sdk.NewDec(3), sdk.ZeroDec())
+			},
+			expectedLegacy:   sdk.OneDec(),
+			expectedDirected: sdk.OneDec(),
+		},
+		// the functions below are not affected by the rounding
+		"next sqrt price from amount1 in": {
+			compute: func(math.Rounding) sdk.Dec {
+				return math.GetNextSqrtPriceFromAmount1InRoundingDown(sdk.OneDec(), sdk.NewDec(3), sdk.NewDec(2))
+			},
+			expectedLegacy:   sdk.MustNewDecFromStr("1.666666666666666666"),
+			expectedDirected: sdk.MustNewDecFromStr("1.666666666666666666"),
+		},
+		"next sqrt price from amount1 out": {
+			compute: func(math.Rounding) sdk.Dec {
+				return math.GetNextSqrtPriceFromAmount1OutRoundingDown(sdk.NewDec(2), sdk.NewDec(3), sdk.NewDec(2))
+			},
+			expectedLegacy:   sdk.MustNewDecFromStr("1.333333333333333333"),
+			expectedDirected: sdk.MustNewDecFromStr("1.333333333333333333"),
+		},
+		"square round up": {
+			compute: func(math.Rounding) sdk.Dec {
+				return math.SquareRoundUp(sdk.OneDec().Add(oneUnit))
+			},
+			expectedLegacy:   sdk.MustNewDecFromStr("1.000000000000000003"),
+			expectedDirected: sdk.MustNewDecFromStr("1.000000000000000003"),
+		},
+		"square truncate": {
+			compute: func(math.Rounding) sdk.Dec {
+				return math.SquareTruncate(sdk.OneDec().Add(oneUnit))
+			},
+			expectedLegacy:   sdk.MustNewDecFromStr("1.000000000000000002"),
+			expectedDirected: sdk.MustNewDecFromStr("1.000000000000000002"),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.Require().Equal(tc.expectedLegacy.String(), tc.compute(math.Rounding{Legacy: true}).String())
+			suite.Require().Equal(tc.expectedDirected.String(), tc.compute(math.Rounding{}).String())
+		})
+	}
+}
@@ -150,6 +150,33 @@ func PriceToTickRoundDown(price sdk.Dec, tickSpacing uint64) (int64, error) {
 	return tickIndex, nil
 }
 
+// SqrtPriceToTickRoundDownSpacing takes a sqrt price and returns the corresponding tick index, rounded down
+// to the nearest multiple of tickSpacing.
+// Squaring the sqrt price loses precision, so that a sqrt price right below the sqrt price of a tick can
+// square to the price of that tick. The tick is then decremented, as its sqrt price is above the sqrt price.
+func SqrtPriceToTickRoundDownSpacing(sqrtPrice sdk.Dec, tickSpacing uint64) (int64, error) {
+	tickIndex, err := PriceToTickRoundDown(sqrtPrice.Mul(sqrtPrice), tickSpacing)
+	if err != nil {
+		return 0, err
+	}
+
+	_, sqrtPriceTick, err := TickToSqrtPrice(tickIndex)
+	if err != nil {
+		return 0, err
+	}
+
+	if sqrtPriceTick.GT(sqrtPrice) {
+		tickIndex = tickIndex - int64(tickSpacing)
+	}
+
+	// Defense-in-depth check to ensure that the tick index is within the authorized range
+	if tickIndex < types.MinTick {
+		return 0, types.TickIndexNotWithinBoundariesError{ActualTick: tickIndex, MinTick: types.MinTick, MaxTick: types.MaxTick}
+	}
+
+	return tickIndex, nil
+}
+
 // powTen treats negative exponents as 1/(10**|exponent|) instead of 10**-exponent
 // This is because the sdk.Dec.Power function does not support negative exponents
 func PowTenInternal(exponent int64) sdk.Dec {
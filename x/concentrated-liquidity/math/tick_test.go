@@ -456,6 +456,60 @@ func (suite *ConcentratedMathTestSuite) TestPriceToTickRoundDown() {
 	}
 }
 
+func (suite *ConcentratedMathTestSuite) TestSqrtPriceToTickRoundDownSpacing() {
+	testCases := map[string]struct {
+		// the sqrt price is the sqrt price of the tick, minus sqrtPriceBelowTick.
+		tick               int64
+		sqrtPriceBelowTick sdk.Dec
+		tickSpacing        uint64
+		tickExpected       int64
+	}{
+		"tick spacing 100, sqrt price of 1": {
+			tick:               0,
+			sqrtPriceBelowTick: sdk.ZeroDec(),
+			tickSpacing:        defaultTickSpacing,
+			tickExpected:       0,
+		},
+		"tick spacing 100, sqrt price right below 1, tick 0 -> -100": {
+			tick:               0,
+			sqrtPriceBelowTick: sdk.SmallestDec(),
+			tickSpacing:        defaultTickSpacing,
+			tickExpected:       -100,
+		},
+		"tick spacing 1, sqrt price of tick -33116824": {
+			tick:               -33116824,
+			sqrtPriceBelowTick: sdk.ZeroDec(),
+			tickSpacing:        1,
+			tickExpected:       -33116824,
+		},
+		"tick spacing 1, sqrt price right below tick -33116824 squares to its price -> -33116825": {
+			tick:               -33116824,
+			sqrtPriceBelowTick: sdk.SmallestDec().MulInt64(10),
+			tickSpacing:        1,
+			tickExpected:       -33116825,
+		},
+		"tick spacing 100, sqrt price right below tick -33116800 squares to its price -> -33116900": {
+			tick:               -33116800,
+			sqrtPriceBelowTick: sdk.SmallestDec().MulInt64(10),
+			tickSpacing:        defaultTickSpacing,
+			tickExpected:       -33116900,
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+
+		suite.Run(name, func() {
+			_, sqrtPrice, err := math.TickToSqrtPrice(tc.tick)
+			suite.Require().NoError(err)
+
+			tick, err := math.SqrtPriceToTickRoundDownSpacing(sqrtPrice.Sub(tc.sqrtPriceBelowTick), tc.tickSpacing)
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.tickExpected, tick)
+		})
+	}
+}
+
 // TestTickToSqrtPricePriceToTick_InverseRelationship tests that ensuring the inverse calculation
 // between the two methods: tick to square root price to power of 2 and price to tick
 func (suite *ConcentratedMathTestSuite) TestTickToSqrtPricePriceToTick_InverseRelationship() {
@@ -99,16 +99,17 @@ func (k Keeper) getPoolById(ctx sdk.Context, poolId uint64) (types.ConcentratedP
 }
 
 func (k Keeper) GetPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error) {
-	return osmoutils.GatherValuesFromStorePrefix(
-		ctx.KVStore(k.storeKey), types.PoolPrefix, func(value []byte) (poolmanagertypes.PoolI, error) {
-			pool := model.Pool{}
-			err := k.cdc.Unmarshal(value, &pool)
-			if err != nil {
-				return nil, err
-			}
-			return &pool, nil
-		},
-	)
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PoolPrefix, k.parsePoolFromBz)
+}
+
+// parsePoolFromBz parses and returns a pool from a byte array.
+func (k Keeper) parsePoolFromBz(value []byte) (poolmanagertypes.PoolI, error) {
+	pool := model.Pool{}
+	err := k.cdc.Unmarshal(value, &pool)
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
 }
 
 // setPool stores a ConcentratedPoolExtension in the Keeper's KVStore.
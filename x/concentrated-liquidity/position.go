@@ -14,6 +14,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
@@ -255,7 +256,7 @@ func (k Keeper) GetUserPositionsSerialized(ctx sdk.Context, addr sdk.AccAddress,
 			return err
 		}
 
-		asset0, asset1, err := CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+		asset0, asset1, err := k.CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
 		if err != nil {
 			return err
 		}
@@ -521,7 +522,44 @@ func (k Keeper) mintSharesAndLock(ctx sdk.Context, concentratedPoolId, positionI
 	return concentratedLock.ID, underlyingLiquidityTokenized, nil
 }
 
-func CalculateUnderlyingAssetsFromPosition(ctx sdk.Context, position model.Position, pool types.ConcentratedPoolExtension) (sdk.Coin, sdk.Coin, error) {
+// calcActualAmounts returns the amounts of the pool assets added or removed with liquidityDelta in the given tick range,
+// as computed by CalcActualAmounts of the pool.
+// Until the v17 upgrade enables directed rounding, the amounts are computed with the rounding of the blocks before it.
+func (k Keeper) calcActualAmounts(ctx sdk.Context, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, liquidityDelta sdk.Dec) (actualAmountDenom0 sdk.Dec, actualAmountDenom1 sdk.Dec, err error) {
+	if !k.useLegacyRounding(ctx) {
+		return pool.CalcActualAmounts(ctx, lowerTick, upperTick, liquidityDelta)
+	}
+
+	// N.B.: this mirrors CalcActualAmounts of the pool model, with the legacy rounding.
+	if liquidityDelta.IsZero() {
+		return sdk.Dec{}, sdk.Dec{}, types.ErrZeroLiquidity
+	}
+	if lowerTick >= upperTick {
+		return sdk.Dec{}, sdk.Dec{}, types.InvalidLowerUpperTickError{LowerTick: lowerTick, UpperTick: upperTick}
+	}
+	_, _, sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	roundUp := liquidityDelta.IsPositive()
+	rounding := math.Rounding{Legacy: true}
+	currentTick := pool.GetCurrentTick()
+	if currentTick >= lowerTick && currentTick < upperTick {
+		currentSqrtPrice := pool.GetCurrentSqrtPrice()
+		actualAmountDenom0 = rounding.CalcAmount0Delta(liquidityDelta, currentSqrtPrice, sqrtPriceUpperTick, roundUp)
+		actualAmountDenom1 = rounding.CalcAmount1Delta(liquidityDelta, currentSqrtPrice, sqrtPriceLowerTick, roundUp)
+	} else if currentTick < lowerTick {
+		actualAmountDenom0 = rounding.CalcAmount0Delta(liquidityDelta, sqrtPriceLowerTick, sqrtPriceUpperTick, roundUp)
+		actualAmountDenom1 = sdk.ZeroDec()
+	} else {
+		actualAmountDenom0 = sdk.ZeroDec()
+		actualAmountDenom1 = rounding.CalcAmount1Delta(liquidityDelta, sqrtPriceLowerTick, sqrtPriceUpperTick, roundUp)
+	}
+	return actualAmountDenom0, actualAmountDenom1, nil
+}
+
+func (k Keeper) CalculateUnderlyingAssetsFromPosition(ctx sdk.Context, position model.Position, pool types.ConcentratedPoolExtension) (sdk.Coin, sdk.Coin, error) {
 	token0 := pool.GetToken0()
 	token1 := pool.GetToken1()
 
@@ -530,7 +568,7 @@ func CalculateUnderlyingAssetsFromPosition(ctx sdk.Context, position model.Posit
 	}
 
 	// Calculate the amount of underlying assets in the position
-	asset0, asset1, err := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, position.Liquidity)
+	asset0, asset1, err := k.calcActualAmounts(ctx, pool, position.LowerTick, position.UpperTick, position.Liquidity)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
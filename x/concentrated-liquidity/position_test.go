@@ -697,7 +697,7 @@ func (s *KeeperTestSuite) TestGetUserPositionsSerialized() {
 					positionPool, err := k.GetConcentratedPoolById(s.Ctx, position.PoolId)
 					s.Require().NoError(err)
 
-					asset0, asset1, err := cl.CalculateUnderlyingAssetsFromPosition(s.Ctx, position, positionPool)
+					asset0, asset1, err := s.App.ConcentratedLiquidityKeeper.CalculateUnderlyingAssetsFromPosition(s.Ctx, position, positionPool)
 					s.Require().NoError(err)
 
 					claimableSpreadRewards, err := k.GetClaimableSpreadRewards(s.Ctx, pos.positionId)
@@ -942,7 +942,7 @@ func (s *KeeperTestSuite) TestCalculateUnderlyingAssetsFromPosition() {
 			// calculate underlying assets from the position
 			clPool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, tc.position.PoolId)
 			s.Require().NoError(err)
-			calculatedCoin0, calculatedCoin1, err := cl.CalculateUnderlyingAssetsFromPosition(s.Ctx, tc.position, clPool)
+			calculatedCoin0, calculatedCoin1, err := s.App.ConcentratedLiquidityKeeper.CalculateUnderlyingAssetsFromPosition(s.Ctx, tc.position, clPool)
 
 			s.Require().NoError(err)
 			s.Require().Equal(calculatedCoin0.String(), sdk.NewCoin(clPool.GetToken0(), actualAmount0).String())
@@ -113,6 +113,16 @@ func RandMsgWithdrawPosition(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sd
 		return nil, err
 	}
 
+	// positions with an immature underlying lock are withdrawn through the superfluid module
+	hasActiveUnderlyingLock, _, err := k.PositionHasActiveUnderlyingLock(ctx, position.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if hasActiveUnderlyingLock {
+		return nil, fmt.Errorf("position %d has an active underlying lock", position.PositionId)
+	}
+
 	withdrawAmount := sim.RandomDecAmount(position.Liquidity)
 	if withdrawAmount.TruncateDec().LT(sdk.ZeroDec()) {
 		return nil, fmt.Errorf("Invalid withdraw amount")
@@ -129,6 +139,71 @@ func RandMsgWithdrawPosition(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sd
 	}, nil
 }
 
+func RandMsgAddToPosition(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgAddToPosition, error) {
+	rand := sim.GetRand()
+	// get random pool
+	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// adding to the last position of a pool is not allowed, as the position is withdrawn before being re-created
+	positionIds, err := k.GetAllPositionIdsForPoolId(ctx, cltypes.PositionPrefix, clPool.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(positionIds) < 2 {
+		return nil, fmt.Errorf("pool %d has less than two positions", clPool.GetId())
+	}
+
+	position, err := k.GetPosition(ctx, positionIds[rand.Intn(len(positionIds))])
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := sim.FindAccount(owner); !found {
+		return nil, fmt.Errorf("position owner %s is not a simulation account", owner)
+	}
+
+	// superfluid staked positions are added to through the superfluid module
+	hasActiveUnderlyingLock, _, err := k.PositionHasActiveUnderlyingLock(ctx, position.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if hasActiveUnderlyingLock {
+		return nil, fmt.Errorf("position %d is superfluid staked", position.PositionId)
+	}
+
+	tokens := sim.RandCoinSubset(ctx, owner, poolDenoms)
+	if tokens.Empty() {
+		return nil, fmt.Errorf("position owner does not have pool tokens")
+	}
+
+	msg := &cltypes.MsgAddToPosition{
+		PositionId:      position.PositionId,
+		Sender:          position.Address,
+		Amount0:         tokens.AmountOf(clPool.GetToken0()),
+		Amount1:         tokens.AmountOf(clPool.GetToken1()),
+		TokenMinAmount0: sdk.ZeroInt(),
+		TokenMinAmount1: sdk.ZeroInt(),
+	}
+
+	// the re-created position must get at least the withdrawn amounts,
+	// which the added amounts do not guarantee at the current price
+	if err := sim.CheckMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
 func RandMsgCollectSpreadRewards(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgCollectSpreadRewards, error) {
 	// get random pool
 	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
@@ -345,12 +420,12 @@ func RandomPreparePoolFunc(sim *osmosimtypes.SimCtx, ctx sdk.Context, k clkeeper
 		return nil, sdk.Coin{}, sdk.Coin{}, 0, sdk.Dec{}, errors.New("chose an account / creation amount that didn't pass fee limit")
 	}
 
-	if poolCoins[0].Denom == sdk.DefaultBondDenom || poolCoins[1].Denom == sdk.DefaultBondDenom {
-		return nil, sdk.Coin{}, sdk.Coin{}, 0, sdk.Dec{}, fmt.Errorf("poolCoins contains denom stake which contains invalid metadata")
-	}
-
 	coin0 := poolCoins[0]
 	coin1 := poolCoins[1]
+	// the stake denom is always kept last by the subset, pair it in some pools so that their shares can be superfluid staked
+	if poolCoins[2].Denom == sdk.DefaultBondDenom && sim.RandIntBetween(0, 3) == 0 {
+		coin1 = poolCoins[2]
+	}
 	tickSpacing := authorizedTickSpacing[rand.Intn(len(authorizedTickSpacing))]
 	spreadFactor := authorizedSpreadFactor[rand.Intn(len(authorizedSpreadFactor))]
 
@@ -373,6 +448,11 @@ func RandomPrepareCreatePositionFunc(sim *osmosimtypes.SimCtx, ctx sdk.Context,
 	//  Retrieve minTick and maxTick from kprecision factor
 	minTick, maxTick := cltypes.MinTick, cltypes.MaxTick
 
+	// full range positions are the only ones backing superfluid staking
+	if sim.RandIntBetween(0, 5) == 0 {
+		return positionCreator.Address, tokens, minTick, maxTick, nil
+	}
+
 	// Randomize lowerTick and upperTick from max values to create position
 	lowerTick, upperTick, err := getRandomTickPositions(sim, minTick, maxTick, clPool.GetTickSpacing())
 	if err != nil {
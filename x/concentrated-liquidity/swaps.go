@@ -277,6 +277,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		asset0                 = p.GetToken0()
 		asset1                 = p.GetToken1()
 		tokenAmountInSpecified = tokenInMin.Amount.ToDec()
+		swapFixesEnabled       = k.swapFixesEnabled(ctx)
 	)
 
 	// If swapping asset0 for asset1, zeroForOne is true
@@ -296,7 +297,7 @@ func (k Keeper) computeOutAmtGivenIn(
 	}
 
 	// Set the swap strategy
-	swapStrategy := swapstrategy.New(zeroForOne, sqrtPriceLimit, k.storeKey, spreadFactor, p.GetTickSpacing())
+	swapStrategy := swapstrategy.New(zeroForOne, sqrtPriceLimit, k.storeKey, spreadFactor, p.GetTickSpacing(), math.Rounding{Legacy: k.useLegacyRounding(ctx)})
 
 	// Get current sqrt price from pool and run sanity check that current sqrt price is
 	// on the correct side of the price limit given swap direction.
@@ -387,6 +388,14 @@ func (k Keeper) computeOutAmtGivenIn(
 			swapState.amountSpecifiedRemaining,
 		)
 
+		// Without spread factor, an amount remaining too small to move the sqrt price at the liquidity
+		// of the bucket is not consumed, so that no progress can be made anymore. It is not charged.
+		// A swap starting on the next initialized tick makes no progress either but still has to cross it.
+		// Before the v17 upgrade, the swap kept looping on it.
+		if swapFixesEnabled && sqrtPrice.Equal(sqrtPriceStart) && !sqrtPrice.Equal(nextTickSqrtPrice) && amountIn.IsZero() && spreadRewardCharge.IsZero() {
+			break
+		}
+
 		// Update the spread reward growth for the entire swap using the total spread factors charged.
 		swapState.updateSpreadRewardGrowthGlobal(spreadRewardCharge)
 
@@ -437,8 +446,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		} else if !sqrtPriceStart.Equal(sqrtPrice) {
 			// Otherwise if the sqrtPrice calculated from computeSwapStep does not equal the sqrtPrice we started with at the
 			// beginning of this iteration, we set the swapState tick to the corresponding tick of the sqrtPrice calculated from computeSwapStep
-			price := sqrtPrice.Mul(sqrtPrice)
-			swapState.tick, err = math.PriceToTickRoundDown(price, tickSpacing)
+			swapState.tick, err = sqrtPriceToTickRoundDownSpacing(sqrtPrice, tickSpacing, swapFixesEnabled)
 			if err != nil {
 				return sdk.Coin{}, sdk.Coin{}, 0, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
 			}
@@ -493,6 +501,7 @@ func (k Keeper) computeInAmtGivenOut(
 		asset0                  = p.GetToken0()
 		asset1                  = p.GetToken1()
 		tokenAmountOutSpecified = desiredTokenOut.Amount.ToDec()
+		swapFixesEnabled        = k.swapFixesEnabled(ctx)
 	)
 
 	// if swapping asset0 (in) for asset1 (out), zeroForOne is true
@@ -512,7 +521,7 @@ func (k Keeper) computeInAmtGivenOut(
 	}
 
 	// set the swap strategy
-	swapStrategy := swapstrategy.New(zeroForOne, sqrtPriceLimit, k.storeKey, spreadFactor, tickSpacing)
+	swapStrategy := swapstrategy.New(zeroForOne, sqrtPriceLimit, k.storeKey, spreadFactor, tickSpacing, math.Rounding{Legacy: k.useLegacyRounding(ctx)})
 
 	// get current sqrt price from pool
 	curSqrtPrice := p.GetCurrentSqrtPrice()
@@ -597,6 +606,14 @@ func (k Keeper) computeInAmtGivenOut(
 			swapState.amountSpecifiedRemaining,
 		)
 
+		// An amount remaining too small to move the sqrt price at the liquidity of the bucket gives no amount out,
+		// so that no progress can be made anymore. Nothing is charged, as the amount in is zero.
+		// A swap starting on the next initialized tick makes no progress either but still has to cross it.
+		// Before the v17 upgrade, the swap kept looping on it.
+		if swapFixesEnabled && sqrtPrice.Equal(sqrtPriceStart) && !sqrtPrice.Equal(sqrtPriceNextTick) && amountOut.IsZero() {
+			break
+		}
+
 		swapState.updateSpreadRewardGrowthGlobal(spreadRewardChargeTotal)
 
 		ctx.Logger().Debug("cl calc in given out")
@@ -643,8 +660,7 @@ func (k Keeper) computeInAmtGivenOut(
 		} else if !sqrtPriceStart.Equal(sqrtPrice) {
 			// otherwise if the sqrtPrice calculated from computeSwapStep does not equal the sqrtPrice we started with at the
 			// beginning of this iteration, we set the swapState tick to the corresponding tick of the sqrtPrice calculated from computeSwapStep
-			price := sqrtPrice.Mul(sqrtPrice)
-			swapState.tick, err = math.PriceToTickRoundDown(price, tickSpacing)
+			swapState.tick, err = sqrtPriceToTickRoundDownSpacing(sqrtPrice, tickSpacing, swapFixesEnabled)
 			if err != nil {
 				return sdk.Coin{}, sdk.Coin{}, 0, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
 			}
@@ -748,3 +764,13 @@ func (k Keeper) updatePoolForSwap(
 
 	return err
 }
+
+// sqrtPriceToTickRoundDownSpacing returns the tick of the sqrt price reached by a swap step, rounded down to the tick spacing.
+// Before the v17 upgrade enabled the swap fixes, it was computed from the rounded square of the sqrt price, which can
+// give a tick whose sqrt price lies above the sqrt price.
+func sqrtPriceToTickRoundDownSpacing(sqrtPrice sdk.Dec, tickSpacing uint64, swapFixesEnabled bool) (int64, error) {
+	if !swapFixesEnabled {
+		return math.PriceToTickRoundDown(sqrtPrice.Mul(sqrtPrice), tickSpacing)
+	}
+	return math.SqrtPriceToTickRoundDownSpacing(sqrtPrice, tickSpacing)
+}
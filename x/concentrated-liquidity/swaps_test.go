@@ -1627,6 +1627,150 @@ func (s *KeeperTestSuite) TestSwap_NoPositions() {
 	s.Require().ErrorIs(err, types.NoSpotPriceWhenNoLiquidityError{PoolId: pool.GetId()})
 }
 
+// TestSwapOutAmtGivenIn_AmountTooSmallToMovePrice tests that swapping an amount too small to move the sqrt price
+// in a pool without spread factor terminates once the swap fixes are enabled, as nothing of the amount in can be consumed.
+func (s *KeeperTestSuite) TestSwapOutAmtGivenIn_AmountTooSmallToMovePrice() {
+	s.SetupTest()
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, sdk.ZeroDec())
+
+	// a liquidity of about 10^24 around a sqrt price of 1 needs more than 10^6 of token one to move the sqrt price.
+	largeAmount := sdk.NewIntWithDecimal(1, 24)
+	s.CreateFullRangePosition(pool, sdk.NewCoins(sdk.NewCoin(ETH, largeAmount), sdk.NewCoin(USDC, largeAmount)))
+
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	_, _, _, _, _, err = s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(
+		s.Ctx, s.TestAccs[0], pool,
+		sdk.NewCoin(USDC, sdk.NewInt(1_000)), ETH,
+		sdk.ZeroDec(), types.MaxSpotPrice,
+	)
+
+	s.Require().ErrorContains(err, types.InvalidAmountCalculatedError{Amount: sdk.ZeroInt()}.Error())
+}
+
+// TestSwapInAmtGivenOut_AmountTooSmallToMovePrice tests that an in given out swap terminates once the amount out
+// remaining is too small to move the sqrt price. With legacy rounding, crossing two ticks can leave an amount of
+// 2 * 10^-18 of token zero, which gives no amount out at a liquidity of 1. The swap fixes terminate the swap on it
+// rather than looping.
+func (s *KeeperTestSuite) TestSwapInAmtGivenOut_AmountTooSmallToMovePrice() {
+	s.SetupTest()
+	s.Ctx.KVStore(s.App.GetKey(types.StoreKey)).Delete(types.KeyDirectedRoundingEnabled)
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, 1, sdk.ZeroDec())
+	s.CreateFullRangePosition(pool, DefaultCoins)
+
+	pool, err := s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	var (
+		firstTick             = int64(-15000000)
+		secondTick            = int64(-14999976)
+		liquidityStart        = sdk.NewDec(2)
+		liquidityBetweenTicks = sdk.MustNewDecFromStr("31579.089473650000176755")
+		liquidityEnd          = sdk.OneDec()
+	)
+
+	// Move the pool below the first tick, with a liquidity of 2 until the first tick,
+	// of liquidityBetweenTicks until the second tick and of 1 above it.
+	sqrtPriceStart := sdk.MustNewDecFromStr("0.19")
+	tickStart, err := math.SqrtPriceToTickRoundDownSpacing(sqrtPriceStart, pool.GetTickSpacing())
+	s.Require().NoError(err)
+	pool.SetCurrentSqrtPrice(sqrtPriceStart)
+	pool.SetCurrentTick(tickStart)
+	pool.UpdateLiquidity(liquidityStart.Sub(pool.GetLiquidity()))
+	s.Require().NoError(s.clk.SetPool(s.Ctx, pool))
+
+	s.Require().NoError(s.clk.InitOrUpdateTick(s.Ctx, pool.GetId(), tickStart, firstTick, liquidityBetweenTicks.Sub(liquidityStart), false))
+	s.Require().NoError(s.clk.InitOrUpdateTick(s.Ctx, pool.GetId(), tickStart, secondTick, liquidityBetweenTicks.Sub(liquidityEnd), true))
+
+	_, _, tick, liquidity, sqrtPrice, _, err := s.clk.ComputeInAmtGivenOut(s.Ctx, sdk.NewCoin(ETH, sdk.OneInt()), USDC, sdk.ZeroDec(), types.MaxSpotPrice, pool.GetId())
+	s.Require().NoError(err)
+
+	_, sqrtPriceSecondTick, err := math.TickToSqrtPrice(secondTick)
+	s.Require().NoError(err)
+	s.Require().Equal(secondTick, tick)
+	s.Require().Equal(liquidityEnd, liquidity)
+	s.Require().Equal(sqrtPriceSecondTick, sqrtPrice)
+}
+
+// TestSwap_TickFromSqrtPrice tests that the tick reached by a swap is computed from the sqrt price once the
+// swap fixes are enabled, and from its rounded square before.
+func (s *KeeperTestSuite) TestSwap_TickFromSqrtPrice() {
+	tests := map[string]struct {
+		swapFixesEnabled  bool
+		tokenIn           sdk.Coin
+		tokenOut          sdk.Coin
+		expectedSqrtPrice sdk.Dec
+		expectedTick      int64
+	}{
+		// the sqrt price reached is below the sqrt price of tick -30099999.
+		"out given in, swap fixes enabled": {
+			swapFixesEnabled:  true,
+			tokenIn:           sdk.NewCoin(USDC, sdk.NewInt(500049986589)),
+			expectedSqrtPrice: sdk.MustNewDecFromStr("0.026267852976594792"),
+			expectedTick:      -30100000,
+		},
+		// the square of the sqrt price reached rounds to the price of tick -30099999.
+		"out given in, before the v17 upgrade": {
+			swapFixesEnabled:  false,
+			tokenIn:           sdk.NewCoin(USDC, sdk.NewInt(500049986589)),
+			expectedSqrtPrice: sdk.MustNewDecFromStr("0.026267852976594792"),
+			expectedTick:      -30099999,
+		},
+		"in given out, swap fixes enabled": {
+			swapFixesEnabled:  true,
+			tokenOut:          sdk.NewCoin(ETH, sdk.NewInt(724637601975317)),
+			expectedSqrtPrice: sdk.MustNewDecFromStr("0.026267852976594793"),
+			expectedTick:      -30100000,
+		},
+		"in given out, before the v17 upgrade": {
+			swapFixesEnabled:  false,
+			tokenOut:          sdk.NewCoin(ETH, sdk.NewInt(724637601975317)),
+			expectedSqrtPrice: sdk.MustNewDecFromStr("0.026267852976594793"),
+			expectedTick:      -30099999,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			if !tc.swapFixesEnabled {
+				s.Ctx.KVStore(s.App.GetKey(types.StoreKey)).Delete(types.KeySwapFixesEnabled)
+			}
+
+			// a full range position at a price of 0.00069 with a liquidity above 10^18, so that the sqrt price
+			// can be moved by less than 10^-18. The spread factor charges the amount too small to move the sqrt
+			// price, on which the swap would loop before the v17 upgrade.
+			spreadFactor := sdk.MustNewDecFromStr("0.0001")
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, 1, spreadFactor)
+			s.CreateFullRangePosition(pool, sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewIntWithDecimal(1, 22)), sdk.NewCoin(USDC, sdk.NewIntWithDecimal(69, 17))))
+
+			pool, err := s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(int64(-30100000), pool.GetCurrentTick())
+
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(USDC, sdk.NewIntWithDecimal(1, 12))))
+			if tc.tokenOut.IsNil() {
+				_, _, _, _, _, err = s.clk.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[0], pool, tc.tokenIn, ETH, spreadFactor, sdk.ZeroDec())
+			} else {
+				_, _, _, _, _, err = s.clk.SwapInAmtGivenOut(s.Ctx, s.TestAccs[0], pool, tc.tokenOut, USDC, spreadFactor, sdk.ZeroDec())
+			}
+			s.Require().NoError(err)
+
+			pool, err = s.clk.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedSqrtPrice, pool.GetCurrentSqrtPrice())
+			s.Require().Equal(tc.expectedTick, pool.GetCurrentTick())
+
+			_, sqrtPriceTick, err := math.TickToSqrtPrice(pool.GetCurrentTick())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.swapFixesEnabled, sqrtPriceTick.LTE(pool.GetCurrentSqrtPrice()))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestSwapOutAmtGivenIn_TickUpdates() {
 	tests := makeTests(swapOutGivenInCases)
 	for name, test := range tests {
@@ -2,14 +2,17 @@ package swapstrategy_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
 	cl "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/swapstrategy"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 )
@@ -212,7 +215,7 @@ func (suite *StrategyTestSuite) TestComputeSwapState_Inverse() {
 	for name, tc := range testCases {
 		tc := tc
 		suite.Run(name, func() {
-			sut := swapstrategy.New(tc.zeroForOne, sdk.ZeroDec(), suite.App.GetKey(types.ModuleName), sdk.ZeroDec(), defaultTickSpacing)
+			sut := swapstrategy.New(tc.zeroForOne, sdk.ZeroDec(), suite.App.GetKey(types.ModuleName), sdk.ZeroDec(), defaultTickSpacing, math.Rounding{})
 			sqrtPriceNextOutGivenIn, amountInOutGivenIn, amountOutOutGivenIn, _ := sut.ComputeSwapStepOutGivenIn(tc.sqrtPriceCurrent, tc.sqrtPriceTarget, tc.liquidity, tc.amountIn)
 			suite.Require().Equal(tc.expectedSqrtPriceNextOutGivenIn.String(), sqrtPriceNextOutGivenIn.String())
 
@@ -258,3 +261,60 @@ func (suite *StrategyTestSuite) TestGetPriceLimit() {
 		})
 	}
 }
+
+// fuzzDec maps a fuzzed integer to a positive decimal with the given precision.
+func fuzzDec(i uint64, prec int64) sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(new(big.Int).SetUint64(i), prec).Add(sdk.SmallestDec())
+}
+
+// requireSwapStepInFavorOfPool checks that the amounts of a swap step moving the sqrt price from
+// sqrtPriceCurrent to sqrtPriceNext are rounded in favor of the pool: the amount in is at least the exact amount
+// required by the move, and the amount out is at most the exact amount released by it.
+func requireSwapStepInFavorOfPool(t *testing.T, zeroForOne bool, liquidity, sqrtPriceCurrent, sqrtPriceNext, amountIn, amountOut sdk.Dec) {
+	sqrtPriceA, sqrtPriceB := sqrtPriceNext, sqrtPriceCurrent
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	// liquidity * (sqrtPriceB - sqrtPriceA) is exact in BigDec, amount0 additionally divides it by sqrtPriceA * sqrtPriceB.
+	amountOneNumerator := osmomath.BigDecFromSDKDec(liquidity).Mul(osmomath.BigDecFromSDKDec(sqrtPriceB.Sub(sqrtPriceA)))
+	sqrtPriceProduct := osmomath.BigDecFromSDKDec(sqrtPriceA).Mul(osmomath.BigDecFromSDKDec(sqrtPriceB))
+	exactAmount := func(isAmountZero, isAmountIn bool) osmomath.BigDec {
+		if !isAmountZero {
+			return amountOneNumerator
+		}
+		return amountOneNumerator.QuoWithRounding(sqrtPriceProduct, osmomath.RoundingDirectionInFavorOfPool(isAmountIn))
+	}
+
+	require.NoError(t, osmomath.CheckInFavorOfPool(exactAmount(zeroForOne, true), osmomath.BigDecFromSDKDec(amountIn), true))
+	require.NoError(t, osmomath.CheckInFavorOfPool(exactAmount(!zeroForOne, false), osmomath.BigDecFromSDKDec(amountOut), false))
+}
+
+// FuzzComputeSwapStepInFavorOfPool checks that swap steps in both directions, given either the amount in
+// or the amount out, never round in favor of the user.
+func FuzzComputeSwapStepInFavorOfPool(f *testing.F) {
+	f.Add(uint64(70_710_678_118), uint64(70_688_664_163), uint64(3_035_764_687_503_020), uint64(13_370_000_000))
+	f.Add(uint64(1), uint64(18_000_000_000_000_000_000), uint64(1_000_000), uint64(1))
+	f.Add(uint64(999_999_999), uint64(1_000_000_001), uint64(1), uint64(42_000_000))
+
+	f.Fuzz(func(t *testing.T, sqrtPriceCurrentInt, sqrtPriceTargetInt, liquidityInt, amountRemainingInt uint64) {
+		if sqrtPriceCurrentInt == sqrtPriceTargetInt {
+			return
+		}
+		// sqrt prices in [10^-6, 1.8 * 10^10], within the supported sqrt prices.
+		sqrtPriceCurrent := fuzzDec(sqrtPriceCurrentInt, 9).Add(sdk.NewDecWithPrec(1, 6))
+		sqrtPriceTarget := fuzzDec(sqrtPriceTargetInt, 9).Add(sdk.NewDecWithPrec(1, 6))
+		liquidity := fuzzDec(liquidityInt, 6)
+		amountRemaining := fuzzDec(amountRemainingInt, 6)
+		zeroForOne := sqrtPriceTarget.LT(sqrtPriceCurrent)
+
+		strategy := swapstrategy.New(zeroForOne, sqrtPriceTarget, nil, zero, defaultTickSpacing, math.Rounding{})
+
+		sqrtPriceNext, amountIn, amountOut, spreadRewardCharge := strategy.ComputeSwapStepOutGivenIn(sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemaining)
+		require.True(t, spreadRewardCharge.IsZero())
+		requireSwapStepInFavorOfPool(t, zeroForOne, liquidity, sqrtPriceCurrent, sqrtPriceNext, amountIn, amountOut)
+
+		sqrtPriceNext, amountOut, amountIn, spreadRewardCharge = strategy.ComputeSwapStepInGivenOut(sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemaining)
+		require.True(t, spreadRewardCharge.IsZero())
+		requireSwapStepInFavorOfPool(t, zeroForOne, liquidity, sqrtPriceCurrent, sqrtPriceNext, amountIn, amountOut)
+	})
+}
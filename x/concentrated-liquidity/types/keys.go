@@ -45,6 +45,12 @@ var (
 	LockToPositionPrefix                  = []byte{0x10}
 	ConcentratedLockPrefix                = []byte{0x11}
 
+	// KeyDirectedRoundingEnabled is set once the math uses directed rounding, since the v17 upgrade
+	KeyDirectedRoundingEnabled = []byte{0x12}
+	// KeySwapFixesEnabled is set once swaps compute their tick from the sqrt price and stop on amounts too small
+	// to move it, since the v17 upgrade
+	KeySwapFixesEnabled = []byte{0x13}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -0,0 +1,238 @@
+package cosmwasmpool
+
+import (
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+)
+
+// sudoWithGasLimit executes the given sudo message on the pool contract, capping the gas it
+// may consume at the SudoGasLimit parameter. Rejects the call if the pool is disabled.
+// See runWithGasLimit for how failures are handled.
+func sudoWithGasLimit[T any, K any](ctx sdk.Context, k Keeper, pool types.CosmWasmExtension, request T) (response K, err error) {
+	if !pool.IsActive(ctx) {
+		return response, types.PoolDisabledError{PoolId: pool.GetId()}
+	}
+
+	err = k.runWithGasLimit(ctx, pool.GetId(), k.GetParams(ctx).SudoGasLimit, func(ctx sdk.Context) error {
+		response, err = cosmwasm.Sudo[T, K](ctx, k.contractKeeper, pool.GetContractAddress(), request)
+		return err
+	})
+	return response, err
+}
+
+// queryWithGasLimit queries the pool contract, capping the gas it may consume at the QueryGasLimit
+// parameter. Queries are allowed on disabled pools so that their state can still be inspected.
+// See runWithGasLimit for how failures are handled.
+func queryWithGasLimit[T any, K any](ctx sdk.Context, k Keeper, pool types.CosmWasmExtension, request T) (response K, err error) {
+	err = k.runWithGasLimit(ctx, pool.GetId(), k.GetParams(ctx).QueryGasLimit, func(ctx sdk.Context) error {
+		response, err = cosmwasm.Query[T, K](ctx, k.wasmKeeper, pool.GetContractAddress(), request)
+		return err
+	})
+	return response, err
+}
+
+// runWithGasLimit runs f in a cache context with a gas meter limited to gasLimit, writing its state
+// changes only if it succeeds. The gas consumed by f is charged to ctx.
+//
+// If f exceeds gasLimit or panics, the panic is recovered, an error is returned and the failure is
+// recorded against the pool. Errors returned by the contract itself (e.g. slippage) are not counted
+// as failures since any user can trigger them. See EndBlock for how failures disable the pool.
+//
+// If the gas remaining in ctx is below gasLimit, f is limited by the remaining gas instead and running
+// out of it panics as it would without the cap, without being counted against the pool.
+//
+// Failures and successes are collected outside of ctx, so that they are counted even if the tx they
+// occur in fails and is reverted.
+func (k Keeper) runWithGasLimit(ctx sdk.Context, poolId uint64, gasLimit uint64, f func(ctx sdk.Context) error) (err error) {
+	limitedByPool := true
+	if parentLimit := ctx.GasMeter().Limit(); parentLimit != 0 {
+		remaining := uint64(0)
+		if consumed := ctx.GasMeter().GasConsumed(); consumed < parentLimit {
+			remaining = parentLimit - consumed
+		}
+		if remaining < gasLimit {
+			gasLimit = remaining
+			limitedByPool = false
+		}
+	}
+
+	childGasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx, write := ctx.WithGasMeter(childGasMeter).CacheContext()
+
+	defer func() {
+		recoveryError := recover()
+
+		// Charge the gas consumed by the call. This panics if ctx runs out of gas.
+		ctx.GasMeter().ConsumeGas(childGasMeter.GasConsumedToLimit(), "cosmwasm pool contract call")
+
+		if recoveryError == nil {
+			return
+		}
+
+		if isOutOfGas, _ := osmoutils.IsOutOfGasError(recoveryError); isOutOfGas {
+			if !limitedByPool {
+				panic(recoveryError)
+			}
+			err = types.PoolGasLimitExceededError{PoolId: poolId, GasLimit: gasLimit}
+		} else {
+			osmoutils.PrintPanicRecoveryError(ctx, recoveryError)
+			err = types.PoolPanicError{PoolId: poolId}
+		}
+		k.recordPoolFailure(ctx, poolId)
+	}()
+
+	if err := f(cacheCtx); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	k.recordPoolSuccess(ctx, poolId)
+	return nil
+}
+
+// GetPoolFailures returns the number of consecutive failed contract calls of the given pool.
+func (k Keeper) GetPoolFailures(ctx sdk.Context, poolId uint64) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.FormatPoolFailuresPrefix(poolId))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// poolCallResults holds the pool contract call failures and successes delivered at a block height.
+type poolCallResults struct {
+	height    int64
+	failures  map[uint64]uint64
+	successes map[uint64]bool
+}
+
+func newPoolCallResults() *poolCallResults {
+	r := &poolCallResults{}
+	r.reset(0)
+	return r
+}
+
+// reset drops the collected results and starts collecting the results of the given height.
+func (r *poolCallResults) reset(height int64) {
+	r.height = height
+	r.failures = map[uint64]uint64{}
+	r.successes = map[uint64]bool{}
+}
+
+// atHeight returns the results collected at the given height, dropping the results of any previous height.
+// Those were collected after the EndBlock of their block and so are never applied.
+func (r *poolCallResults) atHeight(height int64) *poolCallResults {
+	if r.height != height {
+		r.reset(height)
+	}
+	return r
+}
+
+// recordPoolFailure records a failed contract call of the given pool in the block being delivered.
+// Calls in CheckTx, simulations and queries are not recorded since they are not part of consensus.
+func (k Keeper) recordPoolFailure(ctx sdk.Context, poolId uint64) {
+	if ctx.IsCheckTx() {
+		return
+	}
+	k.callResults.atHeight(ctx.BlockHeight()).failures[poolId]++
+}
+
+// recordPoolSuccess records a successful contract call of the given pool in the block being delivered.
+func (k Keeper) recordPoolSuccess(ctx sdk.Context, poolId uint64) {
+	if ctx.IsCheckTx() {
+		return
+	}
+	k.callResults.atHeight(ctx.BlockHeight()).successes[poolId] = true
+}
+
+// EndBlock applies the pool contract call results collected during the block.
+// The failures of a pool are added to its consecutive failure count, and the pool is disabled once
+// the count reaches the MaxConsecutiveFailures parameter. The count of a pool whose calls all
+// succeeded during the block is reset. A success therefore does not clear the failures of its block,
+// so that failing calls cannot be hidden by successful ones.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	results := k.callResults.atHeight(ctx.BlockHeight())
+	defer results.reset(ctx.BlockHeight())
+
+	poolIds := make([]uint64, 0, len(results.failures)+len(results.successes))
+	for poolId := range results.failures {
+		poolIds = append(poolIds, poolId)
+	}
+	for poolId := range results.successes {
+		if _, ok := results.failures[poolId]; !ok {
+			poolIds = append(poolIds, poolId)
+		}
+	}
+	sort.Slice(poolIds, func(i, j int) bool { return poolIds[i] < poolIds[j] })
+
+	for _, poolId := range poolIds {
+		if failures, ok := results.failures[poolId]; ok {
+			k.addPoolFailures(ctx, poolId, failures)
+		} else {
+			k.resetPoolFailures(ctx, poolId)
+		}
+	}
+}
+
+// addPoolFailures adds the given number of failures to the consecutive failure count of the given pool
+// and disables the pool once it reaches the MaxConsecutiveFailures parameter.
+func (k Keeper) addPoolFailures(ctx sdk.Context, poolId uint64, newFailures uint64) {
+	failures := k.GetPoolFailures(ctx, poolId) + newFailures
+	ctx.KVStore(k.storeKey).Set(types.FormatPoolFailuresPrefix(poolId), sdk.Uint64ToBigEndian(failures))
+
+	if failures < k.GetParams(ctx).MaxConsecutiveFailures {
+		return
+	}
+
+	pool, err := k.GetPoolById(ctx, poolId)
+	if err != nil || !pool.IsActive(ctx) {
+		return
+	}
+
+	pool.SetDisabled(true)
+	k.SetPool(ctx, pool)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtDisabledCosmwasmPool,
+		sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyFailures, strconv.FormatUint(failures, 10)),
+	))
+}
+
+// resetPoolFailures clears the consecutive failure count of the given pool.
+// It only writes to the store if the pool has failures.
+func (k Keeper) resetPoolFailures(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatPoolFailuresPrefix(poolId)
+	if store.Has(key) {
+		store.Delete(key)
+	}
+}
+
+// enablePools re-enables the given pools and resets their consecutive failure counts.
+// Returns error if any of the pools does not exist.
+func (k Keeper) enablePools(ctx sdk.Context, poolIds []uint64) error {
+	for _, poolId := range poolIds {
+		pool, err := k.GetPoolById(ctx, poolId)
+		if err != nil {
+			return err
+		}
+
+		pool.SetDisabled(false)
+		k.SetPool(ctx, pool)
+		k.resetPoolFailures(ctx, poolId)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtEnabledCosmwasmPool,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(poolId, 10)),
+		))
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package cosmwasmpool_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+func (s *PoolModuleSuite) TestRunWithGasLimit() {
+	const (
+		gasLimit = uint64(1_000_000)
+		// storeGasMargin bounds the gas consumed on top of the gas consumed by the contract call.
+		storeGasMargin = uint64(50_000)
+	)
+	errContract := errors.New("contract error")
+
+	tests := map[string]struct {
+		initialFailures uint64
+		parentGasLimit  uint64
+		gasToConsume    uint64
+		doPanic         bool
+		returnErr       error
+
+		expectedErr         error
+		expectPanic         bool
+		expectedGasConsumed uint64
+		expectedFailures    uint64
+		expectDisabled      bool
+	}{
+		"success resets failures": {
+			initialFailures:     2,
+			gasToConsume:        gasLimit / 2,
+			expectedGasConsumed: gasLimit / 2,
+			expectedFailures:    0,
+		},
+		"contract error is not counted as a failure": {
+			initialFailures:     2,
+			gasToConsume:        gasLimit / 2,
+			returnErr:           errContract,
+			expectedErr:         errContract,
+			expectedGasConsumed: gasLimit / 2,
+			expectedFailures:    2,
+		},
+		"exceeds gas limit": {
+			gasToConsume:        gasLimit + 1,
+			expectedErr:         types.PoolGasLimitExceededError{PoolId: defaultPoolId, GasLimit: gasLimit},
+			expectedGasConsumed: gasLimit,
+			expectedFailures:    1,
+		},
+		"panics": {
+			doPanic:          true,
+			expectedErr:      types.PoolPanicError{PoolId: defaultPoolId},
+			expectedFailures: 1,
+		},
+		"reaches max consecutive failures and disables the pool": {
+			initialFailures:     types.DefaultMaxConsecutiveFailures - 1,
+			gasToConsume:        gasLimit + 1,
+			expectedErr:         types.PoolGasLimitExceededError{PoolId: defaultPoolId, GasLimit: gasLimit},
+			expectedGasConsumed: gasLimit,
+			expectedFailures:    types.DefaultMaxConsecutiveFailures,
+			expectDisabled:      true,
+		},
+		"runs out of the parent's gas before the limit": {
+			parentGasLimit: gasLimit / 2,
+			gasToConsume:   gasLimit/2 + 1,
+			expectPanic:    true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.Setup()
+			cosmwasmPoolKeeper := s.App.CosmwasmPoolKeeper
+			pool := s.PrepareCosmWasmPool()
+
+			for i := uint64(0); i < tc.initialFailures; i++ {
+				cosmwasmPoolKeeper.RecordPoolFailure(s.Ctx, pool.GetId())
+			}
+			cosmwasmPoolKeeper.EndBlock(s.Ctx)
+
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			if tc.parentGasLimit != 0 {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(tc.parentGasLimit))
+			} else {
+				ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			}
+
+			f := func(ctx sdk.Context) error {
+				ctx.GasMeter().ConsumeGas(tc.gasToConsume, "test")
+				if tc.doPanic {
+					panic("test panic")
+				}
+				return tc.returnErr
+			}
+
+			if tc.expectPanic {
+				s.Require().Panics(func() {
+					_ = cosmwasmPoolKeeper.RunWithGasLimit(ctx, pool.GetId(), gasLimit, f)
+				})
+				cosmwasmPoolKeeper.EndBlock(s.Ctx)
+				s.Require().Equal(uint64(0), cosmwasmPoolKeeper.GetPoolFailures(s.Ctx, pool.GetId()))
+				return
+			}
+
+			err := cosmwasmPoolKeeper.RunWithGasLimit(ctx, pool.GetId(), gasLimit, f)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), tc.expectedGasConsumed)
+			s.Require().Less(ctx.GasMeter().GasConsumed(), tc.expectedGasConsumed+storeGasMargin)
+
+			cosmwasmPoolKeeper.EndBlock(ctx)
+			s.Require().Equal(tc.expectedFailures, cosmwasmPoolKeeper.GetPoolFailures(ctx, pool.GetId()))
+
+			pool, err = cosmwasmPoolKeeper.GetPoolById(ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(!tc.expectDisabled, pool.IsActive(ctx))
+			if tc.expectDisabled {
+				s.AssertEventEmitted(ctx, types.TypeEvtDisabledCosmwasmPool, 1)
+			} else {
+				s.AssertEventEmitted(ctx, types.TypeEvtDisabledCosmwasmPool, 0)
+			}
+		})
+	}
+}
+
+// TestCircuitBreaker tests that a pool whose contract calls exceed the gas limits is
+// disabled, rejects swaps until re-enabled and can be swapped through once re-enabled.
+func (s *PoolModuleSuite) TestCircuitBreaker() {
+	s.Setup()
+	cosmwasmPoolKeeper := s.App.CosmwasmPoolKeeper
+
+	s.FundAcc(s.TestAccs[0], initalDefaultSupply)
+	pool := s.PrepareCustomTransmuterPool(s.TestAccs[0], defaultDenoms)
+	s.JoinTransmuterPool(s.TestAccs[0], pool.GetId(), initalDefaultSupply)
+
+	swapper := s.TestAccs[1]
+	tokenIn := sdk.NewCoin(denomA, sdk.NewInt(10))
+	s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: denomB}}
+
+	// Set gas limits too low for any contract call and disable pools on the second failure.
+	originalParams := cosmwasmPoolKeeper.GetParams(s.Ctx)
+	params := originalParams
+	params.SudoGasLimit = 1
+	params.QueryGasLimit = 1
+	params.MaxConsecutiveFailures = 2
+	cosmwasmPoolKeeper.SetParams(s.Ctx, params)
+
+	_, err := cosmwasmPoolKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, denomB, sdk.ZeroDec())
+	s.Require().ErrorIs(err, types.PoolGasLimitExceededError{PoolId: pool.GetId(), GasLimit: 1})
+	// Failures are only counted at the end of the block.
+	s.Require().Equal(uint64(0), cosmwasmPoolKeeper.GetPoolFailures(s.Ctx, pool.GetId()))
+	cosmwasmPoolKeeper.EndBlock(s.Ctx)
+	s.Require().Equal(uint64(1), cosmwasmPoolKeeper.GetPoolFailures(s.Ctx, pool.GetId()))
+
+	// The failure of a reverted swap is counted as well, and the second failure disables the pool.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(cacheCtx, swapper, route, tokenIn, sdk.OneInt())
+	s.Require().ErrorIs(err, types.PoolGasLimitExceededError{PoolId: pool.GetId(), GasLimit: 1})
+	cosmwasmPoolKeeper.EndBlock(s.Ctx)
+	s.Require().Equal(uint64(2), cosmwasmPoolKeeper.GetPoolFailures(s.Ctx, pool.GetId()))
+
+	disabledPool, err := cosmwasmPoolKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().False(disabledPool.IsActive(s.Ctx))
+
+	// Swaps are rejected even with sufficient gas limits.
+	cosmwasmPoolKeeper.SetParams(s.Ctx, originalParams)
+
+	cacheCtx, _ = s.Ctx.CacheContext()
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(cacheCtx, swapper, route, tokenIn, sdk.OneInt())
+	s.Require().ErrorIs(err, poolmanagertypes.InactivePoolError{PoolId: pool.GetId()})
+
+	cacheCtx, _ = s.Ctx.CacheContext()
+	_, err = cosmwasmPoolKeeper.SwapExactAmountIn(cacheCtx, swapper, disabledPool, tokenIn, denomB, sdk.OneInt(), sdk.ZeroDec())
+	s.Require().ErrorIs(err, types.PoolDisabledError{PoolId: pool.GetId()})
+
+	// Re-enabling the pool resets its failures and allows swaps again.
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err = cosmwasmPoolKeeper.EnablePools(s.Ctx, []uint64{pool.GetId()})
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtEnabledCosmwasmPool, 1)
+	s.Require().Equal(uint64(0), cosmwasmPoolKeeper.GetPoolFailures(s.Ctx, pool.GetId()))
+
+	tokenOut, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, swapper, route, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(tokenIn.Amount, tokenOut)
+
+	// Enabling a non-existent pool fails.
+	err = cosmwasmPoolKeeper.EnablePools(s.Ctx, []uint64{pool.GetId() + 1})
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: pool.GetId() + 1})
+}
+
+// TestCircuitBreaker_OutOfGasTx tests that the failures of swap txs that run out of gas count
+// toward disabling the pool, although the state of the txs is reverted.
+func (s *PoolModuleSuite) TestCircuitBreaker_OutOfGasTx() {
+	const txGasLimit = uint64(1_000_000)
+	s.Setup()
+	cosmwasmPoolKeeper := s.App.CosmwasmPoolKeeper
+
+	s.FundAcc(s.TestAccs[0], initalDefaultSupply)
+	pool := s.PrepareCustomTransmuterPool(s.TestAccs[0], defaultDenoms)
+	s.JoinTransmuterPool(s.TestAccs[0], pool.GetId(), initalDefaultSupply)
+
+	swapper := s.TestAccs[1]
+	tokenIn := sdk.NewCoin(denomA, sdk.NewInt(10))
+	s.FundAcc(swapper, sdk.NewCoins(tokenIn))
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: denomB}}
+
+	params := cosmwasmPoolKeeper.GetParams(s.Ctx)
+	params.SudoGasLimit = 1
+	params.QueryGasLimit = 1
+	params.MaxConsecutiveFailures = 2
+	cosmwasmPoolKeeper.SetParams(s.Ctx, params)
+
+	for i := uint64(1); i <= params.MaxConsecutiveFailures; i++ {
+		// The tx swaps through the pool, whose contract exceeds its gas limit, and then runs out of gas.
+		txCtx, _ := s.Ctx.CacheContext()
+		txCtx = txCtx.WithGasMeter(sdk.NewGasMeter(txGasLimit))
+		s.Require().Panics(func() {
+			_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(txCtx, swapper, route, tokenIn, sdk.OneInt())
+			s.Require().ErrorIs(err, types.PoolGasLimitExceededError{PoolId: pool.GetId(), GasLimit: 1})
+			txCtx.GasMeter().ConsumeGas(txGasLimit, "rest of the tx")
+		})
+
+		cosmwasmPoolKeeper.EndBlock(s.Ctx)
+		s.Require().Equal(i, cosmwasmPoolKeeper.GetPoolFailures(s.Ctx, pool.GetId()))
+	}
+
+	pool, err := cosmwasmPoolKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().False(pool.IsActive(s.Ctx))
+}
+
+// TestEndBlock_SuccessDoesNotClearFailuresOfItsBlock tests that a successful contract call
+// only resets the failures of a pool if no call to the pool failed during its block.
+func (s *PoolModuleSuite) TestEndBlock_SuccessDoesNotClearFailuresOfItsBlock() {
+	s.Setup()
+	cosmwasmPoolKeeper := s.App.CosmwasmPoolKeeper
+	pool := s.PrepareCosmWasmPool()
+	succeed := func(ctx sdk.Context) error { return nil }
+
+	cosmwasmPoolKeeper.RecordPoolFailure(s.Ctx, pool.GetId())
+	s.Require().NoError(cosmwasmPoolKeeper.RunWithGasLimit(s.Ctx, pool.GetId(), 1_000_000, succeed))
+	cosmwasmPoolKeeper.EndBlock(s.Ctx)
+	s.Require().Equal(uint64(1), cosmwasmPoolKeeper.GetPoolFailures(s.Ctx, pool.GetId()))
+
+	s.Require().NoError(cosmwasmPoolKeeper.RunWithGasLimit(s.Ctx, pool.GetId(), 1_000_000, succeed))
+	cosmwasmPoolKeeper.EndBlock(s.Ctx)
+	s.Require().Equal(uint64(0), cosmwasmPoolKeeper.GetPoolFailures(s.Ctx, pool.GetId()))
+}
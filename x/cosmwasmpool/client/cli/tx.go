@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+)
+
+const (
+	FlagPoolIds    = "pool-ids"
+	FlagFromCodeId = "from-code-id"
+	FlagNewCodeId  = "new-code-id"
+	FlagWasmFile   = "wasm-file"
+	FlagMigrateMsg = "migrate-msg"
+)
+
+func NewCmdMigratePoolContractsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-cw-pool-contracts-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal to migrate cosmwasm pool contracts",
+		Long: strings.TrimSpace(`Submit a proposal to migrate cosmwasm pool contracts.
+
+Exactly one of --pool-ids or --from-code-id must be given. --from-code-id migrates every pool
+that is currently instantiated from the given code id.
+Exactly one of --new-code-id or --wasm-file must be given. --wasm-file uploads the code as part of the proposal.
+Ex) --pool-ids=1,2,3 --new-code-id=5 --migrate-msg='{}'
+Ex) --from-code-id=3 --wasm-file=./transmuter.wasm --migrate-msg='{}'
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseMigratePoolContractsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(FlagPoolIds, "", "Comma separated pool ids to migrate")
+	cmd.Flags().Uint64(FlagFromCodeId, 0, "Migrate every pool currently instantiated from this code id")
+	cmd.Flags().Uint64(FlagNewCodeId, 0, "Code id to migrate the pools to")
+	cmd.Flags().String(FlagWasmFile, "", "Path to the wasm byte code to upload and migrate the pools to")
+	cmd.Flags().String(FlagMigrateMsg, "{}", "JSON migrate message passed to each contract")
+
+	return cmd
+}
+
+func NewCmdEnablePoolsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-cw-pools-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal to re-enable cosmwasm pools disabled by the circuit breaker",
+		Long: strings.TrimSpace(`Submit a proposal to re-enable cosmwasm pools disabled by the circuit breaker.
+Also resets the consecutive failure count of the pools.
+Ex) --pool-ids=1,2,3
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseEnablePoolsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(FlagPoolIds, "", "Comma separated pool ids to re-enable")
+
+	return cmd
+}
+
+func parseMigratePoolContractsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdsStr, err := cmd.Flags().GetString(FlagPoolIds)
+	if err != nil {
+		return nil, err
+	}
+	var poolIds []uint64
+	if poolIdsStr != "" {
+		poolIds, err = osmoutils.ParseUint64SliceFromString(poolIdsStr, ",")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fromCodeId, err := cmd.Flags().GetUint64(FlagFromCodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	newCodeId, err := cmd.Flags().GetUint64(FlagNewCodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	wasmFile, err := cmd.Flags().GetString(FlagWasmFile)
+	if err != nil {
+		return nil, err
+	}
+	var wasmByteCode []byte
+	if wasmFile != "" {
+		wasmByteCode, err = os.ReadFile(wasmFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	migrateMsg, err := cmd.Flags().GetString(FlagMigrateMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	content := types.NewMigratePoolContractsProposal(title, description, poolIds, fromCodeId, newCodeId, wasmByteCode, []byte(migrateMsg))
+	return content, nil
+}
+
+func parseEnablePoolsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdsStr, err := cmd.Flags().GetString(FlagPoolIds)
+	if err != nil {
+		return nil, err
+	}
+	poolIds, err := osmoutils.ParseUint64SliceFromString(poolIdsStr, ",")
+	if err != nil {
+		return nil, err
+	}
+
+	content := types.NewEnablePoolsProposal(title, description, poolIds)
+	return content, nil
+}
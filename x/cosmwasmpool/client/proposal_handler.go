@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/client/cli"
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	MigratePoolContractsProposalHandler = govclient.NewProposalHandler(cli.NewCmdMigratePoolContractsProposal, rest.ProposalMigratePoolContractsRESTHandler)
+	EnablePoolsProposalHandler          = govclient.NewProposalHandler(cli.NewCmdEnablePoolsProposal, rest.ProposalEnablePoolsRESTHandler)
+)
@@ -0,0 +1,52 @@
+package queryclient
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/cosmwasmpool/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/client/queryproto"
+)
+
+// Paths of the queries, used by gRPC, ABCI and Stargate queries.
+const (
+	ParamsPath = "/osmosis.cosmwasmpool.v1beta1.Query/Params"
+)
+
+// Client is a typed client of the queries, over a gRPC connection to a node or a client.Context.
+type Client struct {
+	q queryproto.QueryClient
+}
+
+func NewClient(conn gogogrpc.ClientConn) Client {
+	return Client{q: queryproto.NewQueryClient(conn)}
+}
+
+func (c Client) Params(ctx context.Context,
+	req queryproto.ParamsRequest, opts ...grpc.CallOption,
+) (*queryproto.ParamsResponse, error) {
+	return c.q.Params(ctx, &req, opts...)
+}
+
+// NewParamsABCIRequest returns the ABCI query of Params at the given height, 0 being the latest one.
+func NewParamsABCIRequest(req queryproto.ParamsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: ParamsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeParamsResponse decodes the response of Params, e.g. the value of its ABCI query.
+func DecodeParamsResponse(bz []byte) (*queryproto.ParamsResponse, error) {
+	res := &queryproto.ParamsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
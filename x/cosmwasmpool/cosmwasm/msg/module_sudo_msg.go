@@ -31,3 +31,25 @@ func NewSwapExactAmountOutSudoMsg(sender string, tokenInDenom string, tokenOut s
 		},
 	}
 }
+
+// JoinPool
+func NewJoinPoolSudoMsg(sender string, tokensIn sdk.Coins, shareOutMinAmount sdk.Int) JoinPoolSudoMsg {
+	return JoinPoolSudoMsg{
+		JoinPool: JoinPool{
+			Sender:            sender,
+			TokensIn:          tokensIn,
+			ShareOutMinAmount: shareOutMinAmount,
+		},
+	}
+}
+
+// ExitPool
+func NewExitPoolSudoMsg(sender string, shareInAmount sdk.Int, tokenOutMins sdk.Coins) ExitPoolSudoMsg {
+	return ExitPoolSudoMsg{
+		ExitPool: ExitPool{
+			Sender:        sender,
+			ShareInAmount: shareInAmount,
+			TokenOutMins:  tokenOutMins,
+		},
+	}
+}
@@ -329,6 +329,297 @@ func (m *SwapExactAmountOutSudoMsgResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SwapExactAmountOutSudoMsgResponse proto.InternalMessageInfo
 
+// ===================== JoinPool
+type JoinPool struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// tokens_in are the tokens sent to the pool.
+	TokensIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=tokens_in,json=tokensIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_in"`
+	// share_out_min_amount is the minimum amount of shares to be minted for the
+	// tokens sent to the pool.
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount"`
+}
+
+func (m *JoinPool) Reset()         { *m = JoinPool{} }
+func (m *JoinPool) String() string { return proto.CompactTextString(m) }
+func (*JoinPool) ProtoMessage()    {}
+func (*JoinPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{6}
+}
+func (m *JoinPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinPool.Merge(m, src)
+}
+func (m *JoinPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinPool proto.InternalMessageInfo
+
+func (m *JoinPool) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *JoinPool) GetTokensIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensIn
+	}
+	return nil
+}
+
+type JoinPoolSudoMsg struct {
+	// join_pool is the structure containing all the request
+	// information for this message.
+	JoinPool JoinPool `protobuf:"bytes,1,opt,name=join_pool,json=joinPool,proto3" json:"join_pool"`
+}
+
+func (m *JoinPoolSudoMsg) Reset()         { *m = JoinPoolSudoMsg{} }
+func (m *JoinPoolSudoMsg) String() string { return proto.CompactTextString(m) }
+func (*JoinPoolSudoMsg) ProtoMessage()    {}
+func (*JoinPoolSudoMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{7}
+}
+func (m *JoinPoolSudoMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinPoolSudoMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinPoolSudoMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinPoolSudoMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinPoolSudoMsg.Merge(m, src)
+}
+func (m *JoinPoolSudoMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinPoolSudoMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinPoolSudoMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinPoolSudoMsg proto.InternalMessageInfo
+
+func (m *JoinPoolSudoMsg) GetJoinPool() JoinPool {
+	if m != nil {
+		return m.JoinPool
+	}
+	return JoinPool{}
+}
+
+type JoinPoolSudoMsgResponse struct {
+	// share_out_amount is the amount of shares minted to the sender by the
+	// cosmwasm pool module for the tokens sent to the pool.
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount"`
+}
+
+func (m *JoinPoolSudoMsgResponse) Reset()         { *m = JoinPoolSudoMsgResponse{} }
+func (m *JoinPoolSudoMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*JoinPoolSudoMsgResponse) ProtoMessage()    {}
+func (*JoinPoolSudoMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{8}
+}
+func (m *JoinPoolSudoMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinPoolSudoMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinPoolSudoMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinPoolSudoMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinPoolSudoMsgResponse.Merge(m, src)
+}
+func (m *JoinPoolSudoMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinPoolSudoMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinPoolSudoMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinPoolSudoMsgResponse proto.InternalMessageInfo
+
+// ===================== ExitPool
+type ExitPool struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// share_in_amount is the amount of shares burned from the sender by the
+	// cosmwasm pool module.
+	ShareInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_in_amount"`
+	// token_out_mins are the minimum amounts of tokens to be sent out of the
+	// pool.
+	TokenOutMins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=token_out_mins,json=tokenOutMins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"token_out_mins"`
+}
+
+func (m *ExitPool) Reset()         { *m = ExitPool{} }
+func (m *ExitPool) String() string { return proto.CompactTextString(m) }
+func (*ExitPool) ProtoMessage()    {}
+func (*ExitPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{9}
+}
+func (m *ExitPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExitPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitPool.Merge(m, src)
+}
+func (m *ExitPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitPool proto.InternalMessageInfo
+
+func (m *ExitPool) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ExitPool) GetTokenOutMins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokenOutMins
+	}
+	return nil
+}
+
+type ExitPoolSudoMsg struct {
+	// exit_pool is the structure containing all the request
+	// information for this message.
+	ExitPool ExitPool `protobuf:"bytes,1,opt,name=exit_pool,json=exitPool,proto3" json:"exit_pool"`
+}
+
+func (m *ExitPoolSudoMsg) Reset()         { *m = ExitPoolSudoMsg{} }
+func (m *ExitPoolSudoMsg) String() string { return proto.CompactTextString(m) }
+func (*ExitPoolSudoMsg) ProtoMessage()    {}
+func (*ExitPoolSudoMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{10}
+}
+func (m *ExitPoolSudoMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitPoolSudoMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitPoolSudoMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExitPoolSudoMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitPoolSudoMsg.Merge(m, src)
+}
+func (m *ExitPoolSudoMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitPoolSudoMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitPoolSudoMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitPoolSudoMsg proto.InternalMessageInfo
+
+func (m *ExitPoolSudoMsg) GetExitPool() ExitPool {
+	if m != nil {
+		return m.ExitPool
+	}
+	return ExitPool{}
+}
+
+type ExitPoolSudoMsgResponse struct {
+	// tokens_out are the tokens sent out of the pool to the sender.
+	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out"`
+}
+
+func (m *ExitPoolSudoMsgResponse) Reset()         { *m = ExitPoolSudoMsgResponse{} }
+func (m *ExitPoolSudoMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*ExitPoolSudoMsgResponse) ProtoMessage()    {}
+func (*ExitPoolSudoMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3b9879c5388a3a5, []int{11}
+}
+func (m *ExitPoolSudoMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitPoolSudoMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitPoolSudoMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExitPoolSudoMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitPoolSudoMsgResponse.Merge(m, src)
+}
+func (m *ExitPoolSudoMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitPoolSudoMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitPoolSudoMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitPoolSudoMsgResponse proto.InternalMessageInfo
+
+func (m *ExitPoolSudoMsgResponse) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensOut
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SwapExactAmountIn)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountIn")
 	proto.RegisterType((*SwapExactAmountInSudoMsg)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountInSudoMsg")
@@ -336,6 +627,12 @@ func init() {
 	proto.RegisterType((*SwapExactAmountOut)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountOut")
 	proto.RegisterType((*SwapExactAmountOutSudoMsg)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountOutSudoMsg")
 	proto.RegisterType((*SwapExactAmountOutSudoMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.SwapExactAmountOutSudoMsgResponse")
+	proto.RegisterType((*JoinPool)(nil), "osmosis.cosmwasmpool.v1beta1.JoinPool")
+	proto.RegisterType((*JoinPoolSudoMsg)(nil), "osmosis.cosmwasmpool.v1beta1.JoinPoolSudoMsg")
+	proto.RegisterType((*JoinPoolSudoMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.JoinPoolSudoMsgResponse")
+	proto.RegisterType((*ExitPool)(nil), "osmosis.cosmwasmpool.v1beta1.ExitPool")
+	proto.RegisterType((*ExitPoolSudoMsg)(nil), "osmosis.cosmwasmpool.v1beta1.ExitPoolSudoMsg")
+	proto.RegisterType((*ExitPoolSudoMsgResponse)(nil), "osmosis.cosmwasmpool.v1beta1.ExitPoolSudoMsgResponse")
 }
 
 func init() {
@@ -343,42 +640,54 @@ func init() {
 }
 
 var fileDescriptor_e3b9879c5388a3a5 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0xc6, 0xda, 0xa6, 0xa3, 0xad, 0xcd, 0x18, 0x65, 0x5b, 0x64, 0x1b, 0x83, 0x94, 0x5e,
-	0x3a, 0x63, 0x14, 0x44, 0x44, 0x10, 0x63, 0x15, 0x72, 0x08, 0x81, 0x14, 0x44, 0x05, 0x59, 0x36,
-	0xbb, 0xd3, 0x75, 0x69, 0x66, 0xde, 0xd2, 0x99, 0x6d, 0x22, 0x7a, 0xf2, 0xe0, 0xd9, 0xdf, 0xa3,
-	0x7f, 0xa0, 0xc7, 0x1e, 0xc5, 0x43, 0x91, 0xe4, 0x8f, 0xc8, 0xee, 0xcc, 0x26, 0x6d, 0x43, 0xc4,
-	0xd6, 0x5e, 0x76, 0x77, 0xe6, 0xbd, 0xef, 0x7d, 0xef, 0x7d, 0xfb, 0xcd, 0xa0, 0xc7, 0x20, 0x39,
-	0xc8, 0x48, 0x52, 0x1f, 0x24, 0xef, 0x7b, 0x92, 0xc7, 0x00, 0x3d, 0x7a, 0x50, 0xef, 0x32, 0xe5,
-	0xd5, 0x29, 0x87, 0x80, 0xf5, 0xd2, 0x67, 0xd2, 0x63, 0xae, 0x4c, 0x02, 0x70, 0xb9, 0x0c, 0x49,
-	0xbc, 0x0f, 0x0a, 0xf0, 0x1d, 0x83, 0x24, 0x27, 0x91, 0xc4, 0x20, 0xd7, 0x2a, 0x21, 0x84, 0x90,
-	0x25, 0xd2, 0xf4, 0x4b, 0x63, 0xd6, 0x1c, 0x3f, 0x03, 0xd1, 0xae, 0x27, 0xd9, 0x98, 0xc4, 0x87,
-	0x48, 0xe8, 0x78, 0xed, 0x47, 0x11, 0x95, 0x77, 0xfa, 0x5e, 0xfc, 0x72, 0xe0, 0xf9, 0xea, 0x39,
-	0x87, 0x44, 0xa8, 0xa6, 0xc0, 0xb7, 0xd1, 0xbc, 0x64, 0x22, 0x60, 0xfb, 0xb6, 0x55, 0xb5, 0x36,
-	0x17, 0x3b, 0x66, 0x85, 0x9f, 0xa0, 0x92, 0x82, 0x3d, 0x26, 0xdc, 0x48, 0xd8, 0xc5, 0xaa, 0xb5,
-	0x79, 0xed, 0xc1, 0x2a, 0xd1, 0x04, 0x24, 0x25, 0xc8, 0x7b, 0x21, 0x2f, 0x20, 0x12, 0x8d, 0xb9,
-	0xc3, 0xe3, 0xf5, 0x42, 0x67, 0x21, 0x03, 0x34, 0x05, 0xde, 0x40, 0x37, 0x34, 0x16, 0x12, 0xe5,
-	0x06, 0x4c, 0x00, 0xb7, 0xaf, 0x64, 0xc5, 0x97, 0xb2, 0xed, 0x76, 0xa2, 0xb6, 0xd3, 0x4d, 0xec,
-	0xa2, 0xca, 0x24, 0x8f, 0x47, 0xc2, 0xf5, 0xb2, 0xae, 0xec, 0xb9, 0x34, 0xb9, 0x41, 0xd2, 0xa2,
-	0xbf, 0x8e, 0xd7, 0x37, 0xc2, 0x48, 0x7d, 0x48, 0xba, 0xc4, 0x07, 0x4e, 0xcd, 0x88, 0xfa, 0xb5,
-	0x25, 0x83, 0x3d, 0xaa, 0x3e, 0xc6, 0x4c, 0x92, 0xa6, 0x50, 0x9d, 0x72, 0x5e, 0xbc, 0x15, 0x09,
-	0x3d, 0x1e, 0x6e, 0xa2, 0x92, 0xec, 0x7b, 0xb1, 0xbb, 0xcb, 0x98, 0x7d, 0xf5, 0xdc, 0x45, 0xb7,
-	0x99, 0xdf, 0x59, 0x48, 0xf1, 0xaf, 0x18, 0xab, 0x7d, 0xb1, 0x90, 0x3d, 0xa5, 0xde, 0x4e, 0x12,
-	0x40, 0x4b, 0x86, 0x78, 0x17, 0x55, 0x32, 0x1e, 0x96, 0x06, 0xcd, 0x14, 0xa9, 0x70, 0x56, 0x26,
-	0x1c, 0x25, 0x7f, 0xfb, 0x9b, 0x64, 0xaa, 0xaa, 0x91, 0xb3, 0x2c, 0xcf, 0x06, 0x6a, 0x9f, 0x51,
-	0x75, 0x56, 0x0f, 0x1d, 0x26, 0x63, 0x10, 0x92, 0xe1, 0x37, 0x68, 0x65, 0x22, 0xaa, 0x11, 0xd4,
-	0xba, 0x90, 0xa0, 0xcb, 0xb9, 0xa0, 0x9a, 0xaa, 0xf6, 0xbd, 0x88, 0xf0, 0x19, 0xfa, 0x76, 0xa2,
-	0x66, 0x3a, 0xe8, 0x29, 0x5a, 0x1c, 0x37, 0xf2, 0xaf, 0x16, 0x2a, 0xe5, 0x94, 0xf8, 0x1e, 0x5a,
-	0xce, 0xfd, 0x77, 0xca, 0x42, 0xd7, 0x8d, 0xc9, 0xb4, 0x83, 0xde, 0xa3, 0x9b, 0xe3, 0x2c, 0xee,
-	0x0d, 0xfe, 0xcf, 0x40, 0x2b, 0xa6, 0x74, 0xcb, 0x1b, 0x5c, 0xbe, 0x7f, 0xbe, 0x5a, 0x68, 0x75,
-	0x5a, 0xbc, 0xdc, 0x40, 0x11, 0xba, 0x35, 0x6d, 0xa0, 0x54, 0x37, 0xed, 0xa0, 0xfb, 0xe7, 0x72,
-	0x50, 0x3b, 0x51, 0x46, 0x4e, 0x2c, 0xa7, 0x22, 0xb5, 0x4f, 0xe8, 0xee, 0xcc, 0x3e, 0xc6, 0x26,
-	0x7a, 0x9d, 0x9f, 0xe0, 0xc9, 0xa1, 0xbc, 0x98, 0x87, 0x96, 0x8c, 0xa6, 0x9a, 0xa9, 0xf1, 0xf6,
-	0x70, 0xe8, 0x58, 0x47, 0x43, 0xc7, 0xfa, 0x3d, 0x74, 0xac, 0x6f, 0x23, 0xa7, 0x70, 0x34, 0x72,
-	0x0a, 0x3f, 0x47, 0x4e, 0xe1, 0xdd, 0xb3, 0x13, 0x05, 0xcd, 0xb0, 0x5b, 0x3d, 0xaf, 0x2b, 0xf3,
-	0x05, 0x3d, 0xa8, 0x3f, 0xa2, 0x83, 0xd3, 0x37, 0x69, 0xbe, 0xa0, 0x5c, 0x86, 0xdd, 0xf9, 0xec,
-	0x96, 0x7b, 0xf8, 0x27, 0x00, 0x00, 0xff, 0xff, 0xba, 0xd7, 0x28, 0x12, 0x75, 0x05, 0x00, 0x00,
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x4e, 0x13, 0x41,
+	0x14, 0xee, 0xb6, 0x08, 0xe5, 0xc8, 0xef, 0x8a, 0xb2, 0x10, 0xb3, 0xe0, 0xc6, 0x10, 0x6e, 0xd8,
+	0x05, 0x4d, 0x8c, 0x31, 0x26, 0x46, 0x04, 0x93, 0x9a, 0x10, 0x4c, 0x49, 0x88, 0x1a, 0xcd, 0x66,
+	0xdb, 0x0e, 0x65, 0xa1, 0x3b, 0x53, 0x39, 0xb3, 0xb0, 0x46, 0xaf, 0x4c, 0xf4, 0xda, 0xe7, 0xf0,
+	0x11, 0xf4, 0x05, 0xb8, 0xe4, 0xd2, 0x78, 0x81, 0x06, 0xae, 0x7d, 0x07, 0x33, 0xbb, 0x33, 0xed,
+	0xd2, 0xa6, 0x0d, 0x14, 0x6e, 0xda, 0xce, 0xce, 0x9c, 0xef, 0x9c, 0xf3, 0x7d, 0xdf, 0x9c, 0x2e,
+	0x3c, 0x64, 0x18, 0x30, 0xf4, 0xd1, 0x29, 0x33, 0x0c, 0x0e, 0x3c, 0x0c, 0xea, 0x8c, 0xd5, 0x9c,
+	0xfd, 0xa5, 0x12, 0xe1, 0xde, 0x92, 0x13, 0xb0, 0x0a, 0xa9, 0x89, 0xcf, 0xb0, 0x46, 0x5c, 0x0c,
+	0x2b, 0xcc, 0x0d, 0xb0, 0x6a, 0xd7, 0xf7, 0x18, 0x67, 0xfa, 0x6d, 0x19, 0x69, 0xa7, 0x23, 0x6d,
+	0x19, 0x39, 0x3d, 0x51, 0x65, 0x55, 0x16, 0x1f, 0x74, 0xc4, 0xaf, 0x24, 0x66, 0xda, 0x2c, 0xc7,
+	0x41, 0x4e, 0xc9, 0x43, 0xd2, 0x48, 0x52, 0x66, 0x3e, 0x4d, 0xf6, 0xad, 0x9f, 0x59, 0x18, 0xdf,
+	0x38, 0xf0, 0xea, 0xab, 0x91, 0x57, 0xe6, 0x4f, 0x03, 0x16, 0x52, 0x5e, 0xa0, 0xfa, 0x2d, 0xe8,
+	0x47, 0x42, 0x2b, 0x64, 0xcf, 0xd0, 0x66, 0xb5, 0xf9, 0xc1, 0xa2, 0x5c, 0xe9, 0x8f, 0x20, 0xcf,
+	0xd9, 0x2e, 0xa1, 0xae, 0x4f, 0x8d, 0xec, 0xac, 0x36, 0x7f, 0xfd, 0xde, 0x94, 0x9d, 0x24, 0xb0,
+	0x45, 0x02, 0x55, 0x8b, 0xfd, 0x8c, 0xf9, 0x74, 0xb9, 0xef, 0xf0, 0x78, 0x26, 0x53, 0x1c, 0x88,
+	0x03, 0x0a, 0x54, 0x9f, 0x83, 0xd1, 0x24, 0x96, 0x85, 0xdc, 0xad, 0x10, 0xca, 0x02, 0x23, 0x17,
+	0x83, 0x0f, 0xc7, 0x8f, 0xd7, 0x43, 0xbe, 0x22, 0x1e, 0xea, 0x2e, 0x4c, 0x34, 0xcf, 0x05, 0x3e,
+	0x75, 0xbd, 0xb8, 0x2a, 0xa3, 0x4f, 0x1c, 0x5e, 0xb6, 0x05, 0xe8, 0xef, 0xe3, 0x99, 0xb9, 0xaa,
+	0xcf, 0xb7, 0xc3, 0x92, 0x5d, 0x66, 0x81, 0x23, 0x5b, 0x4c, 0xbe, 0x16, 0xb0, 0xb2, 0xeb, 0xf0,
+	0x0f, 0x75, 0x82, 0x76, 0x81, 0xf2, 0xe2, 0xb8, 0x02, 0x5f, 0xf3, 0x69, 0xd2, 0x9e, 0x5e, 0x80,
+	0x3c, 0x1e, 0x78, 0x75, 0x77, 0x8b, 0x10, 0xe3, 0xda, 0x85, 0x41, 0x57, 0x48, 0xb9, 0x38, 0x20,
+	0xe2, 0x9f, 0x13, 0x62, 0x7d, 0xd6, 0xc0, 0x68, 0x63, 0x6f, 0x23, 0xac, 0xb0, 0x35, 0xac, 0xea,
+	0x5b, 0x30, 0x11, 0xe7, 0x21, 0x62, 0x53, 0x76, 0x21, 0x88, 0xd3, 0x62, 0xe2, 0x1c, 0xbb, 0x9b,
+	0x9a, 0x76, 0x1b, 0xaa, 0xa4, 0x73, 0x1c, 0x5b, 0x37, 0xac, 0x4f, 0x30, 0xdb, 0xa9, 0x86, 0x22,
+	0xc1, 0x3a, 0xa3, 0x48, 0xf4, 0x57, 0x30, 0xd6, 0x24, 0x55, 0x12, 0xaa, 0xf5, 0x44, 0xe8, 0x88,
+	0x22, 0x34, 0x49, 0x65, 0xfd, 0xc8, 0x82, 0xde, 0x92, 0x7e, 0x3d, 0xe4, 0x1d, 0x1d, 0xf4, 0x18,
+	0x06, 0x1b, 0x85, 0x9c, 0xd7, 0x42, 0x79, 0x95, 0x52, 0xbf, 0x0b, 0x23, 0xca, 0x7f, 0x67, 0x2c,
+	0x34, 0x24, 0x4d, 0x96, 0x38, 0xe8, 0x1d, 0xdc, 0x68, 0x9c, 0x0a, 0xbc, 0xe8, 0x72, 0x06, 0x1a,
+	0x93, 0xd0, 0x6b, 0x5e, 0x74, 0xf5, 0xfe, 0xf9, 0xaa, 0xc1, 0x54, 0x3b, 0x79, 0xca, 0x40, 0x3e,
+	0xdc, 0x6c, 0x37, 0x90, 0xe0, 0x2d, 0x71, 0xd0, 0xe2, 0x85, 0x1c, 0xb4, 0x1e, 0x72, 0x49, 0xa7,
+	0x8e, 0x6d, 0x3b, 0xd6, 0x47, 0xb8, 0xd3, 0xb1, 0x8e, 0x86, 0x89, 0x36, 0xd5, 0x0d, 0x6e, 0x5e,
+	0xca, 0xde, 0x3c, 0x34, 0x2c, 0x39, 0x95, 0x16, 0xfa, 0xa7, 0x41, 0xfe, 0x05, 0xf3, 0xe9, 0x4b,
+	0xc6, 0x6a, 0x1d, 0x8d, 0xb3, 0x2d, 0x8d, 0x83, 0xc9, 0xec, 0xc9, 0x75, 0x37, 0xce, 0xa2, 0xa8,
+	0xe8, 0xfb, 0x9f, 0x99, 0xf9, 0x73, 0x54, 0x24, 0x02, 0x50, 0x9a, 0x0c, 0x0b, 0x54, 0x0c, 0x20,
+	0xdc, 0xf6, 0xf6, 0x48, 0xeb, 0x00, 0xca, 0xf5, 0x36, 0x80, 0x62, 0xac, 0xf4, 0x00, 0xb2, 0xde,
+	0xc2, 0xa8, 0x6a, 0x57, 0x49, 0x5d, 0x80, 0xc1, 0x1d, 0xe6, 0x53, 0x57, 0x28, 0x28, 0xe5, 0x9d,
+	0xeb, 0x2e, 0xaf, 0x42, 0x50, 0x77, 0x64, 0x47, 0xae, 0x2d, 0x84, 0xc9, 0x16, 0xf4, 0xf4, 0x14,
+	0x68, 0x76, 0x76, 0xb9, 0x29, 0xa0, 0xba, 0x4a, 0x49, 0xb8, 0x1a, 0xf9, 0xbc, 0xab, 0x84, 0x9b,
+	0x30, 0x9a, 0xa4, 0x6f, 0x72, 0x9a, 0xed, 0xcd, 0x3f, 0x31, 0x8c, 0xf2, 0x8f, 0xfe, 0x5e, 0x4d,
+	0x05, 0x29, 0x18, 0x1a, 0xb9, 0xab, 0xf7, 0xc7, 0x50, 0xea, 0x8f, 0x04, 0x85, 0x84, 0xaa, 0xdd,
+	0x94, 0x84, 0x24, 0xf2, 0xf9, 0x05, 0x24, 0x54, 0x08, 0x4a, 0x42, 0x22, 0xd7, 0xd6, 0x17, 0x0d,
+	0x26, 0x5b, 0xe0, 0x1b, 0x1a, 0xee, 0x00, 0xc8, 0x7b, 0x90, 0x4c, 0x82, 0x2b, 0x6f, 0x54, 0x5e,
+	0x33, 0x31, 0x2f, 0x5e, 0x1f, 0x9e, 0x98, 0xda, 0xd1, 0x89, 0xa9, 0xfd, 0x3d, 0x31, 0xb5, 0x6f,
+	0xa7, 0x66, 0xe6, 0xe8, 0xd4, 0xcc, 0xfc, 0x3a, 0x35, 0x33, 0x6f, 0x9e, 0xa4, 0xe0, 0x64, 0x8f,
+	0x0b, 0x35, 0xaf, 0x84, 0x6a, 0xe1, 0xec, 0x2f, 0x3d, 0x70, 0xa2, 0xb3, 0xaf, 0x38, 0x6a, 0xe1,
+	0x04, 0x58, 0x2d, 0xf5, 0xc7, 0xaf, 0x1f, 0xf7, 0xff, 0x0f, 0x00, 0xd7, 0x78, 0x43, 0x82, 0x0e,
+	0x09, 0x00, 0x00,
 }
 
 func (m *SwapExactAmountIn) Marshal() (dAtA []byte, err error) {
@@ -647,71 +956,315 @@ func (m *SwapExactAmountOutSudoMsgResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintModuleSudoMsg(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModuleSudoMsg(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JoinPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JoinPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
 	}
-	l = m.TokenIn.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.TokenOutMinAmount.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	l = m.SwapFee.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SwapExactAmountInSudoMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JoinPoolSudoMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.SwapExactAmountIn.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SwapExactAmountInSudoMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TokenOutAmount.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
+func (m *JoinPoolSudoMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwapExactAmountOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JoinPoolSudoMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	{
+		size, err := m.JoinPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinPoolSudoMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinPoolSudoMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinPoolSudoMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutMins) > 0 {
+		for iNdEx := len(m.TokenOutMins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenOutMins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitPoolSudoMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitPoolSudoMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitPoolSudoMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExitPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitPoolSudoMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitPoolSudoMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitPoolSudoMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModuleSudoMsg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModuleSudoMsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModuleSudoMsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *SwapExactAmountInSudoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapExactAmountIn.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *SwapExactAmountInSudoMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *SwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
 	}
 	l = m.TokenOut.Size()
 	n += 1 + l + sovModuleSudoMsg(uint64(l))
@@ -730,31 +1283,801 @@ func (m *SwapExactAmountOutSudoMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.SwapExactAmountOut.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.SwapExactAmountOut.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *SwapExactAmountOutSudoMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *JoinPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovModuleSudoMsg(uint64(l))
+		}
+	}
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *JoinPoolSudoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JoinPool.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *JoinPoolSudoMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *ExitPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovModuleSudoMsg(uint64(l))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovModuleSudoMsg(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExitPoolSudoMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExitPool.Size()
+	n += 1 + l + sovModuleSudoMsg(uint64(l))
+	return n
+}
+
+func (m *ExitPoolSudoMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovModuleSudoMsg(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovModuleSudoMsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModuleSudoMsg(x uint64) (n int) {
+	return sovModuleSudoMsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountInSudoMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountInSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapExactAmountIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapExactAmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountInSudoMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountInSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapExactAmountOutSudoMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapExactAmountOutSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapExactAmountOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleSudoMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapExactAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModuleSudoMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SwapExactAmountOutSudoMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovModuleSudoMsg(uint64(l))
-	return n
-}
-
-func sovModuleSudoMsg(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModuleSudoMsg(x uint64) (n int) {
-	return sovModuleSudoMsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *SwapExactAmountOutSudoMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -777,15 +2100,15 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapExactAmountOutSudoMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapExactAmountOutSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -813,44 +2136,63 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModuleSudoMsg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModuleSudoMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModuleSudoMsg
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModuleSudoMsg
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,13 +2220,13 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModuleSudoMsg
@@ -894,29 +2236,29 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensIn = append(m.TokensIn, types.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -944,7 +2286,7 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -969,7 +2311,7 @@ func (m *SwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
+func (m *JoinPoolSudoMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,15 +2334,15 @@ func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountInSudoMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinPoolSudoMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountInSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinPoolSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapExactAmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1027,7 +2369,7 @@ func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapExactAmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JoinPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1052,7 +2394,7 @@ func (m *SwapExactAmountInSudoMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *JoinPoolSudoMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1075,15 +2417,15 @@ func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountInSudoMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinPoolSudoMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountInSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinPoolSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1111,7 +2453,7 @@ func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1136,7 +2478,7 @@ func (m *SwapExactAmountInSudoMsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *ExitPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1159,10 +2501,10 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1199,72 +2541,7 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModuleSudoMsg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModuleSudoMsg
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModuleSudoMsg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModuleSudoMsg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModuleSudoMsg
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModuleSudoMsg
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1292,15 +2569,15 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModuleSudoMsg
@@ -1310,23 +2587,23 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1351,7 +2628,7 @@ func (m *SwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
+func (m *ExitPoolSudoMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1374,15 +2651,15 @@ func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountOutSudoMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitPoolSudoMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountOutSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitPoolSudoMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapExactAmountOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1409,7 +2686,7 @@ func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapExactAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExitPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1434,7 +2711,7 @@ func (m *SwapExactAmountOutSudoMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapExactAmountOutSudoMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *ExitPoolSudoMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1457,17 +2734,17 @@ func (m *SwapExactAmountOutSudoMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapExactAmountOutSudoMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitPoolSudoMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapExactAmountOutSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitPoolSudoMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModuleSudoMsg
@@ -1477,23 +2754,23 @@ func (m *SwapExactAmountOutSudoMsgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModuleSudoMsg
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
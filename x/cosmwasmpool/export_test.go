@@ -14,6 +14,18 @@ func (k Keeper) UploadCodeIdAndWhitelist(ctx sdk.Context, byteCode []byte) (uint
 	return k.uploadCodeIdAndWhitelist(ctx, byteCode)
 }
 
-func (k Keeper) MigrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeId uint64, uploadByteCode []byte, migrateMsg []byte) (err error) {
-	return k.migrateCosmwasmPools(ctx, poolIds, newCodeId, uploadByteCode, migrateMsg)
+func (k Keeper) MigrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, fromCodeId uint64, newCodeId uint64, uploadByteCode []byte, migrateMsg []byte) (err error) {
+	return k.migrateCosmwasmPools(ctx, poolIds, fromCodeId, newCodeId, uploadByteCode, migrateMsg)
+}
+
+func (k Keeper) RunWithGasLimit(ctx sdk.Context, poolId uint64, gasLimit uint64, f func(ctx sdk.Context) error) error {
+	return k.runWithGasLimit(ctx, poolId, gasLimit, f)
+}
+
+func (k Keeper) RecordPoolFailure(ctx sdk.Context, poolId uint64) {
+	k.recordPoolFailure(ctx, poolId)
+}
+
+func (k Keeper) EnablePools(ctx sdk.Context, poolIds []uint64) error {
+	return k.enablePools(ctx, poolIds)
 }
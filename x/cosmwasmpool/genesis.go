@@ -1,20 +1,55 @@
 package cosmwasmpool
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/model"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 )
 
 // InitGenesis initializes the store state from a genesis state.
 func (k *Keeper) InitGenesis(ctx sdk.Context, gen *types.GenesisState) {
 	k.SetParams(ctx, gen.Params)
+	k.EnableMsgCreatePool(ctx)
+
+	var unpacker codectypes.AnyUnpacker = k.cdc
+	for _, any := range gen.Pools {
+		var pool types.CosmWasmExtension
+		if err := unpacker.UnpackAny(any, &pool); err != nil {
+			panic(err)
+		}
+		storeModel, ok := pool.(*model.CosmWasmPool)
+		if !ok {
+			panic(types.InvalidPoolTypeError{ActualPool: pool})
+		}
+		k.SetPool(ctx, &model.Pool{CosmWasmPool: *storeModel})
+	}
 }
 
 // ExportGenesis returns the cosmwasm pool's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
+
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	poolAnys := make([]*codectypes.Any, 0, len(pools))
+	for _, pool := range pools {
+		cwPool, err := k.asCosmwasmPool(pool)
+		if err != nil {
+			panic(err)
+		}
+		any, err := codectypes.NewAnyWithValue(cwPool.GetStoreModel())
+		if err != nil {
+			panic(err)
+		}
+		poolAnys = append(poolAnys, any)
+	}
+
 	return &types.GenesisState{
 		Params: params,
+		Pools:  poolAnys,
 	}
 }
@@ -0,0 +1,30 @@
+package cosmwasmpool_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+)
+
+// TestExportInitGenesis tests that the pools exported from state are restored by InitGenesis.
+func (s *PoolModuleSuite) TestExportInitGenesis() {
+	s.Setup()
+	cosmwasmPoolKeeper := s.App.CosmwasmPoolKeeper
+
+	pool := s.PrepareCosmWasmPool()
+
+	exported := cosmwasmPoolKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(exported.Pools, 1)
+	s.Require().Equal(cosmwasmPoolKeeper.GetParams(s.Ctx), exported.Params)
+
+	// Restore the genesis on a fresh state.
+	s.Setup()
+	cosmwasmPoolKeeper = s.App.CosmwasmPoolKeeper
+	_, err := cosmwasmPoolKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: pool.GetId()})
+
+	cosmwasmPoolKeeper.InitGenesis(s.Ctx, exported)
+
+	restored, err := cosmwasmPoolKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(pool.GetStoreModel(), restored.GetStoreModel())
+	s.Require().Equal(exported, cosmwasmPoolKeeper.ExportGenesis(s.Ctx))
+}
@@ -19,7 +19,9 @@ func NewCosmWasmPoolProposalHandler(k Keeper) govtypes.Handler {
 			_, err := k.uploadCodeIdAndWhitelist(ctx, c.WASMByteCode)
 			return err
 		case *types.MigratePoolContractsProposal:
-			return k.migrateCosmwasmPools(ctx, c.PoolIds, c.NewCodeId, c.WASMByteCode, c.MigrateMsg)
+			return k.migrateCosmwasmPools(ctx, c.PoolIds, c.FromCodeId, c.NewCodeId, c.WASMByteCode, c.MigrateMsg)
+		case *types.EnablePoolsProposal:
+			return k.enablePools(ctx, c.PoolIds)
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
 		}
@@ -71,6 +73,8 @@ func (k Keeper) uploadCodeIdAndWhitelist(ctx sdk.Context, byteCode []byte) (uint
 // the pool to. Errors if uploadByteCode is empty or invalid.
 //
 // In both cases, if one of the pools specified by the given poolID does not exist, the proposal fails.
+// Instead of the pool ids, fromCodeId may be given to migrate every pool whose contract is on that code id,
+// in which case the proposal fails if there is no such pool.
 //
 // The reason for having poolIDs be a slice of ids is to account for the potential need for emergency migration
 // of all old code ids associated with particular pools to new code ids, or simply having the flexibility of
@@ -79,13 +83,26 @@ func (k Keeper) uploadCodeIdAndWhitelist(ctx sdk.Context, byteCode []byte) (uint
 // poolD count to be submitted at once is gated by a governance paramets (20 at launch).
 // The proposal fails if more. Note that 20 was chosen arbitrarily to have a constant bound on the number of pools migrated
 // at once. This size will be configured by a module parameter so it can be changed by a constant.
-func (k Keeper) migrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeId uint64, uploadByteCode []byte, migrateMsg []byte) (err error) {
+//
+// Each migrated pool records its previous code id and emits an event.
+func (k Keeper) migrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, fromCodeId uint64, newCodeId uint64, uploadByteCode []byte, migrateMsg []byte) (err error) {
 	cosmwasmPoolModuleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
 
-	if err := types.ValidateMigrationProposalConfiguration(poolIds, newCodeId, uploadByteCode); err != nil {
+	if err := types.ValidateMigrationProposalConfiguration(poolIds, fromCodeId, newCodeId, uploadByteCode); err != nil {
 		return err
 	}
 
+	// Select the pools on the given code id if no pool ids are given.
+	if fromCodeId != 0 {
+		poolIds, err = k.getPoolIdsByCodeId(ctx, fromCodeId)
+		if err != nil {
+			return err
+		}
+		if len(poolIds) == 0 {
+			return types.NoPoolsWithCodeIdError{CodeId: fromCodeId}
+		}
+	}
+
 	// Validate that the given pool ids are below the pool count limit.
 	requestedPoolMigrationCount := uint64(len(poolIds))
 	params := k.GetParams(ctx)
@@ -122,6 +139,17 @@ func (k Keeper) migrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeI
 		if err != nil {
 			return err
 		}
+
+		// Record the new code id on the pool model, keeping the previous one.
+		cwPool.SetCodeId(newCodeId)
+		k.SetPool(ctx, cwPool)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtMigratedCosmwasmPool,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPreviousCodeID, strconv.FormatUint(cwPool.GetPreviousCodeId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(newCodeId, 10)),
+		))
 	}
 
 	// Whitelist new code id. No-op if already whitelisted.
@@ -135,3 +163,23 @@ func (k Keeper) migrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeI
 
 	return nil
 }
+
+// getPoolIdsByCodeId returns the ids of the pools whose contract is on the given code id, in ascending order.
+func (k Keeper) getPoolIdsByCodeId(ctx sdk.Context, codeId uint64) ([]uint64, error) {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds := []uint64{}
+	for _, pool := range pools {
+		cwPool, err := k.asCosmwasmPool(pool)
+		if err != nil {
+			return nil, err
+		}
+		if cwPool.GetCodeId() == codeId {
+			poolIds = append(poolIds, cwPool.GetId())
+		}
+	}
+	return poolIds, nil
+}
@@ -9,6 +9,7 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 )
@@ -164,6 +165,7 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 		name                                     string
 		poolCountToPreCreate                     uint64
 		poolIdsToMigrate                         []uint64
+		fromCodeId                               uint64
 		newCodeId                                uint64
 		byteCode                                 []byte
 		migrateMsg                               []byte
@@ -171,7 +173,8 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 		shouldWhitelistCWPoolModuleAccountUpload bool
 		poolIdLimitOverwrite                     uint64
 
-		expectedErr bool
+		expectedPoolIdsMigrated []uint64
+		expectedErr             bool
 	}{
 		{
 			name:                 "happy path with pre-uploaded code id",
@@ -183,6 +186,19 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 
 			expectedCodeId: validCodeId,
 		},
+		{
+			// Each pool is created with its own upload of the transmuter code,
+			// so only the first pool is on the first code id.
+			name:                 "happy path migrating every pool on a code id",
+			poolCountToPreCreate: defaultPoolCountToPreCreate,
+			fromCodeId:           validCodeId,
+			newCodeId:            preUploadCodeIdPlaceholder,
+			byteCode:             emptyByteCode,
+			migrateMsg:           emptyMigrateMsg,
+
+			expectedCodeId:          validCodeId,
+			expectedPoolIdsMigrated: []uint64{1},
+		},
 		{
 			name:                                     "happy path with code id to upload",
 			poolCountToPreCreate:                     defaultPoolCountToPreCreate,
@@ -236,6 +252,27 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 
 			expectedErr: true,
 		},
+		{
+			name:                 "error: no pools on the code id to migrate from",
+			poolCountToPreCreate: defaultPoolCountToPreCreate,
+			fromCodeId:           defaultPoolCountToPreCreate + 1,
+			newCodeId:            validCodeId,
+			byteCode:             emptyByteCode,
+			migrateMsg:           emptyMigrateMsg,
+
+			expectedErr: true,
+		},
+		{
+			name:                 "error: both pool ids and the code id to migrate from are given",
+			poolCountToPreCreate: defaultPoolCountToPreCreate,
+			poolIdsToMigrate:     defaultPoolIdsToMigrate,
+			fromCodeId:           validCodeId,
+			newCodeId:            preUploadCodeIdPlaceholder,
+			byteCode:             emptyByteCode,
+			migrateMsg:           emptyMigrateMsg,
+
+			expectedErr: true,
+		},
 		{
 			name:                 "error: migration fails because pool id list is empty",
 			poolCountToPreCreate: defaultPoolCountToPreCreate,
@@ -285,8 +322,16 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 				tc.newCodeId = s.StoreCosmWasmPoolContractCode(apptesting.TransmuterMigrateContractName)
 			}
 
+			// Record the code ids of the pools before the migration.
+			codeIdsBefore := map[uint64]uint64{}
+			for poolId := uint64(1); poolId <= tc.poolCountToPreCreate; poolId++ {
+				pool, err := cosmwasmPoolKeeper.GetPoolById(s.Ctx, poolId)
+				s.Require().NoError(err)
+				codeIdsBefore[poolId] = pool.GetCodeId()
+			}
+
 			// System under test.
-			err := cosmwasmPoolKeeper.MigrateCosmwasmPools(s.Ctx, tc.poolIdsToMigrate, tc.newCodeId, tc.byteCode, tc.migrateMsg)
+			err := cosmwasmPoolKeeper.MigrateCosmwasmPools(s.Ctx, tc.poolIdsToMigrate, tc.fromCodeId, tc.newCodeId, tc.byteCode, tc.migrateMsg)
 
 			if tc.expectedErr {
 				s.Require().Error(err)
@@ -298,8 +343,28 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 			// Check that the code id is whitelisted.
 			s.Require().True(cosmwasmPoolKeeper.IsWhitelisted(s.Ctx, tc.expectedCodeId))
 
-			// Validate that the event is emitted.
+			// Validate that the events are emitted.
+			expectedPoolIdsMigrated := tc.poolIdsToMigrate
+			if tc.expectedPoolIdsMigrated != nil {
+				expectedPoolIdsMigrated = tc.expectedPoolIdsMigrated
+			}
 			s.AssertEventEmitted(s.Ctx, types.TypeEvtMigratedCosmwasmPoolCode, 1)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtMigratedCosmwasmPool, len(expectedPoolIdsMigrated))
+
+			// Validate that the migrated pools record their new and previous code ids,
+			// and that the other pools are untouched.
+			for poolId, codeIdBefore := range codeIdsBefore {
+				pool, err := cosmwasmPoolKeeper.GetPoolById(s.Ctx, poolId)
+				s.Require().NoError(err)
+
+				if osmoutils.Contains(expectedPoolIdsMigrated, poolId) {
+					s.Require().NotEqual(codeIdBefore, pool.GetCodeId())
+					s.Require().Equal(codeIdBefore, pool.GetPreviousCodeId())
+				} else {
+					s.Require().Equal(codeIdBefore, pool.GetCodeId())
+					s.Require().Zero(pool.GetPreviousCodeId())
+				}
+			}
 		})
 	}
 }
@@ -0,0 +1,52 @@
+package cosmwasmpool
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+)
+
+const poolBalancesInvariantName = "pool-balances-cover-liquidity"
+
+// DeclareInvariants declares all cosmwasm pool invariants to the invariant registry.
+func DeclareInvariants(r *invariants.Registry, k Keeper) {
+	r.Register(types.ModuleName, poolBalancesInvariantName, invariants.Cheap, PoolBalancesInvariant(k))
+}
+
+// PoolBalancesInvariant checks that the balance of every pool contract covers the total liquidity
+// reported by the contract.
+func PoolBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := k.GetPools(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolBalancesInvariantName,
+				fmt.Sprintf("\tpool retrieval failed: %s\n", err)), true
+		}
+
+		for _, pool := range pools {
+			liquidity, err := k.GetTotalPoolLiquidity(ctx, pool.GetId())
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, poolBalancesInvariantName,
+					fmt.Sprintf("\tpool id %d liquidity: %s\n", pool.GetId(), err)), true
+			}
+			cwPool, ok := pool.(types.CosmWasmExtension)
+			if !ok {
+				return sdk.FormatInvariant(types.ModuleName, poolBalancesInvariantName,
+					fmt.Sprintf("\tpool id %d is not a cosmwasm pool\n", pool.GetId())), true
+			}
+			balances := k.bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(cwPool.GetContractAddress()))
+			// the contract reports zero amounts for the assets of an empty pool.
+			if !balances.IsAllGTE(sdk.NewCoins(liquidity...)) {
+				return sdk.FormatInvariant(types.ModuleName, poolBalancesInvariantName,
+					fmt.Sprintf("\tpool id %d\n\tliquidity: %s\n\tcontract balance: %s\n",
+						pool.GetId(), liquidity, balances)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolBalancesInvariantName,
+			"\tall pool contract balances cover their liquidity\n"), false
+	}
+}
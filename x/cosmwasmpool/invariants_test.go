@@ -0,0 +1,49 @@
+package cosmwasmpool_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool"
+)
+
+func (s *PoolModuleSuite) TestPoolBalancesInvariant() {
+	tests := map[string]struct {
+		joinedCoins sdk.Coins
+		// sends coins out of the pool contract, behind its back.
+		drainedCoins sdk.Coins
+		expectBroken bool
+	}{
+		"invariant holds": {
+			joinedCoins: initalDefaultSupply,
+		},
+		"empty pool": {},
+		"contract balance below liquidity": {
+			joinedCoins:  initalDefaultSupply,
+			drainedCoins: sdk.NewCoins(sdk.NewCoin(denomA, sdk.OneInt())),
+			expectBroken: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			s.FundAcc(s.TestAccs[0], initalDefaultSupply)
+			pool := s.PrepareCustomTransmuterPool(s.TestAccs[0], defaultDenoms)
+			if !tc.joinedCoins.Empty() {
+				s.JoinTransmuterPool(s.TestAccs[0], pool.GetId(), tc.joinedCoins)
+			}
+
+			if !tc.drainedCoins.Empty() {
+				err := s.App.BankKeeper.SendCoins(s.Ctx, sdk.MustAccAddressFromBech32(pool.GetContractAddress()), s.TestAccs[1], tc.drainedCoins)
+				s.Require().NoError(err)
+			}
+
+			r := invariants.NewRegistry()
+			cosmwasmpool.DeclareInvariants(r, *s.App.CosmwasmPoolKeeper)
+			results := invariants.Check(s.Ctx, r.Invariants(invariants.Cheap))
+			s.Require().Len(results, 1)
+			s.Require().Equal(tc.expectBroken, results[0].Broken, results[0].Message)
+		})
+	}
+}
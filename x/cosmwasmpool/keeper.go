@@ -21,6 +21,12 @@ type Keeper struct {
 	poolmanagerKeeper types.PoolManagerKeeper
 	contractKeeper    types.ContractKeeper
 	wasmKeeper        types.WasmKeeper
+
+	hooks types.CosmwasmPoolHooks
+
+	// callResults collects the pool contract call failures and successes of the block being delivered,
+	// outside of the tx state so that failing txs do not revert them. They are applied in EndBlock.
+	callResults *poolCallResults
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper) *Keeper {
@@ -29,7 +35,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtyp
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &Keeper{cdc: cdc, storeKey: storeKey, paramSpace: paramSpace, accountKeeper: accountKeeper, bankKeeper: bankKeeper}
+	return &Keeper{cdc: cdc, storeKey: storeKey, paramSpace: paramSpace, accountKeeper: accountKeeper, bankKeeper: bankKeeper, callResults: newPoolCallResults()}
 }
 
 // GetParams returns the total set of cosmwasmpool parameters.
@@ -43,6 +49,19 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// EnableMsgCreatePool enables the creation of cosmwasm pools with MsgCreateCosmWasmPool.
+// It is enabled by the v17 upgrade handler, and at genesis.
+func (k Keeper) EnableMsgCreatePool(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MsgCreatePoolEnabledKey, []byte{1})
+}
+
+// isMsgCreatePoolEnabled returns true if cosmwasm pools can be created with MsgCreateCosmWasmPool.
+func (k Keeper) isMsgCreatePoolEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.MsgCreatePoolEnabledKey)
+}
+
 // Set the poolmanager keeper.
 func (k *Keeper) SetPoolManagerKeeper(poolmanagerKeeper types.PoolManagerKeeper) {
 	k.poolmanagerKeeper = poolmanagerKeeper
@@ -58,6 +77,17 @@ func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
 	k.wasmKeeper = wasmKeeper
 }
 
+// Set the cosmwasm pool hooks.
+func (k *Keeper) SetHooks(hooks types.CosmwasmPoolHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set cosmwasm pool hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
 // asCosmwasmPool converts a poolI to a CosmWasmExtension.
 func (k *Keeper) asCosmwasmPool(poolI poolmanagertypes.PoolI) (types.CosmWasmExtension, error) {
 	cosmwasmPool, ok := poolI.(types.CosmWasmExtension)
@@ -66,9 +66,9 @@ func (p Pool) GetSpreadFactor(ctx sdk.Context) sdk.Dec {
 	return response.SwapFee
 }
 
-// IsActive returns true if the pool is active
+// IsActive returns true if the pool is active, i.e. it has not been disabled by the circuit breaker.
 func (p Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !p.Disabled
 }
 
 // SpotPrice returns the spot price of the pool.
@@ -104,6 +104,19 @@ func (p Pool) GetCodeId() uint64 {
 	return p.CodeId
 }
 
+func (p Pool) GetPreviousCodeId() uint64 {
+	return p.PreviousCodeId
+}
+
+func (p *Pool) SetCodeId(codeId uint64) {
+	p.PreviousCodeId = p.CodeId
+	p.CodeId = codeId
+}
+
+func (p *Pool) SetDisabled(disabled bool) {
+	p.Disabled = disabled
+}
+
 func (p Pool) GetInstantiateMsg() []byte {
 	return p.InstantiateMsg
 }
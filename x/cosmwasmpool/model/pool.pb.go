@@ -30,6 +30,13 @@ type CosmWasmPool struct {
 	PoolId          uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	CodeId          uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
 	InstantiateMsg  []byte `protobuf:"bytes,4,opt,name=instantiate_msg,json=instantiateMsg,proto3" json:"instantiate_msg,omitempty" yaml:"instantiate_msg"`
+	// previous_code_id is the code id of the pool contract before its last
+	// migration. Zero if the pool contract has never been migrated.
+	PreviousCodeId uint64 `protobuf:"varint,5,opt,name=previous_code_id,json=previousCodeId,proto3" json:"previous_code_id,omitempty" yaml:"previous_code_id"`
+	// disabled is true if the pool was disabled by the circuit breaker after
+	// too many consecutive failures. A disabled pool rejects swaps, joins and
+	// exits until re-enabled via governance.
+	Disabled bool `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty" yaml:"disabled"`
 }
 
 func (m *CosmWasmPool) Reset()      { *m = CosmWasmPool{} }
@@ -73,29 +80,33 @@ func init() {
 }
 
 var fileDescriptor_a0cb64564a744af1 = []byte{
-	// 350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x4f, 0x4a, 0xc3, 0x40,
-	0x14, 0xc6, 0x33, 0x5a, 0x2b, 0x86, 0x62, 0x35, 0x88, 0xad, 0x55, 0x92, 0x92, 0x55, 0x37, 0xcd,
-	0x50, 0x04, 0x91, 0xee, 0x6c, 0x41, 0xe8, 0x42, 0x90, 0x6c, 0x04, 0x37, 0x61, 0xf2, 0xc7, 0x18,
-	0xc8, 0xf4, 0x95, 0xbe, 0x69, 0xd5, 0x1b, 0xb8, 0x74, 0xe9, 0xb2, 0x87, 0xf0, 0x10, 0xe2, 0xaa,
-	0x4b, 0x57, 0xa5, 0xb4, 0x37, 0xe8, 0x09, 0x64, 0x32, 0x29, 0xd4, 0xee, 0xf2, 0xfd, 0xbe, 0x5f,
-	0x98, 0x99, 0xf7, 0xf4, 0x26, 0x20, 0x07, 0x4c, 0x90, 0x06, 0x80, 0xfc, 0x85, 0x21, 0x1f, 0x00,
-	0xa4, 0x74, 0xdc, 0xf2, 0x23, 0xc1, 0x5a, 0x94, 0x43, 0x18, 0xa5, 0x54, 0x22, 0x67, 0x30, 0x04,
-	0x01, 0xc6, 0x45, 0xae, 0x3b, 0x9b, 0xba, 0x93, 0xeb, 0xb5, 0xb3, 0x20, 0xab, 0xbd, 0xcc, 0xa5,
-	0x2a, 0xa8, 0x1f, 0x6b, 0x27, 0x31, 0xc4, 0xa0, 0xb8, 0xfc, 0xca, 0xa9, 0x15, 0x03, 0xc4, 0x69,
-	0x44, 0xb3, 0xe4, 0x8f, 0x9e, 0xa8, 0x48, 0x78, 0x84, 0x82, 0xf1, 0x81, 0x12, 0xec, 0x39, 0xd1,
-	0x4b, 0x5d, 0x40, 0xfe, 0xc0, 0x90, 0xdf, 0x03, 0xa4, 0xc6, 0xad, 0x7e, 0x14, 0x40, 0x5f, 0x0c,
-	0x59, 0x20, 0x3c, 0x16, 0x86, 0xc3, 0x08, 0xb1, 0x4a, 0xea, 0xa4, 0x71, 0xd0, 0x39, 0x5f, 0xcd,
-	0xac, 0xca, 0x1b, 0xe3, 0x69, 0xdb, 0xde, 0x36, 0x6c, 0xb7, 0xbc, 0x46, 0x37, 0x8a, 0x18, 0x15,
-	0x7d, 0x5f, 0x5e, 0xdd, 0x4b, 0xc2, 0xea, 0x4e, 0x9d, 0x34, 0x0a, 0x6e, 0x51, 0xc6, 0x5e, 0x28,
-	0x8b, 0x00, 0xc2, 0x48, 0x16, 0xbb, 0xaa, 0x90, 0xb1, 0x17, 0x1a, 0x5d, 0xbd, 0x9c, 0xf4, 0x51,
-	0xb0, 0xbe, 0x48, 0x98, 0x88, 0x3c, 0x8e, 0x71, 0xb5, 0x50, 0x27, 0x8d, 0x52, 0xa7, 0xb6, 0x9a,
-	0x59, 0xa7, 0xea, 0xe0, 0x2d, 0xc1, 0x76, 0x0f, 0x37, 0xc8, 0x1d, 0xc6, 0xed, 0xe3, 0xf7, 0x89,
-	0xa5, 0x7d, 0x4e, 0x2c, 0xed, 0xe7, 0xab, 0xb9, 0x27, 0x1f, 0xd4, 0xeb, 0xb8, 0xdf, 0x0b, 0x93,
-	0x4c, 0x17, 0x26, 0x99, 0x2f, 0x4c, 0xf2, 0xb1, 0x34, 0xb5, 0xe9, 0xd2, 0xd4, 0x7e, 0x97, 0xa6,
-	0xf6, 0x78, 0x1d, 0x27, 0xe2, 0x79, 0xe4, 0x3b, 0x01, 0x70, 0x9a, 0xcf, 0xbd, 0x99, 0x32, 0x1f,
-	0xd7, 0x81, 0x8e, 0x5b, 0x57, 0xf4, 0xf5, 0xff, 0xe6, 0xb2, 0x8d, 0xf9, 0xc5, 0x6c, 0x7a, 0x97,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x51, 0x2a, 0x73, 0x14, 0xde, 0x01, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0xeb, 0x6e, 0x5d, 0xc3, 0xb2, 0x5d, 0xa3, 0xb8, 0xb5, 0x4a, 0x52, 0x72, 0xea,
+	0xa5, 0x19, 0x8a, 0x20, 0xb2, 0x37, 0x53, 0x14, 0x7a, 0x10, 0x24, 0x17, 0xc1, 0x4b, 0x98, 0x64,
+	0xc6, 0x38, 0x90, 0xe9, 0x0b, 0x79, 0xd3, 0xaa, 0xdf, 0xc0, 0xa3, 0x47, 0x8f, 0xfb, 0x21, 0xfc,
+	0x10, 0x1e, 0x17, 0x4f, 0x7b, 0x2a, 0xd2, 0x7e, 0x83, 0x7e, 0x02, 0x99, 0x4c, 0x22, 0xdd, 0xde,
+	0xe6, 0xff, 0xff, 0xff, 0x1e, 0xef, 0x31, 0xef, 0xb9, 0x13, 0x40, 0x05, 0x28, 0x91, 0xe6, 0x80,
+	0xea, 0x0b, 0x43, 0x55, 0x01, 0x94, 0x74, 0x35, 0xcd, 0x84, 0x66, 0x53, 0xaa, 0x80, 0x8b, 0x92,
+	0x1a, 0x2b, 0xaa, 0x6a, 0xd0, 0xe0, 0x3d, 0x6f, 0xf1, 0x68, 0x1f, 0x8f, 0x5a, 0x7c, 0xf8, 0x34,
+	0x6f, 0xe2, 0xb4, 0x61, 0xa9, 0x15, 0xb6, 0x70, 0xf8, 0xb8, 0x80, 0x02, 0xac, 0x6f, 0x5e, 0xad,
+	0x1b, 0x14, 0x00, 0x45, 0x29, 0x68, 0xa3, 0xb2, 0xe5, 0x27, 0xaa, 0xa5, 0x12, 0xa8, 0x99, 0xaa,
+	0x2c, 0x10, 0xde, 0x1e, 0xb9, 0x67, 0x33, 0x40, 0xf5, 0x81, 0xa1, 0x7a, 0x0f, 0x50, 0x7a, 0x6f,
+	0xdd, 0x8b, 0x1c, 0x16, 0xba, 0x66, 0xb9, 0x4e, 0x19, 0xe7, 0xb5, 0x40, 0x1c, 0x90, 0x11, 0x19,
+	0x3f, 0x88, 0x9f, 0xed, 0xd6, 0xc1, 0xe5, 0x37, 0xa6, 0xca, 0xab, 0xf0, 0x90, 0x08, 0x93, 0x7e,
+	0x67, 0xbd, 0xb6, 0x8e, 0x77, 0xe9, 0xde, 0x37, 0xa3, 0xa7, 0x92, 0x0f, 0x8e, 0x46, 0x64, 0x7c,
+	0x9c, 0xf4, 0x8c, 0x9c, 0x73, 0x13, 0xe4, 0xc0, 0x85, 0x09, 0xee, 0xd9, 0xc0, 0xc8, 0x39, 0xf7,
+	0x66, 0x6e, 0x5f, 0x2e, 0x50, 0xb3, 0x85, 0x96, 0x4c, 0x8b, 0x54, 0x61, 0x31, 0x38, 0x1e, 0x91,
+	0xf1, 0x59, 0x3c, 0xdc, 0xad, 0x83, 0x27, 0xb6, 0xf1, 0x01, 0x10, 0x26, 0xe7, 0x7b, 0xce, 0x3b,
+	0x2c, 0xbc, 0x37, 0xee, 0x45, 0x55, 0x8b, 0x95, 0x84, 0x25, 0xa6, 0x5d, 0x9b, 0x13, 0xd3, 0x66,
+	0x7f, 0xfc, 0x43, 0x22, 0x4c, 0xce, 0x3b, 0x6b, 0x66, 0x67, 0xa1, 0xee, 0x29, 0x97, 0xc8, 0xb2,
+	0x52, 0xf0, 0x41, 0x6f, 0x44, 0xc6, 0xa7, 0xf1, 0xa3, 0xdd, 0x3a, 0xe8, 0xdb, 0xf2, 0x2e, 0x09,
+	0x93, 0xff, 0xd0, 0xd5, 0xc3, 0xef, 0xd7, 0x81, 0xf3, 0xf3, 0x3a, 0x70, 0xfe, 0xfc, 0x9a, 0x9c,
+	0x98, 0x8f, 0x9c, 0xc7, 0xc9, 0xef, 0x8d, 0x4f, 0x6e, 0x36, 0x3e, 0xf9, 0xbb, 0xf1, 0xc9, 0x8f,
+	0xad, 0xef, 0xdc, 0x6c, 0x7d, 0xe7, 0x76, 0xeb, 0x3b, 0x1f, 0x5f, 0x15, 0x52, 0x7f, 0x5e, 0x66,
+	0x51, 0x0e, 0x8a, 0xb6, 0xfb, 0x9e, 0x94, 0x2c, 0xc3, 0x4e, 0xd0, 0xd5, 0xf4, 0x25, 0xfd, 0x7a,
+	0xf7, 0x62, 0x9a, 0x4b, 0xc9, 0x7a, 0xcd, 0xd6, 0x5e, 0xfc, 0x1b, 0x00, 0x6d, 0xba, 0xa3, 0xc9,
+	0x56, 0x02, 0x00, 0x00,
 }
 
 func (m *CosmWasmPool) Marshal() (dAtA []byte, err error) {
@@ -118,6 +129,21 @@ func (m *CosmWasmPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PreviousCodeId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PreviousCodeId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.InstantiateMsg) > 0 {
 		i -= len(m.InstantiateMsg)
 		copy(dAtA[i:], m.InstantiateMsg)
@@ -176,6 +202,12 @@ func (m *CosmWasmPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPool(uint64(l))
 	}
+	if m.PreviousCodeId != 0 {
+		n += 1 + sovPool(uint64(m.PreviousCodeId))
+	}
+	if m.Disabled {
+		n += 2
+	}
 	return n
 }
 
@@ -318,6 +350,45 @@ func (m *CosmWasmPool) Unmarshal(dAtA []byte) error {
 				m.InstantiateMsg = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousCodeId", wireType)
+			}
+			m.PreviousCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -64,6 +64,18 @@ func (p CosmWasmPool) GetCodeId() uint64 {
 	panic("CosmWasmPool.GetType not implemented")
 }
 
+func (p CosmWasmPool) GetPreviousCodeId() uint64 {
+	panic("CosmWasmPool.GetPreviousCodeId not implemented")
+}
+
+func (p CosmWasmPool) SetCodeId(codeId uint64) {
+	panic("CosmWasmPool.SetCodeId not implemented")
+}
+
+func (p CosmWasmPool) SetDisabled(disabled bool) {
+	panic("CosmWasmPool.SetDisabled not implemented")
+}
+
 func (p CosmWasmPool) GetInstantiateMsg() []byte {
 	panic("CosmWasmPool.GetInstantiateMsg not implemented")
 }
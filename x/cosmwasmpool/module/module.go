@@ -15,12 +15,14 @@ import (
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 	"github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
 	cosmwasmpool "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool"
 	moduleclient "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/client"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/client/grpc"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/simulation"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 )
 
@@ -83,6 +85,9 @@ type AppModule struct {
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), cosmwasmpool.NewMsgServerImpl(&am.k))
+	// MsgCreateCosmWasmPool is routed here so that it can be delivered in txs, by users and by the simulator.
+	// It is rejected until the v17 upgrade.
+	model.RegisterMsgCreatorServer(cfg.MsgServer(), cosmwasmpool.NewMsgCreatorServerImpl(&am.k))
 	queryproto.RegisterQueryServer(cfg.QueryServer(), grpc.Querier{Q: moduleclient.NewQuerier(am.k)})
 }
 
@@ -96,6 +101,11 @@ func NewAppModule(cosmwasmpoolKeeper cosmwasmpool.Keeper) AppModule {
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 }
 
+// DeclareInvariants declares the cosmwasm pool invariants to the invariant registry.
+func (am AppModule) DeclareInvariants(r *invariants.Registry) {
+	cosmwasmpool.DeclareInvariants(r, am.k)
+}
+
 func (am AppModule) Route() sdk.Route {
 	return sdk.NewRoute(types.RouterKey, nil)
 }
@@ -129,8 +139,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock performs a no-op.
+// EndBlock applies the pool contract call failures of the block, disabling failing pools.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.k.EndBlock(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -138,5 +149,14 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 func (am AppModule) Actions() []simtypes.Action {
-	return nil
+	return []simtypes.Action{
+		simtypes.NewMsgBasedAction("CreateTransmuterPool", am.k, simulation.RandomMsgCreateTransmuterPool),
+	}
+}
+
+// PropertyChecks checks that the pool contracts hold their liquidity at the end of every block.
+func (am AppModule) PropertyChecks() []simtypes.PropertyCheck {
+	return []simtypes.PropertyCheck{
+		simtypes.NewInvariantPropertyCheck(cosmwasmpool.PoolBalancesInvariant(am.k)),
+	}
 }
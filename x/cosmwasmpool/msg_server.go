@@ -24,9 +24,20 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 	}
 }
 
+// NewMsgCreatorServerImpl returns the server of MsgCreateCosmWasmPool, which creates pools from the v17 upgrade on.
+func NewMsgCreatorServerImpl(keeper *Keeper) model.MsgCreatorServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
 func (m msgServer) CreateCosmWasmPool(goCtx context.Context, msg *model.MsgCreateCosmWasmPool) (*model.MsgCreateCosmWasmPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if !m.keeper.isMsgCreatePoolEnabled(ctx) {
+		return nil, types.ErrMsgCreatePoolDisabled
+	}
+
 	poolId, err := m.keeper.poolmanagerKeeper.CreatePool(ctx, msg)
 	if err != nil {
 		return nil, err
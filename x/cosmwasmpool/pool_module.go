@@ -2,19 +2,23 @@
 package cosmwasmpool
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/cosmwasm/msg"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/model"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager/events"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
-
-	"github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
 )
 
 var (
 	emptyCoins = sdk.NewCoins()
+
+	_ poolmanagertypes.JoinExitPoolModuleI = Keeper{}
 )
 
 // It converts the given pool to a CosmWasmPool, instantiates the Wasm contract using the contract keeper,
@@ -60,6 +64,26 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, cre
 	// Store the pool model
 	k.SetPool(ctx, cosmwasmPool)
 
+	// Add the share token's meta data to the bank keeper.
+	poolShareDenom := types.GetPoolShareDenom(cosmwasmPool.GetId())
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("The share token of the cosmwasm pool %d", cosmwasmPool.GetId()),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    poolShareDenom,
+				Exponent: 0,
+			},
+		},
+		Base:    poolShareDenom,
+		Display: poolShareDenom,
+	})
+
+	// N.B.: these hooks are used in x/pool-incentives to create gauges
+	// for the pool shares.
+	if k.hooks != nil {
+		k.hooks.AfterCosmWasmPoolCreated(ctx, creatorAddress, cosmwasmPool.GetId())
+	}
+
 	return nil
 }
 
@@ -124,7 +148,10 @@ func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) (denoms []string,
 		return nil, err
 	}
 
-	liquidity := cosmwasmPool.GetTotalPoolLiquidity(ctx)
+	liquidity, err := k.getTotalPoolLiquidityWithGasLimit(ctx, cosmwasmPool)
+	if err != nil {
+		return nil, err
+	}
 
 	denoms = make([]string, 0, liquidity.Len())
 	for _, coin := range liquidity {
@@ -156,7 +183,11 @@ func (k Keeper) CalculateSpotPrice(
 		return sdk.Dec{}, err
 	}
 
-	return cosmwasmPool.SpotPrice(ctx, quoteAssetDenom, baseAssetDenom)
+	err = k.runWithGasLimit(ctx, poolId, k.GetParams(ctx).QueryGasLimit, func(ctx sdk.Context) error {
+		price, err = cosmwasmPool.SpotPrice(ctx, quoteAssetDenom, baseAssetDenom)
+		return err
+	})
+	return price, err
 }
 
 // SwapExactAmountIn performs a swap operation with a specified input amount in a CosmWasm-based liquidity pool.
@@ -196,7 +227,7 @@ func (k Keeper) SwapExactAmountIn(
 	}
 
 	request := msg.NewSwapExactAmountInSudoMsg(sender.String(), tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
-	response, err := cosmwasm.Sudo[msg.SwapExactAmountInSudoMsg, msg.SwapExactAmountInSudoMsgResponse](ctx, k.contractKeeper, cosmwasmPool.GetContractAddress(), request)
+	response, err := sudoWithGasLimit[msg.SwapExactAmountInSudoMsg, msg.SwapExactAmountInSudoMsgResponse](ctx, k, cosmwasmPool, request)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -229,7 +260,7 @@ func (k Keeper) CalcOutAmtGivenIn(
 	}
 
 	request := msg.NewCalcOutAmtGivenInRequest(tokenIn, tokenOutDenom, swapFee)
-	response, err := cosmwasm.Query[msg.CalcOutAmtGivenInRequest, msg.CalcOutAmtGivenInResponse](ctx, k.wasmKeeper, cosmwasmPool.GetContractAddress(), request)
+	response, err := queryWithGasLimit[msg.CalcOutAmtGivenInRequest, msg.CalcOutAmtGivenInResponse](ctx, k, cosmwasmPool, request)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -266,7 +297,7 @@ func (k Keeper) SwapExactAmountOut(
 	}
 
 	request := msg.NewSwapExactAmountOutSudoMsg(sender.String(), tokenInDenom, tokenOut, tokenInMaxAmount, swapFee)
-	response, err := cosmwasm.Sudo[msg.SwapExactAmountOutSudoMsg, msg.SwapExactAmountOutSudoMsgResponse](ctx, k.contractKeeper, cosmwasmPool.GetContractAddress(), request)
+	response, err := sudoWithGasLimit[msg.SwapExactAmountOutSudoMsg, msg.SwapExactAmountOutSudoMsgResponse](ctx, k, cosmwasmPool, request)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -307,7 +338,7 @@ func (k Keeper) CalcInAmtGivenOut(
 	}
 
 	request := msg.NewCalcInAmtGivenOutRequest(tokenInDenom, tokenOut, swapFee)
-	response, err := cosmwasm.Query[msg.CalcInAmtGivenOutRequest, msg.CalcInAmtGivenOutResponse](ctx, k.wasmKeeper, cosmwasmPool.GetContractAddress(), request)
+	response, err := queryWithGasLimit[msg.CalcInAmtGivenOutRequest, msg.CalcInAmtGivenOutResponse](ctx, k, cosmwasmPool, request)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -315,6 +346,111 @@ func (k Keeper) CalcInAmtGivenOut(
 	return response.TokenIn, nil
 }
 
+// JoinPool adds liquidity to a CosmWasm-based liquidity pool, minting the pool's shares to the sender.
+//
+// Parameters:
+// - ctx: The context of the operation.
+// - sender: The address of the account joining the pool.
+// - pool: The liquidity pool to join.
+// - tokensIn: The tokens (assets) added to the pool's liquidity.
+// - shareOutMinAmount: The minimum amount of shares to be minted to the sender.
+//
+// Returns:
+// - sdk.Coin: The shares minted to the sender.
+// - error: An error if the pool conversion fails, if the contract fails to join the pool
+// or if fewer than shareOutMinAmount shares would be minted.
+func (k Keeper) JoinPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool poolmanagertypes.PoolI,
+	tokensIn sdk.Coins,
+	shareOutMinAmount sdk.Int,
+) (shareOut sdk.Coin, err error) {
+	cosmwasmPool, err := k.asCosmwasmPool(pool)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Send tokens in from sender to the pool
+	// We do this because sudo message does not support sending coins from the sender
+	if err := k.bankKeeper.SendCoins(ctx, sender, sdk.MustAccAddressFromBech32(cosmwasmPool.GetContractAddress()), tokensIn); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	request := msg.NewJoinPoolSudoMsg(sender.String(), tokensIn, shareOutMinAmount)
+	response, err := sudoWithGasLimit[msg.JoinPoolSudoMsg, msg.JoinPoolSudoMsgResponse](ctx, k, cosmwasmPool, request)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// The contract is expected to enforce the minimum, we check it again
+	// since the module mints the shares.
+	if response.ShareOutAmount.IsNil() || !response.ShareOutAmount.IsPositive() || response.ShareOutAmount.LT(shareOutMinAmount) {
+		return sdk.Coin{}, types.ShareOutBelowMinError{PoolId: pool.GetId(), ShareOutAmount: response.ShareOutAmount, MinAmount: shareOutMinAmount}
+	}
+
+	shareOut = sdk.NewCoin(types.GetPoolShareDenom(pool.GetId()), response.ShareOutAmount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareOut)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(shareOut)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	events.EmitAddLiquidityEvent(ctx, sender, pool.GetId(), tokensIn)
+	return shareOut, nil
+}
+
+// ExitPool removes liquidity from a CosmWasm-based liquidity pool, burning the sender's pool shares.
+//
+// Parameters:
+// - ctx: The context of the operation.
+// - sender: The address of the account exiting the pool.
+// - pool: The liquidity pool to exit.
+// - shareInAmount: The amount of the sender's shares to burn.
+// - tokenOutMins: The minimum amounts of the tokens (assets) to be sent to the sender.
+//
+// Returns:
+// - sdk.Coins: The tokens sent to the sender by the contract.
+// - error: An error if the pool conversion fails, if the sender does not have enough shares,
+// if the contract fails to exit the pool or if any of the tokens sent out would be less than its minimum.
+func (k Keeper) ExitPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool poolmanagertypes.PoolI,
+	shareInAmount sdk.Int,
+	tokenOutMins sdk.Coins,
+) (tokensOut sdk.Coins, err error) {
+	cosmwasmPool, err := k.asCosmwasmPool(pool)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Burn the shares before exiting the pool so that the contract
+	// sees the total shares after the exit.
+	shareIn := sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(pool.GetId()), shareInAmount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, shareIn); err != nil {
+		return sdk.Coins{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareIn); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Note that the contract sends the tokens out to the sender.
+	request := msg.NewExitPoolSudoMsg(sender.String(), shareInAmount, tokenOutMins)
+	response, err := sudoWithGasLimit[msg.ExitPoolSudoMsg, msg.ExitPoolSudoMsgResponse](ctx, k, cosmwasmPool, request)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if !response.TokensOut.IsAllGTE(tokenOutMins) {
+		return sdk.Coins{}, types.TokensOutBelowMinError{PoolId: pool.GetId(), TokensOut: response.TokensOut, TokenOutMins: tokenOutMins}
+	}
+
+	events.EmitRemoveLiquidityEvent(ctx, sender, pool.GetId(), response.TokensOut)
+	return response.TokensOut, nil
+}
+
 // ValidatePermissionlessPoolCreationEnabled returns nil if permissionless pool creation in the module is enabled.
 // Otherwise, returns an error.
 func (k Keeper) ValidatePermissionlessPoolCreationEnabled(ctx sdk.Context) error {
@@ -336,5 +472,18 @@ func (k Keeper) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins
 	if err != nil {
 		return sdk.Coins{}, err
 	}
-	return pool.GetTotalPoolLiquidity(ctx), nil
+	return k.getTotalPoolLiquidityWithGasLimit(ctx, pool)
+}
+
+// getTotalPoolLiquidityWithGasLimit queries the total liquidity of the given pool, capping the gas
+// of the contract query at the QueryGasLimit parameter.
+func (k Keeper) getTotalPoolLiquidityWithGasLimit(ctx sdk.Context, pool types.CosmWasmExtension) (liquidity sdk.Coins, err error) {
+	err = k.runWithGasLimit(ctx, pool.GetId(), k.GetParams(ctx).QueryGasLimit, func(ctx sdk.Context) error {
+		liquidity = pool.GetTotalPoolLiquidity(ctx)
+		return nil
+	})
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	return liquidity, nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
 	clmodel "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
+	cosmwasmpool "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/model"
 	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
@@ -18,7 +19,7 @@ const (
 	denomA        = apptesting.DefaultTransmuterDenomA
 	denomB        = apptesting.DefaultTransmuterDenomB
 	validCodeId   = uint64(1)
-	invalidCodeId        = validCodeId + 1
+	invalidCodeId = validCodeId + 1
 	defaultPoolId = uint64(1)
 	nonZeroFeeStr = "0.01"
 )
@@ -85,6 +86,8 @@ func (s *PoolModuleSuite) TestInitializePool() {
 			var testPool poolmanagertypes.PoolI
 			if !tc.isInvalidPoolType {
 				testPool = model.NewCosmWasmPool(defaultPoolId, tc.codeid, tc.instantiateMsg)
+				// the poolmanager routes the pool to its module before initializing it.
+				s.App.PoolManagerKeeper.SetPoolRoute(s.Ctx, defaultPoolId, poolmanagertypes.CosmWasm)
 			} else {
 				testPool = s.PrepareConcentratedPool()
 			}
@@ -123,6 +126,55 @@ func (s *PoolModuleSuite) TestInitializePool() {
 
 			// Validate that the pool's instantiate msg is set
 			s.Require().Equal(tc.instantiateMsg, cosmWasmPool.GetInstantiateMsg())
+
+			// Validate that the share denom metadata is set
+			metadata, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx, types.GetPoolShareDenom(defaultPoolId))
+			s.Require().True(found)
+			s.Require().Equal("cw-pool/1", metadata.Base)
+		})
+	}
+}
+
+// TestMsgCreateCosmWasmPool tests that cosmwasm pools are created with MsgCreateCosmWasmPool only once it is enabled
+// by the v17 upgrade.
+func (s *PoolModuleSuite) TestMsgCreateCosmWasmPool() {
+	tests := map[string]struct {
+		isEnabled   bool
+		expectedErr error
+	}{
+		"enabled": {
+			isEnabled: true,
+		},
+		"before the v17 upgrade": {
+			isEnabled:   false,
+			expectedErr: types.ErrMsgCreatePoolDisabled,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.Setup()
+			if !tc.isEnabled {
+				s.Ctx.KVStore(s.App.GetKey(types.StoreKey)).Delete(types.MsgCreatePoolEnabledKey)
+			}
+
+			s.FundAcc(s.TestAccs[0], apptesting.DefaultAcctFunds)
+			codeId := s.StoreCosmWasmPoolContractCode(apptesting.TransmuterContractName)
+			s.App.CosmwasmPoolKeeper.WhitelistCodeId(s.Ctx, codeId)
+			msg := model.NewMsgCreateCosmWasmPool(codeId, s.TestAccs[0], s.GetDefaultTransmuterInstantiateMsgBytes())
+
+			msgServer := cosmwasmpool.NewMsgCreatorServerImpl(s.App.CosmwasmPoolKeeper)
+			response, err := msgServer.CreateCosmWasmPool(sdk.WrapSDKContext(s.Ctx), &msg)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, err = s.App.CosmwasmPoolKeeper.GetPool(s.Ctx, response.PoolID)
+			s.Require().NoError(err)
 		})
 	}
 }
@@ -475,3 +527,73 @@ func (s *PoolModuleSuite) TestGetTotalPoolLiquidity() {
 		})
 	}
 }
+
+func (s *PoolModuleSuite) TestJoinPool_ExitPool() {
+	s.Setup()
+	cosmwasmPoolKeeper := s.App.CosmwasmPoolKeeper
+
+	pool := s.PrepareCosmWasmPool()
+	s.FundAcc(s.TestAccs[1], initalDefaultSupply)
+
+	// the transmuter contract does not implement the join and exit pool sudo messages.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, err := cosmwasmPoolKeeper.JoinPool(cacheCtx, s.TestAccs[1], pool, initalDefaultSupply, sdk.OneInt())
+	s.Require().Error(err)
+	s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, types.GetPoolShareDenom(pool.GetId())).IsZero())
+
+	// exiting requires the sender to hold the shares.
+	_, err = cosmwasmPoolKeeper.ExitPool(s.Ctx, s.TestAccs[1], pool, sdk.OneInt(), sdk.NewCoins())
+	s.Require().Error(err)
+
+	// joining and exiting route to the pool's module through the poolmanager.
+	_, err = s.App.PoolManagerKeeper.JoinPool(s.Ctx, s.TestAccs[1], pool.GetId(), initalDefaultSupply, sdk.OneInt())
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "join_pool")
+}
+
+func (s *PoolModuleSuite) TestJoinPool_ExitPool_Success() {
+	s.Setup()
+	cosmwasmPoolKeeper := s.App.CosmwasmPoolKeeper
+
+	pool := s.PrepareCosmWasmPool()
+	s.SetupJoinExitCosmWasmPools()
+	sender := s.TestAccs[1]
+	contractAddress := sdk.MustAccAddressFromBech32(pool.GetContractAddress())
+	shareDenom := types.GetPoolShareDenom(pool.GetId())
+
+	tokensIn := sdk.NewCoins(sdk.NewInt64Coin(denomA, 1_000), sdk.NewInt64Coin(denomB, 3_000))
+	s.FundAcc(sender, tokensIn)
+
+	// fewer shares than the minimum fails the join.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, err := cosmwasmPoolKeeper.JoinPool(cacheCtx, sender, pool, tokensIn, sdk.NewInt(4_001))
+	s.Require().EqualError(err, types.ShareOutBelowMinError{PoolId: pool.GetId(), ShareOutAmount: sdk.NewInt(4_000), MinAmount: sdk.NewInt(4_001)}.Error())
+
+	shareOut, err := cosmwasmPoolKeeper.JoinPool(s.Ctx, sender, pool, tokensIn, sdk.NewInt(4_000))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(shareDenom, 4_000), shareOut)
+	s.Require().Equal(sdk.NewCoins(shareOut), s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+	s.Require().Equal(tokensIn, s.App.BankKeeper.GetAllBalances(s.Ctx, contractAddress))
+	s.Require().Equal(shareOut, s.App.BankKeeper.GetSupply(s.Ctx, shareDenom))
+
+	// fewer tokens than the minimums fails the exit.
+	cacheCtx, _ = s.Ctx.CacheContext()
+	_, err = cosmwasmPoolKeeper.ExitPool(cacheCtx, sender, pool, sdk.NewInt(2_000), sdk.NewCoins(sdk.NewInt64Coin(denomA, 501)))
+	s.Require().EqualError(err, types.TokensOutBelowMinError{
+		PoolId:       pool.GetId(),
+		TokensOut:    sdk.NewCoins(sdk.NewInt64Coin(denomA, 500), sdk.NewInt64Coin(denomB, 1_500)),
+		TokenOutMins: sdk.NewCoins(sdk.NewInt64Coin(denomA, 501)),
+	}.Error())
+
+	// exiting more shares than held fails.
+	cacheCtx, _ = s.Ctx.CacheContext()
+	_, err = cosmwasmPoolKeeper.ExitPool(cacheCtx, sender, pool, sdk.NewInt(4_001), sdk.NewCoins())
+	s.Require().Error(err)
+
+	tokensOut, err := cosmwasmPoolKeeper.ExitPool(s.Ctx, sender, pool, sdk.NewInt(2_000), sdk.NewCoins(sdk.NewInt64Coin(denomA, 500)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomA, 500), sdk.NewInt64Coin(denomB, 1_500)), tokensOut)
+	s.Require().Equal(tokensOut.Add(sdk.NewInt64Coin(shareDenom, 2_000)), s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+	s.Require().Equal(tokensIn.Sub(tokensOut), s.App.BankKeeper.GetAllBalances(s.Ctx, contractAddress))
+	s.Require().Equal(sdk.NewInt64Coin(shareDenom, 2_000), s.App.BankKeeper.GetSupply(s.Ctx, shareDenom))
+}
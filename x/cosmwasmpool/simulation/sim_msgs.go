@@ -0,0 +1,99 @@
+package simulation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacysimulationtype "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	osmosimtypes "github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
+	cosmwasmpool "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool"
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/cosmwasm/msg"
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+)
+
+var PoolCreationFee = sdk.NewInt64Coin("stake", 10_000_000)
+
+// TransmuterByteCodePath is the path of the transmuter contract uploaded by the simulator,
+// relative to the directory the simulator runs in.
+var TransmuterByteCodePath = "../../x/cosmwasmpool/bytecode/transmuter.wasm"
+
+// RandomMsgCreateTransmuterPool creates a transmuter pool over two random denoms of the sender.
+func RandomMsgCreateTransmuterPool(k cosmwasmpool.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*model.MsgCreateCosmWasmPool, error) {
+	codeId, err := getTransmuterCodeId(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, senderExists := sim.RandomSimAccountWithConstraint(createPoolRestriction(sim, ctx))
+	if !senderExists {
+		return nil, errors.New("no sender with two different denoms & pool creation fee exists")
+	}
+
+	poolCoins, ok := sim.GetRandSubsetOfKDenoms(ctx, sender, 2)
+	if !ok {
+		return nil, fmt.Errorf("provided sender with requested number of denoms does not exist")
+	}
+
+	poolAssetDenoms := make([]string, 0, len(poolCoins))
+	for _, coin := range poolCoins {
+		poolAssetDenoms = append(poolAssetDenoms, coin.Denom)
+	}
+
+	instantiateMsg, err := json.Marshal(msg.InstantiateMsg{PoolAssetDenoms: poolAssetDenoms})
+	if err != nil {
+		return nil, err
+	}
+
+	createPoolMsg := model.NewMsgCreateCosmWasmPool(codeId, sender.Address, instantiateMsg)
+
+	// the contract rejects some denom pairs when instantiated
+	if err := sim.CheckMsg(ctx, &createPoolMsg); err != nil {
+		return nil, err
+	}
+
+	return &createPoolMsg, nil
+}
+
+// getTransmuterCodeId returns the whitelisted transmuter code id, uploading and whitelisting
+// the transmuter code the first time as governance would. Governance also removes the denom creation
+// fee, as on mainnet since v16, since the transmuter contracts create their share denom without holding fees.
+func getTransmuterCodeId(k cosmwasmpool.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (uint64, error) {
+	if whitelist := k.GetParams(ctx).CodeIdWhitelist; len(whitelist) > 0 {
+		return whitelist[0], nil
+	}
+
+	tokenFactoryParams := sim.TokenFactoryKeeper().GetParams(ctx)
+	tokenFactoryParams.DenomCreationFee = nil
+	sim.TokenFactoryKeeper().SetParams(ctx, tokenFactoryParams)
+
+	byteCode, err := os.ReadFile(TransmuterByteCodePath)
+	if err != nil {
+		return 0, err
+	}
+
+	proposal := &types.UploadCosmWasmPoolCodeAndWhiteListProposal{
+		Title:        "Upload transmuter",
+		Description:  "Upload and whitelist the transmuter code",
+		WASMByteCode: byteCode,
+	}
+	if err := cosmwasmpool.NewCosmWasmPoolProposalHandler(k)(ctx, proposal); err != nil {
+		return 0, err
+	}
+
+	return k.GetParams(ctx).CodeIdWhitelist[0], nil
+}
+
+// createPoolRestriction creates specific restriction for the creation of a pool.
+func createPoolRestriction(sim *osmosimtypes.SimCtx, ctx sdk.Context) osmosimtypes.SimAccountConstraint {
+	return func(acc legacysimulationtype.Account) bool {
+		accCoins := sim.BankKeeper().SpendableCoins(ctx, acc.Address)
+		hasTwoCoins := len(accCoins) >= 3
+		hasPoolCreationFee := accCoins.AmountOf(PoolCreationFee.Denom).GT(PoolCreationFee.Amount)
+		return hasTwoCoins && hasPoolCreationFee
+	}
+}
@@ -3,12 +3,16 @@ package types
 import (
 	"errors"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
 	ErrEmptyPoolIds                         = errors.New("pool id list cannot be empty")
+	ErrBothOfPoolIdsAndFromCodeIdSpecified  = errors.New("both pool ids and from code id are set. Only one must be specified.")
 	ErrNoneOfCodeIdAndContractCodeSpecified = errors.New("both code id and byte code are unset. Only one must be specified.")
 	ErrBothOfCodeIdAndContractCodeSpecified = errors.New("both code id and byte code are set. Only one must be specified.")
+	ErrMsgCreatePoolDisabled                = errors.New("MsgCreateCosmWasmPool is not enabled before the v17 upgrade")
 )
 
 type InvalidPoolTypeError struct {
@@ -27,6 +31,14 @@ func (e PoolNotFoundError) Error() string {
 	return fmt.Sprintf("pool not found. pool id (%d)", e.PoolId)
 }
 
+type NoPoolsWithCodeIdError struct {
+	CodeId uint64
+}
+
+func (e NoPoolsWithCodeIdError) Error() string {
+	return fmt.Sprintf("no cosmwasm pools with code id (%d) to migrate", e.CodeId)
+}
+
 type CodeIdNotWhitelistedError struct {
 	CodeId uint64
 }
@@ -34,3 +46,48 @@ type CodeIdNotWhitelistedError struct {
 func (e CodeIdNotWhitelistedError) Error() string {
 	return fmt.Sprintf("cannot create coswasm pool with the given code id (%d). Please whitelist it via governance", e.CodeId)
 }
+
+type ShareOutBelowMinError struct {
+	PoolId         uint64
+	ShareOutAmount sdk.Int
+	MinAmount      sdk.Int
+}
+
+func (e ShareOutBelowMinError) Error() string {
+	return fmt.Sprintf("joining cosmwasm pool (%d) minted (%s) shares, less than the minimum (%s)", e.PoolId, e.ShareOutAmount, e.MinAmount)
+}
+
+type TokensOutBelowMinError struct {
+	PoolId       uint64
+	TokensOut    sdk.Coins
+	TokenOutMins sdk.Coins
+}
+
+func (e TokensOutBelowMinError) Error() string {
+	return fmt.Sprintf("exiting cosmwasm pool (%d) returned (%s), less than the minimum (%s)", e.PoolId, e.TokensOut, e.TokenOutMins)
+}
+
+type PoolDisabledError struct {
+	PoolId uint64
+}
+
+func (e PoolDisabledError) Error() string {
+	return fmt.Sprintf("cosmwasm pool (%d) is disabled by the circuit breaker. It can be re-enabled via governance", e.PoolId)
+}
+
+type PoolGasLimitExceededError struct {
+	PoolId   uint64
+	GasLimit uint64
+}
+
+func (e PoolGasLimitExceededError) Error() string {
+	return fmt.Sprintf("cosmwasm pool (%d) contract call exceeded the gas limit (%d)", e.PoolId, e.GasLimit)
+}
+
+type PoolPanicError struct {
+	PoolId uint64
+}
+
+func (e PoolPanicError) Error() string {
+	return fmt.Sprintf("cosmwasm pool (%d) contract call panicked", e.PoolId)
+}
@@ -3,9 +3,15 @@ package types
 const (
 	TypeEvtUploadedCosmwasmPoolCode = "uploaded_cosmwasm_pool_code"
 	TypeEvtMigratedCosmwasmPoolCode = "migrated_cosmwasm_pool_code"
+	TypeEvtMigratedCosmwasmPool     = "migrated_cosmwasm_pool"
+	TypeEvtDisabledCosmwasmPool     = "disabled_cosmwasm_pool"
+	TypeEvtEnabledCosmwasmPool      = "enabled_cosmwasm_pool"
 
 	AttributeValueCategory      = ModuleName
 	AttributeKeyCodeID          = "code_id"
 	AttributeKeyChecksum        = "checksum"
 	AttributeKeyPoolIDsMigrated = "pool_ids_migrated"
+	AttributeKeyPoolID          = "pool_id"
+	AttributeKeyPreviousCodeID  = "previous_code_id"
+	AttributeKeyFailures        = "consecutive_failures"
 )
@@ -6,7 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -31,6 +31,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params is the container of cosmwasmpool parameters.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// pools are the cosmwasm pools, with the address of the contract holding
+	// their liquidity.
+	Pools []*types.Any `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,6 +76,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPools() []*types.Any {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.cosmwasmpool.v1beta1.GenesisState")
 }
@@ -82,24 +92,26 @@ func init() {
 }
 
 var fileDescriptor_8fd7fc7fdf8fd2f4 = []byte{
-	// 266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x8f, 0xb1, 0x4b, 0xc4, 0x30,
-	0x18, 0xc5, 0x5b, 0x90, 0x1b, 0xaa, 0xd3, 0xe1, 0xa0, 0x87, 0x44, 0x11, 0x07, 0x15, 0xcc, 0x47,
-	0x15, 0xc4, 0xb9, 0x8b, 0xab, 0xd4, 0xcd, 0x45, 0x92, 0x1a, 0x63, 0xa1, 0xe9, 0x57, 0xfa, 0xa5,
-	0xa7, 0xf7, 0x5f, 0xf8, 0x67, 0xdd, 0x78, 0xa3, 0x93, 0x48, 0xfb, 0x8f, 0x88, 0x49, 0x2a, 0x7a,
-	0xc3, 0x6d, 0x79, 0xbc, 0xdf, 0xfb, 0xf2, 0x5e, 0x72, 0x8e, 0x64, 0x90, 0x4a, 0x82, 0x02, 0xc9,
-	0xbc, 0x0a, 0x32, 0x0d, 0x62, 0x05, 0xf3, 0x54, 0x2a, 0x2b, 0x52, 0xd0, 0xaa, 0x56, 0x54, 0x12,
-	0x6f, 0x5a, 0xb4, 0x38, 0x3d, 0x08, 0x2c, 0xff, 0xcb, 0xf2, 0xc0, 0xce, 0x76, 0x35, 0x6a, 0x74,
-	0x20, 0xfc, 0xbc, 0x7c, 0x66, 0xb6, 0xaf, 0x11, 0x75, 0xa5, 0xc0, 0x29, 0xd9, 0x3d, 0x83, 0xa8,
-	0x17, 0xa3, 0x55, 0xb8, 0x7b, 0x8f, 0x3e, 0xe3, 0x45, 0xb0, 0xd8, 0x7a, 0xea, 0xa9, 0x6b, 0x85,
-	0x2d, 0xb1, 0x1e, 0x7d, 0x4f, 0x83, 0x14, 0xa4, 0x7e, 0xcb, 0x16, 0x58, 0x8e, 0xfe, 0xd9, 0xc6,
-	0x55, 0x8d, 0x68, 0x85, 0x09, 0x5f, 0x1d, 0xe7, 0xc9, 0xce, 0xad, 0x5f, 0x79, 0x6f, 0x85, 0x55,
-	0xd3, 0x2c, 0x99, 0x78, 0x7f, 0x2f, 0x3e, 0x8a, 0x4f, 0xb7, 0x2f, 0x4f, 0xf8, 0xa6, 0xd5, 0xfc,
-	0xce, 0xb1, 0xd9, 0xd6, 0xf2, 0xf3, 0x30, 0xca, 0x43, 0x32, 0xcb, 0x97, 0x3d, 0x8b, 0x57, 0x3d,
-	0x8b, 0xbf, 0x7a, 0x16, 0xbf, 0x0f, 0x2c, 0x5a, 0x0d, 0x2c, 0xfa, 0x18, 0x58, 0xf4, 0x70, 0xa3,
-	0x4b, 0xfb, 0xd2, 0x49, 0x5e, 0xa0, 0x81, 0x70, 0xf7, 0xa2, 0x12, 0x92, 0x46, 0x01, 0xf3, 0xf4,
-	0x1a, 0xde, 0xfe, 0xd7, 0xb6, 0x8b, 0x46, 0x91, 0x9c, 0xb8, 0xba, 0x57, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x1b, 0x3e, 0x0d, 0xb5, 0xb1, 0x01, 0x00, 0x00,
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x8f, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0x7b, 0x69, 0x25, 0x52, 0xa6, 0xaa, 0x43, 0xa9, 0x90, 0xa9, 0x10, 0x43, 0x41,
+	0xaa, 0xad, 0x14, 0x81, 0x58, 0xc9, 0x82, 0xd8, 0xaa, 0xb0, 0xb1, 0x20, 0x3b, 0x18, 0x13, 0x29,
+	0xc9, 0x89, 0x62, 0xa7, 0x90, 0x47, 0x60, 0xe3, 0x61, 0x78, 0x88, 0x8a, 0xa9, 0x23, 0x13, 0x42,
+	0xc9, 0x8b, 0xa0, 0xc6, 0x0e, 0x02, 0x86, 0x6e, 0x3e, 0xfa, 0xbf, 0xdf, 0xe7, 0x7c, 0xee, 0x31,
+	0xa8, 0x04, 0x54, 0xa4, 0x68, 0x08, 0x2a, 0x79, 0x64, 0x2a, 0xc9, 0x00, 0x62, 0xba, 0xf0, 0xb8,
+	0xd0, 0xcc, 0xa3, 0x52, 0xa4, 0x42, 0x45, 0x8a, 0x64, 0x39, 0x68, 0xe8, 0xef, 0x59, 0x96, 0xfc,
+	0x64, 0x89, 0x65, 0x47, 0x03, 0x09, 0x12, 0x1a, 0x90, 0xae, 0x5f, 0xa6, 0x33, 0xda, 0x95, 0x00,
+	0x32, 0x16, 0xb4, 0x99, 0x78, 0x71, 0x4f, 0x59, 0x5a, 0xb6, 0x51, 0xd8, 0xfc, 0x77, 0x6b, 0x3a,
+	0x66, 0xb0, 0x11, 0xfe, 0xdb, 0xba, 0x2b, 0x72, 0xa6, 0x23, 0x48, 0xdb, 0xdc, 0xd0, 0x94, 0x33,
+	0x25, 0xbe, 0x8f, 0x0d, 0x21, 0x6a, 0xf3, 0xa3, 0x8d, 0x56, 0x19, 0xcb, 0x59, 0x62, 0x57, 0x1d,
+	0x3c, 0x23, 0x77, 0xe7, 0xd2, 0x68, 0x5e, 0x6b, 0xa6, 0x45, 0xdf, 0x77, 0xbb, 0x06, 0x18, 0xa2,
+	0x31, 0x9a, 0xf4, 0x66, 0x87, 0x64, 0x93, 0x36, 0x99, 0x37, 0xac, 0xbf, 0xb5, 0xfc, 0xd8, 0x77,
+	0x02, 0xdb, 0xec, 0x9f, 0xba, 0x9d, 0x35, 0xa4, 0x86, 0xff, 0xc6, 0xff, 0x27, 0xbd, 0xd9, 0x80,
+	0x18, 0x1f, 0xd2, 0xfa, 0x90, 0x8b, 0xb4, 0xf4, 0xb7, 0xdf, 0x5e, 0xa7, 0x9d, 0x39, 0x40, 0x7c,
+	0x15, 0x18, 0xda, 0x0f, 0x96, 0x15, 0x46, 0xab, 0x0a, 0xa3, 0xcf, 0x0a, 0xa3, 0x97, 0x1a, 0x3b,
+	0xab, 0x1a, 0x3b, 0xef, 0x35, 0x76, 0x6e, 0xce, 0x65, 0xa4, 0x1f, 0x0a, 0x4e, 0x42, 0x48, 0xa8,
+	0x3d, 0x67, 0x1a, 0x33, 0xae, 0xda, 0x81, 0x2e, 0xbc, 0x33, 0xfa, 0xf4, 0x5b, 0x57, 0x97, 0x99,
+	0x50, 0xbc, 0xdb, 0xec, 0x3c, 0xf9, 0x1a, 0x00, 0x59, 0x60, 0xd0, 0x48, 0xe9, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -122,6 +134,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -154,6 +180,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -225,6 +257,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 const (
 	ProposalTypeUploadCosmWasmPoolCodeAndWhiteList = "UploadCosmWasmPoolCodeAndWhiteListProposal"
 	ProposalTypeMigratePoolContractsProposal       = "MigratePoolContractsProposal"
+	ProposalTypeEnablePoolsProposal                = "EnablePoolsProposal"
 )
 
 func init() {
@@ -17,11 +18,14 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&UploadCosmWasmPoolCodeAndWhiteListProposal{}, "osmosis/UploadCosmWasmPoolCodeAndWhiteListProposal")
 	govtypes.RegisterProposalType(ProposalTypeMigratePoolContractsProposal)
 	govtypes.RegisterProposalTypeCodec(&MigratePoolContractsProposal{}, "osmosis/MigratePoolContractsProposal")
+	govtypes.RegisterProposalType(ProposalTypeEnablePoolsProposal)
+	govtypes.RegisterProposalTypeCodec(&EnablePoolsProposal{}, "osmosis/EnablePoolsProposal")
 }
 
 var (
 	_ govtypes.Content = &UploadCosmWasmPoolCodeAndWhiteListProposal{}
 	_ govtypes.Content = &MigratePoolContractsProposal{}
+	_ govtypes.Content = &EnablePoolsProposal{}
 )
 
 // NewUploadCosmWasmPoolCodeAndWhiteListProposal returns a new instance of an upload cosmwasm pool code and whitelist proposal struct.
@@ -71,13 +75,15 @@ Description: %s
 }
 
 // NewMigratePoolContractsProposal returns a new instance of a contact code migration proposal.
-func NewMigratePoolContractsProposal(title, description string, poolCodeIds []uint64, newCodeId uint64, wasmByteCode []byte) govtypes.Content {
+func NewMigratePoolContractsProposal(title, description string, poolIds []uint64, fromCodeId uint64, newCodeId uint64, wasmByteCode []byte, migrateMsg []byte) govtypes.Content {
 	return &MigratePoolContractsProposal{
 		Title:        title,
 		Description:  description,
-		PoolIds:      []uint64{},
+		PoolIds:      poolIds,
+		FromCodeId:   fromCodeId,
 		NewCodeId:    newCodeId,
 		WASMByteCode: wasmByteCode,
+		MigrateMsg:   migrateMsg,
 	}
 }
 
@@ -101,7 +107,7 @@ func (p *MigratePoolContractsProposal) ValidateBasic() error {
 		return err
 	}
 
-	if err := ValidateMigrationProposalConfiguration(p.PoolIds, p.NewCodeId, p.WASMByteCode); err != nil {
+	if err := ValidateMigrationProposalConfiguration(p.PoolIds, p.FromCodeId, p.NewCodeId, p.WASMByteCode); err != nil {
 		return err
 	}
 
@@ -115,9 +121,10 @@ func (p MigratePoolContractsProposal) String() string {
 Title:       %s
 Description: %s
 PoolIds: %v
+FromCodeId:  %d
 NewCodeId:   %d
 Upload Wasm Code Given: %t
-`, p.Title, p.Description, p.PoolIds, p.NewCodeId, len(p.WASMByteCode) > 0))
+`, p.Title, p.Description, p.PoolIds, p.FromCodeId, p.NewCodeId, len(p.WASMByteCode) > 0))
 	return b.String()
 }
 
@@ -131,11 +138,16 @@ Upload Wasm Code Given: %t
 // 2. If the codeID is zero, it will upload the given uploadByteCode and use the new resulting code id to migrate
 // the pool to. Errors if uploadByteCode is empty or invalid.
 //
-// For any of the options, it also validates that pool id list is not empty. Returns error if it is.
-func ValidateMigrationProposalConfiguration(poolIds []uint64, newCodeId uint64, uploadByteCode []byte) error {
-	if len(poolIds) == 0 {
+// For any of the options, it also validates that exactly one of the pool id list and the code id to migrate
+// from is set. Returns error if none or both are.
+func ValidateMigrationProposalConfiguration(poolIds []uint64, fromCodeId uint64, newCodeId uint64, uploadByteCode []byte) error {
+	isFromCodeIdGiven := fromCodeId != 0
+	if len(poolIds) == 0 && !isFromCodeIdGiven {
 		return ErrEmptyPoolIds
 	}
+	if len(poolIds) != 0 && isFromCodeIdGiven {
+		return ErrBothOfPoolIdsAndFromCodeIdSpecified
+	}
 
 	isNewCodeIdGiven := newCodeId != 0
 	isUploadByteCodeGiven := len(uploadByteCode) != 0
@@ -147,3 +159,50 @@ func ValidateMigrationProposalConfiguration(poolIds []uint64, newCodeId uint64,
 	}
 	return nil
 }
+
+// NewEnablePoolsProposal returns a new instance of a proposal to re-enable pools disabled by the circuit breaker.
+func NewEnablePoolsProposal(title, description string, poolIds []uint64) govtypes.Content {
+	return &EnablePoolsProposal{
+		Title:       title,
+		Description: description,
+		PoolIds:     poolIds,
+	}
+}
+
+func (p *EnablePoolsProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *EnablePoolsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *EnablePoolsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *EnablePoolsProposal) ProposalType() string {
+	return ProposalTypeEnablePoolsProposal
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *EnablePoolsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.PoolIds) == 0 {
+		return ErrEmptyPoolIds
+	}
+
+	return nil
+}
+
+// String returns a string containing the enable pools proposal.
+func (p EnablePoolsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Enable CosmWasm Pools Proposal:
+Title:       %s
+Description: %s
+PoolIds: %v
+`, p.Title, p.Description, p.PoolIds))
+	return b.String()
+}
@@ -84,6 +84,10 @@ var xxx_messageInfo_UploadCosmWasmPoolCodeAndWhiteListProposal proto.InternalMes
 // In both cases, if one of the pools specified by the given poolID does not
 // exist, the proposal fails.
 //
+// Instead of listing pool ids, the proposal may set from_code_id to migrate
+// every pool whose contract is on the given code id. Only one of pool_ids and
+// from_code_id should be set.
+//
 // The reason for having poolIDs be a slice of ids is to account for the
 // potential need for emergency migration of all old code ids associated with
 // particular pools to new code ids, or simply having the flexibility of
@@ -111,6 +115,9 @@ type MigratePoolContractsProposal struct {
 	WASMByteCode []byte `protobuf:"bytes,5,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
 	// MigrateMsg migrate message to be used for migrating the pool contracts.
 	MigrateMsg []byte `protobuf:"bytes,6,opt,name=migrate_msg,json=migrateMsg,proto3" json:"migrate_msg,omitempty"`
+	// from_code_id is the code id whose pool contracts are all to be migrated.
+	// Only one of pool_ids and from_code_id should be set.
+	FromCodeId uint64 `protobuf:"varint,7,opt,name=from_code_id,json=fromCodeId,proto3" json:"from_code_id,omitempty"`
 }
 
 func (m *MigratePoolContractsProposal) Reset()      { *m = MigratePoolContractsProposal{} }
@@ -145,9 +152,52 @@ func (m *MigratePoolContractsProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MigratePoolContractsProposal proto.InternalMessageInfo
 
+// EnablePoolsProposal is a gov Content type for re-enabling cosmwasm pools
+// that were disabled by the circuit breaker. It also resets the consecutive
+// failure count of the pools.
+type EnablePoolsProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// pool_ids are the ids of the pools to re-enable.
+	PoolIds []uint64 `protobuf:"varint,3,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+}
+
+func (m *EnablePoolsProposal) Reset()      { *m = EnablePoolsProposal{} }
+func (*EnablePoolsProposal) ProtoMessage() {}
+func (*EnablePoolsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c184a48c55bbcf5c, []int{2}
+}
+func (m *EnablePoolsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnablePoolsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnablePoolsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnablePoolsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnablePoolsProposal.Merge(m, src)
+}
+func (m *EnablePoolsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnablePoolsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnablePoolsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnablePoolsProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*UploadCosmWasmPoolCodeAndWhiteListProposal)(nil), "osmosis.cosmwasmpool.v1beta1.UploadCosmWasmPoolCodeAndWhiteListProposal")
 	proto.RegisterType((*MigratePoolContractsProposal)(nil), "osmosis.cosmwasmpool.v1beta1.MigratePoolContractsProposal")
+	proto.RegisterType((*EnablePoolsProposal)(nil), "osmosis.cosmwasmpool.v1beta1.EnablePoolsProposal")
 }
 
 func init() {
@@ -155,32 +205,34 @@ func init() {
 }
 
 var fileDescriptor_c184a48c55bbcf5c = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xb1, 0x8b, 0xd4, 0x40,
-	0x14, 0xc6, 0x33, 0xee, 0xde, 0xe9, 0xcd, 0x06, 0x91, 0x70, 0x45, 0x94, 0x23, 0x09, 0x57, 0x48,
-	0x10, 0x4c, 0x58, 0x84, 0x43, 0xec, 0x6e, 0xaf, 0x3a, 0x70, 0xe1, 0x88, 0xc8, 0x82, 0x4d, 0x98,
-	0x64, 0x86, 0xdc, 0x40, 0x26, 0x2f, 0xe4, 0x8d, 0xbb, 0xee, 0x7f, 0x60, 0x69, 0x69, 0xb9, 0xb5,
-	0x7f, 0x89, 0xe5, 0x95, 0x56, 0x22, 0xd9, 0xc6, 0x3f, 0xc1, 0x52, 0x26, 0xc9, 0xc2, 0x2e, 0x58,
-	0x08, 0xd7, 0xcd, 0xf7, 0xe6, 0x7b, 0xcc, 0xef, 0x1b, 0x3e, 0xfa, 0x1c, 0x50, 0x01, 0x4a, 0x8c,
-	0x73, 0x40, 0xb5, 0x62, 0xa8, 0x6a, 0x80, 0x32, 0x5e, 0x4e, 0x33, 0xa1, 0xd9, 0x34, 0x2e, 0x60,
-	0x19, 0xd5, 0x0d, 0x68, 0x70, 0xce, 0x06, 0x5f, 0xb4, 0xef, 0x8b, 0x06, 0xdf, 0xb3, 0xd3, 0x02,
-	0x0a, 0xe8, 0x8c, 0xb1, 0x39, 0xf5, 0x3b, 0xe7, 0xdf, 0x08, 0x7d, 0xf1, 0xbe, 0x2e, 0x81, 0xf1,
-	0x2b, 0x40, 0xb5, 0x60, 0xa8, 0x6e, 0x00, 0xca, 0x2b, 0xe0, 0xe2, 0xb2, 0xe2, 0x8b, 0x5b, 0xa9,
-	0xc5, 0x5b, 0x89, 0xfa, 0xa6, 0x81, 0x1a, 0x90, 0x95, 0xce, 0x29, 0x3d, 0xd2, 0x52, 0x97, 0xc2,
-	0x25, 0x01, 0x09, 0x4f, 0x92, 0x5e, 0x38, 0x01, 0x9d, 0x70, 0x81, 0x79, 0x23, 0x6b, 0x2d, 0xa1,
-	0x72, 0x1f, 0x74, 0x77, 0xfb, 0x23, 0xe7, 0x82, 0x3e, 0x36, 0x40, 0x69, 0xb6, 0xd6, 0x22, 0xcd,
-	0x81, 0x0b, 0x77, 0x14, 0x90, 0xd0, 0x9e, 0x3d, 0x69, 0x7f, 0xfa, 0xf6, 0xe2, 0xf2, 0xdd, 0x7c,
-	0xb6, 0xd6, 0xc2, 0xbc, 0x9a, 0xd8, 0xc6, 0xb7, 0x53, 0x6f, 0xec, 0xcf, 0x1b, 0xdf, 0xfa, 0xba,
-	0xf1, 0xad, 0xdf, 0x1b, 0x9f, 0x9c, 0xff, 0x21, 0xf4, 0x6c, 0x2e, 0x8b, 0x86, 0x69, 0xd1, 0x53,
-	0x56, 0xba, 0x61, 0xb9, 0xc6, 0x7b, 0xe3, 0x3d, 0xa5, 0x8f, 0xcc, 0x5f, 0xa5, 0x92, 0xa3, 0x3b,
-	0x0a, 0x46, 0xe1, 0x38, 0x79, 0x68, 0xf4, 0x35, 0x47, 0xc7, 0xa3, 0x93, 0x4a, 0xac, 0x3a, 0xe6,
-	0x54, 0x72, 0x77, 0x1c, 0x90, 0x70, 0x9c, 0x9c, 0x54, 0x62, 0x65, 0xf8, 0xae, 0xf9, 0x3f, 0x92,
-	0x1d, 0xfd, 0x4f, 0x32, 0xc7, 0xa7, 0x13, 0xd5, 0x47, 0x49, 0x15, 0x16, 0xee, 0xb1, 0x59, 0x4a,
-	0xe8, 0x30, 0x9a, 0x63, 0x71, 0x18, 0x7d, 0x96, 0x7c, 0x6f, 0x3d, 0x72, 0xd7, 0x7a, 0xe4, 0x57,
-	0xeb, 0x91, 0x2f, 0x5b, 0xcf, 0xba, 0xdb, 0x7a, 0xd6, 0x8f, 0xad, 0x67, 0x7d, 0x78, 0x5d, 0x48,
-	0x7d, 0xfb, 0x31, 0x8b, 0x72, 0x50, 0xf1, 0x50, 0x80, 0x97, 0x25, 0xcb, 0x70, 0x27, 0xe2, 0xe5,
-	0xf4, 0x22, 0xfe, 0x74, 0xd8, 0x1d, 0xbd, 0xae, 0x05, 0x66, 0xc7, 0x5d, 0x05, 0x5e, 0xfd, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x51, 0x07, 0x9b, 0x6a, 0x60, 0x02, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x41, 0x6b, 0xd4, 0x40,
+	0x18, 0xcd, 0x74, 0xb7, 0xad, 0x9d, 0x0d, 0x22, 0xb1, 0x87, 0x28, 0x25, 0x09, 0x3d, 0xc8, 0x22,
+	0xb8, 0x61, 0x11, 0x8a, 0x78, 0xeb, 0x16, 0x0f, 0x05, 0x17, 0x4a, 0x44, 0x16, 0xbc, 0x84, 0x49,
+	0x66, 0x4c, 0x07, 0x32, 0xf9, 0x42, 0xbe, 0x31, 0xeb, 0xfe, 0x03, 0x8f, 0x1e, 0xc5, 0xd3, 0x9e,
+	0xfd, 0x25, 0x1e, 0x7b, 0xf4, 0x24, 0x92, 0xbd, 0xf8, 0x33, 0x64, 0x92, 0x54, 0xba, 0xe2, 0x41,
+	0x90, 0xde, 0xe6, 0x7d, 0xf3, 0x3e, 0xde, 0xfb, 0x1e, 0x8f, 0x3e, 0x02, 0x54, 0x80, 0x12, 0xc3,
+	0x14, 0x50, 0x2d, 0x19, 0xaa, 0x12, 0x20, 0x0f, 0xeb, 0x69, 0x22, 0x34, 0x9b, 0x86, 0x19, 0xd4,
+	0x93, 0xb2, 0x02, 0x0d, 0xce, 0x51, 0xcf, 0x9b, 0xdc, 0xe4, 0x4d, 0x7a, 0xde, 0xc3, 0xc3, 0x0c,
+	0x32, 0x68, 0x89, 0xa1, 0x79, 0x75, 0x3b, 0xc7, 0x5f, 0x08, 0x7d, 0xfc, 0xba, 0xcc, 0x81, 0xf1,
+	0x33, 0x40, 0xb5, 0x60, 0xa8, 0x2e, 0x00, 0xf2, 0x33, 0xe0, 0xe2, 0xb4, 0xe0, 0x8b, 0x4b, 0xa9,
+	0xc5, 0x4b, 0x89, 0xfa, 0xa2, 0x82, 0x12, 0x90, 0xe5, 0xce, 0x21, 0xdd, 0xd5, 0x52, 0xe7, 0xc2,
+	0x25, 0x01, 0x19, 0x1f, 0x44, 0x1d, 0x70, 0x02, 0x3a, 0xe2, 0x02, 0xd3, 0x4a, 0x96, 0x5a, 0x42,
+	0xe1, 0xee, 0xb4, 0x7f, 0x37, 0x47, 0xce, 0x09, 0xbd, 0x6b, 0x0c, 0xc5, 0xc9, 0x4a, 0x8b, 0x38,
+	0x05, 0x2e, 0xdc, 0x41, 0x40, 0xc6, 0xf6, 0xec, 0x5e, 0xf3, 0xdd, 0xb7, 0x17, 0xa7, 0xaf, 0xe6,
+	0xb3, 0x95, 0x16, 0x46, 0x35, 0xb2, 0x0d, 0xef, 0x1a, 0x3d, 0xb7, 0x3f, 0xac, 0x7d, 0xeb, 0xd3,
+	0xda, 0xb7, 0x7e, 0xae, 0x7d, 0x72, 0xfc, 0x79, 0x87, 0x1e, 0xcd, 0x65, 0x56, 0x31, 0x2d, 0x3a,
+	0x97, 0x85, 0xae, 0x58, 0xaa, 0xf1, 0xbf, 0xed, 0x3d, 0xa0, 0x77, 0x4c, 0x56, 0xb1, 0xe4, 0xe8,
+	0x0e, 0x82, 0xc1, 0x78, 0x18, 0xed, 0x1b, 0x7c, 0xce, 0xd1, 0xf1, 0xe8, 0xa8, 0x10, 0xcb, 0xd6,
+	0x73, 0x2c, 0xb9, 0x3b, 0x0c, 0xc8, 0x78, 0x18, 0x1d, 0x14, 0x62, 0x69, 0xfc, 0x9d, 0xf3, 0xbf,
+	0x5c, 0xb6, 0xfb, 0x2f, 0x97, 0x39, 0x3e, 0x1d, 0xa9, 0xee, 0x94, 0x58, 0x61, 0xe6, 0xee, 0x99,
+	0xa5, 0x88, 0xf6, 0xa3, 0x39, 0x66, 0x4e, 0x40, 0xed, 0xb7, 0x15, 0xa8, 0xdf, 0xca, 0xfb, 0xad,
+	0x32, 0x35, 0xb3, 0x4e, 0xfa, 0x8f, 0x70, 0x6a, 0x7a, 0xff, 0x45, 0xc1, 0x92, 0xbc, 0x8d, 0xe6,
+	0x36, 0x23, 0xd9, 0xd6, 0x9d, 0x45, 0x5f, 0x1b, 0x8f, 0x5c, 0x35, 0x1e, 0xf9, 0xd1, 0x78, 0xe4,
+	0xe3, 0xc6, 0xb3, 0xae, 0x36, 0x9e, 0xf5, 0x6d, 0xe3, 0x59, 0x6f, 0x9e, 0x65, 0x52, 0x5f, 0xbe,
+	0x4b, 0x26, 0x29, 0xa8, 0xb0, 0xaf, 0xe6, 0x93, 0x9c, 0x25, 0x78, 0x0d, 0xc2, 0x7a, 0x7a, 0x12,
+	0xbe, 0xdf, 0x6e, 0xb5, 0x5e, 0x95, 0x02, 0x93, 0xbd, 0xb6, 0x9c, 0x4f, 0x7f, 0x0d, 0x00, 0x75,
+	0x38, 0xde, 0xee, 0xfa, 0x02, 0x00, 0x00,
 }
 
 func (this *UploadCosmWasmPoolCodeAndWhiteListProposal) Equal(that interface{}) bool {
@@ -255,6 +307,44 @@ func (this *MigratePoolContractsProposal) Equal(that interface{}) bool {
 	if !bytes.Equal(this.MigrateMsg, that1.MigrateMsg) {
 		return false
 	}
+	if this.FromCodeId != that1.FromCodeId {
+		return false
+	}
+	return true
+}
+func (this *EnablePoolsProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EnablePoolsProposal)
+	if !ok {
+		that2, ok := that.(EnablePoolsProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIds) != len(that1.PoolIds) {
+		return false
+	}
+	for i := range this.PoolIds {
+		if this.PoolIds[i] != that1.PoolIds[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *UploadCosmWasmPoolCodeAndWhiteListProposal) Marshal() (dAtA []byte, err error) {
@@ -321,6 +411,11 @@ func (m *MigratePoolContractsProposal) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.FromCodeId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.FromCodeId))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.MigrateMsg) > 0 {
 		i -= len(m.MigrateMsg)
 		copy(dAtA[i:], m.MigrateMsg)
@@ -375,6 +470,61 @@ func (m *MigratePoolContractsProposal) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *EnablePoolsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnablePoolsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnablePoolsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA4 := make([]byte, len(m.PoolIds)*10)
+		var j3 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGov(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -439,6 +589,33 @@ func (m *MigratePoolContractsProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.FromCodeId != 0 {
+		n += 1 + sovGov(uint64(m.FromCodeId))
+	}
+	return n
+}
+
+func (m *EnablePoolsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
 	return n
 }
 
@@ -852,6 +1029,215 @@ func (m *MigratePoolContractsProposal) Unmarshal(dAtA []byte) error {
 				m.MigrateMsg = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCodeId", wireType)
+			}
+			m.FromCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnablePoolsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnablePoolsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnablePoolsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -22,8 +22,10 @@ func TestCWPoolGovTypesSuite(t *testing.T) {
 // 1. Success: pool ids are set, code id is set and byte code is not.
 // 2. Success: pool ids are set, code id is not set and byte code is set.
 // 3. Error: pool ids are not set, code id is set and byte code is not.
-// 4. Error: pool ids are set but both code id and byte code are set at the same time.
-// 5. Error: pool ids are set but both code id and byte code are unset.
+// 4. Success: code id to migrate from is set instead of pool ids.
+// 5. Error: both pool ids and code id to migrate from are set.
+// 6. Error: pool ids are set but both code id and byte code are set at the same time.
+// 7. Error: pool ids are set but both code id and byte code are unset.
 // See method spec for more details as to why these vectors are chosen.
 func (s *CWPoolGovTypesSuite) TestValidateMigrationProposalCondiguration() {
 	// Get valid transmuter code.
@@ -42,10 +44,11 @@ func (s *CWPoolGovTypesSuite) TestValidateMigrationProposalCondiguration() {
 	)
 
 	tests := []struct {
-		name      string
-		poolIds   []uint64
-		newCodeId uint64
-		byteCode  []byte
+		name       string
+		poolIds    []uint64
+		fromCodeId uint64
+		newCodeId  uint64
+		byteCode   []byte
 
 		expectedErr error
 	}{
@@ -68,6 +71,21 @@ func (s *CWPoolGovTypesSuite) TestValidateMigrationProposalCondiguration() {
 
 			expectedErr: types.ErrEmptyPoolIds,
 		},
+		{
+			name:       "success: code id to migrate from is set, code id is set and byte code is not",
+			fromCodeId: preUploadCodeIdPlaceholder - 1,
+			newCodeId:  preUploadCodeIdPlaceholder,
+			byteCode:   emptyByteCode,
+		},
+		{
+			name:       "error: both pool ids and code id to migrate from are set",
+			poolIds:    defaultPoolIdsToMigrate,
+			fromCodeId: preUploadCodeIdPlaceholder - 1,
+			newCodeId:  preUploadCodeIdPlaceholder,
+			byteCode:   emptyByteCode,
+
+			expectedErr: types.ErrBothOfPoolIdsAndFromCodeIdSpecified,
+		},
 		{
 			name:      "error: pool ids are set but both code id and byte code are set at the same time",
 			poolIds:   defaultPoolIdsToMigrate,
@@ -90,7 +108,7 @@ func (s *CWPoolGovTypesSuite) TestValidateMigrationProposalCondiguration() {
 		tc := tc
 		s.Run(tc.name, func() {
 			// System under test.
-			err := types.ValidateMigrationProposalConfiguration(tc.poolIds, tc.newCodeId, tc.byteCode)
+			err := types.ValidateMigrationProposalConfiguration(tc.poolIds, tc.fromCodeId, tc.newCodeId, tc.byteCode)
 
 			if tc.expectedErr != nil {
 				s.Require().Error(err)
@@ -0,0 +1,24 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+type CosmwasmPoolHooks interface {
+	// AfterCosmWasmPoolCreated is called after a cosmwasm pool is created
+	AfterCosmWasmPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64)
+}
+
+var _ CosmwasmPoolHooks = MultiCosmwasmPoolHooks{}
+
+// combine multiple cosmwasm pool hooks, all hook functions are run in array sequence.
+type MultiCosmwasmPoolHooks []CosmwasmPoolHooks
+
+// Creates hooks for the cosmwasm pool module.
+func NewMultiCosmwasmPoolHooks(hooks ...CosmwasmPoolHooks) MultiCosmwasmPoolHooks {
+	return hooks
+}
+
+func (h MultiCosmwasmPoolHooks) AfterCosmWasmPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	for i := range h {
+		h[i].AfterCosmWasmPoolCreated(ctx, sender, poolId)
+	}
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +14,9 @@ const (
 	StoreKey = ModuleName
 
 	RouterKey = ModuleName
+
+	// PoolShareDenomPrefix is the prefix of the share denoms of cosmwasm pools.
+	PoolShareDenomPrefix = "cw-pool"
 )
 
 var (
@@ -18,6 +25,12 @@ var (
 
 	// CodeIdWhiteListKey defines the store key for code id whitelist.
 	CodeIdWhiteListKey = []byte{0x02}
+
+	// PoolFailuresKey defines the store key for the consecutive failure counts of pools.
+	PoolFailuresKey = []byte{0x03}
+
+	// MsgCreatePoolEnabledKey defines the store key set once MsgCreateCosmWasmPool is routed, since the v17 upgrade.
+	MsgCreatePoolEnabledKey = []byte{0x04}
 )
 
 func FormatPoolsPrefix(poolId uint64) []byte {
@@ -27,3 +40,25 @@ func FormatPoolsPrefix(poolId uint64) []byte {
 func FormatCodeIdWhitelistPrefix(codeId uint64) []byte {
 	return append(CodeIdWhiteListKey, sdk.Uint64ToBigEndian(codeId)...)
 }
+
+func FormatPoolFailuresPrefix(poolId uint64) []byte {
+	return append(PoolFailuresKey, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetPoolShareDenom returns the share denom of the cosmwasm pool with the given id.
+func GetPoolShareDenom(poolId uint64) string {
+	return fmt.Sprintf("%s/%d", PoolShareDenomPrefix, poolId)
+}
+
+// GetPoolIdFromShareDenom returns the id of the cosmwasm pool whose share denom is the given denom.
+func GetPoolIdFromShareDenom(denom string) (uint64, error) {
+	poolIdStr, ok := strings.CutPrefix(denom, PoolShareDenomPrefix+"/")
+	if !ok {
+		return 0, fmt.Errorf("denom %s is not a cosmwasm pool share denom", denom)
+	}
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cosmwasm pool share denom %s: %v", denom, err)
+	}
+	return poolId, nil
+}
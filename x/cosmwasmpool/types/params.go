@@ -10,12 +10,26 @@ const (
 	// DefaultPoolMigrationLimit is the max number of pools that can be migrated at once.
 	// Note that 20 was chosen arbitrarily to have a constant bound on the number of pools migrated.
 	DefaultPoolMigrationLimit = 20
+
+	// DefaultSudoGasLimit is the max gas a single sudo call to a pool contract may consume.
+	// It is well above what a swap on the transmuter contract consumes.
+	DefaultSudoGasLimit = 2_000_000
+
+	// DefaultQueryGasLimit is the max gas a single query to a pool contract may consume.
+	DefaultQueryGasLimit = 1_000_000
+
+	// DefaultMaxConsecutiveFailures is the number of consecutive failures after which a pool is disabled.
+	DefaultMaxConsecutiveFailures = 10
 )
 
 // Parameter store keys.
 var (
 	KeyCodeIdWhitelist    = []byte("CodeIdWhitelist")
 	KeyPoolMigrationLimit = []byte("PoolMigrationLimit")
+
+	KeySudoGasLimit           = []byte("SudoGasLimit")
+	KeyQueryGasLimit          = []byte("QueryGasLimit")
+	KeyMaxConsecutiveFailures = []byte("MaxConsecutiveFailures")
 )
 
 // ParamTable for cosmwasmpool module.
@@ -32,14 +46,26 @@ func NewParams() Params {
 // DefaultParams are the default cosmwasmpool module parameters.
 func DefaultParams() Params {
 	return Params{
-		CodeIdWhitelist:    []uint64{},
-		PoolMigrationLimit: DefaultPoolMigrationLimit,
+		CodeIdWhitelist:        []uint64{},
+		PoolMigrationLimit:     DefaultPoolMigrationLimit,
+		SudoGasLimit:           DefaultSudoGasLimit,
+		QueryGasLimit:          DefaultQueryGasLimit,
+		MaxConsecutiveFailures: DefaultMaxConsecutiveFailures,
 	}
 }
 
 // Validate validates params.
 func (p Params) Validate() error {
-	return validateCodeIdWhitelist(p.CodeIdWhitelist)
+	if err := validateCodeIdWhitelist(p.CodeIdWhitelist); err != nil {
+		return err
+	}
+	if err := validateGasLimit(p.SudoGasLimit); err != nil {
+		return err
+	}
+	if err := validateGasLimit(p.QueryGasLimit); err != nil {
+		return err
+	}
+	return validateMaxConsecutiveFailures(p.MaxConsecutiveFailures)
 }
 
 // Implements params.ParamSet.
@@ -47,6 +73,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyCodeIdWhitelist, &p.CodeIdWhitelist, validateCodeIdWhitelist),
 		paramtypes.NewParamSetPair(KeyPoolMigrationLimit, &p.PoolMigrationLimit, validatePoolMigrationLimit),
+		paramtypes.NewParamSetPair(KeySudoGasLimit, &p.SudoGasLimit, validateGasLimit),
+		paramtypes.NewParamSetPair(KeyQueryGasLimit, &p.QueryGasLimit, validateGasLimit),
+		paramtypes.NewParamSetPair(KeyMaxConsecutiveFailures, &p.MaxConsecutiveFailures, validateMaxConsecutiveFailures),
 	}
 }
 
@@ -66,3 +95,29 @@ func validatePoolMigrationLimit(value interface{}) error {
 
 	return nil
 }
+
+func validateGasLimit(value interface{}) error {
+	gasLimit, ok := value.(uint64)
+	if !ok {
+		return errors.New("invalid type for gas limit")
+	}
+
+	if gasLimit == 0 {
+		return errors.New("gas limit must be greater than 0")
+	}
+
+	return nil
+}
+
+func validateMaxConsecutiveFailures(value interface{}) error {
+	maxConsecutiveFailures, ok := value.(uint64)
+	if !ok {
+		return errors.New("invalid type for max consecutive failures")
+	}
+
+	if maxConsecutiveFailures == 0 {
+		return errors.New("max consecutive failures must be greater than 0")
+	}
+
+	return nil
+}
@@ -34,6 +34,18 @@ type Params struct {
 	// number of pools that can be migrated at once and remove the possibility
 	// of an unlikely scenario of causing a chain halt due to a large migration.
 	PoolMigrationLimit uint64 `protobuf:"varint,2,opt,name=pool_migration_limit,json=poolMigrationLimit,proto3" json:"pool_migration_limit,omitempty" yaml:"pool_migration_limit"`
+	// sudo_gas_limit is the maximum gas that a single sudo call to a pool
+	// contract (swaps, joins and exits) may consume. A call exceeding it is
+	// aborted and counted as a failure of the pool.
+	SudoGasLimit uint64 `protobuf:"varint,3,opt,name=sudo_gas_limit,json=sudoGasLimit,proto3" json:"sudo_gas_limit,omitempty" yaml:"sudo_gas_limit"`
+	// query_gas_limit is the maximum gas that a single query to a pool contract
+	// (estimates, spot prices and liquidity) may consume. A query exceeding it
+	// is aborted and counted as a failure of the pool.
+	QueryGasLimit uint64 `protobuf:"varint,4,opt,name=query_gas_limit,json=queryGasLimit,proto3" json:"query_gas_limit,omitempty" yaml:"query_gas_limit"`
+	// max_consecutive_failures is the number of consecutive failures after
+	// which a pool is disabled. Disabled pools reject swaps, joins and exits
+	// until re-enabled via governance.
+	MaxConsecutiveFailures uint64 `protobuf:"varint,5,opt,name=max_consecutive_failures,json=maxConsecutiveFailures,proto3" json:"max_consecutive_failures,omitempty" yaml:"max_consecutive_failures"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -83,6 +95,27 @@ func (m *Params) GetPoolMigrationLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetSudoGasLimit() uint64 {
+	if m != nil {
+		return m.SudoGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetQueryGasLimit() uint64 {
+	if m != nil {
+		return m.QueryGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveFailures
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.cosmwasmpool.v1beta1.Params")
 }
@@ -92,26 +125,32 @@ func init() {
 }
 
 var fileDescriptor_6cf69242a2b5e68e = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0xce, 0x2f, 0xce, 0x2d, 0x4f, 0x2c, 0xce, 0x2d, 0xc8, 0xcf, 0xcf,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x2a, 0xd5, 0x43, 0x56, 0xaa, 0x07, 0x55, 0x2a,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x49, 0x26, 0x83,
-	0x35, 0xc5, 0x43, 0x24, 0x20, 0x1c, 0xa8, 0x94, 0x5c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e,
-	0x98, 0x97, 0x54, 0x9a, 0xa6, 0x9f, 0x52, 0x5a, 0x94, 0x58, 0x92, 0x99, 0x9f, 0x07, 0x91, 0x57,
-	0x5a, 0xca, 0xc8, 0xc5, 0x16, 0x00, 0xb6, 0x5f, 0xc8, 0x83, 0x4b, 0x30, 0x39, 0x3f, 0x25, 0x35,
-	0x3e, 0x33, 0x25, 0xbe, 0x3c, 0x23, 0xb3, 0x24, 0x35, 0x27, 0xb3, 0xb8, 0x44, 0x82, 0x51, 0x81,
-	0x59, 0x83, 0xc5, 0x49, 0xe6, 0xd3, 0x3d, 0x79, 0x89, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x0c,
-	0x25, 0x4a, 0x41, 0xfc, 0x20, 0x31, 0xcf, 0x94, 0x70, 0x98, 0x88, 0x50, 0x20, 0x97, 0x08, 0xc8,
-	0xd5, 0xf1, 0xb9, 0x99, 0xe9, 0x10, 0xcb, 0xe2, 0x73, 0x32, 0x73, 0x33, 0x4b, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0x58, 0x9c, 0xe4, 0x3f, 0xdd, 0x93, 0x97, 0x86, 0x18, 0x86, 0x4d, 0x95, 0x52, 0x90,
-	0x10, 0x48, 0xd8, 0x17, 0x26, 0xea, 0x03, 0x12, 0x74, 0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0x68, 0xd8, 0xe9, 0xe6, 0x24, 0x26, 0x15, 0xc3, 0x38, 0xfa, 0x65, 0x86, 0x66, 0xfa, 0x15,
-	0xa8, 0x21, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x02, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x3d, 0xb4, 0xb5, 0xb7, 0x9e, 0x01, 0x00, 0x00,
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0xca, 0xd3, 0x40,
+	0x14, 0x86, 0x1b, 0x5b, 0xbf, 0x45, 0x50, 0x8b, 0xa1, 0x96, 0xb4, 0x96, 0xa4, 0xc4, 0x4d, 0x5d,
+	0x98, 0xa1, 0x08, 0x22, 0x6e, 0x84, 0x08, 0xfe, 0x80, 0x82, 0x66, 0x23, 0x08, 0x32, 0x4c, 0x92,
+	0x69, 0x3a, 0x90, 0xe9, 0x89, 0x99, 0x49, 0x7f, 0x2e, 0xc1, 0x9d, 0x97, 0xe5, 0xb2, 0x4b, 0x57,
+	0x41, 0xda, 0x3b, 0xc8, 0x15, 0x48, 0x66, 0x52, 0x6d, 0xd5, 0x6f, 0x97, 0xf3, 0xbe, 0xcf, 0x3c,
+	0x13, 0x98, 0x63, 0x3e, 0x04, 0xc1, 0x41, 0x30, 0x81, 0x62, 0x10, 0x7c, 0x43, 0x04, 0xcf, 0x01,
+	0x32, 0xb4, 0x9e, 0x47, 0x54, 0x92, 0x39, 0xca, 0x49, 0x41, 0xb8, 0xf0, 0xf3, 0x02, 0x24, 0x58,
+	0x93, 0x16, 0xf5, 0xcf, 0x51, 0xbf, 0x45, 0xc7, 0x83, 0x14, 0x52, 0x50, 0x20, 0x6a, 0xbe, 0xf4,
+	0x99, 0xf1, 0x28, 0x56, 0x87, 0xb0, 0x2e, 0xf4, 0xd0, 0x56, 0x4e, 0x0a, 0x90, 0x66, 0x14, 0xa9,
+	0x29, 0x2a, 0x17, 0x28, 0x29, 0x0b, 0x22, 0x19, 0xac, 0x74, 0xef, 0x7d, 0xed, 0x9a, 0x57, 0xef,
+	0xd5, 0xfd, 0xd6, 0x6b, 0xf3, 0x6e, 0x0c, 0x09, 0xc5, 0x2c, 0xc1, 0x9b, 0x25, 0x93, 0x34, 0x63,
+	0x42, 0xda, 0xc6, 0xb4, 0x3b, 0xeb, 0x05, 0x93, 0xba, 0x72, 0xed, 0x1d, 0xe1, 0xd9, 0x33, 0xef,
+	0x1f, 0xc4, 0x0b, 0xfb, 0x4d, 0xf6, 0x26, 0xf9, 0x78, 0x4a, 0xac, 0x0f, 0xe6, 0xa0, 0xf9, 0x6b,
+	0xcc, 0x59, 0xaa, 0x2f, 0xc3, 0x19, 0xe3, 0x4c, 0xda, 0x37, 0xa6, 0xc6, 0xac, 0x17, 0xb8, 0x75,
+	0xe5, 0xde, 0xd7, 0xb2, 0xff, 0x51, 0x5e, 0x68, 0x35, 0xf1, 0xbb, 0x53, 0xfa, 0xb6, 0x09, 0xad,
+	0xe7, 0xe6, 0x1d, 0x51, 0x26, 0x80, 0x53, 0x22, 0x5a, 0x59, 0x57, 0xc9, 0x46, 0x75, 0xe5, 0xde,
+	0xd3, 0xb2, 0xcb, 0xde, 0x0b, 0x6f, 0x35, 0xc1, 0x2b, 0x22, 0xb4, 0x20, 0x30, 0xfb, 0x5f, 0x4a,
+	0x5a, 0xec, 0xce, 0x0c, 0x3d, 0x65, 0x18, 0xd7, 0x95, 0x3b, 0xd4, 0x86, 0xbf, 0x00, 0x2f, 0xbc,
+	0xad, 0x92, 0xdf, 0x8e, 0xcf, 0xa6, 0xcd, 0xc9, 0x16, 0xc7, 0xb0, 0x12, 0x34, 0x2e, 0x25, 0x5b,
+	0x53, 0xbc, 0x20, 0x2c, 0x2b, 0x0b, 0x2a, 0xec, 0x9b, 0x4a, 0xf6, 0xa0, 0xae, 0x5c, 0x57, 0xcb,
+	0xae, 0x23, 0xbd, 0x70, 0xc8, 0xc9, 0xf6, 0xc5, 0x9f, 0xe6, 0x65, 0x5b, 0x04, 0xe1, 0xf7, 0x83,
+	0x63, 0xec, 0x0f, 0x8e, 0xf1, 0xf3, 0xe0, 0x18, 0xdf, 0x8e, 0x4e, 0x67, 0x7f, 0x74, 0x3a, 0x3f,
+	0x8e, 0x4e, 0xe7, 0xd3, 0xd3, 0x94, 0xc9, 0x65, 0x19, 0xf9, 0x31, 0x70, 0xd4, 0xee, 0xc7, 0xa3,
+	0x8c, 0x44, 0xe2, 0x34, 0xa0, 0xf5, 0xfc, 0x09, 0xda, 0x5e, 0x6e, 0x97, 0xdc, 0xe5, 0x54, 0x44,
+	0x57, 0xea, 0x99, 0x1f, 0xff, 0x1a, 0x00, 0xa4, 0x8c, 0x9f, 0x13, 0x82, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -134,6 +173,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxConsecutiveFailures != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxConsecutiveFailures))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QueryGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QueryGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SudoGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SudoGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.PoolMigrationLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.PoolMigrationLimit))
 		i--
@@ -187,6 +241,15 @@ func (m *Params) Size() (n int) {
 	if m.PoolMigrationLimit != 0 {
 		n += 1 + sovParams(uint64(m.PoolMigrationLimit))
 	}
+	if m.SudoGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.SudoGasLimit))
+	}
+	if m.QueryGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.QueryGasLimit))
+	}
+	if m.MaxConsecutiveFailures != 0 {
+		n += 1 + sovParams(uint64(m.MaxConsecutiveFailures))
+	}
 	return n
 }
 
@@ -320,6 +383,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoGasLimit", wireType)
+			}
+			m.SudoGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SudoGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryGasLimit", wireType)
+			}
+			m.QueryGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveFailures", wireType)
+			}
+			m.MaxConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -13,12 +13,22 @@ type CosmWasmExtension interface {
 
 	GetCodeId() uint64
 
+	// GetPreviousCodeId returns the code id of the pool contract before its last migration.
+	GetPreviousCodeId() uint64
+
+	// SetCodeId sets the code id of the migrated pool contract, recording the current one as the previous code id.
+	SetCodeId(codeId uint64)
+
 	GetInstantiateMsg() []byte
 
 	GetContractAddress() string
 
 	SetContractAddress(contractAddress string)
 
+	// SetDisabled sets whether the pool is disabled by the circuit breaker.
+	// A disabled pool is not active.
+	SetDisabled(disabled bool)
+
 	GetStoreModel() proto.Message
 
 	SetWasmKeeper(wasmKeeper WasmKeeper)
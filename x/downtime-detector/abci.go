@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v16/x/downtime-detector/types"
 )
 
@@ -16,6 +17,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 	}
 	downtime := curTime.Sub(lastBlockTime)
 	k.saveDowntimeUpdates(ctx, downtime)
+	k.updateDowntimeBuckets(ctx, downtime)
 	k.StoreLastBlockTime(ctx, curTime)
 }
 
@@ -23,7 +25,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 // last time the chain was down for all downtime lengths that are LTE the provided downtime.
 func (k *Keeper) saveDowntimeUpdates(ctx sdk.Context, downtime time.Duration) {
 	// minimum stored downtime is 30S, so if downtime is less than that, don't update anything.
-	if downtime < 30*time.Second {
+	if downtime < types.MinDowntime {
 		return
 	}
 	types.DowntimeToDuration.Ascend(0, func(downType types.Downtime, duration time.Duration) bool {
@@ -35,3 +37,46 @@ func (k *Keeper) saveDowntimeUpdates(ctx sdk.Context, downtime time.Duration) {
 		return true
 	})
 }
+
+// updateDowntimeBuckets marks the registered buckets with a downtime LTE the provided downtime as recovering,
+// and the recovering buckets whose recovery duration has passed as recovered, calling the hooks for each.
+// A downtime detected while a bucket is recovering restarts its recovery.
+func (k *Keeper) updateDowntimeBuckets(ctx sdk.Context, downtime time.Duration) {
+	for _, bucket := range k.buckets {
+		state := k.GetDowntimeBucketState(ctx, bucket)
+		if downtime >= bucket.Downtime {
+			state.LastDowntime = ctx.BlockTime()
+			state.Recovering = true
+			k.SetDowntimeBucketState(ctx, state)
+			k.emitDowntimeBucketEvent(ctx, types.TypeEvtDowntimeDetected, state)
+			k.callHook(ctx, func(ctx sdk.Context) { k.hooks.AfterDowntimeDetected(ctx, bucket, downtime) })
+		} else if state.Recovering && !ctx.BlockTime().Before(state.LastDowntime.Add(bucket.Recovery)) {
+			state.Recovering = false
+			k.SetDowntimeBucketState(ctx, state)
+			k.emitDowntimeBucketEvent(ctx, types.TypeEvtDowntimeRecovered, state)
+			k.callHook(ctx, func(ctx sdk.Context) { k.hooks.AfterDowntimeRecovered(ctx, bucket) })
+		}
+	}
+}
+
+// callHook runs a hook if any is set, dropping its state changes if it panics so that
+// a misbehaving receiver cannot halt the chain.
+func (k *Keeper) callHook(ctx sdk.Context, hook func(ctx sdk.Context)) {
+	if k.hooks == nil {
+		return
+	}
+	_ = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		hook(ctx)
+		return nil
+	})
+}
+
+func (k *Keeper) emitDowntimeBucketEvent(ctx sdk.Context, eventType string, state types.DowntimeBucketState) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyDowntime, state.Downtime.String()),
+		sdk.NewAttribute(types.AttributeKeyRecovery, state.Recovery.String()),
+		sdk.NewAttribute(types.AttributeKeyLastDowntime, state.LastDowntime.String()),
+	))
+}
@@ -0,0 +1,52 @@
+package queryclient
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/downtime-detector/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/v16/x/downtime-detector/client/queryproto"
+)
+
+// Paths of the queries, used by gRPC, ABCI and Stargate queries.
+const (
+	RecoveredSinceDowntimeOfLengthPath = "/osmosis.downtimedetector.v1beta1.Query/RecoveredSinceDowntimeOfLength"
+)
+
+// Client is a typed client of the queries, over a gRPC connection to a node or a client.Context.
+type Client struct {
+	q queryproto.QueryClient
+}
+
+func NewClient(conn gogogrpc.ClientConn) Client {
+	return Client{q: queryproto.NewQueryClient(conn)}
+}
+
+func (c Client) RecoveredSinceDowntimeOfLength(ctx context.Context,
+	req queryproto.RecoveredSinceDowntimeOfLengthRequest, opts ...grpc.CallOption,
+) (*queryproto.RecoveredSinceDowntimeOfLengthResponse, error) {
+	return c.q.RecoveredSinceDowntimeOfLength(ctx, &req, opts...)
+}
+
+// NewRecoveredSinceDowntimeOfLengthABCIRequest returns the ABCI query of RecoveredSinceDowntimeOfLength at the given height, 0 being the latest one.
+func NewRecoveredSinceDowntimeOfLengthABCIRequest(req queryproto.RecoveredSinceDowntimeOfLengthRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: RecoveredSinceDowntimeOfLengthPath, Data: bz, Height: height}, nil
+}
+
+// DecodeRecoveredSinceDowntimeOfLengthResponse decodes the response of RecoveredSinceDowntimeOfLength, e.g. the value of its ABCI query.
+func DecodeRecoveredSinceDowntimeOfLengthResponse(bz []byte) (*queryproto.RecoveredSinceDowntimeOfLengthResponse, error) {
+	res := &queryproto.RecoveredSinceDowntimeOfLengthResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
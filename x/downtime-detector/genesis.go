@@ -12,6 +12,9 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gen *types.GenesisState) {
 	k.setGenDowntimes(ctx, types.DefaultGenesis().GetDowntimes())
 	// override with genesis list
 	k.setGenDowntimes(ctx, gen.Downtimes)
+	for _, state := range gen.Buckets {
+		k.SetDowntimeBucketState(ctx, state)
+	}
 }
 
 func (k *Keeper) setGenDowntimes(ctx sdk.Context, genDowntimes []types.GenesisDowntimeEntry) {
@@ -29,6 +32,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
 		Downtimes:     k.getGenDowntimes(ctx),
 		LastBlockTime: t,
+		Buckets:       k.GetAllDowntimeBucketStates(ctx),
 	}
 }
 
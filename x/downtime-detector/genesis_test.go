@@ -10,6 +10,7 @@ func (s *KeeperTestSuite) TestImportExport() {
 	tests := map[string]struct {
 		Downtimes     []types.GenesisDowntimeEntry
 		LastBlockTime time.Time
+		Buckets       []types.DowntimeBucketState
 	}{
 		"no downtimes": {
 			LastBlockTime: baseTime,
@@ -21,14 +22,22 @@ func (s *KeeperTestSuite) TestImportExport() {
 				{Duration: types.Downtime_DURATION_30M, LastDowntime: baseTime.Add(-time.Hour)},
 			},
 		},
+		"some buckets": {
+			LastBlockTime: baseTime,
+			Buckets: []types.DowntimeBucketState{
+				{Downtime: time.Minute, Recovery: 10 * time.Minute, LastDowntime: baseTime.Add(-time.Minute), Recovering: true},
+				{Downtime: time.Hour, Recovery: time.Minute, LastDowntime: baseTime.Add(-2 * time.Hour)},
+			},
+		},
 	}
 	for name, test := range tests {
 		s.Run(name, func() {
 			s.Ctx = s.Ctx.WithBlockTime(test.LastBlockTime.Add(time.Hour))
-			genState := &types.GenesisState{Downtimes: test.Downtimes, LastBlockTime: test.LastBlockTime}
+			genState := &types.GenesisState{Downtimes: test.Downtimes, LastBlockTime: test.LastBlockTime, Buckets: test.Buckets}
 			s.App.DowntimeKeeper.InitGenesis(s.Ctx, genState)
 			exportedState := s.App.DowntimeKeeper.ExportGenesis(s.Ctx)
 			s.Require().Equal(test.LastBlockTime, exportedState.LastBlockTime)
+			s.Require().ElementsMatch(test.Buckets, exportedState.Buckets)
 			// O(N^2) method of checking downtimes, not concerned with run-time as its bounded.
 			for _, downtime := range test.Downtimes {
 				found := false
@@ -0,0 +1,136 @@
+package downtimedetector_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	downtimedetector "github.com/osmosis-labs/osmosis/v16/x/downtime-detector"
+	"github.com/osmosis-labs/osmosis/v16/x/downtime-detector/types"
+)
+
+type hookCall struct {
+	bucket    types.DowntimeBucket
+	recovered bool
+	blockTime time.Time
+}
+
+type mockDowntimeHooks struct {
+	calls []hookCall
+	panic bool
+}
+
+var _ types.DowntimeHooks = &mockDowntimeHooks{}
+
+func (h *mockDowntimeHooks) AfterDowntimeDetected(ctx sdk.Context, bucket types.DowntimeBucket, _ time.Duration) {
+	h.calls = append(h.calls, hookCall{bucket: bucket, blockTime: ctx.BlockTime()})
+	if h.panic {
+		panic("hook panicked")
+	}
+}
+
+func (h *mockDowntimeHooks) AfterDowntimeRecovered(ctx sdk.Context, bucket types.DowntimeBucket) {
+	h.calls = append(h.calls, hookCall{bucket: bucket, recovered: true, blockTime: ctx.BlockTime()})
+	if h.panic {
+		panic("hook panicked")
+	}
+}
+
+// setupBucketKeeper returns a keeper on the downtime detector store with the given buckets and mock hooks.
+func (s *KeeperTestSuite) setupBucketKeeper(hooks *mockDowntimeHooks, buckets ...types.DowntimeBucket) *downtimedetector.Keeper {
+	k := downtimedetector.NewKeeper(s.App.GetKey(types.StoreKey))
+	k.SetHooks(hooks)
+	for _, bucket := range buckets {
+		s.Require().NoError(k.RegisterDowntimeBucket(bucket.Downtime, bucket.Recovery))
+	}
+	s.Ctx = s.Ctx.WithBlockTime(baseTime)
+	k.StoreLastBlockTime(s.Ctx, baseTime)
+	return k
+}
+
+func (s *KeeperTestSuite) runBlocktimesWithKeeper(k *downtimedetector.Keeper, times blocktimes) {
+	for _, duration := range times {
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(duration))
+		k.BeginBlock(s.Ctx)
+	}
+}
+
+func (s *KeeperTestSuite) TestRegisterDowntimeBucket() {
+	k := downtimedetector.NewKeeper(s.App.GetKey(types.StoreKey))
+	s.Require().Error(k.RegisterDowntimeBucket(10*sec, min))
+	s.Require().Error(k.RegisterDowntimeBucket(min, 0))
+	s.Require().NoError(k.RegisterDowntimeBucket(7*min, min))
+	s.Require().NoError(k.RegisterDowntimeBucket(90*sec, 2*min))
+	s.Require().NoError(k.RegisterDowntimeBucket(90*sec, min))
+	s.Require().NoError(k.RegisterDowntimeBucket(7*min, min))
+	s.Require().Equal([]types.DowntimeBucket{
+		types.NewDowntimeBucket(90*sec, min),
+		types.NewDowntimeBucket(90*sec, 2*min),
+		types.NewDowntimeBucket(7*min, min),
+	}, k.GetDowntimeBuckets())
+}
+
+func (s *KeeperTestSuite) TestDowntimeHooks() {
+	ninetySec := types.NewDowntimeBucket(90*sec, 3*min)
+	sevenMin := types.NewDowntimeBucket(7*min, 5*min)
+
+	tests := map[string]struct {
+		times         blocktimes
+		expectedCalls []hookCall
+		recovering    map[types.DowntimeBucket]bool
+	}{
+		"no downtime": {
+			times:      []time.Duration{5 * sec, 5 * sec, 29 * sec},
+			recovering: map[types.DowntimeBucket]bool{ninetySec: false, sevenMin: false},
+		},
+		"10 min halt, then 1 min sequence": {
+			times: smootherRecovery5minDowntime10min,
+			expectedCalls: []hookCall{
+				{bucket: ninetySec, blockTime: baseTime.Add(sec + 10*min)},
+				{bucket: sevenMin, blockTime: baseTime.Add(sec + 10*min)},
+				{bucket: ninetySec, recovered: true, blockTime: baseTime.Add(sec + 13*min)},
+				{bucket: sevenMin, recovered: true, blockTime: baseTime.Add(sec + 15*min)},
+			},
+			recovering: map[types.DowntimeBucket]bool{ninetySec: false, sevenMin: false},
+		},
+		"downtime during recovery restarts it": {
+			times: []time.Duration{2 * min, min, 2 * min, min, min},
+			expectedCalls: []hookCall{
+				{bucket: ninetySec, blockTime: baseTime.Add(2 * min)},
+				{bucket: ninetySec, blockTime: baseTime.Add(5 * min)},
+			},
+			recovering: map[types.DowntimeBucket]bool{ninetySec: true, sevenMin: false},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			hooks := &mockDowntimeHooks{}
+			k := s.setupBucketKeeper(hooks, sevenMin, ninetySec)
+			s.runBlocktimesWithKeeper(k, test.times)
+
+			s.Require().Equal(test.expectedCalls, hooks.calls)
+			for bucket, expected := range test.recovering {
+				recovering, err := k.IsRecoveringFromDowntime(s.Ctx, bucket.Downtime, bucket.Recovery)
+				s.Require().NoError(err)
+				s.Require().Equal(expected, recovering, bucket.String())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDowntimeHooksPanic() {
+	bucket := types.NewDowntimeBucket(min, min)
+	hooks := &mockDowntimeHooks{panic: true}
+	k := s.setupBucketKeeper(hooks, bucket)
+
+	s.runBlocktimesWithKeeper(k, []time.Duration{2 * min})
+	s.Require().Len(hooks.calls, 1)
+	// the state of the bucket is updated regardless of the hook panicking
+	recovering, err := k.IsRecoveringFromDowntime(s.Ctx, bucket.Downtime, bucket.Recovery)
+	s.Require().NoError(err)
+	s.Require().True(recovering)
+
+	_, err = k.IsRecoveringFromDowntime(s.Ctx, 2*min, min)
+	s.Require().Error(err)
+}
@@ -1,13 +1,70 @@
 package downtimedetector
 
 import (
+	"fmt"
+	"sort"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/downtime-detector/types"
 )
 
 type Keeper struct {
 	storeKey sdk.StoreKey
+
+	hooks   types.DowntimeHooks
+	buckets []types.DowntimeBucket
 }
 
 func NewKeeper(storeKey sdk.StoreKey) *Keeper {
 	return &Keeper{storeKey: storeKey}
 }
+
+// SetHooks sets the downtime hooks.
+func (k *Keeper) SetHooks(hooks types.DowntimeHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set downtime hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// RegisterDowntimeBucket registers a downtime duration for which the hooks are called, along with the duration
+// it takes to recover from it. Registering a bucket twice is a no-op.
+// Like SetHooks, this must be called while setting up the app, before the keeper is passed to its module.
+func (k *Keeper) RegisterDowntimeBucket(downtime, recovery time.Duration) error {
+	bucket := types.NewDowntimeBucket(downtime, recovery)
+	if err := bucket.Validate(); err != nil {
+		return err
+	}
+	for _, registered := range k.buckets {
+		if registered == bucket {
+			return nil
+		}
+	}
+	k.buckets = append(k.buckets, bucket)
+	sort.Slice(k.buckets, func(i, j int) bool {
+		if k.buckets[i].Downtime != k.buckets[j].Downtime {
+			return k.buckets[i].Downtime < k.buckets[j].Downtime
+		}
+		return k.buckets[i].Recovery < k.buckets[j].Recovery
+	})
+	return nil
+}
+
+// GetDowntimeBuckets returns the registered buckets, ordered by downtime then recovery.
+func (k *Keeper) GetDowntimeBuckets() []types.DowntimeBucket {
+	return k.buckets
+}
+
+func (k *Keeper) isRegisteredBucket(bucket types.DowntimeBucket) error {
+	for _, registered := range k.buckets {
+		if registered == bucket {
+			return nil
+		}
+	}
+	return fmt.Errorf("downtime bucket of %s is not registered", bucket)
+}
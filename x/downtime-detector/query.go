@@ -24,3 +24,13 @@ func (k *Keeper) RecoveredSinceDowntimeOfLength(ctx sdk.Context, downtime types.
 	}
 	return true, nil
 }
+
+// IsRecoveringFromDowntime returns true if the chain was down for at least the downtime of a registered bucket,
+// and its recovery duration has not passed yet, i.e. if its recovery hook has not been called yet.
+func (k *Keeper) IsRecoveringFromDowntime(ctx sdk.Context, downtime, recoveryDuration time.Duration) (bool, error) {
+	bucket := types.NewDowntimeBucket(downtime, recoveryDuration)
+	if err := k.isRegisteredBucket(bucket); err != nil {
+		return false, err
+	}
+	return k.GetDowntimeBucketState(ctx, bucket).Recovering, nil
+}
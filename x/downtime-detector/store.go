@@ -47,3 +47,36 @@ func (k *Keeper) StoreLastDowntimeOfLength(ctx sdk.Context, dur types.Downtime,
 	timeBz := osmoutils.FormatTimeString(t)
 	store.Set(types.GetLastDowntimeOfLengthKey(dur), []byte(timeBz))
 }
+
+// GetDowntimeBucketState returns the state of a bucket. Buckets that were never stored have never had a downtime.
+func (k *Keeper) GetDowntimeBucketState(ctx sdk.Context, bucket types.DowntimeBucket) types.DowntimeBucketState {
+	store := ctx.KVStore(k.storeKey)
+	state := types.DowntimeBucketState{}
+	found, err := osmoutils.Get(store, types.GetDowntimeBucketKey(bucket), &state)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.NewDowntimeBucketState(bucket)
+	}
+	return state
+}
+
+func (k *Keeper) SetDowntimeBucketState(ctx sdk.Context, state types.DowntimeBucketState) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.GetDowntimeBucketKey(state.Bucket()), &state)
+}
+
+// GetAllDowntimeBucketStates returns the stored state of all buckets, including the ones that are no longer registered.
+func (k *Keeper) GetAllDowntimeBucketStates(ctx sdk.Context) []types.DowntimeBucketState {
+	store := ctx.KVStore(k.storeKey)
+	states, err := osmoutils.GatherValuesFromStorePrefix(store, types.GetDowntimeBucketPrefix(), func(bz []byte) (types.DowntimeBucketState, error) {
+		state := types.DowntimeBucketState{}
+		err := state.Unmarshal(bz)
+		return state, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return states
+}
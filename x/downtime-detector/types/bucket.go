@@ -0,0 +1,47 @@
+package types
+
+import (
+	fmt "fmt"
+	time "time"
+)
+
+// MinDowntime is the shortest downtime that is detected, shorter gaps between blocks are not considered downtime.
+const MinDowntime = 30 * time.Second
+
+// DowntimeBucket is a downtime duration registered by a module, along with the time the module needs for the
+// chain to recover from it.
+type DowntimeBucket struct {
+	Downtime time.Duration
+	Recovery time.Duration
+}
+
+func NewDowntimeBucket(downtime, recovery time.Duration) DowntimeBucket {
+	return DowntimeBucket{Downtime: downtime, Recovery: recovery}
+}
+
+func (b DowntimeBucket) Validate() error {
+	if b.Downtime < MinDowntime {
+		return fmt.Errorf("downtime of %s is less than the minimum downtime of %s", b.Downtime, MinDowntime)
+	}
+	if b.Recovery <= 0 {
+		return fmt.Errorf("invalid recovery duration of %s", b.Recovery)
+	}
+	return nil
+}
+
+func (b DowntimeBucket) String() string {
+	return fmt.Sprintf("%s downtime, %s recovery", b.Downtime, b.Recovery)
+}
+
+// NewDowntimeBucketState returns the state of a bucket that never had a downtime.
+func NewDowntimeBucketState(bucket DowntimeBucket) DowntimeBucketState {
+	return DowntimeBucketState{
+		Downtime:     bucket.Downtime,
+		Recovery:     bucket.Recovery,
+		LastDowntime: DefaultLastDowntime,
+	}
+}
+
+func (s DowntimeBucketState) Bucket() DowntimeBucket {
+	return NewDowntimeBucket(s.Downtime, s.Recovery)
+}
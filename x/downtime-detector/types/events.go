@@ -0,0 +1,10 @@
+package types
+
+const (
+	TypeEvtDowntimeDetected  = "downtime_detected"
+	TypeEvtDowntimeRecovered = "downtime_recovered"
+
+	AttributeKeyDowntime     = "downtime"
+	AttributeKeyRecovery     = "recovery"
+	AttributeKeyLastDowntime = "last_downtime"
+)
@@ -1,6 +1,9 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 func DefaultGenesis() *GenesisState {
 	genDowntimes := []GenesisDowntimeEntry{}
@@ -17,6 +20,17 @@ func DefaultGenesis() *GenesisState {
 }
 
 func (g *GenesisState) Validate() error {
+	buckets := map[DowntimeBucket]bool{}
+	for _, state := range g.Buckets {
+		bucket := state.Bucket()
+		if err := bucket.Validate(); err != nil {
+			return err
+		}
+		if buckets[bucket] {
+			return fmt.Errorf("duplicate downtime bucket: %s", bucket)
+		}
+		buckets[bucket] = true
+	}
 	return nil
 }
 
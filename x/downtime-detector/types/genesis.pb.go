@@ -81,17 +81,90 @@ func (m *GenesisDowntimeEntry) GetLastDowntime() time.Time {
 	return time.Time{}
 }
 
+// DowntimeBucketState is the state of a downtime duration registered by a
+// module, along with the time it takes to recover from it.
+type DowntimeBucketState struct {
+	Downtime     time.Duration `protobuf:"bytes,1,opt,name=downtime,proto3,stdduration" json:"downtime" yaml:"downtime"`
+	Recovery     time.Duration `protobuf:"bytes,2,opt,name=recovery,proto3,stdduration" json:"recovery" yaml:"recovery"`
+	LastDowntime time.Time     `protobuf:"bytes,3,opt,name=last_downtime,json=lastDowntime,proto3,stdtime" json:"last_downtime" yaml:"last_downtime"`
+	// recovering is true from the time a downtime is detected until the
+	// recovery duration has passed.
+	Recovering bool `protobuf:"varint,4,opt,name=recovering,proto3" json:"recovering,omitempty" yaml:"recovering"`
+}
+
+func (m *DowntimeBucketState) Reset()         { *m = DowntimeBucketState{} }
+func (m *DowntimeBucketState) String() string { return proto.CompactTextString(m) }
+func (*DowntimeBucketState) ProtoMessage()    {}
+func (*DowntimeBucketState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4581e137a44782af, []int{1}
+}
+func (m *DowntimeBucketState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeBucketState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeBucketState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeBucketState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeBucketState.Merge(m, src)
+}
+func (m *DowntimeBucketState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeBucketState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeBucketState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeBucketState proto.InternalMessageInfo
+
+func (m *DowntimeBucketState) GetDowntime() time.Duration {
+	if m != nil {
+		return m.Downtime
+	}
+	return 0
+}
+
+func (m *DowntimeBucketState) GetRecovery() time.Duration {
+	if m != nil {
+		return m.Recovery
+	}
+	return 0
+}
+
+func (m *DowntimeBucketState) GetLastDowntime() time.Time {
+	if m != nil {
+		return m.LastDowntime
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeBucketState) GetRecovering() bool {
+	if m != nil {
+		return m.Recovering
+	}
+	return false
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	Downtimes     []GenesisDowntimeEntry `protobuf:"bytes,1,rep,name=downtimes,proto3" json:"downtimes"`
 	LastBlockTime time.Time              `protobuf:"bytes,2,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time" yaml:"last_block_time"`
+	Buckets       []DowntimeBucketState  `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4581e137a44782af, []int{1}
+	return fileDescriptor_4581e137a44782af, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,8 +207,16 @@ func (m *GenesisState) GetLastBlockTime() time.Time {
 	return time.Time{}
 }
 
+func (m *GenesisState) GetBuckets() []DowntimeBucketState {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisDowntimeEntry)(nil), "osmosis.downtimedetector.v1beta1.GenesisDowntimeEntry")
+	proto.RegisterType((*DowntimeBucketState)(nil), "osmosis.downtimedetector.v1beta1.DowntimeBucketState")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.downtimedetector.v1beta1.GenesisState")
 }
 
@@ -144,33 +225,40 @@ func init() {
 }
 
 var fileDescriptor_4581e137a44782af = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6a, 0xe2, 0x40,
-	0x1c, 0xc6, 0x33, 0xbb, 0xcb, 0xb2, 0x1b, 0xdd, 0x15, 0xb2, 0xb2, 0x58, 0x0f, 0x49, 0xc8, 0x49,
-	0x0a, 0xce, 0xa0, 0x05, 0xa1, 0x85, 0x5e, 0x42, 0x4b, 0xef, 0xb6, 0x50, 0xb0, 0x87, 0x30, 0x89,
-	0x63, 0x1a, 0x9a, 0x64, 0x24, 0x33, 0xda, 0xe6, 0x2d, 0x7c, 0x2c, 0x8f, 0xd2, 0x43, 0xe9, 0xc9,
-	0x16, 0x7d, 0x03, 0x9f, 0xa0, 0x24, 0x99, 0xd1, 0x2a, 0x82, 0xbd, 0x25, 0xf3, 0xff, 0xbe, 0x8f,
-	0xef, 0xf7, 0xe7, 0xaf, 0x22, 0xca, 0x22, 0xca, 0x02, 0x86, 0xfa, 0xf4, 0x31, 0xe6, 0x41, 0x44,
-	0x9a, 0x7d, 0xc2, 0x89, 0xc7, 0x69, 0x82, 0xc6, 0x2d, 0x97, 0x70, 0xdc, 0x42, 0x3e, 0x89, 0x09,
-	0x0b, 0x18, 0x1c, 0x26, 0x94, 0x53, 0xcd, 0x14, 0x06, 0x28, 0x0d, 0x52, 0x0f, 0x85, 0xbe, 0x5e,
-	0xf5, 0xa9, 0x4f, 0x73, 0x31, 0xca, 0xbe, 0x0a, 0x5f, 0xfd, 0xc8, 0xa7, 0xd4, 0x0f, 0x09, 0xca,
-	0xff, 0xdc, 0xd1, 0x00, 0xe1, 0x38, 0x95, 0x23, 0x2f, 0xcf, 0x74, 0x0a, 0x4f, 0xf1, 0x23, 0x46,
-	0xfa, 0xae, 0xab, 0x3f, 0x4a, 0x30, 0x0f, 0x68, 0x2c, 0xe6, 0xc6, 0xee, 0x3c, 0x6b, 0xc4, 0x38,
-	0x8e, 0x86, 0x42, 0x70, 0x7a, 0x98, 0x4f, 0x4e, 0x9c, 0xed, 0x6c, 0xeb, 0x05, 0xa8, 0xd5, 0xab,
-	0x82, 0xfd, 0x42, 0x48, 0x2e, 0x63, 0x9e, 0xa4, 0xda, 0x9d, 0xfa, 0x4b, 0x4a, 0x6b, 0xc0, 0x04,
-	0x8d, 0xbf, 0xed, 0x63, 0x78, 0x68, 0x2b, 0x50, 0x46, 0xd8, 0xff, 0x56, 0x73, 0xa3, 0x92, 0xe2,
-	0x28, 0x3c, 0xb3, 0x64, 0x8a, 0xd5, 0x5d, 0x07, 0x6a, 0x58, 0xfd, 0x13, 0x62, 0xc6, 0x1d, 0x19,
-	0x54, 0xfb, 0x66, 0x82, 0x46, 0xa9, 0x5d, 0x87, 0x05, 0x29, 0x94, 0xa4, 0xf0, 0x46, 0x92, 0xda,
-	0xe6, 0x74, 0x6e, 0x28, 0xab, 0xb9, 0x51, 0x2d, 0x52, 0xb7, 0xec, 0xd6, 0xe4, 0xcd, 0x00, 0xdd,
-	0x72, 0xf6, 0x26, 0x1b, 0x58, 0xcf, 0x40, 0x2d, 0x0b, 0xb0, 0x6b, 0x8e, 0x39, 0xd1, 0x7a, 0xea,
-	0x6f, 0xa9, 0x67, 0x35, 0x60, 0x7e, 0x6f, 0x94, 0xda, 0x9d, 0xc3, 0x44, 0xfb, 0x76, 0x63, 0xff,
-	0xc8, 0xba, 0x74, 0x37, 0x71, 0xda, 0x40, 0xad, 0xe4, 0x85, 0xdc, 0x90, 0x7a, 0x0f, 0xce, 0x17,
-	0x89, 0x2c, 0x41, 0xf4, 0xff, 0x13, 0xd1, 0x26, 0xa0, 0x60, 0xca, 0xd7, 0x64, 0x67, 0x8f, 0x99,
-	0xcf, 0xbe, 0x9d, 0x2e, 0x74, 0x30, 0x5b, 0xe8, 0xe0, 0x7d, 0xa1, 0x83, 0xc9, 0x52, 0x57, 0x66,
-	0x4b, 0x5d, 0x79, 0x5d, 0xea, 0x4a, 0xef, 0xdc, 0x0f, 0xf8, 0xfd, 0xc8, 0x85, 0x1e, 0x8d, 0xe4,
-	0xb5, 0x37, 0x43, 0xec, 0xb2, 0xf5, 0xe9, 0x8f, 0x5b, 0x1d, 0xf4, 0xb4, 0xe7, 0x40, 0x78, 0x3a,
-	0x24, 0xcc, 0xfd, 0x99, 0xf7, 0x3b, 0xf9, 0x08, 0x00, 0x00, 0xff, 0xff, 0x82, 0xf7, 0x0f, 0xe8,
-	0x2a, 0x03, 0x00, 0x00,
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x49, 0x05, 0x61, 0x5b, 0xa8, 0x70, 0x03, 0x72, 0x83, 0x64, 0x5b, 0x3e, 0x45,
+	0x48, 0xf5, 0x2a, 0x41, 0xad, 0x04, 0x12, 0x17, 0xab, 0x88, 0x7b, 0x00, 0x21, 0x95, 0x43, 0xb4,
+	0x76, 0xb6, 0xc6, 0xaa, 0xed, 0x8d, 0xbc, 0x9b, 0x80, 0xdf, 0xa2, 0x47, 0x5e, 0x81, 0x37, 0xe9,
+	0xb1, 0x27, 0xe0, 0x14, 0x50, 0xf2, 0x06, 0x79, 0x02, 0xe4, 0xf5, 0x4e, 0xd2, 0xb4, 0x95, 0x42,
+	0x25, 0x6e, 0x99, 0x9d, 0x99, 0x6f, 0xe6, 0xff, 0x35, 0x31, 0x26, 0x5c, 0xa4, 0x5c, 0xc4, 0x82,
+	0x0c, 0xf9, 0x97, 0x4c, 0xc6, 0x29, 0x3b, 0x18, 0x32, 0xc9, 0x42, 0xc9, 0x73, 0x32, 0xe9, 0x06,
+	0x4c, 0xd2, 0x2e, 0x89, 0x58, 0xc6, 0x44, 0x2c, 0xbc, 0x51, 0xce, 0x25, 0x37, 0x1c, 0xdd, 0xe0,
+	0x41, 0x03, 0xd4, 0x7b, 0xba, 0xbe, 0xdd, 0x8a, 0x78, 0xc4, 0x55, 0x31, 0x29, 0x7f, 0x55, 0x7d,
+	0xed, 0xfd, 0x88, 0xf3, 0x28, 0x61, 0x44, 0x45, 0xc1, 0xf8, 0x94, 0xd0, 0xac, 0x80, 0x54, 0xa8,
+	0x98, 0x83, 0xaa, 0xa7, 0x0a, 0x74, 0xca, 0xba, 0xde, 0x35, 0x1c, 0xe7, 0x54, 0xc6, 0x3c, 0xd3,
+	0x79, 0xfb, 0x7a, 0xbe, 0xdc, 0x48, 0x48, 0x9a, 0x8e, 0x74, 0xc1, 0xcb, 0xcd, 0xfa, 0x20, 0x33,
+	0x58, 0x67, 0xbb, 0x3f, 0x10, 0x6e, 0xbd, 0xad, 0xb4, 0x1f, 0xeb, 0x92, 0x37, 0x99, 0xcc, 0x0b,
+	0xe3, 0x13, 0x6e, 0x42, 0xa9, 0x89, 0x1c, 0xd4, 0x79, 0xd4, 0x7b, 0xee, 0x6d, 0x72, 0xc5, 0x03,
+	0x84, 0xbf, 0xb7, 0x98, 0xda, 0xbb, 0x05, 0x4d, 0x93, 0x57, 0x2e, 0x50, 0xdc, 0xfe, 0x12, 0x68,
+	0x50, 0xfc, 0x30, 0xa1, 0x42, 0x0e, 0x00, 0x64, 0xd6, 0x1d, 0xd4, 0xd9, 0xee, 0xb5, 0xbd, 0x4a,
+	0xa9, 0x07, 0x4a, 0xbd, 0xf7, 0xa0, 0xd4, 0x77, 0x2e, 0xa6, 0x76, 0x6d, 0x31, 0xb5, 0x5b, 0x15,
+	0x75, 0xad, 0xdd, 0x3d, 0xff, 0x6d, 0xa3, 0xfe, 0x4e, 0xf9, 0x06, 0x1b, 0xb8, 0x3f, 0xeb, 0x78,
+	0x6f, 0xb9, 0xce, 0x38, 0x3c, 0x63, 0xf2, 0x9d, 0xa4, 0x92, 0x19, 0x7d, 0xdc, 0x5c, 0x4e, 0x45,
+	0x6a, 0xea, 0xfe, 0x8d, 0xa9, 0xc7, 0x7a, 0x4f, 0xff, 0x99, 0x1e, 0x0a, 0x52, 0x00, 0xfe, 0xad,
+	0x9c, 0xb7, 0xe4, 0x94, 0xcc, 0x9c, 0x85, 0x7c, 0xc2, 0xf2, 0xc2, 0xac, 0xdf, 0x91, 0x09, 0x8d,
+	0x9a, 0x09, 0xe1, 0x4d, 0x8b, 0x1a, 0xff, 0xdb, 0x22, 0xe3, 0x10, 0x63, 0x3d, 0x2e, 0xce, 0x22,
+	0x73, 0xcb, 0x41, 0x9d, 0xa6, 0xff, 0x64, 0x31, 0xb5, 0x1f, 0xaf, 0x6d, 0x16, 0x67, 0x91, 0xdb,
+	0xbf, 0x52, 0xe8, 0x7e, 0xaf, 0xe3, 0x1d, 0x7d, 0x32, 0x95, 0xa5, 0x27, 0xf8, 0x01, 0x8c, 0x11,
+	0x26, 0x72, 0x1a, 0x9d, 0xed, 0xde, 0xd1, 0xe6, 0x5b, 0xb9, 0xed, 0xea, 0xfc, 0xad, 0x52, 0x42,
+	0x7f, 0x85, 0x33, 0x4e, 0xf1, 0xae, 0xd2, 0x11, 0x24, 0x3c, 0x3c, 0x1b, 0xfc, 0xe3, 0xad, 0xb8,
+	0xda, 0x88, 0xa7, 0x57, 0x8c, 0x58, 0x01, 0x2a, 0x2b, 0x94, 0xbb, 0x7e, 0xf9, 0x58, 0xf6, 0x19,
+	0x1f, 0xf0, 0xfd, 0x40, 0x5d, 0x89, 0x30, 0x1b, 0x4a, 0xc1, 0xe1, 0x1d, 0xae, 0x7d, 0x75, 0x5e,
+	0x5a, 0x00, 0xb0, 0xfc, 0x8f, 0x17, 0x33, 0x0b, 0x5d, 0xce, 0x2c, 0xf4, 0x67, 0x66, 0xa1, 0xf3,
+	0xb9, 0x55, 0xbb, 0x9c, 0x5b, 0xb5, 0x5f, 0x73, 0xab, 0x76, 0xf2, 0x3a, 0x8a, 0xe5, 0xe7, 0x71,
+	0xe0, 0x85, 0x3c, 0x85, 0xcf, 0xd3, 0x41, 0x42, 0x03, 0x01, 0x01, 0x99, 0x74, 0x8f, 0xc8, 0xd7,
+	0x5b, 0xfe, 0xd1, 0xb2, 0x18, 0x31, 0x11, 0xdc, 0x53, 0xb2, 0x5f, 0xfc, 0x1d, 0x00, 0x89, 0xb6,
+	0x4b, 0xf8, 0xdb, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisDowntimeEntry) Marshal() (dAtA []byte, err error) {
@@ -209,7 +297,7 @@ func (m *GenesisDowntimeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *DowntimeBucketState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -219,23 +307,94 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *DowntimeBucketState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DowntimeBucketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
+	if m.Recovering {
+		i--
+		if m.Recovering {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastDowntime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDowntime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Recovery, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Recovery):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Downtime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Downtime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Downtimes) > 0 {
 		for iNdEx := len(m.Downtimes) - 1; iNdEx >= 0; iNdEx-- {
@@ -279,6 +438,24 @@ func (m *GenesisDowntimeEntry) Size() (n int) {
 	return n
 }
 
+func (m *DowntimeBucketState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Downtime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Recovery)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDowntime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Recovering {
+		n += 2
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -293,6 +470,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime)
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -404,6 +587,175 @@ func (m *GenesisDowntimeEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DowntimeBucketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeBucketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeBucketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Downtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Recovery, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastDowntime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovering", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recovering = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -500,6 +852,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, DowntimeBucketState{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,39 @@
+package types
+
+import (
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type DowntimeHooks interface {
+	// AfterDowntimeDetected is called in BeginBlock when the chain resumes after being down for at least
+	// the downtime of a registered bucket. downtime is how long the chain was down for.
+	AfterDowntimeDetected(ctx sdk.Context, bucket DowntimeBucket, downtime time.Duration)
+
+	// AfterDowntimeRecovered is called in BeginBlock once the recovery duration of a registered bucket
+	// has passed since its last downtime.
+	AfterDowntimeRecovered(ctx sdk.Context, bucket DowntimeBucket)
+}
+
+var _ DowntimeHooks = MultiDowntimeHooks{}
+
+// combine multiple downtime hooks, all hook functions are run in array sequence.
+type MultiDowntimeHooks []DowntimeHooks
+
+// Creates hooks for the downtime detector module.
+func NewMultiDowntimeHooks(hooks ...DowntimeHooks) MultiDowntimeHooks {
+	return hooks
+}
+
+func (h MultiDowntimeHooks) AfterDowntimeDetected(ctx sdk.Context, bucket DowntimeBucket, downtime time.Duration) {
+	for i := range h {
+		h[i].AfterDowntimeDetected(ctx, bucket, downtime)
+	}
+}
+
+func (h MultiDowntimeHooks) AfterDowntimeRecovered(ctx sdk.Context, bucket DowntimeBucket) {
+	for i := range h {
+		h[i].AfterDowntimeRecovered(ctx, bucket)
+	}
+}
@@ -6,6 +6,7 @@ import fmt "fmt"
 var (
 	lastBlockTimestampKey      = []byte("last_block_timestamp")
 	lastDowntimeOfLengthPrefix = "last_downtime_of_length/%s"
+	downtimeBucketPrefix       = "downtime_bucket/"
 )
 
 func GetLastBlockTimestampKey() []byte { return lastBlockTimestampKey }
@@ -13,3 +14,11 @@ func GetLastBlockTimestampKey() []byte { return lastBlockTimestampKey }
 func GetLastDowntimeOfLengthKey(downtimeDur Downtime) []byte {
 	return []byte(fmt.Sprintf(lastDowntimeOfLengthPrefix, downtimeDur.String()))
 }
+
+func GetDowntimeBucketPrefix() []byte { return []byte(downtimeBucketPrefix) }
+
+// GetDowntimeBucketKey returns the key of the state of a registered bucket,
+// keyed by its downtime and recovery durations in nanoseconds.
+func GetDowntimeBucketKey(bucket DowntimeBucket) []byte {
+	return []byte(fmt.Sprintf("%s%d/%d", downtimeBucketPrefix, bucket.Downtime, bucket.Recovery))
+}
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 )
 
@@ -17,6 +18,11 @@ func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKe
 	ir.RegisterRoute(types.ModuleName, poolBalanceInvariantName, PoolAccountInvariant(keeper, bk))
 }
 
+// DeclareInvariants declares all gamm invariants to the invariant registry.
+func DeclareInvariants(r *invariants.Registry, keeper Keeper, bk types.BankKeeper) {
+	r.Register(types.ModuleName, poolBalanceInvariantName, invariants.Cheap, PoolAccountInvariant(keeper, bk))
+}
+
 // AllInvariants runs all invariants of the gamm module
 func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
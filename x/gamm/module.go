@@ -25,6 +25,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 	"github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/client/cli"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/keeper"
@@ -126,6 +127,11 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper, am.bk)
 }
 
+// DeclareInvariants declares the gamm module invariants to the invariant registry.
+func (am AppModule) DeclareInvariants(r *invariants.Registry) {
+	keeper.DeclareInvariants(r, am.keeper, am.bk)
+}
+
 // Route returns the message routing key for the gamm module.
 func (am AppModule) Route() sdk.Route {
 	return sdk.Route{}
@@ -1246,3 +1246,44 @@ func TestSingleAssetJoinSpreadFactorRatio(t *testing.T) {
 		})
 	}
 }
+
+// cfmmConstantFromLiquidity returns the CFMM constant of a two-asset pool with equal scaling factors, computed
+// exactly on the raw reserves, since scaling both reserves by the same factor preserves the ordering of the constants.
+func cfmmConstantFromLiquidity(liquidity sdk.Coins) osmomath.BigDec {
+	return cfmmConstantMultiNoV(osmomath.BigDecFromSDKDec(liquidity[0].Amount.ToDec()), osmomath.BigDecFromSDKDec(liquidity[1].Amount.ToDec()), osmomath.ZeroDec())
+}
+
+// FuzzSwapCFMMConstantNonDecreasing checks that, without spread factor, swapping out given in and in given out
+// never decreases the CFMM constant of the pool, i.e. the swaps are rounded in favor of the pool.
+func FuzzSwapCFMMConstantNonDecreasing(f *testing.F) {
+	f.Add(uint64(1_000_000_000), uint64(1_000_000_000), uint64(1_000_000), true)
+	f.Add(uint64(10_000_000), uint64(1_000_000_000_000), uint64(4_999_999), false)
+	f.Add(uint64(123_456_789_012), uint64(987_654_321), uint64(1), true)
+
+	f.Fuzz(func(t *testing.T, reserveA, reserveB, amount uint64, givenIn bool) {
+		// bound the reserves and amounts to the pool sizes the solver supports.
+		reserveA, reserveB = reserveA%1_000_000_000_000_000+1_000_000, reserveB%1_000_000_000_000_000+1_000_000
+		amount = amount%(reserveB/2) + 1
+
+		poolLiquidity := sdk.NewCoins(sdk.NewCoin("tokenA", sdk.NewIntFromUint64(reserveA)), sdk.NewCoin("tokenB", sdk.NewIntFromUint64(reserveB)))
+		pool := createTestPool(t, poolLiquidity, sdk.ZeroDec(), sdk.ZeroDec(), []uint64{1, 1})
+		ctx := sdk.Context{}
+		constantBefore := cfmmConstantFromLiquidity(pool.GetTotalPoolLiquidity(ctx))
+
+		var err error
+		if givenIn {
+			_, err = pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewCoin("tokenA", sdk.NewIntFromUint64(amount))), "tokenB", sdk.ZeroDec())
+		} else {
+			_, err = pool.SwapInAmtGivenOut(ctx, sdk.NewCoins(sdk.NewCoin("tokenB", sdk.NewIntFromUint64(amount))), "tokenA", sdk.ZeroDec())
+		}
+		// swaps that are rejected, e.g. for draining the pool, leave it untouched.
+		if err != nil {
+			return
+		}
+
+		liquidityAfter := pool.GetTotalPoolLiquidity(ctx)
+		require.Len(t, liquidityAfter, 2)
+		constantAfter := cfmmConstantFromLiquidity(liquidityAfter)
+		require.True(t, constantAfter.GTE(constantBefore), "CFMM constant decreased from %s to %s", constantBefore, constantAfter)
+	})
+}
@@ -0,0 +1,261 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+func GetPendingAsyncAckKey(channel string, packetSequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s::%s::%d", types.PendingAsyncAckPrefix, channel, packetSequence))
+}
+
+func getAsyncAckTimeoutKey(timeout []byte, channel string, packetSequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s::%s::%s::%d", types.AsyncAckTimeoutPrefix, timeout, channel, packetSequence))
+}
+
+// StorePendingAsyncAck stores a received packet whose ack was deferred by the contract it was routed to.
+// transferAck is the ack of the underlying transfer, included in the ack once the contract emits it.
+// If the contract has not emitted the ack after types.AsyncAckTimeout, an error ack is written by ExpireAsyncAcks.
+func (k Keeper) StorePendingAsyncAck(ctx sdk.Context, contract string, packet channeltypes.Packet, transferAck []byte) error {
+	key := GetPendingAsyncAckKey(packet.GetDestChannel(), packet.GetSequence())
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(key) {
+		return fmt.Errorf("packet %s/%d already has a pending async ack", packet.GetDestChannel(), packet.GetSequence())
+	}
+
+	packetBz, err := packet.Marshal()
+	if err != nil {
+		return err
+	}
+
+	pending := types.PendingAsyncAck{
+		Contract:    contract,
+		Packet:      packetBz,
+		TransferAck: transferAck,
+		Timeout:     ctx.BlockTime().Add(types.AsyncAckTimeout),
+	}
+	if err := k.setPendingAsyncAck(ctx, packet.GetDestChannel(), packet.GetSequence(), pending); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtAsyncAckPending,
+		sdk.NewAttribute(types.AttributeKeyContract, contract),
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.GetDestChannel()),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(types.AttributeKeyTimeout, pending.Timeout.String()),
+	))
+	return nil
+}
+
+// setPendingAsyncAck stores the pending async ack of a packet and adds it to the timeout queue.
+func (k Keeper) setPendingAsyncAck(ctx sdk.Context, channel string, packetSequence uint64, pending types.PendingAsyncAck) error {
+	bz, err := pending.Marshal()
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingAsyncAckKey(channel, packetSequence)
+	store.Set(key, bz)
+	store.Set(getAsyncAckTimeoutKey(sdk.FormatTimeBytes(pending.Timeout), channel, packetSequence), key)
+	return nil
+}
+
+// GetPendingAsyncAck returns the pending async ack of the given packet, if any.
+func (k Keeper) GetPendingAsyncAck(ctx sdk.Context, channel string, packetSequence uint64) (types.PendingAsyncAck, bool) {
+	return k.getPendingAsyncAckByKey(ctx, GetPendingAsyncAckKey(channel, packetSequence))
+}
+
+func (k Keeper) getPendingAsyncAckByKey(ctx sdk.Context, key []byte) (types.PendingAsyncAck, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return types.PendingAsyncAck{}, false
+	}
+	var pending types.PendingAsyncAck
+	if err := pending.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return pending, true
+}
+
+// GetAllPendingAsyncAcks returns all the pending async acks, ordered by channel and sequence.
+func (k Keeper) GetAllPendingAsyncAcks(ctx sdk.Context) []types.PendingAsyncAck {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(types.PendingAsyncAckPrefix+"::"))
+	defer iter.Close()
+
+	var all []types.PendingAsyncAck
+	for ; iter.Valid(); iter.Next() {
+		var pending types.PendingAsyncAck
+		if err := pending.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		all = append(all, pending)
+	}
+	return all
+}
+
+func (k Keeper) deletePendingAsyncAck(ctx sdk.Context, channel string, packetSequence uint64, pending types.PendingAsyncAck) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetPendingAsyncAckKey(channel, packetSequence))
+	store.Delete(getAsyncAckTimeoutKey(sdk.FormatTimeBytes(pending.Timeout), channel, packetSequence))
+}
+
+// EmitIBCAck writes the deferred ack of a packet. Only the contract the packet was routed to can emit it.
+// If errMsg is not empty, an error ack is written and the funds received with the packet are taken back
+// from the contract, so that the sender can be refunded on the counterparty chain. Otherwise, the ack
+// wraps contractResult and the ack of the underlying transfer, as for packets acked synchronously.
+func (k Keeper) EmitIBCAck(ctx sdk.Context, sender string, channel string, packetSequence uint64, contractResult []byte, errMsg string) error {
+	pending, found := k.GetPendingAsyncAck(ctx, channel, packetSequence)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAsyncAckNotFound, "channel %s, sequence %d", channel, packetSequence)
+	}
+	if pending.Contract != sender {
+		return errorsmod.Wrapf(types.ErrAsyncAckUnauthorized, "expected %s, got %s", pending.Contract, sender)
+	}
+
+	var packet channeltypes.Packet
+	if err := packet.Unmarshal(pending.Packet); err != nil {
+		return err
+	}
+
+	success := errMsg == ""
+	var ack channeltypes.Acknowledgement
+	if success {
+		bz, err := json.Marshal(types.ContractAck{ContractResult: contractResult, IbcAck: pending.TransferAck})
+		if err != nil {
+			return err
+		}
+		ack = channeltypes.NewResultAcknowledgement(bz)
+	} else {
+		if err := k.returnFunds(ctx, pending, packet); err != nil {
+			return errorsmod.Wrap(types.ErrCannotReturnFunds, err.Error())
+		}
+		ack = osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrAsyncAckError, errMsg)
+	}
+
+	return k.writeAsyncAck(ctx, pending, packet, ack, false)
+}
+
+// ExpireAsyncAcks writes an error ack for every pending async ack whose timeout has passed.
+//
+// The funds received with the packet are taken back from the contract before writing the error ack.
+// If the contract does not hold them anymore, refunding the sender would create tokens out of thin air,
+// so a result ack without contract result is written instead.
+func (k Keeper) ExpireAsyncAcks(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, []byte(types.AsyncAckTimeoutPrefix+"::"))
+	defer iter.Close()
+
+	var expired [][]byte
+	for ; iter.Valid(); iter.Next() {
+		pending, found := k.getPendingAsyncAckByKey(ctx, iter.Value())
+		if found && pending.Timeout.After(ctx.BlockTime()) {
+			break
+		}
+		expired = append(expired, iter.Value())
+	}
+
+	for _, key := range expired {
+		pending, found := k.getPendingAsyncAckByKey(ctx, key)
+		if !found {
+			continue
+		}
+		k.expireAsyncAck(ctx, pending)
+	}
+}
+
+func (k Keeper) expireAsyncAck(ctx sdk.Context, pending types.PendingAsyncAck) {
+	var packet channeltypes.Packet
+	if err := packet.Unmarshal(pending.Packet); err != nil {
+		panic(err)
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := k.returnFunds(ctx, pending, packet); err == nil {
+		ack = osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrAsyncAckError, "async ack timed out")
+	} else {
+		k.Logger(ctx).Error("cannot return the funds of expired async ack", "channel", packet.GetDestChannel(), "sequence", packet.GetSequence(), "error", err)
+		bz, err := json.Marshal(types.ContractAck{IbcAck: pending.TransferAck})
+		if err != nil {
+			panic(err)
+		}
+		ack = channeltypes.NewResultAcknowledgement(bz)
+	}
+
+	if err := k.writeAsyncAck(ctx, pending, packet, ack, true); err != nil {
+		// The ack cannot be written, e.g. because the channel was closed. Drop it so it is not retried every block.
+		k.Logger(ctx).Error("cannot write expired async ack", "channel", packet.GetDestChannel(), "sequence", packet.GetSequence(), "error", err)
+		k.deletePendingAsyncAck(ctx, packet.GetDestChannel(), packet.GetSequence(), pending)
+	}
+}
+
+// writeAsyncAck writes the ack of a pending packet and deletes it from the store.
+func (k Keeper) writeAsyncAck(ctx sdk.Context, pending types.PendingAsyncAck, packet channeltypes.Packet, ack channeltypes.Acknowledgement, timedOut bool) error {
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel()))
+	if !ok {
+		return errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if err := k.channelKeeper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+	k.deletePendingAsyncAck(ctx, packet.GetDestChannel(), packet.GetSequence(), pending)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtAsyncAckWritten,
+		sdk.NewAttribute(types.AttributeKeyContract, pending.Contract),
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.GetDestChannel()),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(ack.Success())),
+		sdk.NewAttribute(types.AttributeKeyTimedOut, strconv.FormatBool(timedOut)),
+	))
+	return nil
+}
+
+// returnFunds takes the funds received with the packet back from the contract, undoing the receive of the
+// transfer module: tokens native to this chain are sent back to the escrow account of the channel and
+// vouchers are burned. Nothing is changed if it fails.
+func (k Keeper) returnFunds(ctx sdk.Context, pending types.PendingAsyncAck, packet channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return err
+	}
+	amount, ok := sdk.NewIntFromString(data.GetAmount())
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", data.GetAmount())
+	}
+	contract, err := sdk.AccAddressFromBech32(pending.Contract)
+	if err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(osmoutils.MustExtractDenomFromPacketOnRecv(packet), amount))
+
+	cacheCtx, write := ctx.CacheContext()
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		escrow := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(cacheCtx, contract, escrow, coins); err != nil {
+			return err
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, contract, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(cacheCtx, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+// InitGenesis initializes the ibc-hooks module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	for _, pending := range genState.PendingAsyncAcks {
+		var packet channeltypes.Packet
+		if err := packet.Unmarshal(pending.Packet); err != nil {
+			panic(err)
+		}
+		if err := k.setPendingAsyncAck(ctx, packet.GetDestChannel(), packet.GetSequence(), pending); err != nil {
+			panic(err)
+		}
+	}
+	for _, stranded := range genState.StrandedFunds {
+		k.setStrandedFunds(ctx, stranded)
+	}
+}
+
+// ExportGenesis returns the ibc-hooks module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		PendingAsyncAcks: k.GetAllPendingAsyncAcks(ctx),
+		StrandedFunds:    k.GetAllStrandedFunds(ctx),
+	}
+}
@@ -15,15 +15,27 @@ import (
 type (
 	Keeper struct {
 		storeKey sdk.StoreKey
+
+		channelKeeper types.ChannelKeeper
+		scopedKeeper  types.ScopedKeeper
+		bankKeeper    types.BankKeeper
+
+		transferKeeper types.TransferKeeper
 	}
 )
 
 // NewKeeper returns a new instance of the x/ibchooks keeper
 func NewKeeper(
 	storeKey sdk.StoreKey,
+	channelKeeper types.ChannelKeeper,
+	scopedKeeper types.ScopedKeeper,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 	return Keeper{
-		storeKey: storeKey,
+		storeKey:      storeKey,
+		channelKeeper: channelKeeper,
+		scopedKeeper:  scopedKeeper,
+		bankKeeper:    bankKeeper,
 	}
 }
 
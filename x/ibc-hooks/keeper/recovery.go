@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+func GetStrandedFundsKey(channel string, originalSender string) []byte {
+	return []byte(fmt.Sprintf("%s::%s::%s", types.StrandedFundsPrefix, channel, originalSender))
+}
+
+// SetTransferKeeper sets the transfer keeper used to send recovered funds back over IBC.
+// The transfer keeper is created after the ibc-hooks keeper, as its ICS4 wrapper is the wasm hooks middleware.
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
+// RecordStrandedFunds records the balance in denom of the intermediary sender of originalSender on channel as stranded.
+// Only the given denom is updated, and nothing is written if its recorded amount is unchanged. The record is removed
+// once it holds no funds.
+func (k Keeper) RecordStrandedFunds(ctx sdk.Context, channel string, originalSender string, intermediary sdk.AccAddress, denom string) {
+	balance := k.bankKeeper.GetBalance(ctx, intermediary, denom)
+	stranded, found := k.GetStrandedFunds(ctx, channel, originalSender)
+	if stranded.Amount.AmountOf(denom).Equal(balance.Amount) {
+		return
+	}
+	if !found {
+		stranded = types.StrandedFunds{
+			Channel:            channel,
+			OriginalSender:     originalSender,
+			IntermediarySender: intermediary.String(),
+		}
+	}
+	amount := sdk.NewCoins(balance)
+	for _, coin := range stranded.Amount {
+		if coin.Denom != denom {
+			amount = amount.Add(coin)
+		}
+	}
+	stranded.Amount = amount
+	k.setStrandedFunds(ctx, stranded)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtStrandedFunds,
+		sdk.NewAttribute(types.AttributeKeyChannel, channel),
+		sdk.NewAttribute(types.AttributeKeyOriginalSender, originalSender),
+		sdk.NewAttribute(types.AttributeKeyIntermediary, intermediary.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+}
+
+// setStrandedFunds stores the stranded funds record, or removes it if it holds no funds.
+func (k Keeper) setStrandedFunds(ctx sdk.Context, stranded types.StrandedFunds) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetStrandedFundsKey(stranded.Channel, stranded.OriginalSender)
+	if stranded.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	bz, err := stranded.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// GetStrandedFunds returns the funds recorded as stranded at the intermediary sender of originalSender on channel.
+func (k Keeper) GetStrandedFunds(ctx sdk.Context, channel string, originalSender string) (types.StrandedFunds, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetStrandedFundsKey(channel, originalSender))
+	if bz == nil {
+		return types.StrandedFunds{}, false
+	}
+	var stranded types.StrandedFunds
+	if err := stranded.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return stranded, true
+}
+
+// GetAllStrandedFunds returns all the funds recorded as stranded.
+func (k Keeper) GetAllStrandedFunds(ctx sdk.Context) []types.StrandedFunds {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(types.StrandedFundsPrefix+"::"))
+	defer iter.Close()
+
+	var all []types.StrandedFunds
+	for ; iter.Valid(); iter.Next() {
+		var stranded types.StrandedFunds
+		if err := stranded.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		all = append(all, stranded)
+	}
+	return all
+}
+
+// RecoverStrandedFunds sends the funds recorded as stranded at the intermediary sender of originalSender on channel,
+// along with the funds received by the intermediary sender with the recovery request, to receiver. Other funds held
+// by the intermediary sender are left there. If receiver is empty, the funds are sent back to originalSender over
+// channel. The caller is responsible for proving that originalSender requested the recovery, i.e. the request must
+// come from an IBC packet sent by originalSender on channel.
+func (k Keeper) RecoverStrandedFunds(ctx sdk.Context, channel string, originalSender string, intermediary sdk.AccAddress, received sdk.Coins, receiver string) (sdk.Coins, error) {
+	stranded, _ := k.GetStrandedFunds(ctx, channel, originalSender)
+	// The intermediary sender always holds the recorded funds, as they are recorded from its balance and it cannot
+	// send funds by itself. The amount is capped by its balance nonetheless, so that a recovery never fails to pay.
+	balance := stranded.Amount.Add(received...).Min(k.bankKeeper.GetAllBalances(ctx, intermediary))
+	if balance.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNoStrandedFunds, "intermediary sender %s", intermediary)
+	}
+
+	if receiver != "" {
+		receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrRecovery, "invalid receiver %s: %s", receiver, err)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, intermediary, receiverAddr, balance); err != nil {
+			return nil, err
+		}
+	} else {
+		if k.transferKeeper == nil {
+			return nil, errorsmod.Wrap(types.ErrRecovery, "transfer keeper not configured")
+		}
+		// The funds are sent back with a timeout. If the transfer fails, they are refunded to the intermediary sender
+		// and can be recovered again.
+		timeout := uint64(ctx.BlockTime().Add(types.RecoveryTransferTimeout).UnixNano())
+		for _, coin := range balance {
+			err := k.transferKeeper.SendTransfer(ctx, transfertypes.PortID, channel, coin, intermediary, originalSender, clienttypes.ZeroHeight(), timeout)
+			if err != nil {
+				return nil, err
+			}
+		}
+		receiver = originalSender
+	}
+
+	ctx.KVStore(k.storeKey).Delete(GetStrandedFundsKey(channel, originalSender))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtFundsRecovered,
+		sdk.NewAttribute(types.AttributeKeyChannel, channel),
+		sdk.NewAttribute(types.AttributeKeyOriginalSender, originalSender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(types.AttributeKeyAmount, balance.String()),
+	))
+	return balance, nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import "time"
+
+// AsyncAckTimeout is the time a contract has to emit a deferred ack before an error ack is written automatically.
+const AsyncAckTimeout = 24 * time.Hour
+
+// ContractAck is the acknowledgement written for a packet that executed a contract through the wasm hook.
+type ContractAck struct {
+	ContractResult []byte `json:"contract_result"`
+	IbcAck         []byte `json:"ibc_ack"`
+}
+
+// OnRecvPacketAsyncAckResponse is the data a contract called by the wasm hook returns to defer
+// the ack of the packet until it emits it with MsgEmitIBCAck.
+type OnRecvPacketAsyncAckResponse struct {
+	IsAsyncAck bool `json:"is_async_ack"`
+}
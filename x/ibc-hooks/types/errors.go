@@ -14,4 +14,12 @@ var (
 	ErrBadResponse   = errorsmod.Register("wasm-hooks", 5, "cannot create response")
 	ErrWasmError     = errorsmod.Register("wasm-hooks", 6, "wasm error")
 	ErrBadSender     = errorsmod.Register("wasm-hooks", 7, "bad sender")
+
+	ErrAsyncAckNotFound     = errorsmod.Register("wasm-hooks", 8, "no pending async ack for packet")
+	ErrAsyncAckUnauthorized = errorsmod.Register("wasm-hooks", 9, "only the contract the packet was routed to can emit its ack")
+	ErrAsyncAckError        = errorsmod.Register("wasm-hooks", 10, "async ack error")
+	ErrCannotReturnFunds    = errorsmod.Register("wasm-hooks", 11, "cannot return the received funds")
+
+	ErrNoStrandedFunds = errorsmod.Register("wasm-hooks", 12, "no stranded funds to recover")
+	ErrRecovery        = errorsmod.Register("wasm-hooks", 13, "cannot recover stranded funds")
 )
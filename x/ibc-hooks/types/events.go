@@ -0,0 +1,19 @@
+package types
+
+const (
+	TypeEvtAsyncAckPending = "ibc_hooks_async_ack_pending"
+	TypeEvtAsyncAckWritten = "ibc_hooks_async_ack_written"
+	TypeEvtStrandedFunds   = "ibc_hooks_stranded_funds"
+	TypeEvtFundsRecovered  = "ibc_hooks_funds_recovered"
+
+	AttributeKeyContract       = "contract"
+	AttributeKeyChannel        = "channel"
+	AttributeKeySequence       = "sequence"
+	AttributeKeyTimeout        = "timeout"
+	AttributeKeySuccess        = "success"
+	AttributeKeyTimedOut       = "timed_out"
+	AttributeKeyOriginalSender = "original_sender"
+	AttributeKeyIntermediary   = "intermediary_sender"
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyAmount         = "amount"
+)
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// DefaultGenesis returns the default ibc-hooks genesis state, without pending async acks nor stranded funds.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	packets := make(map[string]bool, len(gs.PendingAsyncAcks))
+	for _, pending := range gs.PendingAsyncAcks {
+		if _, err := sdk.AccAddressFromBech32(pending.Contract); err != nil {
+			return fmt.Errorf("invalid pending async ack contract %s: %w", pending.Contract, err)
+		}
+		var packet channeltypes.Packet
+		if err := packet.Unmarshal(pending.Packet); err != nil {
+			return fmt.Errorf("invalid pending async ack packet: %w", err)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid pending async ack packet: %w", err)
+		}
+		id := fmt.Sprintf("%s/%d", packet.GetDestChannel(), packet.GetSequence())
+		if packets[id] {
+			return fmt.Errorf("duplicate pending async ack for packet %s", id)
+		}
+		packets[id] = true
+	}
+
+	senders := make(map[string]bool, len(gs.StrandedFunds))
+	for _, stranded := range gs.StrandedFunds {
+		if _, err := sdk.AccAddressFromBech32(stranded.IntermediarySender); err != nil {
+			return fmt.Errorf("invalid stranded funds intermediary sender %s: %w", stranded.IntermediarySender, err)
+		}
+		if err := stranded.Amount.Validate(); err != nil || stranded.Amount.IsZero() {
+			return fmt.Errorf("invalid stranded funds of intermediary sender %s: %s", stranded.IntermediarySender, stranded.Amount)
+		}
+		id := fmt.Sprintf("%s/%s", stranded.Channel, stranded.OriginalSender)
+		if senders[id] {
+			return fmt.Errorf("duplicate stranded funds for sender %s", id)
+		}
+		senders[id] = true
+	}
+	return nil
+}
@@ -5,4 +5,12 @@ const (
 	StoreKey       = "hooks-for-ibc" // not using the module name because of collisions with key "ibc"
 	IBCCallbackKey = "ibc_callback"
 	SenderPrefix   = "ibc-wasm-hook-intermediary"
+	RouterKey      = ModuleName
+
+	// PendingAsyncAckPrefix is the store prefix of the packets whose ack was deferred by a contract.
+	PendingAsyncAckPrefix = "async_ack"
+	// AsyncAckTimeoutPrefix is the store prefix of the timeout queue of the pending async acks.
+	AsyncAckTimeoutPrefix = "async_ack_timeout"
+	// StrandedFundsPrefix is the store prefix of the funds stranded at intermediary senders.
+	StrandedFundsPrefix = "stranded_funds"
 )
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+const TypeMsgEmitIBCAck = "emit_ibc_ack"
+
+var _ sdk.Msg = &MsgEmitIBCAck{}
+
+// NewMsgEmitIBCAck creates a message to emit the deferred ack of a packet.
+func NewMsgEmitIBCAck(sender, channel string, packetSequence uint64, contractResult []byte, errMsg string) *MsgEmitIBCAck {
+	return &MsgEmitIBCAck{
+		Sender:         sender,
+		Channel:        channel,
+		PacketSequence: packetSequence,
+		ContractResult: contractResult,
+		Error:          errMsg,
+	}
+}
+
+func (m MsgEmitIBCAck) Route() string { return RouterKey }
+func (m MsgEmitIBCAck) Type() string  { return TypeMsgEmitIBCAck }
+func (m MsgEmitIBCAck) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender address (%s): %w", m.Sender, err)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return err
+	}
+
+	if m.PacketSequence == 0 {
+		return fmt.Errorf("packet sequence cannot be 0")
+	}
+
+	return nil
+}
+
+func (m MsgEmitIBCAck) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgEmitIBCAck) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,494 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/ibchooks/pending_ack.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingAsyncAck is a received packet whose acknowledgement was deferred by
+// the contract it was routed to by the wasm hook.
+type PendingAsyncAck struct {
+	// contract is the address of the contract allowed to emit the ack.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// packet is the proto encoded received channel packet, with its receiver
+	// overridden by the intermediary sender.
+	Packet []byte `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet,omitempty"`
+	// transfer_ack is the acknowledgement returned by the transfer app when
+	// the packet was received.
+	TransferAck []byte `protobuf:"bytes,3,opt,name=transfer_ack,json=transferAck,proto3" json:"transfer_ack,omitempty"`
+	// timeout is the time after which an error ack is written automatically.
+	Timeout time.Time `protobuf:"bytes,4,opt,name=timeout,proto3,stdtime" json:"timeout"`
+}
+
+func (m *PendingAsyncAck) Reset()         { *m = PendingAsyncAck{} }
+func (m *PendingAsyncAck) String() string { return proto.CompactTextString(m) }
+func (*PendingAsyncAck) ProtoMessage()    {}
+func (*PendingAsyncAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_978715fe97343b9a, []int{0}
+}
+func (m *PendingAsyncAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAsyncAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAsyncAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAsyncAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAsyncAck.Merge(m, src)
+}
+func (m *PendingAsyncAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAsyncAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAsyncAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAsyncAck proto.InternalMessageInfo
+
+func (m *PendingAsyncAck) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *PendingAsyncAck) GetPacket() []byte {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *PendingAsyncAck) GetTransferAck() []byte {
+	if m != nil {
+		return m.TransferAck
+	}
+	return nil
+}
+
+func (m *PendingAsyncAck) GetTimeout() time.Time {
+	if m != nil {
+		return m.Timeout
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PendingAsyncAck)(nil), "osmosis.ibchooks.PendingAsyncAck")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/ibchooks/pending_ack.proto", fileDescriptor_978715fe97343b9a)
+}
+
+var fileDescriptor_978715fe97343b9a = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0x63, 0x40, 0xa5, 0xb8, 0x95, 0x40, 0x11, 0x42, 0x51, 0x06, 0x37, 0x74, 0xca, 0x52,
+	0x5b, 0xc0, 0x8e, 0xd4, 0xae, 0x2c, 0x28, 0x62, 0x62, 0x41, 0x8e, 0x71, 0xdd, 0x28, 0x4d, 0x6e,
+	0x14, 0x3b, 0x12, 0x7d, 0x8b, 0x3e, 0x06, 0x8f, 0xd2, 0xb1, 0x23, 0x13, 0xa0, 0xe4, 0x45, 0x50,
+	0x7e, 0xcc, 0xe6, 0xe3, 0x7b, 0xee, 0x3d, 0x9f, 0x0e, 0x9e, 0x83, 0xce, 0x40, 0x27, 0x9a, 0x25,
+	0xb1, 0xd8, 0x00, 0xa4, 0x9a, 0x15, 0x32, 0x7f, 0x4f, 0x72, 0xf5, 0xc6, 0x45, 0x4a, 0x8b, 0x12,
+	0x0c, 0xb8, 0x57, 0x83, 0x87, 0x5a, 0x8f, 0x7f, 0xad, 0x40, 0x41, 0x37, 0x64, 0xed, 0xab, 0xf7,
+	0xf9, 0x33, 0x05, 0xa0, 0xb6, 0x92, 0x75, 0x2a, 0xae, 0xd6, 0xcc, 0x24, 0x99, 0xd4, 0x86, 0x67,
+	0x45, 0x6f, 0x98, 0x7f, 0x22, 0x7c, 0xf9, 0xdc, 0x9f, 0x5f, 0xea, 0x5d, 0x2e, 0x96, 0x22, 0x75,
+	0x7d, 0x3c, 0x16, 0x90, 0x9b, 0x92, 0x0b, 0xe3, 0xa1, 0x00, 0x85, 0x17, 0xd1, 0xbf, 0x76, 0x6f,
+	0xf0, 0xa8, 0xe0, 0x22, 0x95, 0xc6, 0x3b, 0x09, 0x50, 0x38, 0x8d, 0x06, 0xe5, 0xde, 0xe2, 0xa9,
+	0x29, 0x79, 0xae, 0xd7, 0xb2, 0x6c, 0x31, 0xbd, 0xd3, 0x6e, 0x3a, 0xb1, 0x7f, 0xed, 0xd9, 0x47,
+	0x7c, 0xde, 0xa6, 0x43, 0x65, 0xbc, 0xb3, 0x00, 0x85, 0x93, 0x7b, 0x9f, 0xf6, 0x74, 0xd4, 0xd2,
+	0xd1, 0x17, 0x4b, 0xb7, 0x1a, 0x1f, 0xbe, 0x67, 0xce, 0xfe, 0x67, 0x86, 0x22, 0xbb, 0xb4, 0x7a,
+	0x3a, 0xd4, 0x04, 0x1d, 0x6b, 0x82, 0x7e, 0x6b, 0x82, 0xf6, 0x0d, 0x71, 0x8e, 0x0d, 0x71, 0xbe,
+	0x1a, 0xe2, 0xbc, 0xde, 0xa9, 0xc4, 0x6c, 0xaa, 0x98, 0x0a, 0xc8, 0xd8, 0x50, 0xcc, 0x62, 0xcb,
+	0x63, 0x6d, 0x05, 0xfb, 0x68, 0xbb, 0x5c, 0xf4, 0x65, 0x9a, 0x5d, 0x21, 0x75, 0x3c, 0xea, 0x32,
+	0x1f, 0xfe, 0x06, 0x00, 0xf0, 0xb2, 0x35, 0xf3, 0x6d, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingAsyncAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAsyncAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAsyncAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPendingAck(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TransferAck) > 0 {
+		i -= len(m.TransferAck)
+		copy(dAtA[i:], m.TransferAck)
+		i = encodeVarintPendingAck(dAtA, i, uint64(len(m.TransferAck)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Packet) > 0 {
+		i -= len(m.Packet)
+		copy(dAtA[i:], m.Packet)
+		i = encodeVarintPendingAck(dAtA, i, uint64(len(m.Packet)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintPendingAck(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPendingAck(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPendingAck(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingAsyncAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovPendingAck(uint64(l))
+	}
+	l = len(m.Packet)
+	if l > 0 {
+		n += 1 + l + sovPendingAck(uint64(l))
+	}
+	l = len(m.TransferAck)
+	if l > 0 {
+		n += 1 + l + sovPendingAck(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timeout)
+	n += 1 + l + sovPendingAck(uint64(l))
+	return n
+}
+
+func sovPendingAck(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPendingAck(x uint64) (n int) {
+	return sovPendingAck(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingAsyncAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPendingAck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAsyncAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAsyncAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingAck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPendingAck
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packet = append(m.Packet[:0], dAtA[iNdEx:postIndex]...)
+			if m.Packet == nil {
+				m.Packet = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAck", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPendingAck
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferAck = append(m.TransferAck[:0], dAtA[iNdEx:postIndex]...)
+			if m.TransferAck == nil {
+				m.TransferAck = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPendingAck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPendingAck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPendingAck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPendingAck(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPendingAck
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingAck
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingAck
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPendingAck
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPendingAck
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPendingAck
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPendingAck        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPendingAck          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPendingAck = fmt.Errorf("proto: unexpected end of group")
+)
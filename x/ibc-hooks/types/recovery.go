@@ -0,0 +1,18 @@
+package types
+
+import "time"
+
+const (
+	// RecoverMemoKey is the memo key of the packets claiming the funds stranded at the intermediary sender
+	// of their sender, e.g. {"ibc_hooks_recover": {"receiver": "osmo1..."}}.
+	RecoverMemoKey = "ibc_hooks_recover"
+
+	// RecoveryTransferTimeout is the timeout of the transfers sending recovered funds back to their original sender.
+	RecoveryTransferTimeout = time.Hour
+)
+
+// RecoverMemo is the value of RecoverMemoKey in the memo of a recovery packet. If Receiver is empty,
+// the funds are sent back to the original sender over the channel the packet was received on.
+type RecoverMemo struct {
+	Receiver string `json:"receiver,omitempty"`
+}
@@ -0,0 +1,499 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/ibchooks/recovery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StrandedFunds are the funds left at the intermediary sender the wasm hooks
+// execute contracts with. The intermediary sender has no key, so they can only
+// be claimed by the original sender through an IBC packet sent on the same
+// channel.
+type StrandedFunds struct {
+	// channel is the channel (osmosis side) the original sender sends packets on.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// original_sender is the address of the sender on the counterparty chain.
+	OriginalSender string `protobuf:"bytes,2,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+	// intermediary_sender is the local address derived from the channel and the
+	// original sender.
+	IntermediarySender string                                   `protobuf:"bytes,3,opt,name=intermediary_sender,json=intermediarySender,proto3" json:"intermediary_sender,omitempty"`
+	Amount             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *StrandedFunds) Reset()         { *m = StrandedFunds{} }
+func (m *StrandedFunds) String() string { return proto.CompactTextString(m) }
+func (*StrandedFunds) ProtoMessage()    {}
+func (*StrandedFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3db67af2a1328070, []int{0}
+}
+func (m *StrandedFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrandedFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrandedFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrandedFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrandedFunds.Merge(m, src)
+}
+func (m *StrandedFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrandedFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrandedFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrandedFunds proto.InternalMessageInfo
+
+func (m *StrandedFunds) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *StrandedFunds) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+func (m *StrandedFunds) GetIntermediarySender() string {
+	if m != nil {
+		return m.IntermediarySender
+	}
+	return ""
+}
+
+func (m *StrandedFunds) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StrandedFunds)(nil), "osmosis.ibchooks.StrandedFunds")
+}
+
+func init() { proto.RegisterFile("osmosis/ibchooks/recovery.proto", fileDescriptor_3db67af2a1328070) }
+
+var fileDescriptor_3db67af2a1328070 = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbd, 0x4e, 0xfb, 0x30,
+	0x14, 0xc5, 0x93, 0x7f, 0xff, 0x2a, 0xc2, 0x88, 0x0f, 0x05, 0x86, 0xd0, 0xc1, 0xad, 0x58, 0xe8,
+	0x52, 0x9b, 0xc2, 0x1b, 0x14, 0x89, 0x85, 0xad, 0xdd, 0x58, 0x90, 0xbf, 0x94, 0x5a, 0x6d, 0x7c,
+	0x2b, 0xdb, 0xad, 0xe8, 0x5b, 0xf0, 0x1c, 0x3c, 0x49, 0xc7, 0x8e, 0x4c, 0x80, 0xda, 0x85, 0xc7,
+	0x40, 0x71, 0x1c, 0xa9, 0x53, 0x72, 0xef, 0xf9, 0xdd, 0xa3, 0xe3, 0x7b, 0x51, 0x17, 0x5c, 0x09,
+	0x4e, 0x3b, 0xaa, 0xb9, 0x98, 0x02, 0xcc, 0x1c, 0xb5, 0x4a, 0xc0, 0x4a, 0xd9, 0x35, 0x59, 0x58,
+	0xf0, 0x90, 0x5d, 0x44, 0x80, 0x34, 0x40, 0xe7, 0xaa, 0x80, 0x02, 0x82, 0x48, 0xab, 0xbf, 0x9a,
+	0xeb, 0x60, 0x11, 0x40, 0xca, 0x99, 0x53, 0x74, 0x35, 0xe4, 0xca, 0xb3, 0x21, 0x15, 0xa0, 0x4d,
+	0xad, 0xdf, 0xfc, 0xa6, 0xe8, 0x74, 0xe2, 0x2d, 0x33, 0x52, 0xc9, 0xa7, 0xa5, 0x91, 0x2e, 0xcb,
+	0xd1, 0x91, 0x98, 0x32, 0x63, 0xd4, 0x3c, 0x4f, 0x7b, 0x69, 0xff, 0x78, 0xdc, 0x94, 0xd9, 0x2d,
+	0x3a, 0x07, 0xab, 0x0b, 0x6d, 0xd8, 0xfc, 0xd5, 0x29, 0x23, 0x95, 0xcd, 0xff, 0x05, 0xe2, 0xac,
+	0x69, 0x4f, 0x42, 0x37, 0xa3, 0xe8, 0x52, 0x1b, 0xaf, 0x6c, 0xa9, 0xa4, 0x66, 0x76, 0xdd, 0xc0,
+	0xad, 0x00, 0x67, 0x87, 0x52, 0x1c, 0x10, 0xa8, 0xcd, 0x4a, 0x58, 0x1a, 0x9f, 0xff, 0xef, 0xb5,
+	0xfa, 0x27, 0xf7, 0xd7, 0xa4, 0x8e, 0x4d, 0xaa, 0xd8, 0x24, 0xc6, 0x26, 0x8f, 0xa0, 0xcd, 0xe8,
+	0x6e, 0xf3, 0xd5, 0x4d, 0x3e, 0xbe, 0xbb, 0xfd, 0x42, 0xfb, 0xe9, 0x92, 0x13, 0x01, 0x25, 0x8d,
+	0x6f, 0xac, 0x3f, 0x03, 0x27, 0x67, 0xd4, 0xaf, 0x17, 0xca, 0x85, 0x01, 0x37, 0x8e, 0xd6, 0xa3,
+	0xe7, 0xcd, 0x0e, 0xa7, 0xdb, 0x1d, 0x4e, 0x7f, 0x76, 0x38, 0x7d, 0xdf, 0xe3, 0x64, 0xbb, 0xc7,
+	0xc9, 0xe7, 0x1e, 0x27, 0x2f, 0xc3, 0x03, 0xaf, 0xb8, 0xd7, 0xc1, 0x9c, 0x71, 0xd7, 0x14, 0xf4,
+	0xad, 0xba, 0xc3, 0xa0, 0x3e, 0x44, 0xb0, 0xe6, 0xed, 0xb0, 0xbe, 0x87, 0xbf, 0x01, 0x00, 0xf6,
+	0xd1, 0x5b, 0x9b, 0xa9, 0x01, 0x00, 0x00,
+}
+
+func (m *StrandedFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrandedFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrandedFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRecovery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IntermediarySender) > 0 {
+		i -= len(m.IntermediarySender)
+		copy(dAtA[i:], m.IntermediarySender)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.IntermediarySender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintRecovery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecovery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecovery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StrandedFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	l = len(m.IntermediarySender)
+	if l > 0 {
+		n += 1 + l + sovRecovery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovRecovery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRecovery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecovery(x uint64) (n int) {
+	return sovRecovery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StrandedFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrandedFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrandedFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediarySender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediarySender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecovery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecovery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecovery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecovery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecovery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecovery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecovery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecovery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,733 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/ibchooks/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgEmitIBCAck is sent by a contract that deferred the acknowledgement of a
+// packet it was called with by the wasm hook to write that acknowledgement.
+// Only the contract the packet was routed to can emit its acknowledgement.
+//
+// If error is empty, a result acknowledgement carrying contract_result is
+// written. Otherwise, the received funds are returned to the transfer module
+// and an error acknowledgement is written, so that the sender is refunded.
+type MsgEmitIBCAck struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// channel is the local (destination) channel the packet was received on.
+	Channel        string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty" yaml:"channel"`
+	PacketSequence uint64 `protobuf:"varint,3,opt,name=packet_sequence,json=packetSequence,proto3" json:"packet_sequence,omitempty" yaml:"packet_sequence"`
+	ContractResult []byte `protobuf:"bytes,4,opt,name=contract_result,json=contractResult,proto3" json:"contract_result,omitempty" yaml:"contract_result"`
+	Error          string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty" yaml:"error"`
+}
+
+func (m *MsgEmitIBCAck) Reset()         { *m = MsgEmitIBCAck{} }
+func (m *MsgEmitIBCAck) String() string { return proto.CompactTextString(m) }
+func (*MsgEmitIBCAck) ProtoMessage()    {}
+func (*MsgEmitIBCAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb5a795bb7f479a3, []int{0}
+}
+func (m *MsgEmitIBCAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmitIBCAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmitIBCAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmitIBCAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmitIBCAck.Merge(m, src)
+}
+func (m *MsgEmitIBCAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmitIBCAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmitIBCAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmitIBCAck proto.InternalMessageInfo
+
+func (m *MsgEmitIBCAck) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgEmitIBCAck) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *MsgEmitIBCAck) GetPacketSequence() uint64 {
+	if m != nil {
+		return m.PacketSequence
+	}
+	return 0
+}
+
+func (m *MsgEmitIBCAck) GetContractResult() []byte {
+	if m != nil {
+		return m.ContractResult
+	}
+	return nil
+}
+
+func (m *MsgEmitIBCAck) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type MsgEmitIBCAckResponse struct {
+}
+
+func (m *MsgEmitIBCAckResponse) Reset()         { *m = MsgEmitIBCAckResponse{} }
+func (m *MsgEmitIBCAckResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEmitIBCAckResponse) ProtoMessage()    {}
+func (*MsgEmitIBCAckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb5a795bb7f479a3, []int{1}
+}
+func (m *MsgEmitIBCAckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmitIBCAckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmitIBCAckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmitIBCAckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmitIBCAckResponse.Merge(m, src)
+}
+func (m *MsgEmitIBCAckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmitIBCAckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmitIBCAckResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmitIBCAckResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgEmitIBCAck)(nil), "osmosis.ibchooks.MsgEmitIBCAck")
+	proto.RegisterType((*MsgEmitIBCAckResponse)(nil), "osmosis.ibchooks.MsgEmitIBCAckResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/ibchooks/tx.proto", fileDescriptor_eb5a795bb7f479a3) }
+
+var fileDescriptor_eb5a795bb7f479a3 = []byte{
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3d, 0x6b, 0xe3, 0x30,
+	0x18, 0xc7, 0xe3, 0xbc, 0x1d, 0x27, 0x92, 0x5c, 0x62, 0xee, 0xc5, 0x67, 0x38, 0x3b, 0x68, 0xb8,
+	0xcb, 0x95, 0xda, 0xa6, 0xed, 0x96, 0xad, 0x0e, 0x1d, 0x4a, 0xc9, 0xe2, 0x42, 0x87, 0x42, 0x09,
+	0xb6, 0x2a, 0x1c, 0xe3, 0x17, 0xb9, 0x96, 0x02, 0xc9, 0x57, 0xe8, 0xd4, 0x8f, 0xd2, 0x4f, 0xd0,
+	0xb9, 0x63, 0xc6, 0x4e, 0xa6, 0x24, 0x43, 0x77, 0x7f, 0x82, 0x12, 0xcb, 0x86, 0x24, 0x1d, 0xba,
+	0x08, 0xe9, 0xff, 0xff, 0x3d, 0x7f, 0xa4, 0x47, 0x0f, 0xf8, 0x4d, 0x68, 0x48, 0xa8, 0x47, 0x0d,
+	0xcf, 0x41, 0x53, 0x42, 0x7c, 0x6a, 0xb0, 0xb9, 0x1e, 0x27, 0x84, 0x11, 0xb1, 0x5b, 0x58, 0x7a,
+	0x69, 0xc9, 0xdf, 0x5d, 0xe2, 0x92, 0xdc, 0x34, 0x36, 0x3b, 0xce, 0xc9, 0x3d, 0x3b, 0xf4, 0x22,
+	0x62, 0xe4, 0x2b, 0x97, 0xe0, 0x53, 0x15, 0xb4, 0xc7, 0xd4, 0x3d, 0x0b, 0x3d, 0x76, 0x6e, 0x8e,
+	0x4e, 0x91, 0x2f, 0xfe, 0x07, 0x4d, 0x8a, 0xa3, 0x5b, 0x9c, 0x48, 0x42, 0x5f, 0x18, 0x7c, 0x35,
+	0x7b, 0x59, 0xaa, 0xb6, 0x17, 0x76, 0x18, 0x0c, 0x21, 0xd7, 0xa1, 0x55, 0x00, 0xe2, 0x21, 0xf8,
+	0x82, 0xa6, 0x76, 0x14, 0xe1, 0x40, 0xaa, 0xe6, 0xac, 0x98, 0xa5, 0x6a, 0x87, 0xb3, 0x85, 0x01,
+	0xad, 0x12, 0x11, 0x47, 0xe0, 0x5b, 0x6c, 0x23, 0x1f, 0xb3, 0x09, 0xc5, 0x77, 0x33, 0x1c, 0x21,
+	0x2c, 0xd5, 0xfa, 0xc2, 0xa0, 0x6e, 0xca, 0x59, 0xaa, 0xfe, 0xe4, 0x55, 0x7b, 0x00, 0xb4, 0x3a,
+	0x5c, 0xb9, 0x2c, 0x84, 0x4d, 0x08, 0x22, 0x11, 0x4b, 0x6c, 0xc4, 0x26, 0x09, 0xa6, 0xb3, 0x80,
+	0x49, 0xf5, 0xbe, 0x30, 0x68, 0x6d, 0x87, 0xec, 0x01, 0xd0, 0xea, 0x94, 0x8a, 0x95, 0x0b, 0xe2,
+	0x5f, 0xd0, 0xc0, 0x49, 0x42, 0x12, 0xa9, 0x91, 0xdf, 0xba, 0x9b, 0xa5, 0x6a, 0x8b, 0x97, 0xe6,
+	0x32, 0xb4, 0xb8, 0x3d, 0x84, 0xf7, 0x6f, 0x8f, 0x07, 0x7f, 0x3e, 0xf4, 0x1d, 0x87, 0x1e, 0xd3,
+	0x3c, 0x07, 0x69, 0x36, 0xf2, 0xe1, 0x2f, 0xf0, 0x63, 0xa7, 0x7f, 0x16, 0xa6, 0x31, 0x89, 0x28,
+	0x3e, 0xbe, 0x01, 0xb5, 0x31, 0x75, 0xc5, 0x2b, 0x00, 0xb6, 0x9a, 0xab, 0xea, 0xfb, 0x5f, 0xa5,
+	0xef, 0x54, 0xcb, 0xff, 0x3e, 0x01, 0xca, 0x78, 0xf3, 0xe2, 0x79, 0xa5, 0x08, 0xcb, 0x95, 0x22,
+	0xbc, 0xae, 0x14, 0xe1, 0x61, 0xad, 0x54, 0x96, 0x6b, 0xa5, 0xf2, 0xb2, 0x56, 0x2a, 0xd7, 0x47,
+	0xae, 0xc7, 0xa6, 0x33, 0x47, 0x47, 0x24, 0x34, 0x8a, 0x30, 0x2d, 0xb0, 0x1d, 0x5a, 0x1e, 0x8c,
+	0xf9, 0xe6, 0x29, 0x5a, 0x31, 0x43, 0x8b, 0x18, 0x53, 0xa7, 0x99, 0x0f, 0xc3, 0xc9, 0xfb, 0x00,
+	0x2a, 0xde, 0xed, 0x8b, 0x64, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	EmitIBCAck(ctx context.Context, in *MsgEmitIBCAck, opts ...grpc.CallOption) (*MsgEmitIBCAckResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) EmitIBCAck(ctx context.Context, in *MsgEmitIBCAck, opts ...grpc.CallOption) (*MsgEmitIBCAckResponse, error) {
+	out := new(MsgEmitIBCAckResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibchooks.Msg/EmitIBCAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	EmitIBCAck(context.Context, *MsgEmitIBCAck) (*MsgEmitIBCAckResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) EmitIBCAck(ctx context.Context, req *MsgEmitIBCAck) (*MsgEmitIBCAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmitIBCAck not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_EmitIBCAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmitIBCAck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EmitIBCAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibchooks.Msg/EmitIBCAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmitIBCAck(ctx, req.(*MsgEmitIBCAck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.ibchooks.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EmitIBCAck",
+			Handler:    _Msg_EmitIBCAck_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/ibchooks/tx.proto",
+}
+
+func (m *MsgEmitIBCAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmitIBCAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmitIBCAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ContractResult) > 0 {
+		i -= len(m.ContractResult)
+		copy(dAtA[i:], m.ContractResult)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractResult)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PacketSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PacketSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmitIBCAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmitIBCAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmitIBCAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEmitIBCAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovTx(uint64(m.PacketSequence))
+	}
+	l = len(m.ContractResult)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEmitIBCAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEmitIBCAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEmitIBCAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEmitIBCAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractResult", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractResult = append(m.ContractResult[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractResult == nil {
+				m.ContractResult = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEmitIBCAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEmitIBCAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEmitIBCAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -22,10 +22,8 @@ import (
 	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
 )
 
-type ContractAck struct {
-	ContractResult []byte `json:"contract_result"`
-	IbcAck         []byte `json:"ibc_ack"`
-}
+// ContractAck is kept as an alias of types.ContractAck for backwards compatibility.
+type ContractAck = types.ContractAck
 
 type WasmHooks struct {
 	ContractKeeper      *wasmkeeper.PermissionedKeeper
@@ -55,6 +53,11 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 
+	// Packets with the recover key in their memo claim the funds stranded at the intermediary sender of their sender
+	if isRecover, metadata := jsonStringHasKey(data.GetMemo(), types.RecoverMemoKey); isRecover {
+		return h.recoverStrandedFunds(im, ctx, packet, relayer, data, metadata[types.RecoverMemoKey])
+	}
+
 	// Validate the memo
 	isWasmRouted, contractAddr, msgBytes, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver)
 	if !isWasmRouted {
@@ -117,6 +120,19 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
 	}
 
+	// The contract may have sent funds of the packet back to the intermediary sender, e.g. when it fails to use
+	// them, which only the original sender can recover
+	h.ibcHooksKeeper.RecordStrandedFunds(ctx, channel, sender, sdk.MustAccAddressFromBech32(senderBech32), denom)
+
+	// The contract can defer the ack by responding with {"is_async_ack": true}. It will then have to emit
+	// it with MsgEmitIBCAck before types.AsyncAckTimeout, after which an error ack is written.
+	if isAsyncAck(response.Data) {
+		if err := h.ibcHooksKeeper.StorePendingAsyncAck(ctx, contractAddr.String(), packet, ack.Acknowledgement()); err != nil {
+			return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrAsyncAckError, err.Error())
+		}
+		return nil
+	}
+
 	fullAck := ContractAck{ContractResult: response.Data, IbcAck: ack.Acknowledgement()}
 	bz, err = json.Marshal(fullAck)
 	if err != nil {
@@ -126,6 +142,54 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	return channeltypes.NewResultAcknowledgement(bz)
 }
 
+// recoverStrandedFunds sends the funds recorded as stranded at the intermediary sender of the packet's sender to the
+// receiver specified in the memo, or back to the sender over IBC if there is none. The packet proves that the request
+// comes from the original sender. The funds of the packet are received by the intermediary sender first, so
+// they are recovered with the stranded funds.
+func (h WasmHooks) recoverStrandedFunds(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, data transfertypes.FungibleTokenPacketData, recoverRaw interface{}) ibcexported.Acknowledgement {
+	var recoverMemo types.RecoverMemo
+	bz, err := json.Marshal(recoverRaw)
+	if err == nil {
+		err = json.Unmarshal(bz, &recoverMemo)
+	}
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, fmt.Sprintf("invalid %s memo: %s", types.RecoverMemoKey, err.Error()))
+	}
+
+	channel := packet.GetDestChannel()
+	sender := data.GetSender()
+	senderBech32, err := keeper.DeriveIntermediateSender(channel, sender, h.bech32PrefixAccAddr)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
+	}
+
+	data.Receiver = senderBech32
+	bz, err = json.Marshal(data)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+
+	ack := im.App.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := sdk.NewIntFromString(data.GetAmount())
+	if !ok {
+		// This should never happen, as it should've been caught in the underlaying call to OnRecvPacket
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "Amount is not an int")
+	}
+	received := sdk.NewCoins(sdk.NewCoin(osmoutils.MustExtractDenomFromPacketOnRecv(packet), amount))
+
+	_, err = h.ibcHooksKeeper.RecoverStrandedFunds(ctx, channel, sender, sdk.MustAccAddressFromBech32(senderBech32), received, recoverMemo.Receiver)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrRecovery, err.Error())
+	}
+
+	return ack
+}
+
 func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
 	if err := execMsg.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
@@ -134,6 +198,14 @@ func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteCon
 	return wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), execMsg)
 }
 
+func isAsyncAck(data []byte) bool {
+	var response types.OnRecvPacketAsyncAckResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return false
+	}
+	return response.IsAsyncAck
+}
+
 func isIcs20Packet(packet channeltypes.Packet) (isIcs20 bool, ics20data transfertypes.FungibleTokenPacketData) {
 	var data transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
@@ -307,6 +379,13 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return nil
 	}
 
+	// A failed recovery transfer cannot be stranded by a failing callback below: recoveries are sent without a memo,
+	// so no callback is stored for them. For other packets, a failing callback returns an error, which reverts the
+	// whole acknowledgement including the refund of the transfer app, so the funds stay escrowed until it succeeds.
+	if osmoutils.IsAckError(acknowledgement) {
+		h.recordRefundedStrandedFunds(ctx, packet)
+	}
+
 	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
 	if contract == "" {
 		// No callback configured
@@ -354,6 +433,9 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 		return nil
 	}
 
+	// A failing callback below does not revert the refund nor its record, since its error is not returned.
+	h.recordRefundedStrandedFunds(ctx, packet)
+
 	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
 	if contract == "" {
 		// No callback configured
@@ -385,3 +467,20 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
 	return nil
 }
+
+// recordRefundedStrandedFunds records the funds of a failed transfer sent by an intermediary sender to its original
+// sender, i.e. a recovery of stranded funds sent back over IBC, as stranded again. The transfer app refunded them
+// to the intermediary sender.
+func (h WasmHooks) recordRefundedStrandedFunds(ctx sdk.Context, packet channeltypes.Packet) {
+	isIcs20, data := isIcs20Packet(packet)
+	if !isIcs20 {
+		return
+	}
+	channel := packet.GetSourceChannel()
+	intermediary, err := keeper.DeriveIntermediateSender(channel, data.GetReceiver(), h.bech32PrefixAccAddr)
+	if err != nil || intermediary != data.GetSender() {
+		return
+	}
+	denom := transfertypes.ParseDenomTrace(data.GetDenom()).IBCDenom()
+	h.ibcHooksKeeper.RecordStrandedFunds(ctx, channel, data.GetReceiver(), sdk.MustAccAddressFromBech32(intermediary), denom)
+}
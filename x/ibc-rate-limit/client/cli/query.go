@@ -11,6 +11,9 @@ import (
 // GetQueryCmd returns the cli query commands for this module.
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdRateLimit)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllRateLimits)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdFlowHistory)
 
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
@@ -19,3 +22,30 @@ func GetQueryCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdRateLimit() (*osmocli.QueryDescriptor, *queryproto.RateLimitRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rate-limit [channel] [denom]",
+		Short: "Query the native rate limit of a denom in a channel",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} rate-limit channel-0 uosmo`,
+	}, &queryproto.RateLimitRequest{}
+}
+
+func GetCmdAllRateLimits() (*osmocli.QueryDescriptor, *queryproto.AllRateLimitsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rate-limits",
+		Short: "Query all the native rate limits",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} rate-limits`,
+	}, &queryproto.AllRateLimitsRequest{}
+}
+
+func GetCmdFlowHistory() (*osmocli.QueryDescriptor, *queryproto.FlowHistoryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "flow-history [channel] [denom]",
+		Short: "Query the flows of the past windows of the native rate limit of a denom in a channel",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} flow-history channel-0 uosmo`,
+	}, &queryproto.FlowHistoryRequest{}
+}
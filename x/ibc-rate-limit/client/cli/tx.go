@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/types"
+)
+
+const (
+	FlagQuotas = "quotas"
+)
+
+func NewCmdSetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit-proposal [channel] [denom] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to set the native rate limit quotas of a denom in a channel",
+		Long: strings.TrimSpace(`Submit a proposal to set the native rate limit quotas of a denom in a channel.
+The channel can be "any" to limit the transfers of the denom through all channels.
+Quotas are comma separated, each one formatted as name:duration:max_percentage_send:max_percentage_recv, optionally
+followed by :alert_thresholds, the slash separated percentages of the quota whose crossing emits an event.
+Ex) set-rate-limit-proposal channel-0 uosmo --quotas=daily:24h:30:30:50/90/100,weekly:168h:60:60
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			content, err := parseSetRateLimitArgsToContent(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+	cmd.Flags().String(FlagQuotas, "", "Comma separated quotas, formatted as name:duration:max_percentage_send:max_percentage_recv[:alert_thresholds]")
+
+	return cmd
+}
+
+func NewCmdRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit-proposal [channel] [denom] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to remove the native rate limit of a denom in a channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			title, description, err := parseTitleAndDescription(cmd)
+			if err != nil {
+				return err
+			}
+			content := types.NewRemoveRateLimitProposal(title, description, args[0], args[1])
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+}
+
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content) error {
+	from := clientCtx.GetFromAddress()
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func parseTitleAndDescription(cmd *cobra.Command) (string, string, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", err
+	}
+	return title, description, nil
+}
+
+func parseSetRateLimitArgsToContent(cmd *cobra.Command, args []string) (govtypes.Content, error) {
+	title, description, err := parseTitleAndDescription(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	quotasStr, err := cmd.Flags().GetString(FlagQuotas)
+	if err != nil {
+		return nil, err
+	}
+	quotas, err := ParseQuotas(quotasStr)
+	if err != nil {
+		return nil, err
+	}
+
+	content := types.NewSetRateLimitProposal(title, description, args[0], args[1], quotas)
+	return content, nil
+}
+
+// ParseQuotas parses comma separated quotas formatted as
+// name:duration:max_percentage_send:max_percentage_recv[:alert_thresholds], with slash separated alert thresholds.
+func ParseQuotas(quotasStr string) ([]types.Quota, error) {
+	quotas := []types.Quota{}
+	for _, quotaStr := range strings.Split(quotasStr, ",") {
+		parts := strings.Split(strings.TrimSpace(quotaStr), ":")
+		if len(parts) != 4 && len(parts) != 5 {
+			return nil, fmt.Errorf("invalid quota %q, expected name:duration:max_percentage_send:max_percentage_recv[:alert_thresholds]", quotaStr)
+		}
+		duration, err := time.ParseDuration(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		send, err := strconv.ParseUint(parts[2], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		recv, err := strconv.ParseUint(parts[3], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		var thresholds []uint32
+		if len(parts) == 5 {
+			for _, thresholdStr := range strings.Split(parts[4], "/") {
+				threshold, err := strconv.ParseUint(thresholdStr, 10, 32)
+				if err != nil {
+					return nil, err
+				}
+				thresholds = append(thresholds, uint32(threshold))
+			}
+		}
+		quotas = append(quotas, types.Quota{
+			Name:              parts[0],
+			MaxPercentageSend: uint32(send),
+			MaxPercentageRecv: uint32(recv),
+			Duration:          duration,
+			AlertThresholds:   thresholds,
+		})
+	}
+	return quotas, nil
+}
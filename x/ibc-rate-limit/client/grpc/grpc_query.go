@@ -20,6 +20,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) RateLimit(grpcCtx context.Context,
+	req *queryproto.RateLimitRequest,
+) (*queryproto.RateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RateLimit(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -30,3 +40,23 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) FlowHistory(grpcCtx context.Context,
+	req *queryproto.FlowHistoryRequest,
+) (*queryproto.FlowHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.FlowHistory(ctx, *req)
+}
+
+func (q Querier) AllRateLimits(grpcCtx context.Context,
+	req *queryproto.AllRateLimitsRequest,
+) (*queryproto.AllRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AllRateLimits(ctx, *req)
+}
+
@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/client/cli"
+	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	SetRateLimitProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSetRateLimitProposal, rest.ProposalSetRateLimitRESTHandler)
+	RemoveRateLimitProposalHandler = govclient.NewProposalHandler(cli.NewCmdRemoveRateLimitProposal, rest.ProposalRemoveRateLimitRESTHandler)
+)
@@ -1,10 +1,12 @@
 package client
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	ibcratelimit "github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit"
 	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/types"
 )
 
 // This file should evolve to being code gen'd, off of `proto/twap/v1beta/query.yml`
@@ -19,3 +21,25 @@ func (q Querier) Params(ctx sdk.Context,
 	params := q.K.GetParams(ctx)
 	return &queryproto.ParamsResponse{Params: params}, nil
 }
+
+func (q Querier) RateLimit(ctx sdk.Context,
+	req queryproto.RateLimitRequest,
+) (*queryproto.RateLimitResponse, error) {
+	rateLimit, found := q.K.GetRateLimit(ctx, req.Channel, req.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "no rate limit for %s in %s", req.Denom, req.Channel)
+	}
+	return &queryproto.RateLimitResponse{RateLimit: rateLimit}, nil
+}
+
+func (q Querier) AllRateLimits(ctx sdk.Context,
+	req queryproto.AllRateLimitsRequest,
+) (*queryproto.AllRateLimitsResponse, error) {
+	return &queryproto.AllRateLimitsResponse{RateLimits: q.K.GetAllRateLimits(ctx)}, nil
+}
+
+func (q Querier) FlowHistory(ctx sdk.Context,
+	req queryproto.FlowHistoryRequest,
+) (*queryproto.FlowHistoryResponse, error) {
+	return &queryproto.FlowHistoryResponse{FlowHistory: q.K.GetFlowHistory(ctx, req.Channel, req.Denom)}, nil
+}
@@ -0,0 +1,127 @@
+package queryclient
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/ibc-rate-limit/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/client/queryproto"
+)
+
+// Paths of the queries, used by gRPC, ABCI and Stargate queries.
+const (
+	AllRateLimitsPath = "/osmosis.ibcratelimit.v1beta1.Query/AllRateLimits"
+	FlowHistoryPath   = "/osmosis.ibcratelimit.v1beta1.Query/FlowHistory"
+	ParamsPath        = "/osmosis.ibcratelimit.v1beta1.Query/Params"
+	RateLimitPath     = "/osmosis.ibcratelimit.v1beta1.Query/RateLimit"
+)
+
+// Client is a typed client of the queries, over a gRPC connection to a node or a client.Context.
+type Client struct {
+	q queryproto.QueryClient
+}
+
+func NewClient(conn gogogrpc.ClientConn) Client {
+	return Client{q: queryproto.NewQueryClient(conn)}
+}
+
+func (c Client) AllRateLimits(ctx context.Context,
+	req queryproto.AllRateLimitsRequest, opts ...grpc.CallOption,
+) (*queryproto.AllRateLimitsResponse, error) {
+	return c.q.AllRateLimits(ctx, &req, opts...)
+}
+
+// NewAllRateLimitsABCIRequest returns the ABCI query of AllRateLimits at the given height, 0 being the latest one.
+func NewAllRateLimitsABCIRequest(req queryproto.AllRateLimitsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: AllRateLimitsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeAllRateLimitsResponse decodes the response of AllRateLimits, e.g. the value of its ABCI query.
+func DecodeAllRateLimitsResponse(bz []byte) (*queryproto.AllRateLimitsResponse, error) {
+	res := &queryproto.AllRateLimitsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) FlowHistory(ctx context.Context,
+	req queryproto.FlowHistoryRequest, opts ...grpc.CallOption,
+) (*queryproto.FlowHistoryResponse, error) {
+	return c.q.FlowHistory(ctx, &req, opts...)
+}
+
+// NewFlowHistoryABCIRequest returns the ABCI query of FlowHistory at the given height, 0 being the latest one.
+func NewFlowHistoryABCIRequest(req queryproto.FlowHistoryRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: FlowHistoryPath, Data: bz, Height: height}, nil
+}
+
+// DecodeFlowHistoryResponse decodes the response of FlowHistory, e.g. the value of its ABCI query.
+func DecodeFlowHistoryResponse(bz []byte) (*queryproto.FlowHistoryResponse, error) {
+	res := &queryproto.FlowHistoryResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) Params(ctx context.Context,
+	req queryproto.ParamsRequest, opts ...grpc.CallOption,
+) (*queryproto.ParamsResponse, error) {
+	return c.q.Params(ctx, &req, opts...)
+}
+
+// NewParamsABCIRequest returns the ABCI query of Params at the given height, 0 being the latest one.
+func NewParamsABCIRequest(req queryproto.ParamsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: ParamsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeParamsResponse decodes the response of Params, e.g. the value of its ABCI query.
+func DecodeParamsResponse(bz []byte) (*queryproto.ParamsResponse, error) {
+	res := &queryproto.ParamsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) RateLimit(ctx context.Context,
+	req queryproto.RateLimitRequest, opts ...grpc.CallOption,
+) (*queryproto.RateLimitResponse, error) {
+	return c.q.RateLimit(ctx, &req, opts...)
+}
+
+// NewRateLimitABCIRequest returns the ABCI query of RateLimit at the given height, 0 being the latest one.
+func NewRateLimitABCIRequest(req queryproto.RateLimitRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: RateLimitPath, Data: bz, Height: height}, nil
+}
+
+// DecodeRateLimitResponse decodes the response of RateLimit, e.g. the value of its ABCI query.
+func DecodeRateLimitResponse(bz []byte) (*queryproto.RateLimitResponse, error) {
+	res := &queryproto.RateLimitResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
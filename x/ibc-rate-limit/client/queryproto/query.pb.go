@@ -114,9 +114,296 @@ func (m *ParamsResponse) GetParams() types.Params {
 	return types.Params{}
 }
 
+// RateLimitRequest is the request type for the Query/RateLimit RPC method.
+type RateLimitRequest struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *RateLimitRequest) Reset()         { *m = RateLimitRequest{} }
+func (m *RateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitRequest) ProtoMessage()    {}
+func (*RateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9376d12c6390a846, []int{2}
+}
+func (m *RateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitRequest.Merge(m, src)
+}
+func (m *RateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitRequest proto.InternalMessageInfo
+
+func (m *RateLimitRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *RateLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// RateLimitResponse is the response type for the Query/RateLimit RPC method.
+type RateLimitResponse struct {
+	RateLimit types.RateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *RateLimitResponse) Reset()         { *m = RateLimitResponse{} }
+func (m *RateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitResponse) ProtoMessage()    {}
+func (*RateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9376d12c6390a846, []int{3}
+}
+func (m *RateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitResponse.Merge(m, src)
+}
+func (m *RateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitResponse proto.InternalMessageInfo
+
+func (m *RateLimitResponse) GetRateLimit() types.RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return types.RateLimit{}
+}
+
+// AllRateLimitsRequest is the request type for the Query/AllRateLimits RPC
+// method.
+type AllRateLimitsRequest struct {
+}
+
+func (m *AllRateLimitsRequest) Reset()         { *m = AllRateLimitsRequest{} }
+func (m *AllRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*AllRateLimitsRequest) ProtoMessage()    {}
+func (*AllRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9376d12c6390a846, []int{4}
+}
+func (m *AllRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllRateLimitsRequest.Merge(m, src)
+}
+func (m *AllRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllRateLimitsRequest proto.InternalMessageInfo
+
+// AllRateLimitsResponse is the response type for the Query/AllRateLimits RPC
+// method.
+type AllRateLimitsResponse struct {
+	RateLimits []types.RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+}
+
+func (m *AllRateLimitsResponse) Reset()         { *m = AllRateLimitsResponse{} }
+func (m *AllRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*AllRateLimitsResponse) ProtoMessage()    {}
+func (*AllRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9376d12c6390a846, []int{5}
+}
+func (m *AllRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllRateLimitsResponse.Merge(m, src)
+}
+func (m *AllRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllRateLimitsResponse proto.InternalMessageInfo
+
+func (m *AllRateLimitsResponse) GetRateLimits() []types.RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+// FlowHistoryRequest is the request type for the Query/FlowHistory RPC method.
+type FlowHistoryRequest struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *FlowHistoryRequest) Reset()         { *m = FlowHistoryRequest{} }
+func (m *FlowHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*FlowHistoryRequest) ProtoMessage()    {}
+func (*FlowHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9376d12c6390a846, []int{6}
+}
+func (m *FlowHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowHistoryRequest.Merge(m, src)
+}
+func (m *FlowHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowHistoryRequest proto.InternalMessageInfo
+
+func (m *FlowHistoryRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *FlowHistoryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// FlowHistoryResponse is the response type for the Query/FlowHistory RPC
+// method.
+type FlowHistoryResponse struct {
+	FlowHistory []types.FlowRecord `protobuf:"bytes,1,rep,name=flow_history,json=flowHistory,proto3" json:"flow_history"`
+}
+
+func (m *FlowHistoryResponse) Reset()         { *m = FlowHistoryResponse{} }
+func (m *FlowHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*FlowHistoryResponse) ProtoMessage()    {}
+func (*FlowHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9376d12c6390a846, []int{7}
+}
+func (m *FlowHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowHistoryResponse.Merge(m, src)
+}
+func (m *FlowHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowHistoryResponse proto.InternalMessageInfo
+
+func (m *FlowHistoryResponse) GetFlowHistory() []types.FlowRecord {
+	if m != nil {
+		return m.FlowHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.ibcratelimit.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.ibcratelimit.v1beta1.ParamsResponse")
+	proto.RegisterType((*RateLimitRequest)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitRequest")
+	proto.RegisterType((*RateLimitResponse)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitResponse")
+	proto.RegisterType((*AllRateLimitsRequest)(nil), "osmosis.ibcratelimit.v1beta1.AllRateLimitsRequest")
+	proto.RegisterType((*AllRateLimitsResponse)(nil), "osmosis.ibcratelimit.v1beta1.AllRateLimitsResponse")
+	proto.RegisterType((*FlowHistoryRequest)(nil), "osmosis.ibcratelimit.v1beta1.FlowHistoryRequest")
+	proto.RegisterType((*FlowHistoryResponse)(nil), "osmosis.ibcratelimit.v1beta1.FlowHistoryResponse")
 }
 
 func init() {
@@ -124,28 +411,43 @@ func init() {
 }
 
 var fileDescriptor_9376d12c6390a846 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x4a, 0x73, 0x31,
-	0x18, 0xc6, 0x4f, 0x3e, 0x3e, 0x3b, 0x44, 0x54, 0x38, 0x38, 0x48, 0x29, 0x51, 0x8a, 0x48, 0x69,
-	0x6d, 0x42, 0x2b, 0x78, 0x01, 0x1d, 0x9c, 0xb5, 0x38, 0x39, 0x99, 0x1c, 0x42, 0x0c, 0x9c, 0x93,
-	0xf7, 0xf4, 0x24, 0x2d, 0xba, 0x7a, 0x05, 0x42, 0x67, 0xef, 0xa7, 0x63, 0xc1, 0xc5, 0x49, 0xa4,
-	0xf5, 0x42, 0xa4, 0x49, 0xda, 0xc1, 0x41, 0x3b, 0xe5, 0xdf, 0xef, 0x79, 0xde, 0x27, 0xef, 0x8b,
-	0xdb, 0x60, 0x0b, 0xb0, 0xda, 0x32, 0x2d, 0xb2, 0x6e, 0xc5, 0x9d, 0xec, 0xe6, 0xba, 0xd0, 0x8e,
-	0x4d, 0x7a, 0x42, 0x3a, 0xde, 0x63, 0xa3, 0xb1, 0xac, 0x9e, 0x68, 0x59, 0x81, 0x83, 0xb4, 0x11,
-	0x59, 0xaa, 0x45, 0xb6, 0x42, 0x3d, 0x49, 0x23, 0x59, 0x3f, 0x54, 0xa0, 0xc0, 0x83, 0x6c, 0xb5,
-	0x0b, 0x9a, 0x7a, 0x43, 0x01, 0xa8, 0x5c, 0x32, 0x5e, 0x6a, 0xc6, 0x8d, 0x01, 0xc7, 0x9d, 0x06,
-	0x63, 0xe3, 0x6b, 0x3b, 0xf3, 0x96, 0x4c, 0x70, 0x2b, 0x43, 0xa9, 0x4d, 0xe1, 0x92, 0x2b, 0x6d,
-	0x3c, 0x1c, 0xd9, 0xce, 0x1f, 0x49, 0x4b, 0x5e, 0xf1, 0x22, 0x1a, 0x37, 0x0f, 0xf0, 0xde, 0xb5,
-	0x3f, 0x0f, 0xe5, 0x68, 0x2c, 0xad, 0x6b, 0xde, 0xe2, 0xfd, 0xf5, 0x85, 0x2d, 0xc1, 0x58, 0x99,
-	0x0e, 0x70, 0x2d, 0x48, 0x8e, 0xd0, 0x09, 0x6a, 0xed, 0xf6, 0x4f, 0xe9, 0x6f, 0xdf, 0xa3, 0x41,
-	0x3d, 0xf8, 0x3f, 0xfb, 0x38, 0x4e, 0x86, 0x51, 0xd9, 0x7f, 0x45, 0x78, 0xe7, 0x66, 0x15, 0x3b,
-	0x9d, 0x22, 0x5c, 0x0b, 0x48, 0xda, 0xd9, 0xc6, 0x28, 0xe6, 0xaa, 0x9f, 0x6f, 0x07, 0x87, 0xcc,
-	0x4d, 0xfa, 0xfc, 0xf6, 0x35, 0xfd, 0xd7, 0x4a, 0xcf, 0xd8, 0x56, 0xcd, 0x18, 0xdc, 0xcf, 0x16,
-	0x04, 0xcd, 0x17, 0x04, 0x7d, 0x2e, 0x08, 0x7a, 0x59, 0x92, 0x64, 0xbe, 0x24, 0xc9, 0xfb, 0x92,
-	0x24, 0x77, 0x57, 0x4a, 0xbb, 0x87, 0xb1, 0xa0, 0x19, 0x14, 0x6b, 0xaf, 0x6e, 0xce, 0x85, 0xdd,
-	0x18, 0x4f, 0x7a, 0x97, 0xec, 0xf1, 0xa7, 0x7d, 0x96, 0x6b, 0x69, 0x5c, 0x98, 0x94, 0x6f, 0xb4,
-	0xa8, 0xf9, 0xe5, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xde, 0xa0, 0xfd, 0xd1, 0x48, 0x02, 0x00,
-	0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0xe3, 0x42, 0x82, 0x72, 0x43, 0xf9, 0x19, 0x02, 0x8a, 0xa2, 0xca, 0x20, 0x0b, 0x41,
+	0x94, 0x1f, 0x0f, 0x49, 0x10, 0x7b, 0x22, 0x54, 0xb1, 0xa8, 0x10, 0x8d, 0x58, 0xb1, 0x29, 0x63,
+	0x77, 0xea, 0x8c, 0xe4, 0x78, 0x5c, 0xcf, 0xa4, 0xa5, 0x5b, 0x9e, 0x00, 0xa9, 0x4b, 0x36, 0x2c,
+	0xd9, 0xf0, 0x1e, 0x5d, 0x56, 0x62, 0xc3, 0x0a, 0xa1, 0x84, 0x07, 0x41, 0x1e, 0x8f, 0x9d, 0xa4,
+	0x42, 0x89, 0xc3, 0x2a, 0xf1, 0xf5, 0xb9, 0xe7, 0x7c, 0x73, 0x7d, 0x6d, 0x68, 0x72, 0x31, 0xe6,
+	0x82, 0x09, 0xcc, 0x1c, 0xb7, 0x13, 0x11, 0x49, 0x3b, 0x3e, 0x1b, 0x33, 0x89, 0x4f, 0xba, 0x0e,
+	0x95, 0xa4, 0x8b, 0x8f, 0x27, 0x34, 0x3a, 0xb3, 0xc3, 0x88, 0x4b, 0x8e, 0x76, 0xb4, 0xd6, 0x66,
+	0x8e, 0x1b, 0x4b, 0x95, 0xd2, 0xd6, 0xca, 0x7a, 0xd5, 0xe3, 0x1e, 0x57, 0x42, 0x1c, 0xff, 0x4b,
+	0x7a, 0xea, 0x3b, 0x1e, 0xe7, 0x9e, 0x4f, 0x31, 0x09, 0x19, 0x26, 0x41, 0xc0, 0x25, 0x91, 0x8c,
+	0x07, 0x42, 0xdf, 0x6d, 0xba, 0xca, 0x12, 0x3b, 0x44, 0xd0, 0x24, 0x2a, 0x0b, 0x0e, 0x89, 0xc7,
+	0x02, 0x25, 0xd6, 0xda, 0xd6, 0x1a, 0xd2, 0x90, 0x44, 0x64, 0x9c, 0x1a, 0xe3, 0x35, 0xe2, 0xb8,
+	0x74, 0x90, 0xf0, 0xab, 0x06, 0xeb, 0x36, 0x6c, 0xbf, 0x55, 0x06, 0x43, 0x7a, 0x3c, 0xa1, 0x42,
+	0x5a, 0xef, 0xe0, 0x56, 0x5a, 0x10, 0x21, 0x0f, 0x04, 0x45, 0x03, 0x28, 0x25, 0x19, 0x35, 0xe3,
+	0x91, 0xd1, 0xa8, 0xf4, 0x1e, 0xdb, 0xab, 0xe6, 0x61, 0x27, 0xdd, 0x83, 0xeb, 0x17, 0xbf, 0x1e,
+	0x16, 0x86, 0xba, 0xd3, 0x1a, 0xc0, 0x9d, 0x21, 0x91, 0x74, 0x2f, 0x56, 0xea, 0x24, 0x54, 0x83,
+	0x1b, 0xee, 0x88, 0x04, 0x01, 0xf5, 0x95, 0x71, 0x79, 0x98, 0x5e, 0xa2, 0x2a, 0x14, 0x0f, 0x69,
+	0xc0, 0xc7, 0xb5, 0x2d, 0x55, 0x4f, 0x2e, 0x2c, 0x02, 0x77, 0x17, 0x3c, 0x34, 0xdc, 0x1e, 0xc0,
+	0xfc, 0x4c, 0x1a, 0xf0, 0xe9, 0x6a, 0xc0, 0xcc, 0x44, 0x33, 0x96, 0xa3, 0xb4, 0x60, 0x3d, 0x80,
+	0xea, 0x4b, 0xdf, 0xcf, 0x04, 0xd9, 0x50, 0x3c, 0xb8, 0x7f, 0xa5, 0xae, 0xe3, 0xdf, 0x40, 0x65,
+	0x1e, 0x1f, 0x0f, 0xe8, 0xda, 0xe6, 0xf9, 0x90, 0xe5, 0x0b, 0xeb, 0x15, 0xa0, 0x5d, 0x9f, 0x9f,
+	0xbe, 0x66, 0x42, 0xf2, 0xe8, 0xec, 0x7f, 0x27, 0x35, 0x82, 0x7b, 0x4b, 0x2e, 0x1a, 0x76, 0x1f,
+	0x6e, 0x1e, 0xf9, 0xfc, 0xf4, 0x60, 0x94, 0xd4, 0x35, 0x6d, 0x63, 0x35, 0x6d, 0x6c, 0x34, 0xa4,
+	0x2e, 0x8f, 0x0e, 0x35, 0x6e, 0xe5, 0x68, 0x6e, 0xdd, 0xfb, 0x52, 0x84, 0xe2, 0x7e, 0xbc, 0xbf,
+	0xe8, 0xdc, 0x80, 0x52, 0xf2, 0xe8, 0x51, 0x2b, 0xcf, 0x82, 0xe8, 0xb3, 0xd5, 0xdb, 0xf9, 0xc4,
+	0xc9, 0x11, 0x2c, 0xfb, 0xd3, 0x8f, 0x3f, 0xe7, 0x5b, 0x0d, 0xf4, 0x04, 0xe7, 0x7a, 0x2b, 0xd0,
+	0x57, 0x03, 0xca, 0xd9, 0xbc, 0x91, 0x9d, 0xf3, 0xc1, 0xa4, 0x6c, 0x38, 0xb7, 0x5e, 0xe3, 0xf5,
+	0x14, 0x5e, 0x1b, 0x35, 0xf3, 0xbf, 0x87, 0xe8, 0xbb, 0x01, 0xdb, 0x4b, 0xcb, 0x85, 0x7a, 0xab,
+	0x63, 0xff, 0xb5, 0xa1, 0xf5, 0xfe, 0x46, 0x3d, 0x1a, 0xb7, 0xaf, 0x70, 0x3b, 0xa8, 0x95, 0x1f,
+	0x57, 0xa0, 0x6f, 0x06, 0x54, 0x16, 0xb6, 0x0b, 0x3d, 0x5b, 0xbf, 0x3f, 0xcb, 0xeb, 0x5c, 0xef,
+	0x6e, 0xd0, 0xa1, 0x49, 0x9f, 0x2b, 0x52, 0x1b, 0xb5, 0xd7, 0x91, 0x2e, 0x2e, 0xf8, 0xe0, 0xc3,
+	0xc5, 0xd4, 0x34, 0x2e, 0xa7, 0xa6, 0xf1, 0x7b, 0x6a, 0x1a, 0x9f, 0x67, 0x66, 0xe1, 0x72, 0x66,
+	0x16, 0x7e, 0xce, 0xcc, 0xc2, 0xfb, 0x5d, 0x8f, 0xc9, 0xd1, 0xc4, 0xb1, 0x5d, 0x3e, 0x4e, 0x1d,
+	0x3b, 0x3e, 0x71, 0x44, 0x66, 0x7f, 0xd2, 0x7d, 0x81, 0x3f, 0x5e, 0x0d, 0x71, 0x7d, 0x46, 0x03,
+	0x99, 0x7c, 0xb0, 0xd5, 0xe7, 0xd3, 0x29, 0xa9, 0x9f, 0xfe, 0xdf, 0x01, 0x00, 0xac, 0x33, 0x0a,
+	0xd2, 0x4f, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -163,6 +465,13 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// RateLimit returns the native rate limit of a denom in a channel.
+	RateLimit(ctx context.Context, in *RateLimitRequest, opts ...grpc.CallOption) (*RateLimitResponse, error)
+	// AllRateLimits returns all the native rate limits.
+	AllRateLimits(ctx context.Context, in *AllRateLimitsRequest, opts ...grpc.CallOption) (*AllRateLimitsResponse, error)
+	// FlowHistory returns the flows of the past windows of the native rate
+	// limit of a denom in a channel, oldest first.
+	FlowHistory(ctx context.Context, in *FlowHistoryRequest, opts ...grpc.CallOption) (*FlowHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -182,11 +491,45 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) RateLimit(ctx context.Context, in *RateLimitRequest, opts ...grpc.CallOption) (*RateLimitResponse, error) {
+	out := new(RateLimitResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/RateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllRateLimits(ctx context.Context, in *AllRateLimitsRequest, opts ...grpc.CallOption) (*AllRateLimitsResponse, error) {
+	out := new(AllRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/AllRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FlowHistory(ctx context.Context, in *FlowHistoryRequest, opts ...grpc.CallOption) (*FlowHistoryResponse, error) {
+	out := new(FlowHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/FlowHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// RateLimit returns the native rate limit of a denom in a channel.
+	RateLimit(context.Context, *RateLimitRequest) (*RateLimitResponse, error)
+	// AllRateLimits returns all the native rate limits.
+	AllRateLimits(context.Context, *AllRateLimitsRequest) (*AllRateLimitsResponse, error)
+	// FlowHistory returns the flows of the past windows of the native rate
+	// limit of a denom in a channel, oldest first.
+	FlowHistory(context.Context, *FlowHistoryRequest) (*FlowHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -196,6 +539,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RateLimit(ctx context.Context, req *RateLimitRequest) (*RateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
+func (*UnimplementedQueryServer) AllRateLimits(ctx context.Context, req *AllRateLimitsRequest) (*AllRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRateLimits not implemented")
+}
+func (*UnimplementedQueryServer) FlowHistory(ctx context.Context, req *FlowHistoryRequest) (*FlowHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlowHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -219,6 +571,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/RateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimit(ctx, req.(*RateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/AllRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRateLimits(ctx, req.(*AllRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FlowHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlowHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlowHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/FlowHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlowHistory(ctx, req.(*FlowHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.ibcratelimit.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -227,6 +633,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _Query_RateLimit_Handler,
+		},
+		{
+			MethodName: "AllRateLimits",
+			Handler:    _Query_AllRateLimits_Handler,
+		},
+		{
+			MethodName: "FlowHistory",
+			Handler:    _Query_FlowHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/ibc-rate-limit/v1beta1/query.proto",
@@ -288,35 +706,323 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *RateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AllRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AllRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlowHistory) > 0 {
+		for iNdEx := len(m.FlowHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlowHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AllRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlowHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FlowHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FlowHistory) > 0 {
+		for _, e := range m.FlowHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
 
 func sovQuery(x uint64) (n int) {
@@ -458,6 +1164,535 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, types.RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlowHistory = append(m.FlowHistory, types.FlowRecord{})
+			if err := m.FlowHistory[len(m.FlowHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,18 +3,31 @@ package ibc_rate_limit
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
+
 	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/types"
 )
 
 // InitGenesis initializes the x/ibc-rate-limit module's state from a provided genesis
-// state, which includes the parameter for the contract address.
+// state, which includes the parameter for the contract address, the native rate limits and
+// their flow history.
 func (i *ICS4Wrapper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	i.SetParams(ctx, genState.Params)
+	for _, rateLimit := range genState.RateLimits {
+		i.setRateLimit(ctx, rateLimit)
+	}
+	store := ctx.KVStore(i.storeKey)
+	for _, record := range genState.FlowHistory {
+		record := record
+		osmoutils.MustSet(store, types.FlowHistoryKey(record.Channel, record.Denom, record.QuotaName, record.Flow.PeriodEnd), &record)
+	}
 }
 
 // ExportGenesis returns the x/ibc-rate-limit module's exported genesis.
 func (i *ICS4Wrapper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: i.GetParams(ctx),
+		Params:      i.GetParams(ctx),
+		RateLimits:  i.GetAllRateLimits(ctx),
+		FlowHistory: i.GetAllFlowHistory(ctx),
 	}
 }
@@ -2,6 +2,7 @@ package ibc_rate_limit_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -31,6 +32,24 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 		Params: types.Params{
 			ContractAddress: testAddress,
 		},
+		RateLimits: []types.RateLimit{
+			types.NewRateLimit("channel-0", "uosmo", []types.Quota{
+				{Name: "daily", MaxPercentageSend: 10, MaxPercentageRecv: 10, Duration: 24 * time.Hour},
+			}),
+		},
+		FlowHistory: []types.FlowRecord{
+			{
+				Channel:   "channel-0",
+				Denom:     "uosmo",
+				QuotaName: "daily",
+				Flow: types.Flow{
+					Inflow:       sdk.NewInt(10),
+					Outflow:      sdk.NewInt(20),
+					PeriodEnd:    time.Unix(1000, 0).UTC(),
+					ChannelValue: sdk.NewInt(1000),
+				},
+			},
+		},
 	}
 
 	k.InitGenesis(suite.Ctx, initialGenesis)
@@ -0,0 +1,47 @@
+package ibc_rate_limit
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/types"
+)
+
+func NewRateLimitProposalHandler(i *ICS4Wrapper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetRateLimitProposal:
+			return handleSetRateLimitProposal(ctx, i, c)
+		case *types.RemoveRateLimitProposal:
+			return handleRemoveRateLimitProposal(ctx, i, c)
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc rate limit proposal content type: %T", c)
+		}
+	}
+}
+
+func handleSetRateLimitProposal(ctx sdk.Context, i *ICS4Wrapper, p *types.SetRateLimitProposal) error {
+	if err := i.SetRateLimit(ctx, p.Channel, p.Denom, p.Quotas); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventSetRateLimit,
+		sdk.NewAttribute(types.AttributeKeyChannel, p.Channel),
+		sdk.NewAttribute(types.AttributeKeyDenom, p.Denom),
+	))
+	return nil
+}
+
+func handleRemoveRateLimitProposal(ctx sdk.Context, i *ICS4Wrapper, p *types.RemoveRateLimitProposal) error {
+	if err := i.RemoveRateLimit(ctx, p.Channel, p.Denom); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventRemoveRateLimit,
+		sdk.NewAttribute(types.AttributeKeyChannel, p.Channel),
+		sdk.NewAttribute(types.AttributeKeyDenom, p.Denom),
+	))
+	return nil
+}
@@ -130,13 +130,21 @@ func (im *IBCModule) OnRecvPacket(
 		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadMessage, err.Error())
 	}
 
+	native, err := im.ics4Middleware.CheckAndUpdateNativeRateLimits(ctx, types.FlowIn, packet)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, err)
+	}
+	if native {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	err := CheckAndUpdateRateLimits(ctx, im.ics4Middleware.ContractKeeper, "recv_packet", contract, packet)
+	err = CheckAndUpdateRateLimits(ctx, im.ics4Middleware.ContractKeeper, "recv_packet", contract, packet)
 	if err != nil {
 		if strings.Contains(err.Error(), "rate limit exceeded") {
 			return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrRateLimitExceeded)
@@ -199,11 +207,17 @@ func (im *IBCModule) OnTimeoutPacket(
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
-// RevertSentPacket Notifies the contract that a sent packet wasn't properly received
+// RevertSentPacket Notifies the native rate limits, or the contract if there are none for the transfer, that a sent
+// packet wasn't properly received
 func (im *IBCModule) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
+	native, err := im.ics4Middleware.UndoNativeSendRateLimit(ctx, packet)
+	if err != nil || native {
+		return err
+	}
+
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -23,6 +25,7 @@ var (
 )
 
 type ICS4Wrapper struct {
+	storeKey       storetypes.StoreKey
 	channel        porttypes.ICS4Wrapper
 	accountKeeper  *authkeeper.AccountKeeper
 	bankKeeper     *bankkeeper.BaseKeeper
@@ -35,6 +38,7 @@ func (i *ICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string) (
 }
 
 func NewICS4Middleware(
+	storeKey storetypes.StoreKey,
 	channel porttypes.ICS4Wrapper,
 	accountKeeper *authkeeper.AccountKeeper, contractKeeper *wasmkeeper.PermissionedKeeper,
 	bankKeeper *bankkeeper.BaseKeeper, paramSpace paramtypes.Subspace,
@@ -43,6 +47,7 @@ func NewICS4Middleware(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 	return ICS4Wrapper{
+		storeKey:       storeKey,
 		channel:        channel,
 		accountKeeper:  accountKeeper,
 		ContractKeeper: contractKeeper,
@@ -52,11 +57,20 @@ func NewICS4Middleware(
 }
 
 // SendPacket implements the ICS4 interface and is called when sending packets.
-// This method retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
-// the current transfer, in which case it returns an error preventing the IBC send from taking place.
-// If the contract param is not configured, or the contract doesn't have a configuration for the (channel+denom) being
-// used, transfers are not prevented and handled by the wrapped IBC app
+// If there are native rate limits for the channel and denom of the transfer, this method checks them. Otherwise it
+// retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
+// the current transfer. In both cases, exceeding a limit returns an error preventing the IBC send from taking place.
+// If neither native rate limits nor the contract param are configured, or the contract doesn't have a configuration
+// for the (channel+denom) being used, transfers are not prevented and handled by the wrapped IBC app
 func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	native, err := i.CheckAndUpdateNativeRateLimits(ctx, types.FlowOut, packet)
+	if err != nil {
+		return errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
+	}
+	if native {
+		return i.channel.SendPacket(ctx, chanCap, packet)
+	}
+
 	contract := i.GetContractAddress(ctx)
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
@@ -71,7 +85,7 @@ func (i *ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capab
 		return sdkerrors.ErrInvalidRequest
 	}
 
-	err := CheckAndUpdateRateLimits(ctx, i.ContractKeeper, "send_packet", contract, fullPacket)
+	err = CheckAndUpdateRateLimits(ctx, i.ContractKeeper, "send_packet", contract, fullPacket)
 	if err != nil {
 		return errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
 	}
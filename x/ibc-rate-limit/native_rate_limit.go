@@ -0,0 +1,272 @@
+package ibc_rate_limit
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/types"
+)
+
+// SetRateLimit sets the native rate limit quotas of a denom in a channel, replacing any existing ones and resetting
+// their flows.
+func (i *ICS4Wrapper) SetRateLimit(ctx sdk.Context, channel, denom string, quotas []types.Quota) error {
+	if err := types.ValidateRateLimitPath(channel, denom); err != nil {
+		return err
+	}
+	if err := types.ValidateQuotas(quotas); err != nil {
+		return err
+	}
+	i.setRateLimit(ctx, types.NewRateLimit(channel, denom, quotas))
+	return nil
+}
+
+func (i *ICS4Wrapper) setRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(i.storeKey)
+	osmoutils.MustSet(store, types.RateLimitKey(rateLimit.Channel, rateLimit.Denom), &rateLimit)
+}
+
+// GetRateLimit returns the native rate limit of a denom in a channel.
+func (i *ICS4Wrapper) GetRateLimit(ctx sdk.Context, channel, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(i.storeKey)
+	rateLimit := types.RateLimit{}
+	found, err := osmoutils.Get(store, types.RateLimitKey(channel, denom), &rateLimit)
+	if err != nil {
+		panic(err)
+	}
+	return rateLimit, found
+}
+
+// RemoveRateLimit removes the native rate limit of a denom in a channel.
+func (i *ICS4Wrapper) RemoveRateLimit(ctx sdk.Context, channel, denom string) error {
+	store := ctx.KVStore(i.storeKey)
+	key := types.RateLimitKey(channel, denom)
+	if !store.Has(key) {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "no rate limit for %s in %s", denom, channel)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetAllRateLimits returns all the native rate limits.
+func (i *ICS4Wrapper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	store := ctx.KVStore(i.storeKey)
+	rateLimits, err := osmoutils.GatherValuesFromStorePrefix(store, types.RateLimitPrefix, func(bz []byte) (types.RateLimit, error) {
+		rateLimit := types.RateLimit{}
+		err := rateLimit.Unmarshal(bz)
+		return rateLimit, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rateLimits
+}
+
+// CheckAndUpdateNativeRateLimits applies a transfer to the flows of the native rate limits of its channel and denom,
+// and of its denom in any channel. If a quota is exceeded, an ErrRateLimitExceeded error is returned and the flows
+// are left untouched.
+// The flows of windows that ended are recorded in the flow history and new windows are started, with the channel
+// value at that time. Crossing an alert threshold of a quota emits an event.
+// Returns false if there are no native rate limits for the transfer, in which case the contract is in charge of it.
+func (i *ICS4Wrapper) CheckAndUpdateNativeRateLimits(ctx sdk.Context, direction types.FlowDirection, packet exported.PacketI) (bool, error) {
+	channel, denom, amount, escrowed, err := transferPath(direction, packet)
+	if err != nil {
+		return false, err
+	}
+	rateLimits := i.getRateLimitsForPath(ctx, channel, denom)
+	if len(rateLimits) == 0 {
+		return false, nil
+	}
+
+	// The channel values are only needed when a window starts
+	channelValues := map[string]sdk.Int{}
+	now := ctx.BlockTime()
+	records := []types.FlowRecord{}
+	events := sdk.Events{}
+	for _, rateLimit := range rateLimits {
+		for j := range rateLimit.Trackers {
+			tracker := &rateLimit.Trackers[j]
+			if tracker.Flow.IsExpired(now) {
+				if !tracker.Flow.PeriodEnd.IsZero() {
+					records = append(records, types.FlowRecord{
+						Channel: rateLimit.Channel, Denom: rateLimit.Denom, QuotaName: tracker.Quota.Name, Flow: tracker.Flow,
+					})
+				}
+				channelValue, ok := channelValues[rateLimit.Channel]
+				if !ok {
+					channelValue = i.channelValue(ctx, direction, rateLimit.Channel, denom, amount, escrowed)
+					channelValues[rateLimit.Channel] = channelValue
+				}
+				tracker.Flow = types.NewFlow(now.Add(tracker.Quota.Duration), channelValue)
+			}
+			used := tracker.Flow.Balance(direction)
+			tracker.Flow.AddFlow(direction, amount)
+			// The flow is still tracked in unlimited directions, as it offsets the one in the opposite direction
+			if !tracker.Quota.Limits(direction) {
+				continue
+			}
+
+			capacity := tracker.Quota.Capacity(direction, tracker.Flow.ChannelValue)
+			usedAfter := tracker.Flow.Balance(direction)
+			if usedAfter.GT(capacity) {
+				return true, errorsmod.Wrapf(types.ErrRateLimitExceeded,
+					"%s%s %s through %s exceeds quota %s of channel %s: used %s of %s, resets at %s",
+					amount, denom, direction, channel, tracker.Quota.Name, rateLimit.Channel, used, capacity, tracker.Flow.PeriodEnd)
+			}
+
+			for _, threshold := range tracker.Quota.CrossedThresholds(used, usedAfter, capacity) {
+				events = append(events, sdk.NewEvent(
+					types.EventRateLimitThreshold,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.Channel),
+					sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+					sdk.NewAttribute(types.AttributeKeyQuota, tracker.Quota.Name),
+					sdk.NewAttribute(types.AttributeKeyDirection, direction.String()),
+					sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(threshold), 10)),
+					sdk.NewAttribute(types.AttributeKeyUsed, usedAfter.String()),
+					sdk.NewAttribute(types.AttributeKeyCapacity, capacity.String()),
+					sdk.NewAttribute(types.AttributeKeyChannelValue, tracker.Flow.ChannelValue.String()),
+					sdk.NewAttribute(types.AttributeKeyPeriodEnd, tracker.Flow.PeriodEnd.String()),
+				))
+			}
+		}
+	}
+
+	for _, rateLimit := range rateLimits {
+		i.setRateLimit(ctx, rateLimit)
+	}
+	for _, record := range records {
+		i.recordFlow(ctx, record)
+	}
+	ctx.EventManager().EmitEvents(events)
+	return true, nil
+}
+
+// recordFlow adds the flow of a past window to the flow history, removing the oldest windows of the quota beyond
+// MaxFlowHistory.
+func (i *ICS4Wrapper) recordFlow(ctx sdk.Context, record types.FlowRecord) {
+	store := ctx.KVStore(i.storeKey)
+	osmoutils.MustSet(store, types.FlowHistoryKey(record.Channel, record.Denom, record.QuotaName, record.Flow.PeriodEnd), &record)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.FlowHistoryQuotaPrefix(record.Channel, record.Denom, record.QuotaName))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for j := 0; j < len(keys)-types.MaxFlowHistory; j++ {
+		store.Delete(keys[j])
+	}
+}
+
+// GetFlowHistory returns the flows of the past windows of the native rate limit of a denom in a channel, oldest
+// first for each quota.
+func (i *ICS4Wrapper) GetFlowHistory(ctx sdk.Context, channel, denom string) []types.FlowRecord {
+	prefix := append(types.FlowHistoryPrefix, []byte(channel+types.KeySeparator+denom+types.KeySeparator)...)
+	return i.getFlowRecords(ctx, prefix)
+}
+
+// GetAllFlowHistory returns the flows of the past windows of all the native rate limits.
+func (i *ICS4Wrapper) GetAllFlowHistory(ctx sdk.Context) []types.FlowRecord {
+	return i.getFlowRecords(ctx, types.FlowHistoryPrefix)
+}
+
+func (i *ICS4Wrapper) getFlowRecords(ctx sdk.Context, prefix []byte) []types.FlowRecord {
+	store := ctx.KVStore(i.storeKey)
+	records, err := osmoutils.GatherValuesFromStorePrefix(store, prefix, func(bz []byte) (types.FlowRecord, error) {
+		record := types.FlowRecord{}
+		err := record.Unmarshal(bz)
+		return record, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return records
+}
+
+// UndoNativeSendRateLimit removes a sent transfer that failed from the flows of the native rate limits of its
+// channel and denom.
+// Returns false if there are no native rate limits for the transfer, in which case the contract is in charge of it.
+func (i *ICS4Wrapper) UndoNativeSendRateLimit(ctx sdk.Context, packet exported.PacketI) (bool, error) {
+	channel, denom, amount, _, err := transferPath(types.FlowOut, packet)
+	if err != nil {
+		return false, err
+	}
+	rateLimits := i.getRateLimitsForPath(ctx, channel, denom)
+	if len(rateLimits) == 0 {
+		return false, nil
+	}
+
+	for _, rateLimit := range rateLimits {
+		for j := range rateLimit.Trackers {
+			rateLimit.Trackers[j].Flow.UndoFlow(types.FlowOut, amount)
+		}
+		i.setRateLimit(ctx, rateLimit)
+	}
+	return true, nil
+}
+
+// getRateLimitsForPath returns the native rate limits of a denom in a channel and in any channel.
+func (i *ICS4Wrapper) getRateLimitsForPath(ctx sdk.Context, channel, denom string) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	for _, c := range []string{channel, types.AnyChannel} {
+		if rateLimit, found := i.GetRateLimit(ctx, c, denom); found {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+	return rateLimits
+}
+
+// channelValue returns the value of a denom in the channel of a rate limit before a transfer, which the quotas are
+// a percentage of. For native denoms, it is their total supply. For IBC denoms, it is the amount held in escrow for
+// the channel: in the escrow account of the channel, or, for the channel their vouchers are minted and burned
+// through, on its counterparty, which matches the supply of the vouchers. The value of IBC denoms in any channel is
+// the supply of their vouchers, as all of them are backed by the escrow on their source chain.
+// Sends are checked after the transfer module escrowed or burned the tokens being sent, so their amount is
+// accounted for.
+func (i *ICS4Wrapper) channelValue(ctx sdk.Context, direction types.FlowDirection, channel, denom string, amount sdk.Int, escrowed bool) sdk.Int {
+	if !strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+		return i.bankKeeper.GetSupplyWithOffset(ctx, denom).Amount
+	}
+
+	if channel == types.AnyChannel || !escrowed {
+		value := i.bankKeeper.GetSupply(ctx, denom).Amount
+		if direction == types.FlowOut && !escrowed {
+			value = value.Add(amount)
+		}
+		return value
+	}
+
+	value := i.bankKeeper.GetBalance(ctx, transfertypes.GetEscrowAddress(transfertypes.PortID, channel), denom).Amount
+	if direction == types.FlowOut {
+		value = value.Sub(amount)
+	}
+	return value
+}
+
+// transferPath returns the local channel and denom of a transfer, its amount, and whether the tokens are moved in or
+// out of the escrow account of the channel, rather than minted or burned as vouchers.
+func transferPath(direction types.FlowDirection, packet exported.PacketI) (channel, denom string, amount sdk.Int, escrowed bool, err error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return "", "", sdk.Int{}, false, errorsmod.Wrap(types.ErrBadMessage, err.Error())
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return "", "", sdk.Int{}, false, errorsmod.Wrapf(types.ErrBadMessage, "invalid amount %s", data.Amount)
+	}
+
+	if direction == types.FlowIn {
+		escrowed = transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom)
+		return packet.GetDestChannel(), osmoutils.MustExtractDenomFromPacketOnRecv(packet), amount, escrowed, nil
+	}
+	// The denom of sent packets is the full trace, the local denom is its hash for non native tokens
+	escrowed = transfertypes.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom)
+	return packet.GetSourceChannel(), transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount, escrowed, nil
+}
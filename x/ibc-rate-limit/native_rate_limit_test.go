@@ -0,0 +1,367 @@
+package ibc_rate_limit_test
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	ibcratelimit "github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit"
+	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/types"
+	minttypes "github.com/osmosis-labs/osmosis/v16/x/mint/types"
+)
+
+const weekly = 7 * 24 * time.Hour
+
+func (suite *MiddlewareTestSuite) setNativeRateLimit(channel, denom string, sendPercentage, recvPercentage uint32) {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	err := osmosisApp.RateLimitingICS4Wrapper.SetRateLimit(suite.chainA.GetContext(), channel, denom, []types.Quota{
+		{Name: "weekly", MaxPercentageSend: sendPercentage, MaxPercentageRecv: recvPercentage, Duration: weekly},
+	})
+	suite.Require().NoError(err)
+}
+
+// nativeQuota returns the amount of a denom that can be transferred in a window of a quota of the given percentage
+func (suite *MiddlewareTestSuite) nativeQuota(denom string, percentage int64) sdk.Int {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	return CalculateChannelValue(suite.chainA.GetContext(), denom, osmosisApp.BankKeeper).MulRaw(percentage).QuoRaw(100)
+}
+
+// skipFailedSend moves chain A forward one block after a failed send, as the sequence of the sender was used
+func (suite *MiddlewareTestSuite) skipFailedSend() {
+	suite.chainA.NextBlock()
+	err := suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+	suite.Require().NoError(err)
+}
+
+func (suite *MiddlewareTestSuite) nativeSendTest(native bool) {
+	suite.initializeEscrow()
+	denom := sdk.DefaultBondDenom
+	if !native {
+		denom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", denom)).IBCDenom()
+	}
+	suite.setNativeRateLimit("channel-0", denom, 2, 2)
+	// The supply of non native tokens decreases as they are sent, and the channel value with it
+	sendAmount := suite.nativeQuota(denom, 2).QuoRaw(3)
+
+	// send a third of the quota twice
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+
+	rateLimit, found := suite.chainA.GetOsmosisApp().RateLimitingICS4Wrapper.GetRateLimit(suite.chainA.GetContext(), "channel-0", denom)
+	suite.Require().True(found)
+	suite.Require().Equal(sendAmount.MulRaw(2), rateLimit.Trackers[0].Flow.Outflow)
+
+	// Sending above the quota should fail
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(denom, sendAmount.MulRaw(11).QuoRaw(10)))
+	suite.Require().Error(err)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeSendRateLimitNative() {
+	suite.nativeSendTest(true)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeSendRateLimitNonNative() {
+	suite.nativeSendTest(false)
+}
+
+func (suite *MiddlewareTestSuite) nativeRecvTest(native bool) {
+	suite.initializeEscrow()
+	sendDenom := sdk.DefaultBondDenom
+	localDenom := sdk.DefaultBondDenom
+	if native {
+		localDenom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", localDenom)).IBCDenom()
+	} else {
+		sendDenom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", sendDenom)).IBCDenom()
+	}
+	suite.setNativeRateLimit("channel-0", localDenom, 4, 4)
+	sendAmount := suite.nativeQuota(localDenom, 4).QuoRaw(2)
+
+	// receive 2% once (quota is 4%), the channel value grows with the received tokens when they are minted
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sendDenom, sendAmount))
+	suite.Require().NoError(err)
+
+	// Receiving more than the rest of the quota fails
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sendDenom, sendAmount.MulRaw(11).QuoRaw(10)))
+	suite.Require().NoError(err)
+
+	rateLimit, found := suite.chainA.GetOsmosisApp().RateLimitingICS4Wrapper.GetRateLimit(suite.chainA.GetContext(), "channel-0", localDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sendAmount, rateLimit.Trackers[0].Flow.Inflow)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeRecvRateLimitNative() {
+	suite.nativeRecvTest(true)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeRecvRateLimitNonNative() {
+	suite.nativeRecvTest(false)
+}
+
+// Test that the quotas of a denom in any channel apply to all channels
+func (suite *MiddlewareTestSuite) TestNativeRateLimitAnyChannel() {
+	suite.setNativeRateLimit(types.AnyChannel, sdk.DefaultBondDenom, 1, 1)
+	quota := suite.nativeQuota(sdk.DefaultBondDenom, 1)
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, quota))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, sdk.NewInt(2)))
+	suite.Require().Error(err)
+}
+
+// Test that a quota with a zero percentage in a direction does not limit it, but still tracks its flow
+func (suite *MiddlewareTestSuite) TestNativeRateLimitUnlimitedDirection() {
+	suite.initializeEscrow()
+	localDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", sdk.DefaultBondDenom)).IBCDenom()
+	suite.setNativeRateLimit("channel-0", localDenom, 0, 4)
+	sendAmount := suite.nativeQuota(localDenom, 4).QuoRaw(2)
+
+	// receive 2% once (quota is 4%)
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+
+	// sending is unlimited, and the flow offsets the received one
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(localDenom, sendAmount))
+	suite.Require().NoError(err)
+
+	rateLimit, found := suite.chainA.GetOsmosisApp().RateLimitingICS4Wrapper.GetRateLimit(suite.chainA.GetContext(), "channel-0", localDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sendAmount, rateLimit.Trackers[0].Flow.Outflow)
+	suite.Require().True(rateLimit.Trackers[0].Flow.Balance(types.FlowIn).IsZero())
+
+	// receiving is still limited: the quota is used again by the rest of the vouchers coming back
+	_, err = suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sdk.DefaultBondDenom, sendAmount.MulRaw(11).QuoRaw(10)))
+	suite.Require().NoError(err)
+}
+
+// Test that the flows are reset when the window of a quota ends
+func (suite *MiddlewareTestSuite) TestNativeRateLimitReset() {
+	suite.setNativeRateLimit("channel-0", sdk.DefaultBondDenom, 1, 1)
+	quota := suite.nativeQuota(sdk.DefaultBondDenom, 1)
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, quota))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, sdk.NewInt(2)))
+	suite.Require().Error(err)
+	suite.skipFailedSend()
+
+	suite.coordinator.IncrementTimeBy(weekly + time.Second)
+
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sdk.NewInt(2)))
+	suite.Require().NoError(err)
+}
+
+// Test that native rate limits are enforced instead of the contract, which is used again once they are removed
+func (suite *MiddlewareTestSuite) TestNativeRateLimitTakesPrecedenceOverContract() {
+	suite.initializeEscrow()
+	suite.chainA.StoreContractCode(&suite.Suite, "./bytecode/rate_limiter.wasm")
+	quotas := suite.BuildChannelQuota("weekly", "channel-0", sdk.DefaultBondDenom, 604800, 5, 5)
+	addr := suite.chainA.InstantiateRLContract(&suite.Suite, quotas)
+	suite.chainA.RegisterRateLimitingContract(addr)
+
+	suite.setNativeRateLimit("channel-0", sdk.DefaultBondDenom, 1, 1)
+	sendAmount := suite.nativeQuota(sdk.DefaultBondDenom, 2)
+
+	// 2% is within the quota of the contract, but not of the native rate limit
+	_, err := suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().Error(err)
+	suite.skipFailedSend()
+
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	err = osmosisApp.RateLimitingICS4Wrapper.RemoveRateLimit(suite.chainA.GetContext(), "channel-0", sdk.DefaultBondDenom)
+	suite.Require().NoError(err)
+
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+}
+
+// Test that failed sends are removed from the flows
+func (suite *MiddlewareTestSuite) TestNativeUndoSendRateLimit() {
+	suite.setNativeRateLimit("channel-0", sdk.DefaultBondDenom, 1, 1)
+	quota := suite.nativeQuota(sdk.DefaultBondDenom, 1)
+
+	data, err := json.Marshal(transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, quota.String(), "sender", "receiver"))
+	suite.Require().NoError(err)
+	packet := channeltypes.NewPacket(data, 1, "transfer", "channel-0", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+
+	ctx := suite.chainA.GetContext()
+	rateLimiter := suite.chainA.GetOsmosisApp().RateLimitingICS4Wrapper
+	native, err := rateLimiter.CheckAndUpdateNativeRateLimits(ctx, types.FlowOut, packet)
+	suite.Require().NoError(err)
+	suite.Require().True(native)
+
+	_, err = rateLimiter.CheckAndUpdateNativeRateLimits(ctx, types.FlowOut, packet)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	rateLimitingModule := ibcratelimit.NewIBCModule(nil, rateLimiter)
+	suite.Require().NoError(rateLimitingModule.RevertSentPacket(ctx, packet))
+
+	rateLimit, found := rateLimiter.GetRateLimit(ctx, "channel-0", sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Trackers[0].Flow.Outflow.IsZero())
+
+	_, err = rateLimiter.CheckAndUpdateNativeRateLimits(ctx, types.FlowOut, packet)
+	suite.Require().NoError(err)
+}
+
+func (suite *MiddlewareTestSuite) TestRateLimitProposals() {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	ctx := suite.chainA.GetContext()
+	handler := ibcratelimit.NewRateLimitProposalHandler(osmosisApp.RateLimitingICS4Wrapper)
+	quotas := []types.Quota{{Name: "daily", MaxPercentageSend: 10, MaxPercentageRecv: 20, Duration: 24 * time.Hour}}
+
+	err := handler(ctx, types.NewSetRateLimitProposal("title", "description", "channel-0", sdk.DefaultBondDenom, quotas))
+	suite.Require().NoError(err)
+	rateLimit, found := osmosisApp.RateLimitingICS4Wrapper.GetRateLimit(ctx, "channel-0", sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewRateLimit("channel-0", sdk.DefaultBondDenom, quotas), rateLimit)
+	suite.Require().Len(osmosisApp.RateLimitingICS4Wrapper.GetAllRateLimits(ctx), 1)
+
+	err = handler(ctx, types.NewRemoveRateLimitProposal("title", "description", "channel-0", sdk.DefaultBondDenom))
+	suite.Require().NoError(err)
+	_, found = osmosisApp.RateLimitingICS4Wrapper.GetRateLimit(ctx, "channel-0", sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	err = handler(ctx, types.NewRemoveRateLimitProposal("title", "description", "channel-0", sdk.DefaultBondDenom))
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+}
+
+// Test that the channel value is frozen at the start of a window, so minting more tokens does not raise the quota
+func (suite *MiddlewareTestSuite) TestNativeRateLimitFrozenChannelValue() {
+	suite.setNativeRateLimit("channel-0", sdk.DefaultBondDenom, 1, 1)
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.chainA.GetOsmosisApp().BankKeeper)
+	quota := suite.nativeQuota(sdk.DefaultBondDenom, 1)
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, quota.QuoRaw(2)))
+	suite.Require().NoError(err)
+
+	// Double the supply mid window
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, channelValue))
+	suite.Require().NoError(osmosisApp.BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, coins))
+
+	rateLimit, found := osmosisApp.RateLimitingICS4Wrapper.GetRateLimit(suite.chainA.GetContext(), "channel-0", sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(channelValue, rateLimit.Trackers[0].Flow.ChannelValue)
+
+	// The whole quota would be available again with the live supply
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, quota))
+	suite.Require().Error(err)
+}
+
+// Test that the channel value of IBC denoms is the amount in escrow for the channel: the supply of the vouchers for
+// the channel they are minted through and for any channel, and the escrow account balance for other channels
+func (suite *MiddlewareTestSuite) TestNativeRateLimitIBCDenomChannelValue() {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	ctx := suite.chainA.GetContext()
+	rateLimiter := osmosisApp.RateLimitingICS4Wrapper
+	fullDenom := transfertypes.GetPrefixedDenom("transfer", "channel-0", "uatom")
+	denom := transfertypes.ParseDenomTrace(fullDenom).IBCDenom()
+	supply, escrow, amount := sdk.NewInt(1_000_000), sdk.NewInt(10_000), sdk.NewInt(100)
+
+	// vouchers received through channel-0, some of which are escrowed in channel-1
+	coins := sdk.NewCoins(sdk.NewCoin(denom, supply))
+	suite.Require().NoError(osmosisApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	escrowAddress := transfertypes.GetEscrowAddress("transfer", "channel-1")
+	coins = sdk.NewCoins(sdk.NewCoin(denom, escrow))
+	suite.Require().NoError(osmosisApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddress, coins))
+
+	checkChannelValue := func(direction types.FlowDirection, packet channeltypes.Packet, channel string, expected sdk.Int) {
+		for _, c := range []string{channel, types.AnyChannel} {
+			suite.Require().NoError(rateLimiter.SetRateLimit(ctx, c, denom, []types.Quota{{Name: "weekly", MaxPercentageSend: 100, MaxPercentageRecv: 100, Duration: weekly}}))
+		}
+		native, err := rateLimiter.CheckAndUpdateNativeRateLimits(ctx, direction, packet)
+		suite.Require().NoError(err)
+		suite.Require().True(native)
+
+		rateLimit, found := rateLimiter.GetRateLimit(ctx, channel, denom)
+		suite.Require().True(found)
+		suite.Require().Equal(expected.String(), rateLimit.Trackers[0].Flow.ChannelValue.String())
+		rateLimit, found = rateLimiter.GetRateLimit(ctx, types.AnyChannel, denom)
+		suite.Require().True(found)
+		suite.Require().Equal(supply.String(), rateLimit.Trackers[0].Flow.ChannelValue.String())
+
+		for _, c := range []string{channel, types.AnyChannel} {
+			suite.Require().NoError(rateLimiter.RemoveRateLimit(ctx, c, denom))
+		}
+	}
+	newPacket := func(denom, sourceChannel, destChannel string) channeltypes.Packet {
+		data, err := json.Marshal(transfertypes.NewFungibleTokenPacketData(denom, amount.String(), "sender", "receiver"))
+		suite.Require().NoError(err)
+		return channeltypes.NewPacket(data, 1, "transfer", sourceChannel, "transfer", destChannel, clienttypes.NewHeight(0, 100), 0)
+	}
+
+	// Receiving vouchers through the channel they are minted through
+	checkChannelValue(types.FlowIn, newPacket("uatom", "channel-5", "channel-0"), "channel-0", supply)
+	// Receiving vouchers back from escrow
+	checkChannelValue(types.FlowIn, newPacket("transfer/channel-7/"+fullDenom, "channel-7", "channel-1"), "channel-1", escrow)
+
+	// Sends are checked after the vouchers are burned or escrowed
+	suite.Require().NoError(osmosisApp.BankKeeper.BurnCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, amount))))
+	checkChannelValue(types.FlowOut, newPacket(fullDenom, "channel-0", "channel-5"), "channel-0", supply)
+	suite.Require().NoError(osmosisApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, amount))))
+
+	coins = sdk.NewCoins(sdk.NewCoin(denom, amount))
+	suite.Require().NoError(osmosisApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddress, coins))
+	checkChannelValue(types.FlowOut, newPacket(fullDenom, "channel-1", "channel-7"), "channel-1", escrow)
+}
+
+// Test that the flows of past windows are recorded when a new window starts
+func (suite *MiddlewareTestSuite) TestNativeRateLimitFlowHistory() {
+	suite.setNativeRateLimit("channel-0", sdk.DefaultBondDenom, 1, 1)
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.chainA.GetOsmosisApp().BankKeeper)
+	sendAmount := suite.nativeQuota(sdk.DefaultBondDenom, 1).QuoRaw(2)
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+	rateLimiter := suite.chainA.GetOsmosisApp().RateLimitingICS4Wrapper
+	rateLimit, _ := rateLimiter.GetRateLimit(suite.chainA.GetContext(), "channel-0", sdk.DefaultBondDenom)
+	firstWindow := rateLimit.Trackers[0].Flow
+	suite.Require().Empty(rateLimiter.GetFlowHistory(suite.chainA.GetContext(), "channel-0", sdk.DefaultBondDenom))
+
+	suite.coordinator.IncrementTimeBy(weekly + time.Second)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sdk.NewInt(1)))
+	suite.Require().NoError(err)
+
+	history := rateLimiter.GetFlowHistory(suite.chainA.GetContext(), "channel-0", sdk.DefaultBondDenom)
+	suite.Require().Equal([]types.FlowRecord{{Channel: "channel-0", Denom: sdk.DefaultBondDenom, QuotaName: "weekly", Flow: firstWindow}}, history)
+	suite.Require().Equal(sendAmount, history[0].Flow.Outflow)
+	suite.Require().Equal(channelValue, history[0].Flow.ChannelValue)
+}
+
+// Test that events are emitted when the usage of a quota crosses its alert thresholds
+func (suite *MiddlewareTestSuite) TestNativeRateLimitThresholdEvents() {
+	suite.setNativeRateLimit("channel-0", sdk.DefaultBondDenom, 1, 1)
+	quota := suite.nativeQuota(sdk.DefaultBondDenom, 1)
+
+	// Using 60% of the quota crosses the 50% threshold
+	r, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, quota.MulRaw(6).QuoRaw(10)))
+	suite.Require().NoError(err)
+	attrs := suite.ExtractAttributes(suite.FindEvent(r.GetEvents(), types.EventRateLimitThreshold))
+	suite.Require().Equal("50", attrs[types.AttributeKeyThreshold])
+	suite.Require().Equal("out", attrs[types.AttributeKeyDirection])
+	suite.Require().Equal("weekly", attrs[types.AttributeKeyQuota])
+
+	// Using 70% more is rejected
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, quota.MulRaw(7).QuoRaw(10)))
+	suite.Require().Error(err)
+	suite.skipFailedSend()
+
+	// Using the rest of the quota crosses the 80% and 100% thresholds
+	r, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, quota.Sub(quota.MulRaw(6).QuoRaw(10))))
+	suite.Require().NoError(err)
+	thresholds := []string{}
+	for _, event := range r.GetEvents() {
+		if event.Type == types.EventRateLimitThreshold {
+			thresholds = append(thresholds, suite.ExtractAttributes(event)[types.AttributeKeyThreshold])
+		}
+	}
+	suite.Require().Equal([]string{"80", "100"}, thresholds)
+}
@@ -8,4 +8,6 @@ var (
 	ErrRateLimitExceeded = errorsmod.Register(ModuleName, 2, "rate limit exceeded")
 	ErrBadMessage        = errorsmod.Register(ModuleName, 3, "bad message")
 	ErrContractError     = errorsmod.Register(ModuleName, 4, "contract error")
+	ErrRateLimitNotFound = errorsmod.Register(ModuleName, 5, "rate limit not found")
+	ErrInvalidQuota      = errorsmod.Register(ModuleName, 6, "invalid quota")
 )
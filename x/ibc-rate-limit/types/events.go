@@ -5,4 +5,18 @@ const (
 	AttributeKeyPacket      = "packet"
 	AttributeKeyAck         = "acknowledgement"
 	AttributeKeyFailureType = "failure_type"
+
+	EventSetRateLimit    = "set_rate_limit"
+	EventRemoveRateLimit = "remove_rate_limit"
+	AttributeKeyChannel  = "channel"
+	AttributeKeyDenom    = "denom"
+
+	EventRateLimitThreshold  = "rate_limit_threshold"
+	AttributeKeyQuota        = "quota"
+	AttributeKeyDirection    = "direction"
+	AttributeKeyThreshold    = "threshold"
+	AttributeKeyUsed         = "used"
+	AttributeKeyCapacity     = "capacity"
+	AttributeKeyChannelValue = "channel_value"
+	AttributeKeyPeriodEnd    = "period_end"
 )
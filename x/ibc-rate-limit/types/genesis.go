@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -13,5 +15,21 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	paths := make(map[string]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		path := string(RateLimitKey(rateLimit.Channel, rateLimit.Denom))
+		if paths[path] {
+			return fmt.Errorf("duplicate rate limit for %s in %s", rateLimit.Denom, rateLimit.Channel)
+		}
+		paths[path] = true
+	}
+	for _, record := range gs.FlowHistory {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
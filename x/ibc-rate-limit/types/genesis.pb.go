@@ -29,6 +29,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params are all the parameters of the module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// rate_limits are the native rate limits, enforced instead of the contract
+	// for the channels and denoms they are set on.
+	RateLimits []RateLimit `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+	// flow_history are the flows of the past windows of the native rate limits.
+	FlowHistory []FlowRecord `protobuf:"bytes,3,rep,name=flow_history,json=flowHistory,proto3" json:"flow_history" yaml:"flow_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +76,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFlowHistory() []FlowRecord {
+	if m != nil {
+		return m.FlowHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.ibcratelimit.v1beta1.GenesisState")
 }
@@ -80,23 +99,29 @@ func init() {
 }
 
 var fileDescriptor_14e381f6ddb4f706 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x2d, 0x4a, 0x2c, 0x49, 0xd5, 0xcd, 0xc9, 0xcc,
-	0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd6, 0xcb, 0x4c, 0x4a,
-	0x06, 0x29, 0x06, 0xab, 0xd5, 0x83, 0xaa, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd4,
-	0x07, 0xb1, 0x20, 0x7a, 0xa4, 0x24, 0x93, 0xc1, 0x9a, 0xe2, 0x21, 0x12, 0x10, 0x0e, 0x4c, 0x2a,
-	0x3d, 0x3f, 0x3f, 0x3d, 0x27, 0x55, 0x1f, 0xcc, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84,
-	0x4a, 0x69, 0x13, 0x70, 0x57, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x1c, 0xa5, 0x20, 0x2e, 0x1e,
-	0x77, 0x88, 0x3b, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x9c, 0xb8, 0xd8, 0x20, 0xf2, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x2a, 0x7a, 0xf8, 0xdc, 0xad, 0x17, 0x00, 0x56, 0xeb, 0xc4, 0x72,
-	0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xa7, 0x53, 0xc8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x59, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43,
-	0xcd, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x0c, 0xcd, 0xf4, 0x2b, 0xd0, 0x1d,
-	0x5e, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xb0, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff,
-	0xe7, 0xda, 0x14, 0xfa, 0x77, 0x01, 0x00, 0x00,
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x6e, 0xf2, 0x30,
+	0x14, 0x85, 0x13, 0xf8, 0xc5, 0x90, 0x30, 0xe5, 0xef, 0x40, 0x69, 0x15, 0x50, 0x54, 0xa9, 0x48,
+	0x2d, 0xb1, 0xa0, 0x52, 0x07, 0xc6, 0x0c, 0x6d, 0x87, 0x0e, 0x55, 0xda, 0xa9, 0x0b, 0xb2, 0x83,
+	0x09, 0x96, 0x12, 0x2e, 0x8a, 0x0d, 0x34, 0x6f, 0xd1, 0x67, 0xe8, 0xd3, 0x30, 0x32, 0x76, 0x42,
+	0x15, 0xbc, 0x41, 0x9f, 0xa0, 0xb2, 0x9d, 0xa8, 0xa8, 0x03, 0x6c, 0x39, 0xbe, 0xdf, 0x39, 0xc7,
+	0xce, 0xb5, 0xae, 0x81, 0xa7, 0xc0, 0x19, 0x47, 0x8c, 0x44, 0xdd, 0x0c, 0x0b, 0xda, 0x4d, 0x58,
+	0xca, 0x04, 0x5a, 0xf4, 0x08, 0x15, 0xb8, 0x87, 0x62, 0x3a, 0xa5, 0x9c, 0x71, 0x7f, 0x96, 0x81,
+	0x00, 0xe7, 0xbc, 0xa0, 0x7d, 0x46, 0x22, 0x09, 0x2b, 0xd6, 0x2f, 0xd8, 0xe6, 0x49, 0x0c, 0x31,
+	0x28, 0x10, 0xc9, 0x2f, 0xed, 0x69, 0x9e, 0x46, 0xca, 0x34, 0xd4, 0x03, 0x2d, 0xca, 0x51, 0x0c,
+	0x10, 0x27, 0x14, 0x29, 0x45, 0xe6, 0x63, 0x84, 0xa7, 0x79, 0x31, 0xba, 0x3a, 0x72, 0xaf, 0x19,
+	0xce, 0x70, 0x5a, 0xe6, 0xa0, 0x23, 0xb0, 0x3c, 0x1a, 0xea, 0xbb, 0x2a, 0x83, 0xf7, 0x51, 0xb1,
+	0xea, 0xf7, 0xfa, 0x65, 0xcf, 0x02, 0x0b, 0xea, 0x04, 0x56, 0x4d, 0x27, 0x36, 0xcc, 0xb6, 0xd9,
+	0xb1, 0xfb, 0x17, 0xfe, 0xa1, 0x97, 0xfa, 0x4f, 0x8a, 0x0d, 0xfe, 0xad, 0x36, 0x2d, 0x23, 0x2c,
+	0x9c, 0xce, 0xc8, 0xb2, 0x7f, 0x8b, 0x78, 0xa3, 0xd2, 0xae, 0x76, 0xec, 0xfe, 0xe5, 0xe1, 0xa0,
+	0x10, 0x0b, 0xfa, 0x28, 0x4f, 0x82, 0xa6, 0xcc, 0xfa, 0xde, 0xb4, 0x9c, 0x1c, 0xa7, 0xc9, 0xc0,
+	0xdb, 0x4b, 0xf2, 0x42, 0x2b, 0x2b, 0x31, 0xee, 0x4c, 0xac, 0xfa, 0x38, 0x81, 0xe5, 0x70, 0xc2,
+	0xb8, 0x80, 0x2c, 0x6f, 0x54, 0x55, 0x4d, 0xe7, 0x70, 0xcd, 0x5d, 0x02, 0xcb, 0x90, 0x46, 0x90,
+	0x8d, 0x82, 0xb3, 0xa2, 0xe7, 0xbf, 0xee, 0xd9, 0xcf, 0xf2, 0x42, 0x5b, 0xca, 0x07, 0xad, 0x82,
+	0x97, 0xd5, 0xd6, 0x35, 0xd7, 0x5b, 0xd7, 0xfc, 0xda, 0xba, 0xe6, 0xfb, 0xce, 0x35, 0xd6, 0x3b,
+	0xd7, 0xf8, 0xdc, 0xb9, 0xc6, 0xeb, 0x20, 0x66, 0x62, 0x32, 0x27, 0x7e, 0x04, 0x69, 0xf9, 0xeb,
+	0xbb, 0x09, 0x26, 0xbc, 0x14, 0x68, 0xd1, 0xbb, 0x45, 0x6f, 0x7f, 0xb7, 0x21, 0xf2, 0x19, 0xe5,
+	0xa4, 0xa6, 0x36, 0x70, 0xf3, 0x33, 0x00, 0x04, 0xaa, 0x54, 0xa1, 0x79, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +144,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FlowHistory) > 0 {
+		for iNdEx := len(m.FlowHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlowHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -151,6 +204,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FlowHistory) > 0 {
+		for _, e := range m.FlowHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -222,6 +287,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlowHistory = append(m.FlowHistory, FlowRecord{})
+			if err := m.FlowHistory[len(m.FlowHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetRateLimit    = "SetRateLimit"
+	ProposalTypeRemoveRateLimit = "RemoveRateLimit"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetRateLimit)
+	govtypes.RegisterProposalTypeCodec(&SetRateLimitProposal{}, "osmosis/SetRateLimitProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveRateLimit)
+	govtypes.RegisterProposalTypeCodec(&RemoveRateLimitProposal{}, "osmosis/RemoveRateLimitProposal")
+}
+
+var (
+	_ govtypes.Content = &SetRateLimitProposal{}
+	_ govtypes.Content = &RemoveRateLimitProposal{}
+)
+
+// NewSetRateLimitProposal returns a new instance of a set rate limit proposal struct.
+func NewSetRateLimitProposal(title, description, channel, denom string, quotas []Quota) govtypes.Content {
+	return &SetRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Channel:     channel,
+		Denom:       denom,
+		Quotas:      quotas,
+	}
+}
+
+func (p *SetRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetRateLimitProposal) ProposalType() string { return ProposalTypeSetRateLimit }
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateRateLimitPath(p.Channel, p.Denom); err != nil {
+		return err
+	}
+	return ValidateQuotas(p.Quotas)
+}
+
+// String returns a string containing the set rate limit proposal.
+func (p SetRateLimitProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Rate Limit Proposal:
+Title:       %s
+Description: %s
+Channel:     %s
+Denom:       %s
+Quotas:
+`, p.Title, p.Description, p.Channel, p.Denom))
+	for _, quota := range p.Quotas {
+		b.WriteString(fmt.Sprintf("\t%s: send %d%%, recv %d%% every %s\n", quota.Name, quota.MaxPercentageSend, quota.MaxPercentageRecv, quota.Duration))
+	}
+	return b.String()
+}
+
+// NewRemoveRateLimitProposal returns a new instance of a remove rate limit proposal struct.
+func NewRemoveRateLimitProposal(title, description, channel, denom string) govtypes.Content {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Channel:     channel,
+		Denom:       denom,
+	}
+}
+
+func (p *RemoveRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *RemoveRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *RemoveRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return ValidateRateLimitPath(p.Channel, p.Denom)
+}
+
+// String returns a string containing the remove rate limit proposal.
+func (p RemoveRateLimitProposal) String() string {
+	return fmt.Sprintf(`Remove Rate Limit Proposal:
+Title:       %s
+Description: %s
+Channel:     %s
+Denom:       %s
+`, p.Title, p.Description, p.Channel, p.Denom)
+}
@@ -1,12 +1,48 @@
 package types
 
-import "strings"
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	ModuleName = "rate-limited-ibc" // IBC at the end to avoid conflicts with the ibc prefix
 
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// AnyChannel is the channel of the rate limits that apply to the transfers of a denom through all channels.
+	AnyChannel = "any"
 )
 
 // RouterKey is the message route. Can only contain
 // alphanumeric characters.
 var RouterKey = strings.ReplaceAll(ModuleName, "-", "")
+
+var (
+	// RateLimitPrefix is the prefix of the native rate limits, keyed by channel and denom.
+	RateLimitPrefix = []byte{0x01}
+
+	// FlowHistoryPrefix is the prefix of the flows of past windows, keyed by channel, denom, quota and window end.
+	FlowHistoryPrefix = []byte{0x02}
+
+	// KeySeparator is used to combine parts of the keys in the store.
+	KeySeparator = "|"
+)
+
+// RateLimitKey returns the key of the rate limit of a denom in a channel.
+func RateLimitKey(channel, denom string) []byte {
+	return append(RateLimitPrefix, []byte(channel+KeySeparator+denom)...)
+}
+
+// FlowHistoryQuotaPrefix returns the prefix of the flows of past windows of a quota of a denom in a channel.
+func FlowHistoryQuotaPrefix(channel, denom, quotaName string) []byte {
+	return append(FlowHistoryPrefix, []byte(channel+KeySeparator+denom+KeySeparator+quotaName+KeySeparator)...)
+}
+
+// FlowHistoryKey returns the key of the flow of a window of a quota of a denom in a channel.
+func FlowHistoryKey(channel, denom, quotaName string, periodEnd time.Time) []byte {
+	return append(FlowHistoryQuotaPrefix(channel, denom, quotaName), sdk.FormatTimeBytes(periodEnd)...)
+}
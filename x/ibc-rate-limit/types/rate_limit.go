@@ -0,0 +1,210 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxFlowHistory is the number of past windows whose flow is kept for each quota.
+const MaxFlowHistory = 30
+
+// DefaultAlertThresholds are the percentages of a quota whose crossing emits an event, for quotas without thresholds.
+var DefaultAlertThresholds = []uint32{50, 80, 100}
+
+// FlowDirection is the direction of a transfer through a channel.
+type FlowDirection int
+
+const (
+	FlowIn FlowDirection = iota
+	FlowOut
+)
+
+func (d FlowDirection) String() string {
+	if d == FlowIn {
+		return "in"
+	}
+	return "out"
+}
+
+// Validate validates a quota. Percentages are in the [0, 100] range, with at least one of them set, and the
+// duration is positive. A zero percentage leaves its direction unlimited.
+func (q Quota) Validate() error {
+	if q.Name == "" {
+		return errorsmod.Wrap(ErrInvalidQuota, "name cannot be empty")
+	}
+	if q.MaxPercentageSend > 100 || q.MaxPercentageRecv > 100 {
+		return errorsmod.Wrapf(ErrInvalidQuota, "%s: percentages must be at most 100", q.Name)
+	}
+	if q.MaxPercentageSend == 0 && q.MaxPercentageRecv == 0 {
+		return errorsmod.Wrapf(ErrInvalidQuota, "%s: at least one percentage must be positive", q.Name)
+	}
+	if q.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidQuota, "%s: duration must be positive", q.Name)
+	}
+	for j, threshold := range q.AlertThresholds {
+		if threshold == 0 || threshold > 100 {
+			return errorsmod.Wrapf(ErrInvalidQuota, "%s: alert thresholds must be in (0, 100]", q.Name)
+		}
+		if j > 0 && threshold <= q.AlertThresholds[j-1] {
+			return errorsmod.Wrapf(ErrInvalidQuota, "%s: alert thresholds must be increasing", q.Name)
+		}
+	}
+	return nil
+}
+
+// Thresholds returns the alert thresholds of the quota, or the default ones if it has none.
+func (q Quota) Thresholds() []uint32 {
+	if len(q.AlertThresholds) == 0 {
+		return DefaultAlertThresholds
+	}
+	return q.AlertThresholds
+}
+
+// CrossedThresholds returns the alert thresholds crossed by a usage of the quota going from before to after.
+func (q Quota) CrossedThresholds(before, after, capacity sdk.Int) []uint32 {
+	crossed := []uint32{}
+	for _, threshold := range q.Thresholds() {
+		limit := capacity.MulRaw(int64(threshold))
+		if before.MulRaw(100).LT(limit) && after.MulRaw(100).GTE(limit) {
+			crossed = append(crossed, threshold)
+		}
+	}
+	return crossed
+}
+
+// Limits returns whether the quota limits the transfers in a direction, which it does not if its percentage is zero.
+func (q Quota) Limits(direction FlowDirection) bool {
+	if direction == FlowOut {
+		return q.MaxPercentageSend > 0
+	}
+	return q.MaxPercentageRecv > 0
+}
+
+// Capacity returns the value that can be transferred in a direction during a window of the quota, given the value
+// of the denom in the channel.
+func (q Quota) Capacity(direction FlowDirection, channelValue sdk.Int) sdk.Int {
+	percentage := q.MaxPercentageRecv
+	if direction == FlowOut {
+		percentage = q.MaxPercentageSend
+	}
+	return channelValue.MulRaw(int64(percentage)).QuoRaw(100)
+}
+
+// NewFlow returns an empty flow whose window ends at the given time, with the channel value at its start.
+func NewFlow(periodEnd time.Time, channelValue sdk.Int) Flow {
+	return Flow{Inflow: sdk.ZeroInt(), Outflow: sdk.ZeroInt(), PeriodEnd: periodEnd, ChannelValue: channelValue}
+}
+
+// IsExpired returns true if the window of the flow has ended.
+func (f Flow) IsExpired(now time.Time) bool {
+	return f.PeriodEnd.Before(now)
+}
+
+// Balance returns the net value transferred in a direction during the window. Transfers in the opposite direction
+// offset each other.
+func (f Flow) Balance(direction FlowDirection) sdk.Int {
+	balance := f.Inflow.Sub(f.Outflow)
+	if direction == FlowOut {
+		balance = balance.Neg()
+	}
+	if balance.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return balance
+}
+
+// AddFlow adds a transfer to the flow.
+func (f *Flow) AddFlow(direction FlowDirection, amount sdk.Int) {
+	if direction == FlowIn {
+		f.Inflow = f.Inflow.Add(amount)
+	} else {
+		f.Outflow = f.Outflow.Add(amount)
+	}
+}
+
+// UndoFlow removes a transfer from the flow, without going below zero.
+func (f *Flow) UndoFlow(direction FlowDirection, amount sdk.Int) {
+	flow := &f.Inflow
+	if direction == FlowOut {
+		flow = &f.Outflow
+	}
+	*flow = sdk.MaxInt(flow.Sub(amount), sdk.ZeroInt())
+}
+
+// NewRateLimit returns a rate limit with the given quotas and no flow.
+func NewRateLimit(channel, denom string, quotas []Quota) RateLimit {
+	trackers := make([]RateLimitTracker, 0, len(quotas))
+	for _, quota := range quotas {
+		// A zero period end makes the flow expired, so the first window starts with the first transfer
+		trackers = append(trackers, RateLimitTracker{Quota: quota, Flow: NewFlow(time.Time{}, sdk.ZeroInt())})
+	}
+	return RateLimit{Channel: channel, Denom: denom, Trackers: trackers}
+}
+
+// ValidateRateLimitPath validates the channel and denom of a rate limit.
+func ValidateRateLimitPath(channel, denom string) error {
+	if channel != AnyChannel && !channeltypes.IsValidChannelID(channel) {
+		return errorsmod.Wrapf(host.ErrInvalidID, "invalid channel %s, must be a channel identifier or %q", channel, AnyChannel)
+	}
+	return sdk.ValidateDenom(denom)
+}
+
+// ValidateQuotas validates a list of quotas, whose names must be unique.
+func ValidateQuotas(quotas []Quota) error {
+	if len(quotas) == 0 {
+		return errorsmod.Wrap(ErrInvalidQuota, "at least one quota is required")
+	}
+	names := make(map[string]bool, len(quotas))
+	for _, quota := range quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		if names[quota.Name] {
+			return errorsmod.Wrapf(ErrInvalidQuota, "duplicate quota %s", quota.Name)
+		}
+		names[quota.Name] = true
+	}
+	return nil
+}
+
+// Validate validates a rate limit.
+func (r RateLimit) Validate() error {
+	if err := ValidateRateLimitPath(r.Channel, r.Denom); err != nil {
+		return err
+	}
+	quotas := make([]Quota, 0, len(r.Trackers))
+	for _, tracker := range r.Trackers {
+		if err := tracker.Flow.Validate(); err != nil {
+			return fmt.Errorf("invalid flow for quota %s of %s in %s: %w", tracker.Quota.Name, r.Denom, r.Channel, err)
+		}
+		quotas = append(quotas, tracker.Quota)
+	}
+	return ValidateQuotas(quotas)
+}
+
+// Validate validates that the amounts of a flow are not negative.
+func (f Flow) Validate() error {
+	for _, amount := range []sdk.Int{f.Inflow, f.Outflow, f.ChannelValue} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("amounts cannot be negative")
+		}
+	}
+	return nil
+}
+
+// Validate validates a flow record.
+func (r FlowRecord) Validate() error {
+	if err := ValidateRateLimitPath(r.Channel, r.Denom); err != nil {
+		return err
+	}
+	if r.QuotaName == "" {
+		return errorsmod.Wrap(ErrInvalidQuota, "name cannot be empty")
+	}
+	return r.Flow.Validate()
+}
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v16/x/ibc-rate-limit/types"
+)
+
+func TestValidateQuotas(t *testing.T) {
+	valid := types.Quota{Name: "daily", MaxPercentageSend: 10, MaxPercentageRecv: 10, Duration: 24 * time.Hour}
+	tests := map[string]struct {
+		quotas  []types.Quota
+		isValid bool
+	}{
+		"valid":                {quotas: []types.Quota{valid}, isValid: true},
+		"no quotas":            {quotas: []types.Quota{}},
+		"duplicate name":       {quotas: []types.Quota{valid, valid}},
+		"empty name":           {quotas: []types.Quota{{MaxPercentageSend: 10, Duration: time.Hour}}},
+		"percentage above 100": {quotas: []types.Quota{{Name: "daily", MaxPercentageSend: 101, Duration: time.Hour}}},
+		"no percentage":        {quotas: []types.Quota{{Name: "daily", Duration: time.Hour}}},
+		"send only":            {quotas: []types.Quota{{Name: "daily", MaxPercentageSend: 10, Duration: time.Hour}}, isValid: true},
+		"recv only":            {quotas: []types.Quota{{Name: "daily", MaxPercentageRecv: 10, Duration: time.Hour}}, isValid: true},
+		"no duration":          {quotas: []types.Quota{{Name: "daily", MaxPercentageSend: 10}}},
+		"valid thresholds":     {quotas: []types.Quota{{Name: "daily", MaxPercentageSend: 10, Duration: time.Hour, AlertThresholds: []uint32{75, 100}}}, isValid: true},
+		"zero threshold":       {quotas: []types.Quota{{Name: "daily", MaxPercentageSend: 10, Duration: time.Hour, AlertThresholds: []uint32{0}}}},
+		"threshold above 100":  {quotas: []types.Quota{{Name: "daily", MaxPercentageSend: 10, Duration: time.Hour, AlertThresholds: []uint32{101}}}},
+		"unsorted thresholds":  {quotas: []types.Quota{{Name: "daily", MaxPercentageSend: 10, Duration: time.Hour, AlertThresholds: []uint32{80, 50}}}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateQuotas(tc.quotas)
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidQuota)
+			}
+		})
+	}
+}
+
+func TestValidateRateLimitPath(t *testing.T) {
+	require.NoError(t, types.ValidateRateLimitPath("channel-0", "uosmo"))
+	require.NoError(t, types.ValidateRateLimitPath(types.AnyChannel, "uosmo"))
+	require.Error(t, types.ValidateRateLimitPath("connection-0", "uosmo"))
+	require.Error(t, types.ValidateRateLimitPath("channel-0", "1"))
+}
+
+func TestFlow(t *testing.T) {
+	now := time.Unix(1000, 0)
+	flow := types.NewFlow(now, sdk.NewInt(1000))
+	require.False(t, flow.IsExpired(now))
+	require.True(t, flow.IsExpired(now.Add(time.Second)))
+
+	flow.AddFlow(types.FlowOut, sdk.NewInt(100))
+	flow.AddFlow(types.FlowIn, sdk.NewInt(30))
+	require.Equal(t, sdk.NewInt(70), flow.Balance(types.FlowOut))
+	require.Equal(t, sdk.ZeroInt(), flow.Balance(types.FlowIn))
+
+	flow.UndoFlow(types.FlowIn, sdk.NewInt(50))
+	require.Equal(t, sdk.ZeroInt(), flow.Inflow)
+	require.Equal(t, sdk.NewInt(100), flow.Balance(types.FlowOut))
+}
+
+func TestQuotaCapacity(t *testing.T) {
+	quota := types.Quota{Name: "daily", MaxPercentageSend: 10, MaxPercentageRecv: 25, Duration: time.Hour}
+	require.Equal(t, sdk.NewInt(100), quota.Capacity(types.FlowOut, sdk.NewInt(1000)))
+	require.Equal(t, sdk.NewInt(250), quota.Capacity(types.FlowIn, sdk.NewInt(1000)))
+	require.True(t, quota.Limits(types.FlowOut))
+	require.True(t, quota.Limits(types.FlowIn))
+
+	recvOnly := types.Quota{Name: "daily", MaxPercentageRecv: 25, Duration: time.Hour}
+	require.False(t, recvOnly.Limits(types.FlowOut))
+	require.True(t, recvOnly.Limits(types.FlowIn))
+}
+
+func TestCrossedThresholds(t *testing.T) {
+	quota := types.Quota{Name: "daily", MaxPercentageSend: 10, Duration: time.Hour}
+	capacity := sdk.NewInt(100)
+	tests := map[string]struct {
+		before, after int64
+		expected      []uint32
+	}{
+		"none crossed":           {before: 0, after: 49, expected: []uint32{}},
+		"reaching a threshold":   {before: 0, after: 50, expected: []uint32{50}},
+		"several crossed":        {before: 10, after: 100, expected: []uint32{50, 80, 100}},
+		"already above":          {before: 50, after: 79, expected: []uint32{}},
+		"going down":             {before: 90, after: 40, expected: []uint32{}},
+		"custom thresholds used": {before: 0, after: 100, expected: []uint32{25, 100}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			q := quota
+			if name == "custom thresholds used" {
+				q.AlertThresholds = []uint32{25, 100}
+			}
+			require.Equal(t, tc.expected, q.CrossedThresholds(sdk.NewInt(tc.before), sdk.NewInt(tc.after), capacity))
+		})
+	}
+}
@@ -16,30 +16,47 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 // getGaugesFromIterator iterates over everything in a gauge's iterator, until it reaches the end. Return all gauges iterated over.
 func (k Keeper) getGaugesFromIterator(ctx sdk.Context, iterator db.Iterator) []types.Gauge {
 	gauges := []types.Gauge{}
+	err := k.forEachGaugeFromIterator(ctx, iterator, func(gauge types.Gauge) error {
+		gauges = append(gauges, gauge)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return gauges
+}
+
+// forEachGaugeFromIterator calls fn with each gauge referenced by the gauge's iterator, in order, then closes the iterator.
+// It stops at the first error returned by fn.
+func (k Keeper) forEachGaugeFromIterator(ctx sdk.Context, iterator db.Iterator, fn func(types.Gauge) error) error {
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		gaugeIDs := []uint64{}
 		err := json.Unmarshal(iterator.Value(), &gaugeIDs)
 		if err != nil {
-			panic(err)
+			return err
 		}
 		for _, gaugeID := range gaugeIDs {
 			gauge, err := k.GetGaugeByID(ctx, gaugeID)
 			if err != nil {
-				panic(err)
+				return err
+			}
+			if err := fn(*gauge); err != nil {
+				return err
 			}
-			gauges = append(gauges, *gauge)
 		}
 	}
-	return gauges
+	return nil
 }
 
 // setGauge set the gauge inside store.
@@ -111,7 +128,8 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 	}
 
 	// check if denom this gauge pays out to exists on-chain
-	if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") {
+	// cosmwasm pool shares are only minted once the pool is joined, after its gauges are created.
+	if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") && !k.isCosmwasmPoolShare(ctx, distrTo.Denom) {
 		return 0, fmt.Errorf("denom does not exist: %s", distrTo.Denom)
 	}
 
@@ -145,6 +163,16 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 	return gauge.Id, nil
 }
 
+// isCosmwasmPoolShare returns true if the denom is the share denom of an existing cosmwasm pool.
+func (k Keeper) isCosmwasmPoolShare(ctx sdk.Context, denom string) bool {
+	poolId, err := cosmwasmpooltypes.GetPoolIdFromShareDenom(denom)
+	if err != nil {
+		return false
+	}
+	pool, err := k.pmk.GetPool(ctx, poolId)
+	return err == nil && pool.GetType() == poolmanagertypes.CosmWasm
+}
+
 // AddToGaugeRewards adds coins to gauge.
 func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error {
 	gauge, err := k.GetGaugeByID(ctx, gaugeID)
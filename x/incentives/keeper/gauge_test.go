@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 
@@ -50,6 +51,38 @@ func (s *KeeperTestSuite) TestNonExistentDenomGaugeCreation() {
 	s.Require().NoError(err)
 }
 
+// TestCosmwasmPoolShareGaugeCreation tests that gauges can be created for the shares of existing cosmwasm pools
+// before any share is minted, but not for the shares of cosmwasm pools that don't exist.
+func (s *KeeperTestSuite) TestCosmwasmPoolShareGaugeCreation() {
+	s.SetupTest()
+
+	addrs := s.SetupManyLocks(1, defaultLiquidTokens, defaultLPTokens, defaultLockDuration)
+	pool := s.PrepareCosmWasmPool()
+	balancerPoolId := s.PrepareBalancerPool()
+
+	for _, tc := range []struct {
+		denom       string
+		expectError bool
+	}{
+		{denom: cosmwasmpooltypes.GetPoolShareDenom(pool.GetId())},
+		{denom: cosmwasmpooltypes.GetPoolShareDenom(pool.GetId() + 100), expectError: true},
+		{denom: cosmwasmpooltypes.GetPoolShareDenom(balancerPoolId), expectError: true},
+		{denom: cosmwasmpooltypes.PoolShareDenomPrefix + "/invalid", expectError: true},
+	} {
+		distrTo := lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByDuration,
+			Denom:         tc.denom,
+			Duration:      defaultLockDuration,
+		}
+		_, err := s.App.IncentivesKeeper.CreateGauge(s.Ctx, false, addrs[0], sdk.Coins{}, distrTo, time.Time{}, 1)
+		if tc.expectError {
+			s.Require().Error(err, tc.denom)
+		} else {
+			s.Require().NoError(err, tc.denom)
+		}
+	}
+}
+
 // TestGaugeOperations tests perpetual and non-perpetual gauge distribution logic using the gauges by denom keeper.
 func (s *KeeperTestSuite) TestGaugeOperations() {
 	testCases := []struct {
@@ -1,11 +1,20 @@
 package keeper
 
 import (
+	"encoding/json"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/genesisstream"
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
 )
 
+// gaugesJSONName is the JSON name of the genesis array streamed by InitGenesisFromJSON and ExportGenesisTo.
+const gaugesJSONName = "gauges"
+
 // InitGenesis initializes the incentives module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
@@ -20,6 +29,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	k.SetLastGaugeID(ctx, genState.LastGaugeId)
 }
 
+// InitGenesisFromJSON initializes the incentives module's state from a provided JSON genesis state,
+// unmarshalling its gauges one at a time instead of holding all of them in memory.
+func (k Keeper) InitGenesisFromJSON(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := genesisstream.UnmarshalJSON(cdc, bz, &genState, gaugesJSONName); err != nil {
+		return err
+	}
+	k.SetParams(ctx, genState.Params)
+	k.SetLockableDurations(ctx, genState.LockableDurations)
+	err := genesisstream.ForEach(cdc, bz, gaugesJSONName, func(gauge *types.Gauge) error {
+		return k.SetGaugeWithRefKey(ctx, gauge)
+	})
+	if err != nil {
+		return err
+	}
+	k.SetLastGaugeID(ctx, genState.LastGaugeId)
+	return nil
+}
+
 // ExportGenesis returns the x/incentives module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
@@ -29,3 +57,24 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		LastGaugeId:       k.GetLastGaugeID(ctx),
 	}
 }
+
+// ExportGenesisTo writes the x/incentives module's exported genesis as sorted JSON to w,
+// exporting its gauges one at a time instead of holding all of them in memory.
+func (k Keeper) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	genState := &types.GenesisState{
+		Params:            k.GetParams(ctx),
+		LockableDurations: k.GetLockableDurations(ctx),
+		LastGaugeId:       k.GetLastGaugeID(ctx),
+	}
+
+	return genesisstream.WriteJSON(w, cdc, genState, map[string]genesisstream.ArrayWriter{
+		gaugesJSONName: func(write func(proto.Message) error) error {
+			writeGauge := func(gauge types.Gauge) error { return write(&gauge) }
+			// same order as GetNotFinishedGauges, the active gauges first.
+			if err := k.forEachGaugeFromIterator(ctx, k.ActiveGaugesIterator(ctx), writeGauge); err != nil {
+				return err
+			}
+			return k.forEachGaugeFromIterator(ctx, k.UpcomingGaugesIterator(ctx), writeGauge)
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -113,3 +114,44 @@ func TestIncentivesInitGenesis(t *testing.T) {
 	require.Len(t, gauges, 1)
 	require.Equal(t, gauges[0], gauge)
 }
+
+// TestIncentivesStreamingGenesis tests that the streamed genesis export is the sorted JSON of the export,
+// and that the streamed genesis import initializes the same gauges.
+func TestIncentivesStreamingGenesis(t *testing.T) {
+	app := osmoapp.Setup(false)
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	cdc := app.AppCodec()
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10000)}
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         "lptoken",
+		Duration:      time.Second,
+	}
+	err := simapp.FundAccount(app.BankKeeper, ctx, addr, coins.Add(coins...).Add(sdk.NewInt64Coin(distrTo.Denom, 200)))
+	require.NoError(t, err)
+
+	// an upcoming gauge, and an active one.
+	_, err = app.IncentivesKeeper.CreateGauge(ctx, false, addr, coins, distrTo, now.Add(time.Hour), 2)
+	require.NoError(t, err)
+	_, err = app.IncentivesKeeper.CreateGauge(ctx, true, addr, coins, distrTo, now, 1)
+	require.NoError(t, err)
+	activeGauge, err := app.IncentivesKeeper.GetGaugeByID(ctx, 2)
+	require.NoError(t, err)
+	require.NoError(t, app.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(ctx, *activeGauge))
+
+	genesis := app.IncentivesKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.Gauges, 2)
+	expectedJSON := sdk.MustSortJSON(cdc.MustMarshalJSON(genesis))
+	var streamed bytes.Buffer
+	require.NoError(t, app.IncentivesKeeper.ExportGenesisTo(ctx, cdc, &streamed))
+	require.Equal(t, string(expectedJSON), streamed.String())
+
+	importApp := osmoapp.Setup(false)
+	importCtx := importApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	require.NoError(t, importApp.IncentivesKeeper.InitGenesisFromJSON(importCtx, cdc, streamed.Bytes()))
+	require.Equal(t, genesis, importApp.IncentivesKeeper.ExportGenesis(importCtx))
+	require.Len(t, importApp.IncentivesKeeper.GetActiveGauges(importCtx), 1)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	"github.com/osmosis-labs/osmosis/v16/x/incentives/types"
+)
+
+const moduleBalanceInvariantName = "module-balance-covers-gauges"
+
+// DeclareInvariants declares all incentives invariants to the invariant registry.
+func DeclareInvariants(r *invariants.Registry, k Keeper) {
+	r.Register(types.ModuleName, moduleBalanceInvariantName, invariants.Cheap, ModuleBalanceInvariant(k))
+}
+
+// ModuleBalanceInvariant checks that the module account balance covers the coins remaining to be
+// distributed by the gauges.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		undistributedCoins := sdk.NewCoins()
+		for _, gauge := range k.GetGauges(ctx) {
+			remainingCoins, hasNeg := gauge.Coins.SafeSub(gauge.DistributedCoins)
+			if hasNeg {
+				return sdk.FormatInvariant(types.ModuleName, moduleBalanceInvariantName,
+					fmt.Sprintf("\tgauge id %d distributed %s out of %s\n", gauge.Id, gauge.DistributedCoins, gauge.Coins)), true
+			}
+			undistributedCoins = undistributedCoins.Add(remainingCoins...)
+		}
+
+		moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
+		for _, coin := range undistributedCoins {
+			balance := k.bk.GetBalance(ctx, moduleAddr, coin.Denom)
+			if balance.IsLT(coin) {
+				return sdk.FormatInvariant(types.ModuleName, moduleBalanceInvariantName,
+					fmt.Sprintf("\tundistributed gauge coins: %s\n\tmodule balance of %s: %s\n",
+						undistributedCoins, coin.Denom, balance)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, moduleBalanceInvariantName,
+			"\tmodule balance covers the undistributed gauge coins\n"), false
+	}
+}
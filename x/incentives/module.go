@@ -12,6 +12,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 
 	"github.com/gorilla/mux"
@@ -27,6 +28,8 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/genesisstream"
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 	osmosimtypes "github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/client/cli"
 	"github.com/osmosis-labs/osmosis/v16/x/incentives/keeper"
@@ -36,8 +39,9 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule                 = AppModule{}
+	_ module.AppModuleBasic            = AppModuleBasic{}
+	_ genesisstream.HasStreamingExport = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -161,14 +165,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // RegisterInvariants registers the module's invariants.
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
+// DeclareInvariants declares the module's invariants to the invariant registry.
+func (am AppModule) DeclareInvariants(r *invariants.Registry) {
+	keeper.DeclareInvariants(r, am.keeper)
+}
+
 // InitGenesis performs the module's genesis initialization.
 // Returns an empty ValidatorUpdate array.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
-	var genState types.GenesisState
-	// initialize global index to index in genesis state.
-	cdc.MustUnmarshalJSON(gs, &genState)
-
-	am.keeper.InitGenesis(ctx, genState)
+	// the gauges are unmarshalled one at a time.
+	if err := am.keeper.InitGenesisFromJSON(ctx, cdc, gs); err != nil {
+		panic(err)
+	}
 
 	return []abci.ValidatorUpdate{}
 }
@@ -178,6 +186,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
 }
 
+// ExportGenesisTo writes the module's exported genesis state as sorted JSON to w, streaming the gauges.
+func (am AppModule) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	return am.keeper.ExportGenesisTo(ctx, cdc, w)
+}
+
 // BeginBlock executes all ABCI BeginBlock logic respective to the module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
@@ -29,6 +29,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			time.Hour,
 			time.Hour * 3,
 			time.Hour * 7,
+			// superfluid staking creates gauges for the unbonding time, generated by staking before.
+			simState.UnbondTime,
 		},
 	}
 
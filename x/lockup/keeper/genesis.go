@@ -1,11 +1,25 @@
 package keeper
 
 import (
+	"encoding/json"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/genesisstream"
 	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// JSON names of the genesis arrays streamed by InitGenesisFromJSON and ExportGenesisTo.
+const (
+	locksJSONName          = "locks"
+	syntheticLocksJSONName = "synthetic_locks"
+)
+
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
@@ -19,6 +33,50 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	}
 }
 
+// InitGenesisFromJSON initializes the module's state from a provided JSON genesis state, unmarshalling
+// its locks and synthetic locks one at a time instead of holding all of them in memory.
+// Like InitGenesis, the initialization stops at the first lock failing to be initialized.
+func (k Keeper) InitGenesisFromJSON(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := genesisstream.UnmarshalJSON(cdc, bz, &genState, locksJSONName, syntheticLocksJSONName); err != nil {
+		return err
+	}
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetLastLockID(ctx, genState.LastLockId)
+
+	var initErr error
+	entries := newAccumulationEntries()
+	i := 0
+	err := genesisstream.ForEach(cdc, bz, locksJSONName, func(lock *types.PeriodLock) error {
+		initErr = k.initializeLock(ctx, i, *lock, entries)
+		i++
+		return initErr
+	})
+	if initErr != nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	k.setAccumulationEntries(ctx, entries)
+
+	entries = newAccumulationEntries()
+	i = 0
+	err = genesisstream.ForEach(cdc, bz, syntheticLocksJSONName, func(synthLock *types.SyntheticLock) error {
+		initErr = k.initializeSyntheticLock(ctx, i, *synthLock, entries)
+		i++
+		return initErr
+	})
+	if initErr != nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	k.setAccumulationEntries(ctx, entries)
+	return nil
+}
+
 // ExportGenesis returns the capability module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	locks, err := k.GetPeriodLocks(ctx)
@@ -31,3 +89,31 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		SyntheticLocks: k.GetAllSyntheticLockups(ctx),
 	}
 }
+
+// ExportGenesisTo writes the module's exported genesis as sorted JSON to w, exporting its locks
+// and synthetic locks one at a time instead of holding all of them in memory.
+func (k Keeper) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	genState := &types.GenesisState{
+		LastLockId: k.GetLastLockID(ctx),
+	}
+
+	return genesisstream.WriteJSON(w, cdc, genState, map[string]genesisstream.ArrayWriter{
+		locksJSONName: func(write func(proto.Message) error) error {
+			writeLock := func(lock types.PeriodLock) error { return write(&lock) }
+			// same order as GetPeriodLocks, the not unlocking locks first.
+			if err := k.forEachLockFromIterator(ctx, k.LockIterator(ctx, false), writeLock); err != nil {
+				return err
+			}
+			return k.forEachLockFromIterator(ctx, k.LockIterator(ctx, true), writeLock)
+		},
+		syntheticLocksJSONName: func(write func(proto.Message) error) error {
+			return osmoutils.ForEachValueFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPrefixSyntheticLockup,
+				func(bz []byte) (types.SyntheticLock, error) {
+					synthLock := types.SyntheticLock{}
+					err := proto.Unmarshal(bz, &synthLock)
+					return synthLock, err
+				},
+				func(synthLock types.SyntheticLock) error { return write(&synthLock) })
+		},
+	})
+}
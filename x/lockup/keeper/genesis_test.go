@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -150,3 +151,43 @@ func TestMarshalUnmarshalGenesis(t *testing.T) {
 		am.InitGenesis(ctx, appCodec, genesisExported)
 	})
 }
+
+func TestStreamingGenesis(t *testing.T) {
+	app := osmoapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockTime(now.Add(time.Second))
+	cdc := app.AppCodec()
+	app.LockupKeeper.InitGenesis(ctx, testGenesis)
+
+	// an unlocking lock, and a synthetic lock.
+	err := simapp.FundAccount(app.BankKeeper, ctx, acc2, sdk.Coins{sdk.NewInt64Coin("foo", 5000000)})
+	require.NoError(t, err)
+	lock, err := app.LockupKeeper.CreateLock(ctx, acc2, sdk.Coins{sdk.NewInt64Coin("foo", 5000000)}, time.Second*5)
+	require.NoError(t, err)
+	_, err = app.LockupKeeper.BeginUnlock(ctx, lock.ID, nil)
+	require.NoError(t, err)
+	err = app.LockupKeeper.CreateSyntheticLockup(ctx, 1, "foo/superbonding", time.Second, false)
+	require.NoError(t, err)
+
+	// the streamed export is the sorted JSON of the export.
+	genesisExported := app.LockupKeeper.ExportGenesis(ctx)
+	require.Len(t, genesisExported.SyntheticLocks, 1)
+	expectedJSON := sdk.MustSortJSON(cdc.MustMarshalJSON(genesisExported))
+	var streamed bytes.Buffer
+	require.NoError(t, app.LockupKeeper.ExportGenesisTo(ctx, cdc, &streamed))
+	require.Equal(t, string(expectedJSON), streamed.String())
+
+	// the streamed import initializes the same state.
+	importApp := osmoapp.Setup(false)
+	importCtx := importApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(now.Add(time.Second))
+	require.NoError(t, importApp.LockupKeeper.InitGenesisFromJSON(importCtx, cdc, streamed.Bytes()))
+	require.Equal(t, genesisExported, importApp.LockupKeeper.ExportGenesis(importCtx))
+	for _, condition := range []types.QueryCondition{
+		{Denom: "foo", Duration: time.Second},
+		{Denom: "foo/superbonding", Duration: time.Second},
+	} {
+		require.Equal(t,
+			app.LockupKeeper.GetPeriodLocksAccumulation(ctx, condition),
+			importApp.LockupKeeper.GetPeriodLocksAccumulation(importCtx, condition))
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 )
 
@@ -18,6 +19,13 @@ func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
 	ir.RegisterRoute(types.ModuleName, "locks-amount-invariant", LocksBalancesInvariant(keeper))
 }
 
+// DeclareInvariants declares all lockup invariants to the invariant registry.
+func DeclareInvariants(r *invariants.Registry, keeper Keeper) {
+	r.Register(types.ModuleName, "synthetic-lockup-invariant", invariants.Expensive, SyntheticLockupInvariant(keeper))
+	r.Register(types.ModuleName, "accumulation-store-invariant", invariants.Expensive, AccumulationStoreInvariant(keeper))
+	r.Register(types.ModuleName, "locks-amount-invariant", invariants.Expensive, LocksBalancesInvariant(keeper))
+}
+
 // SyntheticLockupInvariant ensures that synthetic lock's underlying lock id and the actual lock's id has the same id.
 func SyntheticLockupInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
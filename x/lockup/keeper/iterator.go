@@ -181,16 +181,31 @@ func (k Keeper) AccountLockIteratorDurationDenom(ctx sdk.Context, isUnlocking bo
 // getLocksFromIterator returns an array of single lock unit by period defined by the x/lockup module.
 func (k Keeper) getLocksFromIterator(ctx sdk.Context, iterator db.Iterator) []types.PeriodLock {
 	locks := []types.PeriodLock{}
+	err := k.forEachLockFromIterator(ctx, iterator, func(lock types.PeriodLock) error {
+		locks = append(locks, lock)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return locks
+}
+
+// forEachLockFromIterator calls fn with each lock referenced by the iterator, in order, then closes the iterator.
+// It stops at the first error returned by fn.
+func (k Keeper) forEachLockFromIterator(ctx sdk.Context, iterator db.Iterator, fn func(types.PeriodLock) error) error {
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		lockID := sdk.BigEndianToUint64(iterator.Value())
 		lock, err := k.GetLockByID(ctx, lockID)
 		if err != nil {
-			panic(err)
+			return err
+		}
+		if err := fn(*lock); err != nil {
+			return err
 		}
-		locks = append(locks, *lock)
 	}
-	return locks
+	return nil
 }
 
 // unlockFromIterator gets locks from the iterator, then unlocks all matured locks. Returns locks unlocked and sum of coins unlocked.
@@ -557,113 +557,117 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lockID uint64, owner sdk.AccAddres
 // them all correctly. This utilizes batch optimizations to improve efficiency,
 // as this becomes a bottleneck at chain initialization & upgrades.
 func (k Keeper) InitializeAllLocks(ctx sdk.Context, locks []types.PeriodLock) error {
-	// index by coin.Denom, them duration -> amt
 	// We accumulate the accumulation store entries separately,
 	// to avoid hitting the myriad of slowdowns in the SDK iterator creation process.
 	// We then save these once to the accumulation store at the end.
-	accumulationStoreEntries := make(map[string]map[time.Duration]sdk.Int)
-	denoms := []string{}
+	entries := newAccumulationEntries()
 	for i, lock := range locks {
-		if i%25000 == 0 {
-			msg := fmt.Sprintf("Reset %d lock refs, cur lock ID %d", i, lock.ID)
-			ctx.Logger().Info(msg)
-		}
-		err := k.setLockAndAddLockRefs(ctx, lock)
+		err := k.initializeLock(ctx, i, lock, entries)
 		if err != nil {
 			return err
 		}
-
-		// Add to the accumlation store cache
-		for _, coin := range lock.Coins {
-			// update or create the new map from duration -> Int for this denom.
-			var curDurationMap map[time.Duration]sdk.Int
-			if durationMap, ok := accumulationStoreEntries[coin.Denom]; ok {
-				curDurationMap = durationMap
-				// update or create new amount in the duration map
-				newAmt := coin.Amount
-				if curAmt, ok := durationMap[lock.Duration]; ok {
-					newAmt = newAmt.Add(curAmt)
-				}
-				curDurationMap[lock.Duration] = newAmt
-			} else {
-				denoms = append(denoms, coin.Denom)
-				curDurationMap = map[time.Duration]sdk.Int{lock.Duration: coin.Amount}
-			}
-			accumulationStoreEntries[coin.Denom] = curDurationMap
-		}
 	}
 
-	// deterministically iterate over durationMap cache.
-	sort.Strings(denoms)
-	for _, denom := range denoms {
-		curDurationMap := accumulationStoreEntries[denom]
-		durations := make([]time.Duration, 0, len(curDurationMap))
-		for duration := range curDurationMap {
-			durations = append(durations, duration)
-		}
-		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
-		// now that we have a sorted list of durations for this denom,
-		// add them all to accumulation store
-		msg := fmt.Sprintf("Setting accumulation entries for locks for %s, there are %d distinct durations",
-			denom, len(durations))
+	k.setAccumulationEntries(ctx, entries)
+	return nil
+}
+
+// initializeLock stores the i-th lock of the initialization along with its refs,
+// adding its coins to the accumulation store entries.
+func (k Keeper) initializeLock(ctx sdk.Context, i int, lock types.PeriodLock, entries *accumulationEntries) error {
+	if i%25000 == 0 {
+		msg := fmt.Sprintf("Reset %d lock refs, cur lock ID %d", i, lock.ID)
 		ctx.Logger().Info(msg)
-		for _, d := range durations {
-			amt := curDurationMap[d]
-			k.accumulationStore(ctx, denom).Increase(accumulationKey(d), amt)
-		}
+	}
+	err := k.setLockAndAddLockRefs(ctx, lock)
+	if err != nil {
+		return err
 	}
 
+	// Add to the accumlation store cache
+	for _, coin := range lock.Coins {
+		entries.add(coin.Denom, lock.Duration, coin.Amount)
+	}
 	return nil
 }
 
 func (k Keeper) InitializeAllSyntheticLocks(ctx sdk.Context, syntheticLocks []types.SyntheticLock) error {
-	// index by coin.Denom, them duration -> amt
 	// We accumulate the accumulation store entries separately,
 	// to avoid hitting the myriad of slowdowns in the SDK iterator creation process.
 	// We then save these once to the accumulation store at the end.
-	accumulationStoreEntries := make(map[string]map[time.Duration]sdk.Int)
-	denoms := []string{}
+	entries := newAccumulationEntries()
 	for i, synthLock := range syntheticLocks {
-		if i%25000 == 0 {
-			msg := fmt.Sprintf("Reset %d synthetic lock refs", i)
-			ctx.Logger().Info(msg)
-		}
-
-		// Add to the accumlation store cache
-		lock, err := k.GetLockByID(ctx, synthLock.UnderlyingLockId)
+		err := k.initializeSyntheticLock(ctx, i, synthLock, entries)
 		if err != nil {
 			return err
 		}
+	}
 
-		err = k.setSyntheticLockAndResetRefs(ctx, *lock, synthLock)
-		if err != nil {
-			return err
-		}
+	k.setAccumulationEntries(ctx, entries)
+	return nil
+}
 
-		coin, err := lock.SingleCoin()
-		if err != nil {
-			return err
-		}
+// initializeSyntheticLock stores the i-th synthetic lock of the initialization and resets its refs,
+// adding the coin of its underlying lock to the accumulation store entries.
+func (k Keeper) initializeSyntheticLock(ctx sdk.Context, i int, synthLock types.SyntheticLock, entries *accumulationEntries) error {
+	if i%25000 == 0 {
+		msg := fmt.Sprintf("Reset %d synthetic lock refs", i)
+		ctx.Logger().Info(msg)
+	}
 
-		var curDurationMap map[time.Duration]sdk.Int
-		if durationMap, ok := accumulationStoreEntries[synthLock.SynthDenom]; ok {
-			curDurationMap = durationMap
-			newAmt := coin.Amount
-			if curAmt, ok := durationMap[synthLock.Duration]; ok {
-				newAmt = newAmt.Add(curAmt)
-			}
-			curDurationMap[synthLock.Duration] = newAmt
-		} else {
-			denoms = append(denoms, synthLock.SynthDenom)
-			curDurationMap = map[time.Duration]sdk.Int{synthLock.Duration: coin.Amount}
-		}
-		accumulationStoreEntries[synthLock.SynthDenom] = curDurationMap
+	// Add to the accumlation store cache
+	lock, err := k.GetLockByID(ctx, synthLock.UnderlyingLockId)
+	if err != nil {
+		return err
+	}
+
+	err = k.setSyntheticLockAndResetRefs(ctx, *lock, synthLock)
+	if err != nil {
+		return err
 	}
 
+	coin, err := lock.SingleCoin()
+	if err != nil {
+		return err
+	}
+
+	entries.add(synthLock.SynthDenom, synthLock.Duration, coin.Amount)
+	return nil
+}
+
+// accumulationEntries are the accumulation store entries of the initialized locks,
+// indexed by coin.Denom, them duration -> amt.
+type accumulationEntries struct {
+	amounts map[string]map[time.Duration]sdk.Int
+	denoms  []string
+}
+
+func newAccumulationEntries() *accumulationEntries {
+	return &accumulationEntries{amounts: make(map[string]map[time.Duration]sdk.Int)}
+}
+
+// add adds the amount to the entry of the denom and duration.
+func (e *accumulationEntries) add(denom string, duration time.Duration, amount sdk.Int) {
+	// update or create the new map from duration -> Int for this denom.
+	curDurationMap, ok := e.amounts[denom]
+	if !ok {
+		e.denoms = append(e.denoms, denom)
+		curDurationMap = map[time.Duration]sdk.Int{}
+		e.amounts[denom] = curDurationMap
+	}
+	// update or create new amount in the duration map
+	if curAmt, ok := curDurationMap[duration]; ok {
+		amount = amount.Add(curAmt)
+	}
+	curDurationMap[duration] = amount
+}
+
+// setAccumulationEntries adds the entries to the accumulation store.
+func (k Keeper) setAccumulationEntries(ctx sdk.Context, entries *accumulationEntries) {
 	// deterministically iterate over durationMap cache.
-	sort.Strings(denoms)
-	for _, denom := range denoms {
-		curDurationMap := accumulationStoreEntries[denom]
+	sort.Strings(entries.denoms)
+	for _, denom := range entries.denoms {
+		curDurationMap := entries.amounts[denom]
 		durations := make([]time.Duration, 0, len(curDurationMap))
 		for duration := range curDurationMap {
 			durations = append(durations, duration)
@@ -679,8 +683,6 @@ func (k Keeper) InitializeAllSyntheticLocks(ctx sdk.Context, syntheticLocks []ty
 			k.accumulationStore(ctx, denom).Increase(accumulationKey(d), amt)
 		}
 	}
-
-	return nil
 }
 
 // SlashTokensFromLockByID sends slashed tokens directly from the lock to the community pool.
@@ -7,7 +7,6 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
-	cl "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
 	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
@@ -1346,7 +1345,7 @@ func (s *KeeperTestSuite) TestSlashTokensFromLockByIDSendUnderlyingAndBurn() {
 
 		tempPositionToCalculateUnderlyingAssets := position
 		tempPositionToCalculateUnderlyingAssets.Liquidity = tc.liquidityToSlash
-		asset0, asset1, err := cl.CalculateUnderlyingAssetsFromPosition(s.Ctx, tempPositionToCalculateUnderlyingAssets, concentratedPool)
+		asset0, asset1, err := s.App.ConcentratedLiquidityKeeper.CalculateUnderlyingAssetsFromPosition(s.Ctx, tempPositionToCalculateUnderlyingAssets, concentratedPool)
 		s.Require().NoError(err)
 
 		underlyingAssetsToSlash := sdk.NewCoins(asset0, asset1)
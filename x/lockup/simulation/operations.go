@@ -29,7 +29,11 @@ func RandomMsgLockTokens(k keeper.Keeper, sim *simtypes.SimCtx, ctx sdk.Context)
 }
 
 func RandomMsgBeginUnlockingAll(k keeper.Keeper, sim *simtypes.SimCtx, ctx sdk.Context) (*types.MsgBeginUnlockingAll, error) {
-	sender := sim.RandomSimAccount()
+	// locks backing superfluid delegations can't begin unlocking, so neither can all locks of their owner
+	sender, senderExists := sim.RandomSimAccountWithConstraint(accountHasNoSyntheticLockupConstraint(k, ctx))
+	if !senderExists {
+		return nil, errors.New("every addr has a synthetic lockup")
+	}
 	return &types.MsgBeginUnlockingAll{
 		Owner: sender.Address.String(),
 	}, nil
@@ -47,16 +51,30 @@ func RandomMsgBeginUnlocking(k keeper.Keeper, sim *simtypes.SimCtx, ctx sdk.Cont
 	}, nil
 }
 
-var notUnlockingFilter = func(l types.PeriodLock) bool { return !l.IsUnlocking() }
+// unlockableFilter keeps the locks that can begin unlocking: not unlocking yet, and without synthetic lockups.
+func unlockableFilter(k keeper.Keeper, ctx sdk.Context) func(types.PeriodLock) bool {
+	return func(l types.PeriodLock) bool { return !l.IsUnlocking() && !k.HasAnySyntheticLockups(ctx, l.ID) }
+}
 
 func accountHasLockConstraint(k keeper.Keeper, ctx sdk.Context) simtypes.SimAccountConstraint {
 	return func(acc legacysimulationtype.Account) bool {
-		return len(osmoutils.Filter(notUnlockingFilter, k.GetAccountPeriodLocks(ctx, acc.Address))) != 0
+		return len(osmoutils.Filter(unlockableFilter(k, ctx), k.GetAccountPeriodLocks(ctx, acc.Address))) != 0
+	}
+}
+
+func accountHasNoSyntheticLockupConstraint(k keeper.Keeper, ctx sdk.Context) simtypes.SimAccountConstraint {
+	return func(acc legacysimulationtype.Account) bool {
+		for _, l := range k.GetAccountPeriodLocks(ctx, acc.Address) {
+			if k.HasAnySyntheticLockups(ctx, l.ID) {
+				return false
+			}
+		}
+		return true
 	}
 }
 
 func randLock(k keeper.Keeper, sim *simtypes.SimCtx, ctx sdk.Context, addr sdk.AccAddress) types.PeriodLock {
 	locks := k.GetAccountPeriodLocks(ctx, addr)
-	notUnlockingLocks := osmoutils.Filter(notUnlockingFilter, locks)
-	return simtypes.RandSelect(sim, notUnlockingLocks...)
+	unlockableLocks := osmoutils.Filter(unlockableFilter(k, ctx), locks)
+	return simtypes.RandSelect(sim, unlockableLocks...)
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	minttypes "github.com/osmosis-labs/osmosis/v16/x/mint/types"
 )
@@ -12,8 +13,9 @@ type Hooks struct {
 }
 
 var (
-	_ gammtypes.GammHooks = Hooks{}
-	_ minttypes.MintHooks = Hooks{}
+	_ gammtypes.GammHooks                 = Hooks{}
+	_ cosmwasmpooltypes.CosmwasmPoolHooks = Hooks{}
+	_ minttypes.MintHooks                 = Hooks{}
 )
 
 // Create new pool incentives hooks.
@@ -21,7 +23,15 @@ func (k Keeper) Hooks() Hooks { return Hooks{k} }
 
 // AfterCFMMPoolCreated creates a gauge for each pool’s lockable duration.
 func (h Hooks) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
-	err := h.k.CreateLockablePoolGauges(ctx, poolId)
+	err := h.k.CreateLockablePoolGauges(ctx, poolId, gammtypes.GetPoolShareDenom(poolId))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// AfterCosmWasmPoolCreated creates a gauge for each lockable duration of the cosmwasm pool's shares.
+func (h Hooks) AfterCosmWasmPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	err := h.k.CreateLockablePoolGauges(ctx, poolId, cosmwasmpooltypes.GetPoolShareDenom(poolId))
 	if err != nil {
 		panic(err)
 	}
@@ -7,7 +7,6 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
-	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v16/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v16/x/pool-incentives/types"
@@ -62,8 +61,9 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
-// CreateLockablePoolGauges create multiple gauges based on lockableDurations.
-func (k Keeper) CreateLockablePoolGauges(ctx sdk.Context, poolId uint64) error {
+// CreateLockablePoolGauges create multiple gauges based on lockableDurations
+// that distribute to the locks of the given pool share denom.
+func (k Keeper) CreateLockablePoolGauges(ctx sdk.Context, poolId uint64, poolShareDenom string) error {
 	// Create the same number of gauges as there are LockableDurations
 	for _, lockableDuration := range k.GetLockableDurations(ctx) {
 		gaugeId, err := k.incentivesKeeper.CreateGauge(
@@ -73,7 +73,7 @@ func (k Keeper) CreateLockablePoolGauges(ctx sdk.Context, poolId uint64) error {
 			sdk.Coins{},
 			lockuptypes.QueryCondition{
 				LockQueryType: lockuptypes.ByDuration,
-				Denom:         gammtypes.GetPoolShareDenom(poolId),
+				Denom:         poolShareDenom,
 				Duration:      lockableDuration,
 				Timestamp:     time.Time{},
 			},
@@ -9,8 +9,10 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
 	appParams "github.com/osmosis-labs/osmosis/v16/app/params"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v16/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v16/x/pool-incentives/types"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v16/x/pool-incentives/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
@@ -131,7 +133,7 @@ func (s *KeeperTestSuite) TestCreateLockablePoolGauges() {
 			s.SetupTest()
 			poolId := s.PrepareBalancerPool()
 
-			err := s.App.PoolIncentivesKeeper.CreateLockablePoolGauges(s.Ctx, tc.poolId)
+			err := s.App.PoolIncentivesKeeper.CreateLockablePoolGauges(s.Ctx, tc.poolId, gammtypes.GetPoolShareDenom(tc.poolId))
 			if tc.expectedErr {
 				s.Require().Error(err)
 			} else {
@@ -160,6 +162,25 @@ func (s *KeeperTestSuite) TestCreateLockablePoolGauges() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCreateCosmWasmPoolGauges() {
+	s.SetupTest()
+
+	pool := s.PrepareCosmWasmPool()
+
+	// a gauge is created for each lockable duration of the cosmwasm pool shares
+	for _, duration := range s.App.PoolIncentivesKeeper.GetLockableDurations(s.Ctx) {
+		gaugeId, err := s.App.PoolIncentivesKeeper.GetPoolGaugeId(s.Ctx, pool.GetId(), duration)
+		s.Require().NoError(err)
+
+		gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+		s.Require().NoError(err)
+		s.Require().True(gauge.IsPerpetual)
+		s.Require().Equal(lockuptypes.ByDuration, gauge.DistributeTo.LockQueryType)
+		s.Require().Equal(cosmwasmpooltypes.GetPoolShareDenom(pool.GetId()), gauge.DistributeTo.Denom)
+		s.Require().Equal(duration, gauge.DistributeTo.Duration)
+	}
+}
+
 func (s *KeeperTestSuite) TestCreateConcentratedLiquidityPoolGauge() {
 	tests := []struct {
 		name            string
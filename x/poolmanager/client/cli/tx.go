@@ -31,6 +31,8 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountIn)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOut)
+	osmocli.AddTxCmd(txCmd, NewJoinPoolCmd)
+	osmocli.AddTxCmd(txCmd, NewExitPoolCmd)
 
 	txCmd.AddCommand(
 		NewCreatePoolCmd(),
@@ -155,6 +157,22 @@ func NewSplitRouteSwapExactAmountOut() (*osmocli.TxCliDesc, *types.MsgSplitRoute
 	}, &types.MsgSplitRouteSwapExactAmountOut{}
 }
 
+func NewJoinPoolCmd() (*osmocli.TxCliDesc, *types.MsgJoinPool) {
+	return &osmocli.TxCliDesc{
+		Use:     "join-pool [pool-id] [tokens-in] [share-out-min-amount]",
+		Short:   "join a pool that supports joining through the poolmanager, such as a cosmwasm pool",
+		Example: "osmosisd tx poolmanager join-pool 1 1000000uosmo,1000000uion 1 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgJoinPool{}
+}
+
+func NewExitPoolCmd() (*osmocli.TxCliDesc, *types.MsgExitPool) {
+	return &osmocli.TxCliDesc{
+		Use:     "exit-pool [pool-id] [share-in-amount] [token-out-mins]",
+		Short:   "exit a pool that supports exiting through the poolmanager, such as a cosmwasm pool",
+		Example: "osmosisd tx poolmanager exit-pool 1 1000000 1uosmo,1uion --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgExitPool{}
+}
+
 func NewMsgNewSplitRouteSwapExactAmountOut(fs *flag.FlagSet) ([]types.SwapAmountOutSplitRoute, error) {
 	routesFile, _ := fs.GetString(FlagRoutesFile)
 	if routesFile == "" {
@@ -0,0 +1,277 @@
+package queryclient
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/poolmanager/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager/client/queryproto"
+)
+
+// Paths of the queries, used by gRPC, ABCI and Stargate queries.
+const (
+	AllPoolsPath                             = "/osmosis.poolmanager.v1beta1.Query/AllPools"
+	EstimateSinglePoolSwapExactAmountInPath  = "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn"
+	EstimateSinglePoolSwapExactAmountOutPath = "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountOut"
+	EstimateSwapExactAmountInPath            = "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountIn"
+	EstimateSwapExactAmountOutPath           = "/osmosis.poolmanager.v1beta1.Query/EstimateSwapExactAmountOut"
+	NumPoolsPath                             = "/osmosis.poolmanager.v1beta1.Query/NumPools"
+	ParamsPath                               = "/osmosis.poolmanager.v1beta1.Query/Params"
+	PoolPath                                 = "/osmosis.poolmanager.v1beta1.Query/Pool"
+	SpotPricePath                            = "/osmosis.poolmanager.v1beta1.Query/SpotPrice"
+	TotalPoolLiquidityPath                   = "/osmosis.poolmanager.v1beta1.Query/TotalPoolLiquidity"
+)
+
+// Client is a typed client of the queries, over a gRPC connection to a node or a client.Context.
+type Client struct {
+	q queryproto.QueryClient
+}
+
+func NewClient(conn gogogrpc.ClientConn) Client {
+	return Client{q: queryproto.NewQueryClient(conn)}
+}
+
+func (c Client) AllPools(ctx context.Context,
+	req queryproto.AllPoolsRequest, opts ...grpc.CallOption,
+) (*queryproto.AllPoolsResponse, error) {
+	return c.q.AllPools(ctx, &req, opts...)
+}
+
+// NewAllPoolsABCIRequest returns the ABCI query of AllPools at the given height, 0 being the latest one.
+func NewAllPoolsABCIRequest(req queryproto.AllPoolsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: AllPoolsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeAllPoolsResponse decodes the response of AllPools, e.g. the value of its ABCI query.
+func DecodeAllPoolsResponse(bz []byte) (*queryproto.AllPoolsResponse, error) {
+	res := &queryproto.AllPoolsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) EstimateSinglePoolSwapExactAmountIn(ctx context.Context,
+	req queryproto.EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption,
+) (*queryproto.EstimateSwapExactAmountInResponse, error) {
+	return c.q.EstimateSinglePoolSwapExactAmountIn(ctx, &req, opts...)
+}
+
+// NewEstimateSinglePoolSwapExactAmountInABCIRequest returns the ABCI query of EstimateSinglePoolSwapExactAmountIn at the given height, 0 being the latest one.
+func NewEstimateSinglePoolSwapExactAmountInABCIRequest(req queryproto.EstimateSinglePoolSwapExactAmountInRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: EstimateSinglePoolSwapExactAmountInPath, Data: bz, Height: height}, nil
+}
+
+// DecodeEstimateSinglePoolSwapExactAmountInResponse decodes the response of EstimateSinglePoolSwapExactAmountIn, e.g. the value of its ABCI query.
+func DecodeEstimateSinglePoolSwapExactAmountInResponse(bz []byte) (*queryproto.EstimateSwapExactAmountInResponse, error) {
+	res := &queryproto.EstimateSwapExactAmountInResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) EstimateSinglePoolSwapExactAmountOut(ctx context.Context,
+	req queryproto.EstimateSinglePoolSwapExactAmountOutRequest, opts ...grpc.CallOption,
+) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
+	return c.q.EstimateSinglePoolSwapExactAmountOut(ctx, &req, opts...)
+}
+
+// NewEstimateSinglePoolSwapExactAmountOutABCIRequest returns the ABCI query of EstimateSinglePoolSwapExactAmountOut at the given height, 0 being the latest one.
+func NewEstimateSinglePoolSwapExactAmountOutABCIRequest(req queryproto.EstimateSinglePoolSwapExactAmountOutRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: EstimateSinglePoolSwapExactAmountOutPath, Data: bz, Height: height}, nil
+}
+
+// DecodeEstimateSinglePoolSwapExactAmountOutResponse decodes the response of EstimateSinglePoolSwapExactAmountOut, e.g. the value of its ABCI query.
+func DecodeEstimateSinglePoolSwapExactAmountOutResponse(bz []byte) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
+	res := &queryproto.EstimateSwapExactAmountOutResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) EstimateSwapExactAmountIn(ctx context.Context,
+	req queryproto.EstimateSwapExactAmountInRequest, opts ...grpc.CallOption,
+) (*queryproto.EstimateSwapExactAmountInResponse, error) {
+	return c.q.EstimateSwapExactAmountIn(ctx, &req, opts...)
+}
+
+// NewEstimateSwapExactAmountInABCIRequest returns the ABCI query of EstimateSwapExactAmountIn at the given height, 0 being the latest one.
+func NewEstimateSwapExactAmountInABCIRequest(req queryproto.EstimateSwapExactAmountInRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: EstimateSwapExactAmountInPath, Data: bz, Height: height}, nil
+}
+
+// DecodeEstimateSwapExactAmountInResponse decodes the response of EstimateSwapExactAmountIn, e.g. the value of its ABCI query.
+func DecodeEstimateSwapExactAmountInResponse(bz []byte) (*queryproto.EstimateSwapExactAmountInResponse, error) {
+	res := &queryproto.EstimateSwapExactAmountInResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) EstimateSwapExactAmountOut(ctx context.Context,
+	req queryproto.EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption,
+) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
+	return c.q.EstimateSwapExactAmountOut(ctx, &req, opts...)
+}
+
+// NewEstimateSwapExactAmountOutABCIRequest returns the ABCI query of EstimateSwapExactAmountOut at the given height, 0 being the latest one.
+func NewEstimateSwapExactAmountOutABCIRequest(req queryproto.EstimateSwapExactAmountOutRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: EstimateSwapExactAmountOutPath, Data: bz, Height: height}, nil
+}
+
+// DecodeEstimateSwapExactAmountOutResponse decodes the response of EstimateSwapExactAmountOut, e.g. the value of its ABCI query.
+func DecodeEstimateSwapExactAmountOutResponse(bz []byte) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
+	res := &queryproto.EstimateSwapExactAmountOutResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) NumPools(ctx context.Context,
+	req queryproto.NumPoolsRequest, opts ...grpc.CallOption,
+) (*queryproto.NumPoolsResponse, error) {
+	return c.q.NumPools(ctx, &req, opts...)
+}
+
+// NewNumPoolsABCIRequest returns the ABCI query of NumPools at the given height, 0 being the latest one.
+func NewNumPoolsABCIRequest(req queryproto.NumPoolsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: NumPoolsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeNumPoolsResponse decodes the response of NumPools, e.g. the value of its ABCI query.
+func DecodeNumPoolsResponse(bz []byte) (*queryproto.NumPoolsResponse, error) {
+	res := &queryproto.NumPoolsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) Params(ctx context.Context,
+	req queryproto.ParamsRequest, opts ...grpc.CallOption,
+) (*queryproto.ParamsResponse, error) {
+	return c.q.Params(ctx, &req, opts...)
+}
+
+// NewParamsABCIRequest returns the ABCI query of Params at the given height, 0 being the latest one.
+func NewParamsABCIRequest(req queryproto.ParamsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: ParamsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeParamsResponse decodes the response of Params, e.g. the value of its ABCI query.
+func DecodeParamsResponse(bz []byte) (*queryproto.ParamsResponse, error) {
+	res := &queryproto.ParamsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) Pool(ctx context.Context,
+	req queryproto.PoolRequest, opts ...grpc.CallOption,
+) (*queryproto.PoolResponse, error) {
+	return c.q.Pool(ctx, &req, opts...)
+}
+
+// NewPoolABCIRequest returns the ABCI query of Pool at the given height, 0 being the latest one.
+func NewPoolABCIRequest(req queryproto.PoolRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: PoolPath, Data: bz, Height: height}, nil
+}
+
+// DecodePoolResponse decodes the response of Pool, e.g. the value of its ABCI query.
+func DecodePoolResponse(bz []byte) (*queryproto.PoolResponse, error) {
+	res := &queryproto.PoolResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) SpotPrice(ctx context.Context,
+	req queryproto.SpotPriceRequest, opts ...grpc.CallOption,
+) (*queryproto.SpotPriceResponse, error) {
+	return c.q.SpotPrice(ctx, &req, opts...)
+}
+
+// NewSpotPriceABCIRequest returns the ABCI query of SpotPrice at the given height, 0 being the latest one.
+func NewSpotPriceABCIRequest(req queryproto.SpotPriceRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: SpotPricePath, Data: bz, Height: height}, nil
+}
+
+// DecodeSpotPriceResponse decodes the response of SpotPrice, e.g. the value of its ABCI query.
+func DecodeSpotPriceResponse(bz []byte) (*queryproto.SpotPriceResponse, error) {
+	res := &queryproto.SpotPriceResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) TotalPoolLiquidity(ctx context.Context,
+	req queryproto.TotalPoolLiquidityRequest, opts ...grpc.CallOption,
+) (*queryproto.TotalPoolLiquidityResponse, error) {
+	return c.q.TotalPoolLiquidity(ctx, &req, opts...)
+}
+
+// NewTotalPoolLiquidityABCIRequest returns the ABCI query of TotalPoolLiquidity at the given height, 0 being the latest one.
+func NewTotalPoolLiquidityABCIRequest(req queryproto.TotalPoolLiquidityRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: TotalPoolLiquidityPath, Data: bz, Height: height}, nil
+}
+
+// DecodeTotalPoolLiquidityResponse decodes the response of TotalPoolLiquidity, e.g. the value of its ABCI query.
+func DecodeTotalPoolLiquidityResponse(bz []byte) (*queryproto.TotalPoolLiquidityResponse, error) {
+	res := &queryproto.TotalPoolLiquidityResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,46 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+const poolRoutesInvariantName = "pool-routes-match-pools"
+
+// DeclareInvariants declares all poolmanager invariants to the invariant registry.
+func DeclareInvariants(r *invariants.Registry, k Keeper) {
+	r.Register(types.ModuleName, poolRoutesInvariantName, invariants.Cheap, PoolRoutesInvariant(k))
+}
+
+// PoolRoutesInvariant checks that every created pool has a route, and that the pool of every route
+// exists with the type of the route.
+func PoolRoutesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		routes := k.getAllPoolRoutes(ctx)
+		nextPoolId := k.GetNextPoolId(ctx)
+		// pool ids start at 1 and pools are never deleted.
+		if uint64(len(routes)) != nextPoolId-1 {
+			return sdk.FormatInvariant(types.ModuleName, poolRoutesInvariantName,
+				fmt.Sprintf("\t%d pool routes for next pool id %d\n", len(routes), nextPoolId)), true
+		}
+
+		for _, route := range routes {
+			pool, err := k.GetPool(ctx, route.PoolId)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, poolRoutesInvariantName,
+					fmt.Sprintf("\tpool id %d retrieval failed: %s\n", route.PoolId, err)), true
+			}
+			if pool.GetType() != route.PoolType {
+				return sdk.FormatInvariant(types.ModuleName, poolRoutesInvariantName,
+					fmt.Sprintf("\tpool id %d has type %s, routed as %s\n", route.PoolId, pool.GetType(), route.PoolType)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolRoutesInvariantName,
+			"\tall pools have a route matching their type\n"), false
+	}
+}
@@ -0,0 +1,74 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+// JoinPool routes joining the pool with the given id to the pool's module, returning the pool shares minted to the sender.
+// Returns error if:
+// - the pool does not exist.
+// - the pool's module does not support joining through the poolmanager.
+// - the pool is inactive.
+// - the pool's module fails to join the pool.
+func (k Keeper) JoinPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokensIn sdk.Coins,
+	shareOutMinAmount sdk.Int,
+) (sdk.Coin, error) {
+	poolModule, pool, err := k.getJoinExitPoolModule(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return poolModule.JoinPool(ctx, sender, pool, tokensIn, shareOutMinAmount)
+}
+
+// ExitPool routes exiting the pool with the given id to the pool's module, returning the tokens sent to the sender.
+// Returns error if:
+// - the pool does not exist.
+// - the pool's module does not support exiting through the poolmanager.
+// - the pool is inactive.
+// - the pool's module fails to exit the pool.
+func (k Keeper) ExitPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	shareInAmount sdk.Int,
+	tokenOutMins sdk.Coins,
+) (sdk.Coins, error) {
+	poolModule, pool, err := k.getJoinExitPoolModule(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	return poolModule.ExitPool(ctx, sender, pool, shareInAmount, tokenOutMins)
+}
+
+// getJoinExitPoolModule returns the active pool with the given id along with its module,
+// erroring if the module does not support joining and exiting through the poolmanager.
+func (k Keeper) getJoinExitPoolModule(ctx sdk.Context, poolId uint64) (types.JoinExitPoolModuleI, types.PoolI, error) {
+	poolModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool, err := poolModule.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	joinExitPoolModule, ok := poolModule.(types.JoinExitPoolModuleI)
+	if !ok {
+		return nil, nil, types.JoinExitUnsupportedError{PoolType: pool.GetType(), PoolId: poolId}
+	}
+
+	if !pool.IsActive(ctx) {
+		return nil, nil, types.InactivePoolError{PoolId: poolId}
+	}
+
+	return joinExitPoolModule, pool, nil
+}
@@ -0,0 +1,78 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestJoinPool_ExitPool() {
+	tests := map[string]struct {
+		poolType    types.PoolType
+		poolId      uint64
+		expectedErr error
+	}{
+		"balancer pool: unsupported": {
+			poolType:    types.Balancer,
+			poolId:      1,
+			expectedErr: types.JoinExitUnsupportedError{PoolType: types.Balancer, PoolId: 1},
+		},
+		"concentrated pool: unsupported": {
+			poolType:    types.Concentrated,
+			poolId:      1,
+			expectedErr: types.JoinExitUnsupportedError{PoolType: types.Concentrated, PoolId: 1},
+		},
+		"non-existent pool": {
+			poolType:    types.Balancer,
+			poolId:      2,
+			expectedErr: types.FailedToFindRouteError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			s.CreatePoolFromType(tc.poolType)
+
+			tokensIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 100), sdk.NewInt64Coin("foo", 100))
+			s.FundAcc(s.TestAccs[1], tokensIn)
+
+			_, err := s.App.PoolManagerKeeper.JoinPool(s.Ctx, s.TestAccs[1], tc.poolId, tokensIn, sdk.OneInt())
+			s.Require().ErrorIs(err, tc.expectedErr)
+
+			_, err = s.App.PoolManagerKeeper.ExitPool(s.Ctx, s.TestAccs[1], tc.poolId, sdk.OneInt(), sdk.NewCoins())
+			s.Require().ErrorIs(err, tc.expectedErr)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestJoinPool_ExitPool_CosmWasmPool() {
+	s.SetupTest()
+	pool := s.PrepareCosmWasmPool()
+	s.SetupJoinExitCosmWasmPools()
+
+	sender := s.TestAccs[1]
+	poolAddress := sdk.MustAccAddressFromBech32(pool.GetContractAddress())
+	shareDenom := cosmwasmpooltypes.GetPoolShareDenom(pool.GetId())
+	tokensIn := sdk.NewCoins(sdk.NewInt64Coin(apptesting.DefaultTransmuterDenomA, 1_000), sdk.NewInt64Coin(apptesting.DefaultTransmuterDenomB, 3_000))
+	s.FundAcc(sender, tokensIn)
+
+	// the pool mints one share per token joined.
+	sharesOut, err := s.App.PoolManagerKeeper.JoinPool(s.Ctx, sender, pool.GetId(), tokensIn, sdk.NewInt(4_000))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(shareDenom, 4_000), sharesOut)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 4_000)), s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+	s.Require().Equal(tokensIn, s.App.BankKeeper.GetAllBalances(s.Ctx, poolAddress))
+
+	// exiting half of the shares pays out half of the liquidity.
+	expectedTokensOut := sdk.NewCoins(sdk.NewInt64Coin(apptesting.DefaultTransmuterDenomA, 500), sdk.NewInt64Coin(apptesting.DefaultTransmuterDenomB, 1_500))
+	tokensOut, err := s.App.PoolManagerKeeper.ExitPool(s.Ctx, sender, pool.GetId(), sdk.NewInt(2_000), expectedTokensOut)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTokensOut, tokensOut)
+	s.Require().Equal(expectedTokensOut.Add(sdk.NewInt64Coin(shareDenom, 2_000)), s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+	s.Require().Equal(tokensIn.Sub(expectedTokensOut), s.App.BankKeeper.GetAllBalances(s.Ctx, poolAddress))
+	s.Require().Equal(sdk.NewInt64Coin(shareDenom, 2_000), s.App.BankKeeper.GetSupply(s.Ctx, shareDenom))
+}
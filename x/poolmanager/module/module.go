@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 	"github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
 	gammsimulation "github.com/osmosis-labs/osmosis/v16/x/gamm/simulation"
 	"github.com/osmosis-labs/osmosis/v16/x/poolmanager"
@@ -98,6 +99,11 @@ func NewAppModule(poolmanagerKeeper poolmanager.Keeper, gammKeeper types.PoolMod
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 }
 
+// DeclareInvariants declares the poolmanager invariants to the invariant registry.
+func (am AppModule) DeclareInvariants(r *invariants.Registry) {
+	poolmanager.DeclareInvariants(r, am.k)
+}
+
 func (am AppModule) Route() sdk.Route {
 	return sdk.Route{}
 }
@@ -133,3 +133,12 @@ type InactivePoolError struct {
 func (e InactivePoolError) Error() string {
 	return fmt.Sprintf("Pool %d is not active.", e.PoolId)
 }
+
+type JoinExitUnsupportedError struct {
+	PoolType PoolType
+	PoolId   uint64
+}
+
+func (e JoinExitUnsupportedError) Error() string {
+	return fmt.Sprintf("pool %d of type (%s) does not support joining and exiting through the poolmanager", e.PoolId, PoolType_name[int32(e.PoolType)])
+}
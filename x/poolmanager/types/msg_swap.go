@@ -0,0 +1,86 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// SwapMsgRoute defines a simple interface for getting the token denoms on a swap message route.
+type SwapMsgRoute interface {
+	TokenInDenom() string
+	TokenOutDenom() string
+	TokenDenomsOnPath() []string
+}
+
+// MultiSwapMsgRoute defines an interface for swap messages that are composed of
+// several independent routes, such as the split route swap messages.
+type MultiSwapMsgRoute interface {
+	GetSwapMsgs() []SwapMsgRoute
+}
+
+var (
+	_ SwapMsgRoute      = MsgSwapExactAmountOut{}
+	_ SwapMsgRoute      = MsgSwapExactAmountIn{}
+	_ MultiSwapMsgRoute = MsgSplitRouteSwapExactAmountIn{}
+	_ MultiSwapMsgRoute = MsgSplitRouteSwapExactAmountOut{}
+)
+
+func (msg MsgSwapExactAmountOut) TokenInDenom() string {
+	return msg.Routes[0].GetTokenInDenom()
+}
+
+func (msg MsgSwapExactAmountOut) TokenOutDenom() string {
+	return msg.TokenOut.Denom
+}
+
+func (msg MsgSwapExactAmountOut) TokenDenomsOnPath() []string {
+	denoms := make([]string, 0, len(msg.Routes)+1)
+	for i := 0; i < len(msg.Routes); i++ {
+		denoms = append(denoms, msg.Routes[i].TokenInDenom)
+	}
+	denoms = append(denoms, msg.TokenOutDenom())
+	return denoms
+}
+
+func (msg MsgSwapExactAmountIn) TokenInDenom() string {
+	return msg.TokenIn.Denom
+}
+
+func (msg MsgSwapExactAmountIn) TokenOutDenom() string {
+	lastRouteIndex := len(msg.Routes) - 1
+	return msg.Routes[lastRouteIndex].GetTokenOutDenom()
+}
+
+func (msg MsgSwapExactAmountIn) TokenDenomsOnPath() []string {
+	denoms := make([]string, 0, len(msg.Routes)+1)
+	denoms = append(denoms, msg.TokenInDenom())
+	for i := 0; i < len(msg.Routes); i++ {
+		denoms = append(denoms, msg.Routes[i].TokenOutDenom)
+	}
+	return denoms
+}
+
+// GetSwapMsgs returns one single-route swap message per split route.
+// Only the denoms of the returned messages are meaningful.
+func (msg MsgSplitRouteSwapExactAmountIn) GetSwapMsgs() []SwapMsgRoute {
+	swapMsgs := make([]SwapMsgRoute, 0, len(msg.Routes))
+	for _, route := range msg.Routes {
+		swapMsgs = append(swapMsgs, MsgSwapExactAmountIn{
+			Sender:  msg.Sender,
+			Routes:  route.Pools,
+			TokenIn: sdk.Coin{Denom: msg.TokenInDenom, Amount: route.TokenInAmount},
+		})
+	}
+	return swapMsgs
+}
+
+// GetSwapMsgs returns one single-route swap message per split route.
+// Only the denoms of the returned messages are meaningful.
+func (msg MsgSplitRouteSwapExactAmountOut) GetSwapMsgs() []SwapMsgRoute {
+	swapMsgs := make([]SwapMsgRoute, 0, len(msg.Routes))
+	for _, route := range msg.Routes {
+		swapMsgs = append(swapMsgs, MsgSwapExactAmountOut{
+			Sender:   msg.Sender,
+			Routes:   route.Pools,
+			TokenOut: sdk.Coin{Denom: msg.TokenOutDenom, Amount: route.TokenOutAmount},
+		})
+	}
+	return swapMsgs
+}
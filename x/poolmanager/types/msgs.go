@@ -12,6 +12,8 @@ const (
 	TypeMsgSwapExactAmountOut           = "swap_exact_amount_out"
 	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
 	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
+	TypeMsgJoinPool                     = "join_pool"
+	TypeMsgExitPool                     = "exit_pool"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -166,3 +168,69 @@ func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgJoinPool{}
+
+func (msg MsgJoinPool) Route() string { return RouterKey }
+func (msg MsgJoinPool) Type() string  { return TypeMsgJoinPool }
+func (msg MsgJoinPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if !msg.TokensIn.IsValid() || !msg.TokensIn.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokensIn.String())
+	}
+
+	if msg.ShareOutMinAmount.IsNil() || msg.ShareOutMinAmount.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "share out min amount must not be negative, was (%s)", msg.ShareOutMinAmount)
+	}
+
+	return nil
+}
+
+func (msg MsgJoinPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgJoinPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgExitPool{}
+
+func (msg MsgExitPool) Route() string { return RouterKey }
+func (msg MsgExitPool) Type() string  { return TypeMsgExitPool }
+func (msg MsgExitPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if msg.ShareInAmount.IsNil() || !msg.ShareInAmount.IsPositive() {
+		return nonPositiveAmountError{msg.ShareInAmount.String()}
+	}
+
+	if !msg.TokenOutMins.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenOutMins.String())
+	}
+
+	return nil
+}
+
+func (msg MsgExitPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExitPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -94,6 +94,18 @@ type PoolModuleI interface {
 	ValidatePermissionlessPoolCreationEnabled(ctx sdk.Context) error
 }
 
+// JoinExitPoolModuleI is the interface that must be fulfilled by the pool modules
+// whose pools can be joined and exited through the poolmanager.
+type JoinExitPoolModuleI interface {
+	// JoinPool adds tokensIn to the pool's liquidity and mints the resulting pool shares to the sender.
+	// Returns error if fewer than shareOutMinAmount shares would be minted.
+	JoinPool(ctx sdk.Context, sender sdk.AccAddress, pool PoolI, tokensIn sdk.Coins, shareOutMinAmount sdk.Int) (shareOut sdk.Coin, err error)
+
+	// ExitPool burns shareInAmount of the sender's pool shares and sends the corresponding liquidity to the sender.
+	// Returns error if any of the tokens sent out would be less than its amount in tokenOutMins.
+	ExitPool(ctx sdk.Context, sender sdk.AccAddress, pool PoolI, shareInAmount sdk.Int, tokenOutMins sdk.Coins) (tokensOut sdk.Coins, err error)
+}
+
 type PoolIncentivesKeeperI interface {
 	IsPoolIncentivized(ctx sdk.Context, poolId uint64) bool
 }
@@ -88,13 +88,22 @@ func (k Keeper) UpdateHighestLiquidityPools(ctx sdk.Context, baseDenomPools map[
 	}
 
 	for _, pool := range pools {
+		// Pool must be active. Inactive pools, e.g. cosmwasm pools disabled by their
+		// circuit breaker, are skipped before querying their liquidity.
+		if !pool.IsActive(ctx) {
+			continue
+		}
+
 		coins, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, pool.GetId())
 		if err != nil {
-			return err
+			// A single pool failing to return its liquidity should not prevent
+			// the highest liquidity pools from being updated.
+			ctx.Logger().Error("Protorev error getting pool liquidity in UpdateHighestLiquidityPools", "pool_id", pool.GetId(), "error", err)
+			continue
 		}
 
-		// Pool must be active and the number of coins must be 2
-		if pool.IsActive(ctx) && len(coins) == 2 {
+		// The number of coins must be 2
+		if len(coins) == 2 {
 			tokenA := coins[0]
 			tokenB := coins[1]
 
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
+)
+
+const developerFeesInvariantName = "module-balance-covers-developer-fees"
+
+// DeclareInvariants declares all protorev invariants to the invariant registry.
+func DeclareInvariants(r *invariants.Registry, k Keeper) {
+	r.Register(types.ModuleName, developerFeesInvariantName, invariants.Cheap, DeveloperFeesInvariant(k))
+}
+
+// DeveloperFeesInvariant checks that the module account balance covers the developer fees that
+// have not been paid out yet.
+func DeveloperFeesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		fees, err := k.GetAllDeveloperFees(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, developerFeesInvariantName,
+				fmt.Sprintf("\tdeveloper fees retrieval failed: %s\n", err)), true
+		}
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		for _, fee := range fees {
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddr, fee.Denom)
+			if balance.IsLT(fee) {
+				return sdk.FormatInvariant(types.ModuleName, developerFeesInvariantName,
+					fmt.Sprintf("\tdeveloper fees: %s\n\tmodule balance: %s\n", fee, balance)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, developerFeesInvariantName,
+			"\tmodule balance covers the developer fees\n"), false
+	}
+}
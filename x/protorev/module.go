@@ -17,8 +17,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
+	osmosimtypes "github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
 	"github.com/osmosis-labs/osmosis/v16/x/protorev/client/cli"
 	"github.com/osmosis-labs/osmosis/v16/x/protorev/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/simulation"
 	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
 )
 
@@ -145,6 +148,11 @@ func (a AppModuleBasic) RegisterRESTRoutes(ctx client.Context, r *mux.Router) {
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
+// DeclareInvariants declares the invariants of the module to the invariant registry.
+func (am AppModule) DeclareInvariants(r *invariants.Registry) {
+	keeper.DeclareInvariants(r, am.keeper)
+}
+
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
 	var genState types.GenesisState
@@ -172,3 +180,25 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
+
+// ___________________________________________________________________________
+
+// AppModuleSimulationV2 functions
+
+// SimulatorGenesisState sets the default genesis of the protorev module, with the simulation bond denom as an
+// additional base denom since the simulation pools hold no uosmo.
+func (am AppModule) SimulatorGenesisState(simState *module.SimulationState, s *osmosimtypes.SimCtx) {
+	protorevGen := types.DefaultGenesis()
+	protorevGen.BaseDenoms = append(protorevGen.BaseDenoms, types.BaseDenom{
+		Denom:    sdk.DefaultBondDenom,
+		StepSize: sdk.NewInt(1_000_000),
+	})
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(protorevGen)
+}
+
+// Actions returns the swaps that trigger protorev backruns in the posthandler.
+func (am AppModule) Actions() []osmosimtypes.Action {
+	return []osmosimtypes.Action{
+		osmosimtypes.NewMsgBasedAction("SwapExactAmountIn backrun by protorev", am.keeper, simulation.RandomMsgSwapExactAmountIn),
+	}
+}
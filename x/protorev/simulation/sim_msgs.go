@@ -0,0 +1,99 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	osmosimtypes "github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/keeper"
+)
+
+// backrunnableSwap is a swap through a pool for which protorev can build a cyclic arbitrage route.
+type backrunnableSwap struct {
+	poolId   uint64
+	tokenIn  string
+	tokenOut string
+}
+
+// RandomMsgSwapExactAmountIn swaps a random amount through a random pool that protorev can backrun, i.e. a pool
+// whose two denoms are both paired with one of the base denoms, so that the posthandler builds routes for it.
+func RandomMsgSwapExactAmountIn(k keeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*poolmanagertypes.MsgSwapExactAmountIn, error) {
+	swaps, err := getBackrunnableSwaps(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(swaps) == 0 {
+		return nil, errors.New("no pool can be backrun by protorev")
+	}
+
+	swap := swaps[sim.GetRand().Intn(len(swaps))]
+	sender, accCoinIn, senderExists := sim.SelAddrWithDenom(ctx, swap.tokenIn)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denom %s exists", swap.tokenIn)
+	}
+
+	tokenIn := sim.RandSubsetCoins(sdk.NewCoins(accCoinIn))
+	if tokenIn.Empty() {
+		return nil, fmt.Errorf("no amount of %s to swap", swap.tokenIn)
+	}
+
+	msg := &poolmanagertypes.MsgSwapExactAmountIn{
+		Sender: sender.Address.String(),
+		Routes: []poolmanagertypes.SwapAmountInRoute{{
+			PoolId:        swap.poolId,
+			TokenOutDenom: swap.tokenOut,
+		}},
+		TokenIn:           tokenIn[0],
+		TokenOutMinAmount: sdk.OneInt(),
+	}
+
+	// the amount may exceed what the pool can swap
+	if err := sim.CheckMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// getBackrunnableSwaps returns every swap direction of the two asset pools that protorev can route around.
+func getBackrunnableSwaps(k keeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) ([]backrunnableSwap, error) {
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := sim.PoolManagerKeeper().AllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isPairedWithBase := func(baseDenom, denom string) bool {
+		_, err := k.GetPoolForDenomPair(ctx, baseDenom, denom)
+		return err == nil
+	}
+
+	swaps := []backrunnableSwap{}
+	for _, pool := range pools {
+		denoms, err := sim.PoolManagerKeeper().RouteGetPoolDenoms(ctx, pool.GetId())
+		if err != nil || len(denoms) != 2 {
+			continue
+		}
+
+		for _, baseDenom := range baseDenoms {
+			if !isPairedWithBase(baseDenom.Denom, denoms[0]) || !isPairedWithBase(baseDenom.Denom, denoms[1]) {
+				continue
+			}
+			swaps = append(swaps,
+				backrunnableSwap{poolId: pool.GetId(), tokenIn: denoms[0], tokenOut: denoms[1]},
+				backrunnableSwap{poolId: pool.GetId(), tokenIn: denoms[1], tokenOut: denoms[0]},
+			)
+			break
+		}
+	}
+
+	return swaps, nil
+}
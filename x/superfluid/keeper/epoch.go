@@ -9,7 +9,6 @@ import (
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
-	cl "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
 	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/model"
 	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
@@ -158,7 +157,7 @@ func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.Sup
 			Liquidity: fullRangeLiquidity,
 		}
 		// Note that the returned amounts are rounded up. This should be fine as they both are used for calculating the multiplier.
-		asset0, asset1, err := cl.CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+		asset0, asset1, err := k.clk.CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
 		if err != nil {
 			k.Logger(ctx).Error(err.Error())
 			k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 	"github.com/osmosis-labs/osmosis/v16/x/superfluid/types"
 )
 
@@ -15,6 +16,11 @@ func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
 	ir.RegisterRoute(types.ModuleName, totalSuperfluidDelegationInvariantName, TotalSuperfluidDelegationInvariant(keeper))
 }
 
+// DeclareInvariants declares all superfluid invariants to the invariant registry.
+func DeclareInvariants(r *invariants.Registry, keeper Keeper) {
+	r.Register(types.ModuleName, totalSuperfluidDelegationInvariantName, invariants.Expensive, TotalSuperfluidDelegationInvariant(keeper))
+}
+
 // AllInvariants runs all invariants of the gamm module.
 func AllInvariants(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
@@ -4,7 +4,6 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
-	cl "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity"
 	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v16/x/superfluid/keeper"
@@ -256,7 +255,7 @@ func (s *KeeperTestSuite) TestPrepareConcentratedLockForSlash() {
 			liquidityPreSlash := clPool.GetLiquidity()
 
 			// Calculate underlying assets from liquidity getting slashed
-			asset0PreSlash, asset1PreSlash, err := cl.CalculateUnderlyingAssetsFromPosition(s.Ctx, positionPreSlash, clPool)
+			asset0PreSlash, asset1PreSlash, err := s.App.ConcentratedLiquidityKeeper.CalculateUnderlyingAssetsFromPosition(s.Ctx, positionPreSlash, clPool)
 			s.Require().NoError(err)
 
 			slashAmt := positionPreSlash.Liquidity.Mul(tc.slashPercent)
@@ -324,7 +323,7 @@ func (s *KeeperTestSuite) TestPrepareConcentratedLockForSlash() {
 				s.Require().NoError(err)
 				s.Require().Equal(positionPreSlash.Liquidity.Sub(slashAmt).String(), positionPostSlash.Liquidity.String())
 
-				asset0PostSlash, asset1PostSlash, err := cl.CalculateUnderlyingAssetsFromPosition(s.Ctx, positionPostSlash, clPool)
+				asset0PostSlash, asset1PostSlash, err := s.App.ConcentratedLiquidityKeeper.CalculateUnderlyingAssetsFromPosition(s.Ctx, positionPostSlash, clPool)
 				s.Require().NoError(err)
 
 				errTolerance := osmomath.ErrTolerance{
@@ -26,6 +26,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils/invariants"
 	osmosimtypes "github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
 	"github.com/osmosis-labs/osmosis/v16/x/mint/client/rest"
 	"github.com/osmosis-labs/osmosis/v16/x/superfluid/client/cli"
@@ -170,6 +171,11 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper)
 }
 
+// DeclareInvariants declares the module's invariants to the invariant registry.
+func (am AppModule) DeclareInvariants(r *invariants.Registry) {
+	keeper.DeclareInvariants(r, am.keeper)
+}
+
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
@@ -231,6 +237,21 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 }
 
+// Actions returns the superfluid actions on concentrated liquidity positions, run by the simulator next to the
+// legacy weighted operations.
+func (am AppModule) Actions() []osmosimtypes.Action {
+	keepers := simulation.ActionKeepers{
+		Keeper:             am.keeper,
+		LockupKeeper:       am.lockupKeeper,
+		ConcentratedKeeper: am.concentratedKeeper,
+	}
+	return []osmosimtypes.Action{
+		osmosimtypes.NewMsgBasedAction("CreateFullRangePositionAndSuperfluidDelegate", keepers, simulation.RandomMsgCreateFullRangePositionAndSuperfluidDelegate),
+		osmosimtypes.NewMsgBasedAction("AddToConcentratedLiquiditySuperfluidPosition", keepers, simulation.RandomMsgAddToConcentratedLiquiditySuperfluidPosition),
+		osmosimtypes.NewMsgBasedAction("SuperfluidUndelegateAndUnbondLock", keepers, simulation.RandomMsgSuperfluidUndelegateAndUnbondLock),
+	}
+}
+
 func (am AppModule) ConsensusVersion() uint64 {
 	return 1
 }
@@ -107,6 +107,16 @@ func SimulateMsgSuperfluidDelegate(ak stakingtypes.AccountKeeper, bk osmosimtype
 				types.ModuleName, types.TypeMsgSuperfluidDelegate, "Lock is already used for superfluid staking"), nil, nil
 		}
 
+		if synthLock, err := lk.GetSyntheticLockupByUnderlyingLockId(ctx, lock.ID); err == nil && synthLock != (lockuptypes.SyntheticLock{}) {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidDelegate, "Lock is superfluid unbonding"), nil, nil
+		}
+
+		if lock.IsUnlocking() {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidDelegate, "Lock is unlocking"), nil, nil
+		}
+
 		msg := types.MsgSuperfluidDelegate{
 			Sender:  lock.Owner,
 			LockId:  lock.ID,
@@ -0,0 +1,208 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	osmosimtypes "github.com/osmosis-labs/osmosis/v16/simulation/simtypes"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v16/x/lockup/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/superfluid/types"
+)
+
+// ActionKeepers are the keepers the superfluid simulator actions read from.
+type ActionKeepers struct {
+	Keeper             keeper.Keeper
+	LockupKeeper       types.LockupKeeper
+	ConcentratedKeeper types.ConcentratedKeeper
+}
+
+// RandomMsgCreateFullRangePositionAndSuperfluidDelegate creates a full range position in a random concentrated pool
+// of the bond denom, and superfluid delegates it to a random bonded validator.
+func RandomMsgCreateFullRangePositionAndSuperfluidDelegate(k ActionKeepers, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*types.MsgCreateFullRangePositionAndSuperfluidDelegate, error) {
+	poolId, poolDenoms, err := getRandSuperfluidConcentratedPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, tokens, senderExists := sim.SelAddrWithDenoms(ctx, poolDenoms)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denoms %s exists", poolDenoms)
+	}
+
+	// full range positions straddle the current tick
+	if len(tokens) < 2 {
+		return nil, fmt.Errorf("user does not have pool tokens")
+	}
+
+	validator, err := getRandBondedValidator(sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgCreateFullRangePositionAndSuperfluidDelegate{
+		Sender:  sender.Address.String(),
+		Coins:   tokens,
+		ValAddr: validator.OperatorAddress,
+		PoolId:  poolId,
+	}
+
+	// delegating depends on the multiplier of the pool shares and on the validator
+	if err := sim.CheckMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// RandomMsgAddToConcentratedLiquiditySuperfluidPosition adds random amounts of the pool tokens of its owner
+// to a random superfluid staked position.
+func RandomMsgAddToConcentratedLiquiditySuperfluidPosition(k ActionKeepers, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*types.MsgAddToConcentratedLiquiditySuperfluidPosition, error) {
+	lock, positionId, err := getRandSuperfluidConcentratedLock(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := k.ConcentratedKeeper.GetPosition(ctx, positionId)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := k.ConcentratedKeeper.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := sim.RandCoinSubset(ctx, lock.OwnerAddress(), []string{pool.GetToken0(), pool.GetToken1()})
+	if len(tokens) < 2 {
+		return nil, fmt.Errorf("lock owner does not have pool tokens")
+	}
+
+	msg := &types.MsgAddToConcentratedLiquiditySuperfluidPosition{
+		PositionId:    positionId,
+		Sender:        lock.Owner,
+		TokenDesired0: sdk.NewCoin(pool.GetToken0(), tokens.AmountOf(pool.GetToken0())),
+		TokenDesired1: sdk.NewCoin(pool.GetToken1(), tokens.AmountOf(pool.GetToken1())),
+	}
+
+	// the position is withdrawn and re-created, which is not allowed for the last position of the pool
+	if err := sim.CheckMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// RandomMsgSuperfluidUndelegateAndUnbondLock undelegates a random superfluid staked position, and starts
+// unbonding its lock.
+func RandomMsgSuperfluidUndelegateAndUnbondLock(k ActionKeepers, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*types.MsgSuperfluidUndelegateAndUnbondLock, error) {
+	lock, _, err := getRandSuperfluidConcentratedLock(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgSuperfluidUndelegateAndUnbondLock{
+		Sender: lock.Owner,
+		LockId: lock.ID,
+		Coin:   lock.Coins[0],
+	}
+
+	if err := sim.CheckMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// getRandSuperfluidConcentratedPool returns a random concentrated pool of the bond denom, with its denoms.
+// The shares of the pool are made a superfluid asset the first time, as governance would.
+func getRandSuperfluidConcentratedPool(k ActionKeepers, sim *osmosimtypes.SimCtx, ctx sdk.Context) (uint64, []string, error) {
+	bondDenom := sim.StakingKeeper().BondDenom(ctx)
+	pools, err := sim.PoolManagerKeeper().AllPools(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var poolIds []uint64
+	for _, pool := range pools {
+		if pool.GetType() != poolmanagertypes.Concentrated {
+			continue
+		}
+		denoms, err := sim.PoolManagerKeeper().RouteGetPoolDenoms(ctx, pool.GetId())
+		if err != nil {
+			return 0, nil, err
+		}
+		if osmoutils.Contains(denoms, bondDenom) {
+			poolIds = append(poolIds, pool.GetId())
+		}
+	}
+
+	if len(poolIds) == 0 {
+		return 0, nil, fmt.Errorf("no concentrated pool of denom %s", bondDenom)
+	}
+
+	poolId := poolIds[sim.GetRand().Intn(len(poolIds))]
+	denom := cltypes.GetConcentratedLockupDenomFromPoolId(poolId)
+	if _, err := k.Keeper.GetSuperfluidAsset(ctx, denom); err != nil {
+		// the multiplier of the asset is backed by the full range liquidity of the pool, which it may not have yet
+		asset := types.SuperfluidAsset{Denom: denom, AssetType: types.SuperfluidAssetTypeConcentratedShare}
+		if err := k.Keeper.AddNewSuperfluidAsset(ctx, asset); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	denoms, err := sim.PoolManagerKeeper().RouteGetPoolDenoms(ctx, poolId)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return poolId, denoms, nil
+}
+
+// getRandSuperfluidConcentratedLock returns a random superfluid staked concentrated liquidity lock
+// of a simulation account, with the id of its position.
+func getRandSuperfluidConcentratedLock(k ActionKeepers, sim *osmosimtypes.SimCtx, ctx sdk.Context) (lockuptypes.PeriodLock, uint64, error) {
+	var locks []lockuptypes.PeriodLock
+	for _, asset := range k.Keeper.GetAllSuperfluidAssets(ctx) {
+		if asset.AssetType != types.SuperfluidAssetTypeConcentratedShare {
+			continue
+		}
+		for _, lock := range k.LockupKeeper.GetLocksLongerThanDurationDenom(ctx, asset.Denom, 0) {
+			if _, found := sim.FindAccount(lock.OwnerAddress()); !found {
+				continue
+			}
+			if k.Keeper.GetLockIdIntermediaryAccountConnection(ctx, lock.ID).Empty() {
+				continue
+			}
+			locks = append(locks, lock)
+		}
+	}
+
+	if len(locks) == 0 {
+		return lockuptypes.PeriodLock{}, 0, errors.New("no superfluid staked concentrated liquidity lock")
+	}
+
+	lock := locks[sim.GetRand().Intn(len(locks))]
+	positionId, err := k.ConcentratedKeeper.GetPositionIdToLockId(ctx, lock.ID)
+	if err != nil {
+		return lockuptypes.PeriodLock{}, 0, err
+	}
+
+	return lock, positionId, nil
+}
+
+// getRandBondedValidator returns a random bonded validator.
+func getRandBondedValidator(sim *osmosimtypes.SimCtx, ctx sdk.Context) (stakingtypes.Validator, error) {
+	validators := sim.StakingKeeper().GetBondedValidatorsByPower(ctx)
+	if len(validators) == 0 {
+		return stakingtypes.Validator{}, errors.New("no bonded validator")
+	}
+
+	return validators[sim.GetRand().Intn(len(validators))], nil
+}
@@ -117,4 +117,5 @@ type ConcentratedKeeper interface {
 	PositionHasActiveUnderlyingLock(ctx sdk.Context, positionId uint64) (bool, uint64, error)
 	HasAnyPositionForPool(ctx sdk.Context, poolId uint64) (bool, error)
 	WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error)
+	CalculateUnderlyingAssetsFromPosition(ctx sdk.Context, position model.Position, pool cltypes.ConcentratedPoolExtension) (sdk.Coin, sdk.Coin, error)
 }
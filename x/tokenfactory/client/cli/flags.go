@@ -0,0 +1,13 @@
+package cli
+
+const (
+	FlagFrozen           = "frozen"
+	FlagAllowlistEnabled = "allowlist-enabled"
+	FlagAddAddresses     = "add"
+	FlagRemoveAddresses  = "remove"
+
+	FlagMaxSupply          = "max-supply"
+	FlagMaxSupplyImmutable = "max-supply-immutable"
+	FlagRateLimitAmount    = "rate-limit-amount"
+	FlagRateLimitWindow    = "rate-limit-window"
+)
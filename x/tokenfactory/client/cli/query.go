@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	// "github.com/cosmos/cosmos-sdk/client/flags"
 	// sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomAuthorityMetadata)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomsFromCreator)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomSendPolicy)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomAddressList)
 
 	cmd.AddCommand(
 		osmocli.GetParams[*types.QueryParamsRequest](
@@ -48,6 +51,31 @@ func GetCmdDenomsFromCreator() (*osmocli.QueryDescriptor, *types.QueryDenomsFrom
 	}, &types.QueryDenomsFromCreatorRequest{}
 }
 
+func GetCmdDenomSendPolicy() (*osmocli.QueryDescriptor, *types.QueryDenomSendPolicyRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-send-policy [denom] [flags]",
+		Short: "Get the native send policy for a specific denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} factory/osmo1.../mytoken`,
+	}, &types.QueryDenomSendPolicyRequest{}
+}
+
+func GetCmdDenomAddressList() (*osmocli.QueryDescriptor, *types.QueryDenomAddressListRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-address-list [denom] [denylist|allowlist] [flags]",
+		Short: "Returns the addresses in the denylist or the allowlist of a specific denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} factory/osmo1.../mytoken denylist`,
+		HasPagination:      true,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{"List": parseAddressList},
+	}, &types.QueryDenomAddressListRequest{}
+}
+
+func parseAddressList(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	list, err := types.AddressListFromString(arg)
+	return list, osmocli.UsedArg, err
+}
+
 // GetCmdDenomAuthorityMetadata returns the authority metadata for a queried denom
 func GetCmdDenomBeforeSendHook() *cobra.Command {
 	cmd := &cobra.Command{
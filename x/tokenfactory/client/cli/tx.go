@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	// "github.com/cosmos/cosmos-sdk/client/flags"
@@ -21,6 +24,11 @@ func GetTxCmd() *cobra.Command {
 		// NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewSetBeforeSendHookCmd(),
+		NewSetDenomSendPolicyCmd(),
+		NewUpdateDenomAddressListCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewSetMintLimitsCmd(),
 	)
 
 	return cmd
@@ -36,14 +44,14 @@ func NewCreateDenomCmd() *cobra.Command {
 func NewMintCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgMint](&osmocli.TxCliDesc{
 		Use:   "mint [amount] [flags]",
-		Short: "Mint a denom to an address. Must have admin authority to do so.",
+		Short: "Mint a denom to an address. Must have the minter role to do so.",
 	})
 }
 
 func NewBurnCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgBurn](&osmocli.TxCliDesc{
 		Use:   "burn [amount] [flags]",
-		Short: "Burn tokens from an address. Must have admin authority to do so.",
+		Short: "Burn tokens from an address. Must have the burner role to do so.",
 	})
 }
 
@@ -58,7 +66,7 @@ func NewChangeAdminCmd() *cobra.Command {
 func NewSetBeforeSendHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-beforesend-hook [denom] [cosmwasm-address] [flags]",
-		Short: "Set a cosmwasm contract to be the beforesend hook for a factory-created denom. Must have admin authority to do so.",
+		Short: "Set a cosmwasm contract to be the beforesend hook for a factory-created denom. Must have the hook manager role to do so.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -81,3 +89,221 @@ func NewSetBeforeSendHookCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetDenomSendPolicyCmd broadcast MsgSetDenomSendPolicy
+func NewSetDenomSendPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-send-policy [denom] [flags]",
+		Short: "Set the native send policy of a factory-created denom, freezing it or enabling its allowlist. Must have the hook manager role to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			frozen, err := cmd.Flags().GetBool(FlagFrozen)
+			if err != nil {
+				return err
+			}
+			allowlistEnabled, err := cmd.Flags().GetBool(FlagAllowlistEnabled)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomSendPolicy(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.DenomSendPolicy{Frozen: frozen, AllowlistEnabled: allowlistEnabled},
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagFrozen, false, "Block every send of the denom")
+	cmd.Flags().Bool(FlagAllowlistEnabled, false, "Only allow sends of the denom between addresses of its allowlist")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateDenomAddressListCmd broadcast MsgUpdateDenomAddressList
+func NewUpdateDenomAddressListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-denom-address-list [denom] [denylist|allowlist] [flags]",
+		Short: "Add addresses to, or remove addresses from, the denylist or the allowlist of a factory-created denom. Must have the hook manager role to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			list, err := types.AddressListFromString(args[1])
+			if err != nil {
+				return err
+			}
+			addAddresses, err := cmd.Flags().GetStringSlice(FlagAddAddresses)
+			if err != nil {
+				return err
+			}
+			removeAddresses, err := cmd.Flags().GetStringSlice(FlagRemoveAddresses)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateDenomAddressList(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				list,
+				addAddresses,
+				removeAddresses,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAddAddresses, []string{}, "Comma separated addresses to add to the list")
+	cmd.Flags().StringSlice(FlagRemoveAddresses, []string{}, "Comma separated addresses to remove from the list")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [minter|burner|metadata_manager|hook_manager] [address] [flags]",
+		Short: "Grant a role over a factory-created denom to an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			role, err := types.RoleFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				role,
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [minter|burner|metadata_manager|hook_manager] [address] [flags]",
+		Short: "Revoke a role over a factory-created denom from an address. Must have admin authority, or be the role holder, to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			role, err := types.RoleFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				role,
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMintLimitsCmd broadcast MsgSetMintLimits
+func NewSetMintLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-limits [denom] [flags]",
+		Short: "Cap the supply of a factory-created denom, and rate limit its mints. Once set, the max supply can only be lowered. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, err := parseIntFlag(cmd, FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			maxSupplyImmutable, err := cmd.Flags().GetBool(FlagMaxSupplyImmutable)
+			if err != nil {
+				return err
+			}
+			rateLimitAmount, err := parseIntFlag(cmd, FlagRateLimitAmount)
+			if err != nil {
+				return err
+			}
+			rateLimitWindow, err := cmd.Flags().GetDuration(FlagRateLimitWindow)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintLimits(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.MintLimits{
+					MaxSupply:          maxSupply,
+					MaxSupplyImmutable: maxSupplyImmutable,
+					RateLimitAmount:    rateLimitAmount,
+					RateLimitWindow:    rateLimitWindow,
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMaxSupply, "0", "Maximum total supply of the denom, 0 for no cap")
+	cmd.Flags().Bool(FlagMaxSupplyImmutable, false, "Prevent the max supply from ever being changed again")
+	cmd.Flags().String(FlagRateLimitAmount, "0", "Maximum amount of the denom that can be minted per rate limit window, 0 for no rate limit")
+	cmd.Flags().Duration(FlagRateLimitWindow, 0, "Duration of the rate limit windows, e.g. 24h")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseIntFlag(cmd *cobra.Command, flag string) (sdk.Int, error) {
+	s, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	i, ok := sdk.NewIntFromString(s)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid %s: %s", flag, s)
+	}
+	return i, nil
+}
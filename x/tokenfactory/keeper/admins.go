@@ -44,6 +44,55 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 	}
 
 	metadata.Admin = admin
+	// renouncing the admin revokes the granted roles as well, so that the denom becomes immutable.
+	if admin == "" {
+		for _, role := range types.GrantableRoles() {
+			metadata.SetRoleHolders(role, nil)
+		}
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// grantRole grants a role over a denom to an address.
+func (k Keeper) grantRole(ctx sdk.Context, denom string, role types.Role, address string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	for _, holder := range metadata.GetRoleHolders(role) {
+		if holder == address {
+			return types.ErrInvalidRole.Wrapf("%s already has the %s role over %s", address, role, denom)
+		}
+	}
+	metadata.SetRoleHolders(role, append(metadata.GetRoleHolders(role), address))
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// revokeRole revokes a role over a denom from an address.
+// The admin can't be revoked a role, as it implicitly holds all of them.
+func (k Keeper) revokeRole(ctx sdk.Context, denom string, role types.Role, address string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	holders := metadata.GetRoleHolders(role)
+	newHolders := make([]string, 0, len(holders))
+	for _, holder := range holders {
+		if holder != address {
+			newHolders = append(newHolders, holder)
+		}
+	}
+	if len(newHolders) == len(holders) {
+		return types.ErrInvalidRole.Wrapf("%s doesn't have the %s role over %s", address, role, denom)
+	}
+	if len(newHolders) == 0 {
+		newHolders = nil
+	}
+	metadata.SetRoleHolders(role, newHolders)
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
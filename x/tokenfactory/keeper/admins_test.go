@@ -532,3 +532,172 @@ func (s *KeeperTestSuite) TestSetDenomMetaData() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestBurnerCannotBurnFromOthers() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	ctx := sdk.WrapSDKContext(s.Ctx)
+	admin := s.TestAccs[0].String()
+	burner := s.TestAccs[1].String()
+	holder := s.TestAccs[2].String()
+
+	_, err := s.msgServer.Mint(ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(s.defaultDenom, 10), burner))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(s.defaultDenom, 10), holder))
+	s.Require().NoError(err)
+	_, err = s.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, s.defaultDenom, types.Role_BURNER, burner))
+	s.Require().NoError(err)
+
+	// a burner can't claw back the tokens of another holder
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurnFrom(burner, sdk.NewInt64Coin(s.defaultDenom, 5), holder))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	s.Require().Equal(int64(10), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[2], s.defaultDenom).Amount.Int64())
+
+	// but can burn its own tokens, explicitly or not
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurnFrom(burner, sdk.NewInt64Coin(s.defaultDenom, 5), burner))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(burner, sdk.NewInt64Coin(s.defaultDenom, 2)))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(3), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], s.defaultDenom).Amount.Int64())
+
+	// while the admin can burn from another holder
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(s.defaultDenom, 5), holder))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(5), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[2], s.defaultDenom).Amount.Int64())
+}
+
+func (s *KeeperTestSuite) TestRenouncingAdminRevokesRoles() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	ctx := sdk.WrapSDKContext(s.Ctx)
+	admin := s.TestAccs[0].String()
+	holder := s.TestAccs[1].String()
+
+	for _, role := range types.GrantableRoles() {
+		_, err := s.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, s.defaultDenom, role, holder))
+		s.Require().NoError(err)
+	}
+	_, err := s.msgServer.Mint(ctx, types.NewMsgMintTo(holder, sdk.NewInt64Coin(s.defaultDenom, 10), holder))
+	s.Require().NoError(err)
+
+	// changing the admin to another address keeps the roles
+	_, err = s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin, s.defaultDenom, s.TestAccs[2].String()))
+	s.Require().NoError(err)
+	queryRes, err := s.queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	for _, role := range types.GrantableRoles() {
+		s.Require().Equal([]string{holder}, queryRes.AuthorityMetadata.GetRoleHolders(role))
+	}
+
+	// renouncing the admin revokes them
+	_, err = s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(s.TestAccs[2].String(), s.defaultDenom, ""))
+	s.Require().NoError(err)
+	queryRes, err = s.queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	for _, role := range types.GrantableRoles() {
+		s.Require().Empty(queryRes.AuthorityMetadata.GetRoleHolders(role))
+	}
+
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMintTo(holder, sdk.NewInt64Coin(s.defaultDenom, 10), holder))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(holder, sdk.NewInt64Coin(s.defaultDenom, 1)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestRoleMsgs() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	ctx := sdk.WrapSDKContext(s.Ctx)
+	admin := s.TestAccs[0].String()
+	holder := s.TestAccs[1].String()
+	other := s.TestAccs[2].String()
+
+	metadata := banktypes.Metadata{
+		Description: "yeehaw",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: s.defaultDenom, Exponent: 0}},
+		Base:        s.defaultDenom,
+		Display:     s.defaultDenom,
+		Name:        s.defaultDenom,
+		Symbol:      "TOKEN",
+	}
+
+	// calls msg server handlers requiring the given role, from the given sender
+	callWithRole := func(role types.Role, sender string) error {
+		var err error
+		switch role {
+		case types.Role_MINTER:
+			_, err = s.msgServer.Mint(ctx, types.NewMsgMintTo(sender, sdk.NewInt64Coin(s.defaultDenom, 10), sender))
+		case types.Role_BURNER:
+			_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(sender, sdk.NewInt64Coin(s.defaultDenom, 1)))
+		case types.Role_METADATA_MANAGER:
+			_, err = s.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(sender, metadata))
+		case types.Role_HOOK_MANAGER:
+			_, err = s.msgServer.SetDenomSendPolicy(ctx, types.NewMsgSetDenomSendPolicy(sender, s.defaultDenom, types.DenomSendPolicy{}))
+		}
+		return err
+	}
+
+	// give some tokens to burn to the holder
+	_, err := s.msgServer.Mint(ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(s.defaultDenom, 10), holder))
+	s.Require().NoError(err)
+
+	for _, role := range types.GrantableRoles() {
+		s.Run(role.String(), func() {
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			ctx = sdk.WrapSDKContext(s.Ctx)
+
+			// the admin implicitly has every role
+			s.Require().NoError(callWithRole(role, admin))
+			s.Require().ErrorIs(callWithRole(role, holder), types.ErrUnauthorized)
+
+			// only the admin can grant roles
+			_, err := s.msgServer.GrantRole(ctx, types.NewMsgGrantRole(holder, s.defaultDenom, role, holder))
+			s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+			_, err = s.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, s.defaultDenom, role, holder))
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeMsgGrantRole, 1)
+			s.Require().NoError(callWithRole(role, holder))
+
+			_, err = s.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, s.defaultDenom, role, holder))
+			s.Require().ErrorIs(err, types.ErrInvalidRole)
+
+			// a role doesn't give any other role
+			for _, otherRole := range types.GrantableRoles() {
+				if otherRole != role {
+					s.Require().ErrorIs(callWithRole(otherRole, holder), types.ErrUnauthorized)
+				}
+			}
+
+			queryRes, err := s.queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: s.defaultDenom})
+			s.Require().NoError(err)
+			s.Require().Equal([]string{holder}, queryRes.AuthorityMetadata.GetRoleHolders(role))
+
+			// only the admin and the holder itself can revoke a role
+			_, err = s.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(other, s.defaultDenom, role, holder))
+			s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+			_, err = s.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(holder, s.defaultDenom, role, holder))
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeMsgRevokeRole, 1)
+			s.Require().ErrorIs(callWithRole(role, holder), types.ErrUnauthorized)
+
+			_, err = s.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, s.defaultDenom, role, holder))
+			s.Require().ErrorIs(err, types.ErrInvalidRole)
+
+			queryRes, err = s.queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: s.defaultDenom})
+			s.Require().NoError(err)
+			s.Require().Empty(queryRes.AuthorityMetadata.GetRoleHolders(role))
+
+			// only the admin can force transfer and change the admin
+			_, err = s.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, s.defaultDenom, role, holder))
+			s.Require().NoError(err)
+			_, err = s.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(holder, sdk.NewInt64Coin(s.defaultDenom, 1), admin, holder))
+			s.Require().ErrorIs(err, types.ErrUnauthorized)
+			_, err = s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(holder, s.defaultDenom, holder))
+			s.Require().ErrorIs(err, types.ErrUnauthorized)
+			_, err = s.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, s.defaultDenom, role, holder))
+			s.Require().NoError(err)
+		})
+	}
+}
@@ -79,8 +79,12 @@ func (h Hooks) TrackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount
 	_ = h.k.callBeforeSendListener(ctx, from, to, amount, false)
 }
 
-// TrackBeforeSend calls the before send listener contract returns any errors
+// BlockBeforeSend checks the native send policies of the sent denoms,
+// then calls the before send listener contract returns any errors
 func (h Hooks) BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if err := h.k.checkSendPolicies(ctx, from, to, amount); err != nil {
+		return err
+	}
 	return h.k.callBeforeSendListener(ctx, from, to, amount, true)
 }
 
@@ -29,6 +29,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		err = k.setSendPolicy(ctx, genDenom.GetDenom(), genDenom.GetSendPolicy())
+		if err != nil {
+			panic(err)
+		}
+		err = k.updateAddressList(ctx, genDenom.GetDenom(), types.AddressList_DENYLIST, genDenom.GetDenylist(), nil)
+		if err != nil {
+			panic(err)
+		}
+		err = k.updateAddressList(ctx, genDenom.GetDenom(), types.AddressList_ALLOWLIST, genDenom.GetAllowlist(), nil)
+		if err != nil {
+			panic(err)
+		}
+		if genDenom.MintWindow != nil {
+			err = k.setMintWindow(ctx, genDenom.GetDenom(), *genDenom.MintWindow)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -45,9 +63,23 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
+		sendPolicy, err := k.GetSendPolicy(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+
+		mintWindow, err := k.GetMintWindow(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+
 		genDenoms = append(genDenoms, types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
+			SendPolicy:        sendPolicy,
+			Denylist:          k.GetAddressList(ctx, denom, types.AddressList_DENYLIST),
+			Allowlist:         k.GetAddressList(ctx, denom, types.AddressList_ALLOWLIST),
+			MintWindow:        mintWindow,
 		})
 	}
 
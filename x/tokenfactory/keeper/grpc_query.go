@@ -4,6 +4,9 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/osmosis-labs/osmosis/v16/x/tokenfactory/types"
 )
@@ -25,7 +28,12 @@ func (k Keeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDeno
 		return nil, err
 	}
 
-	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: authorityMetadata}, nil
+	mintWindow, err := k.GetMintWindow(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: authorityMetadata, MintWindow: mintWindow}, nil
 }
 
 func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
@@ -41,3 +49,30 @@ func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBefor
 
 	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
 }
+
+func (k Keeper) DenomSendPolicy(ctx context.Context, req *types.QueryDenomSendPolicyRequest) (*types.QueryDenomSendPolicyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sendPolicy, err := k.GetSendPolicy(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomSendPolicyResponse{SendPolicy: sendPolicy}, nil
+}
+
+func (k Keeper) DenomAddressList(ctx context.Context, req *types.QueryDenomAddressListRequest) (*types.QueryDenomAddressListResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := k.getAddressListStore(sdkCtx, req.GetDenom(), req.GetList())
+	addresses := []string{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomAddressListResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v16/x/tokenfactory/types"
+)
+
+// GetMintWindow returns the current mint rate limit window of a denom,
+// or nil if no mint happened since its mints are rate limited.
+func (k Keeper) GetMintWindow(ctx sdk.Context, denom string) (*types.MintWindow, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.MintWindowKey))
+	if bz == nil {
+		return nil, nil
+	}
+
+	window := types.MintWindow{}
+	err := proto.Unmarshal(bz, &window)
+	if err != nil {
+		return nil, err
+	}
+	return &window, nil
+}
+
+// setMintWindow stores the current mint rate limit window of a denom.
+func (k Keeper) setMintWindow(ctx sdk.Context, denom string, window types.MintWindow) error {
+	bz, err := proto.Marshal(&window)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.MintWindowKey), bz)
+	return nil
+}
+
+// setMintLimits sets the mint limits of a denom, removing them if they don't restrict anything.
+// It returns an error if the max supply of the denom is raised or removed,
+// or changed at all while immutable.
+func (k Keeper) setMintLimits(ctx sdk.Context, denom string, mintLimits types.MintLimits) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	mintLimits = mintLimits.Normalize()
+	err = types.ValidateMintLimitsUpdate(metadata.MintLimits, mintLimits)
+	if err != nil {
+		return err
+	}
+
+	if mintLimits.IsEmpty() {
+		metadata.MintLimits = nil
+	} else {
+		metadata.MintLimits = &mintLimits
+	}
+
+	// The current window is only relevant to rate limited denoms.
+	if !mintLimits.HasRateLimit() {
+		k.GetDenomPrefixStore(ctx, denom).Delete([]byte(types.MintWindowKey))
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// checkMintLimits returns an error if minting the amount would exceed the max supply
+// or the mint rate limit of the denom. Otherwise, it records the mint in the current
+// rate limit window.
+func (k Keeper) checkMintLimits(ctx sdk.Context, amount sdk.Coin) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	mintLimits := metadata.MintLimits
+	if mintLimits == nil {
+		return nil
+	}
+
+	if mintLimits.HasMaxSupply() {
+		supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+		if supply.Add(amount.Amount).GT(mintLimits.MaxSupply) {
+			return types.ErrMaxSupplyExceeded.Wrapf("supply %s, max supply %s, minting %s", supply, mintLimits.MaxSupply, amount.Amount)
+		}
+	}
+
+	if !mintLimits.HasRateLimit() {
+		return nil
+	}
+
+	window, err := k.GetMintWindow(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	// Start a new window if there is none yet, or if the current one is over.
+	if window == nil || !ctx.BlockTime().Before(window.Start.Add(mintLimits.RateLimitWindow)) {
+		window = &types.MintWindow{Start: ctx.BlockTime(), Minted: sdk.ZeroInt()}
+	}
+
+	minted := window.Minted.Add(amount.Amount)
+	if minted.GT(mintLimits.RateLimitAmount) {
+		return types.ErrMintRateLimitExceeded.Wrapf("minted %s in the current window, rate limit %s per %s, minting %s",
+			window.Minted, mintLimits.RateLimitAmount, mintLimits.RateLimitWindow, amount.Amount)
+	}
+	window.Minted = minted
+
+	return k.setMintWindow(ctx, amount.Denom, *window)
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestMintLimits() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0].String()
+	minter := s.TestAccs[1].String()
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	mint := func(amount int64) error {
+		_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(s.defaultDenom, amount)))
+		return err
+	}
+
+	_, err := s.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, s.defaultDenom, types.Role_MINTER, minter))
+	s.Require().NoError(err)
+	s.Require().NoError(mint(50))
+
+	// only the admin can set mint limits
+	mintLimits := types.MintLimits{
+		MaxSupply:       sdk.NewInt(100),
+		RateLimitAmount: sdk.NewInt(30),
+		RateLimitWindow: time.Hour,
+	}
+	_, err = s.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(minter, s.defaultDenom, mintLimits))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, s.defaultDenom, mintLimits))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeMsgSetMintLimits, 1)
+
+	queryRes, err := s.queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(&mintLimits, queryRes.AuthorityMetadata.MintLimits)
+	s.Require().Nil(queryRes.MintWindow)
+
+	// the rate limit applies within a window
+	s.Require().NoError(mint(20))
+	s.Require().ErrorIs(mint(11), types.ErrMintRateLimitExceeded)
+	s.Require().NoError(mint(10))
+
+	queryRes, err = s.queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.MintWindow{Start: s.Ctx.BlockTime(), Minted: sdk.NewInt(30)}, queryRes.MintWindow)
+
+	// a new window starts once the previous one is over,
+	// while the max supply still applies
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().ErrorIs(mint(21), types.ErrMaxSupplyExceeded)
+	s.Require().NoError(mint(20))
+	s.Require().Equal(sdk.NewInt(100), s.App.BankKeeper.GetSupply(s.Ctx, s.defaultDenom).Amount)
+
+	// burning makes room under the max supply
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(minter, sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, s.defaultDenom, types.Role_BURNER, minter))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(minter, sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+	s.Require().NoError(mint(10))
+}
+
+func (s *KeeperTestSuite) TestSetMintLimits() {
+	for _, tc := range []struct {
+		desc      string
+		current   types.MintLimits
+		updated   types.MintLimits
+		expectErr bool
+	}{
+		{
+			desc:    "set max supply",
+			updated: types.MintLimits{MaxSupply: sdk.NewInt(100)},
+		},
+		{
+			desc:    "lower max supply",
+			current: types.MintLimits{MaxSupply: sdk.NewInt(100)},
+			updated: types.MintLimits{MaxSupply: sdk.NewInt(50)},
+		},
+		{
+			desc:      "raise max supply",
+			current:   types.MintLimits{MaxSupply: sdk.NewInt(100)},
+			updated:   types.MintLimits{MaxSupply: sdk.NewInt(101)},
+			expectErr: true,
+		},
+		{
+			desc:      "remove max supply",
+			current:   types.MintLimits{MaxSupply: sdk.NewInt(100)},
+			updated:   types.MintLimits{},
+			expectErr: true,
+		},
+		{
+			desc:    "make max supply immutable",
+			current: types.MintLimits{MaxSupply: sdk.NewInt(100)},
+			updated: types.MintLimits{MaxSupply: sdk.NewInt(100), MaxSupplyImmutable: true},
+		},
+		{
+			desc:      "lower immutable max supply",
+			current:   types.MintLimits{MaxSupply: sdk.NewInt(100), MaxSupplyImmutable: true},
+			updated:   types.MintLimits{MaxSupply: sdk.NewInt(50), MaxSupplyImmutable: true},
+			expectErr: true,
+		},
+		{
+			desc:      "make max supply mutable again",
+			current:   types.MintLimits{MaxSupply: sdk.NewInt(100), MaxSupplyImmutable: true},
+			updated:   types.MintLimits{MaxSupply: sdk.NewInt(100)},
+			expectErr: true,
+		},
+		{
+			desc:    "change rate limit of immutable max supply",
+			current: types.MintLimits{MaxSupply: sdk.NewInt(100), MaxSupplyImmutable: true},
+			updated: types.MintLimits{MaxSupply: sdk.NewInt(100), MaxSupplyImmutable: true, RateLimitAmount: sdk.NewInt(10), RateLimitWindow: time.Hour},
+		},
+		{
+			desc:    "remove rate limit",
+			current: types.MintLimits{RateLimitAmount: sdk.NewInt(10), RateLimitWindow: time.Hour},
+			updated: types.MintLimits{},
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+			admin := s.TestAccs[0].String()
+			ctx := sdk.WrapSDKContext(s.Ctx)
+
+			_, err := s.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, s.defaultDenom, tc.current))
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, s.defaultDenom, tc.updated))
+			if tc.expectErr {
+				s.Require().ErrorIs(err, types.ErrInvalidMintLimits)
+				return
+			}
+			s.Require().NoError(err)
+
+			metadata, err := s.App.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, s.defaultDenom)
+			s.Require().NoError(err)
+			if tc.updated.IsEmpty() {
+				s.Require().Nil(metadata.MintLimits)
+			} else {
+				s.Require().Equal(tc.updated.Normalize(), *metadata.MintLimits)
+			}
+		})
+	}
+}
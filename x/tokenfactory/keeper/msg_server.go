@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -56,7 +58,7 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.Role_MINTER, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -88,7 +90,7 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.Role_BURNER, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -96,6 +98,11 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		msg.BurnFromAddress = msg.Sender
 	}
 
+	// burning from another account is a clawback, which only the admin can do, like force transfers.
+	if msg.BurnFromAddress != msg.Sender && msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized.Wrapf("only the admin can burn from another account than the sender")
+	}
+
 	accountI := server.Keeper.accountKeeper.GetAccount(ctx, sdk.AccAddress(msg.BurnFromAddress))
 	_, ok := accountI.(authtypes.ModuleAccountI)
 	if ok {
@@ -188,7 +195,7 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.Role_METADATA_MANAGER, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -213,7 +220,7 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.Role_HOOK_MANAGER, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -232,3 +239,153 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
+
+func (server msgServer) SetDenomSendPolicy(goCtx context.Context, msg *types.MsgSetDenomSendPolicy) (*types.MsgSetDenomSendPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.Role_HOOK_MANAGER, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setSendPolicy(ctx, msg.Denom, msg.SendPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomSendPolicy,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(msg.SendPolicy.Frozen)),
+			sdk.NewAttribute(types.AttributeAllowlistEnabled, strconv.FormatBool(msg.SendPolicy.AllowlistEnabled)),
+		),
+	})
+
+	return &types.MsgSetDenomSendPolicyResponse{}, nil
+}
+
+func (server msgServer) UpdateDenomAddressList(goCtx context.Context, msg *types.MsgUpdateDenomAddressList) (*types.MsgUpdateDenomAddressListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.Role_HOOK_MANAGER, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.updateAddressList(ctx, msg.Denom, msg.List, msg.AddAddresses, msg.RemoveAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgUpdateDenomAddressList,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddressList, msg.List.String()),
+			sdk.NewAttribute(types.AttributeAddedAddresses, strings.Join(msg.AddAddresses, ",")),
+			sdk.NewAttribute(types.AttributeRemovedAddresses, strings.Join(msg.RemoveAddresses, ",")),
+		),
+	})
+
+	return &types.MsgUpdateDenomAddressListResponse{}, nil
+}
+
+func (server msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.grantRole(ctx, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgGrantRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeRole, msg.Role.String()),
+			sdk.NewAttribute(types.AttributeAddress, msg.GetAddress()),
+		),
+	})
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// role holders can renounce their own roles
+	if msg.Sender != authorityMetadata.GetAdmin() && msg.Sender != msg.Address {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.revokeRole(ctx, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeRole, msg.Role.String()),
+			sdk.NewAttribute(types.AttributeAddress, msg.GetAddress()),
+		),
+	})
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func (server msgServer) SetMintLimits(goCtx context.Context, msg *types.MsgSetMintLimits) (*types.MsgSetMintLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	mintLimits := msg.MintLimits.Normalize()
+	err = server.Keeper.setMintLimits(ctx, msg.Denom, mintLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMintLimits,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMaxSupply, mintLimits.MaxSupply.String()),
+			sdk.NewAttribute(types.AttributeMaxSupplyImmutable, strconv.FormatBool(mintLimits.MaxSupplyImmutable)),
+			sdk.NewAttribute(types.AttributeRateLimitAmount, mintLimits.RateLimitAmount.String()),
+			sdk.NewAttribute(types.AttributeRateLimitWindow, mintLimits.RateLimitWindow.String()),
+		),
+	})
+
+	return &types.MsgSetMintLimitsResponse{}, nil
+}
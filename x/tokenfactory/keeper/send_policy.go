@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/osmosis/v16/x/tokenfactory/types"
+)
+
+// skipSendPolicyKey is set on the context of admin actions that must not be
+// restricted by the denom send policy, such as force transfers.
+type skipSendPolicyKey struct{}
+
+// GetSendPolicy returns the native send policy of a denom.
+// Denoms without a stored send policy are unrestricted.
+func (k Keeper) GetSendPolicy(ctx sdk.Context, denom string) (types.DenomSendPolicy, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomSendPolicyKey))
+
+	policy := types.DenomSendPolicy{}
+	err := proto.Unmarshal(bz, &policy)
+	if err != nil {
+		return types.DenomSendPolicy{}, err
+	}
+	return policy, nil
+}
+
+// setSendPolicy stores the native send policy of a denom, deleting it
+// if it doesn't restrict anything.
+func (k Keeper) setSendPolicy(ctx sdk.Context, denom string, policy types.DenomSendPolicy) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	if policy.Equal(types.DenomSendPolicy{}) {
+		store.Delete([]byte(types.DenomSendPolicyKey))
+		return nil
+	}
+
+	bz, err := proto.Marshal(&policy)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.DenomSendPolicyKey), bz)
+	return nil
+}
+
+// getAddressListStore returns the substore of a denom's send policy address list.
+func (k Keeper) getAddressListStore(ctx sdk.Context, denom string, list types.AddressList) sdk.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetAddressListPrefix(list))
+}
+
+// IsInAddressList returns true if the address is in the given address list of the denom.
+func (k Keeper) IsInAddressList(ctx sdk.Context, denom string, list types.AddressList, address string) bool {
+	return k.getAddressListStore(ctx, denom, list).Has([]byte(address))
+}
+
+// GetAddressList returns all the addresses in the given address list of the denom.
+func (k Keeper) GetAddressList(ctx sdk.Context, denom string, list types.AddressList) []string {
+	iterator := k.getAddressListStore(ctx, denom, list).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()))
+	}
+	return addresses
+}
+
+// updateAddressList adds and removes addresses from the given address list of the denom.
+// Removals are applied after additions.
+func (k Keeper) updateAddressList(ctx sdk.Context, denom string, list types.AddressList, addAddresses, removeAddresses []string) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := types.AddressList_name[int32(list)]; !ok {
+		return types.ErrInvalidAddressList.Wrapf("unknown address list %d", list)
+	}
+
+	store := k.getAddressListStore(ctx, denom, list)
+	for _, address := range addAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return err
+		}
+		store.Set([]byte(address), []byte{})
+	}
+	for _, address := range removeAddresses {
+		store.Delete([]byte(address))
+	}
+	return nil
+}
+
+// checkSendPolicies checks the native send policy of every tokenfactory denom being sent.
+func (k Keeper) checkSendPolicies(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if ctx.Value(skipSendPolicyKey{}) != nil {
+		return nil
+	}
+
+	// Mints and burns go through the module account, and are always allowed.
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	if from.Equals(moduleAddr) || to.Equals(moduleAddr) {
+		return nil
+	}
+
+	for _, coin := range amount {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+		if err := k.checkSendPolicy(ctx, coin.Denom, from.String(), to.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) checkSendPolicy(ctx sdk.Context, denom string, from, to string) error {
+	policy, err := k.GetSendPolicy(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if policy.Frozen {
+		return types.ErrSendBlockedByPolicy.Wrapf("denom %s is frozen", denom)
+	}
+
+	for _, address := range []string{from, to} {
+		if k.IsInAddressList(ctx, denom, types.AddressList_DENYLIST, address) {
+			return types.ErrSendBlockedByPolicy.Wrapf("address %s is in the denylist of %s", address, denom)
+		}
+		if policy.AllowlistEnabled && !k.IsInAddressList(ctx, denom, types.AddressList_ALLOWLIST, address) {
+			return types.ErrSendBlockedByPolicy.Wrapf("address %s is not in the allowlist of %s", address, denom)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestSendPolicy() {
+	for _, tc := range []struct {
+		desc       string
+		sendPolicy types.DenomSendPolicy
+		// indexes of the test accounts in each list.
+		// Test account 1 sends to test account 2.
+		denylist       []int
+		allowlist      []int
+		expectSendPass bool
+	}{
+		{
+			desc:           "no policy",
+			expectSendPass: true,
+		},
+		{
+			desc:           "frozen",
+			sendPolicy:     types.DenomSendPolicy{Frozen: true},
+			expectSendPass: false,
+		},
+		{
+			desc:           "sender in denylist",
+			denylist:       []int{1},
+			expectSendPass: false,
+		},
+		{
+			desc:           "recipient in denylist",
+			denylist:       []int{2},
+			expectSendPass: false,
+		},
+		{
+			desc:           "other address in denylist",
+			denylist:       []int{0},
+			expectSendPass: true,
+		},
+		{
+			desc:           "both in allowlist",
+			sendPolicy:     types.DenomSendPolicy{AllowlistEnabled: true},
+			allowlist:      []int{1, 2},
+			expectSendPass: true,
+		},
+		{
+			desc:           "recipient not in allowlist",
+			sendPolicy:     types.DenomSendPolicy{AllowlistEnabled: true},
+			allowlist:      []int{1},
+			expectSendPass: false,
+		},
+		{
+			desc:           "allowlist not enabled",
+			allowlist:      []int{1},
+			expectSendPass: true,
+		},
+		{
+			desc:           "in both lists",
+			sendPolicy:     types.DenomSendPolicy{AllowlistEnabled: true},
+			denylist:       []int{2},
+			allowlist:      []int{1, 2},
+			expectSendPass: false,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+			admin := s.TestAccs[0].String()
+			ctx := sdk.WrapSDKContext(s.Ctx)
+
+			_, err := s.msgServer.Mint(ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(s.defaultDenom, 100), s.TestAccs[1].String()))
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.SetDenomSendPolicy(ctx, types.NewMsgSetDenomSendPolicy(admin, s.defaultDenom, tc.sendPolicy))
+			s.Require().NoError(err)
+			for list, indexes := range map[types.AddressList][]int{types.AddressList_DENYLIST: tc.denylist, types.AddressList_ALLOWLIST: tc.allowlist} {
+				addresses := []string{}
+				for _, i := range indexes {
+					addresses = append(addresses, s.TestAccs[i].String())
+				}
+				_, err = s.msgServer.UpdateDenomAddressList(ctx, types.NewMsgUpdateDenomAddressList(admin, s.defaultDenom, list, addresses, nil))
+				s.Require().NoError(err)
+			}
+
+			coins := sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 1))
+			err = s.App.BankKeeper.SendCoins(s.Ctx, s.TestAccs[1], s.TestAccs[2], coins)
+			if tc.expectSendPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, types.ErrSendBlockedByPolicy)
+			}
+
+			// mints and force transfers are never restricted
+			_, err = s.msgServer.Mint(ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(s.defaultDenom, 100), s.TestAccs[2].String()))
+			s.Require().NoError(err)
+			_, err = s.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(admin, coins[0], s.TestAccs[2].String(), s.TestAccs[1].String()))
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSendPolicyMsgsAndQueries() {
+	s.SetupTest()
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0].String()
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	// only the admin can change the send policy
+	_, err := s.msgServer.SetDenomSendPolicy(ctx, types.NewMsgSetDenomSendPolicy(s.TestAccs[1].String(), s.defaultDenom, types.DenomSendPolicy{Frozen: true}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.UpdateDenomAddressList(ctx, types.NewMsgUpdateDenomAddressList(s.TestAccs[1].String(), s.defaultDenom, types.AddressList_DENYLIST, []string{admin}, nil))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	sendPolicy := types.DenomSendPolicy{Frozen: true, AllowlistEnabled: true}
+	_, err = s.msgServer.SetDenomSendPolicy(ctx, types.NewMsgSetDenomSendPolicy(admin, s.defaultDenom, sendPolicy))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeMsgSetDenomSendPolicy, 1)
+
+	policyRes, err := s.queryClient.DenomSendPolicy(ctx, &types.QueryDenomSendPolicyRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(sendPolicy, policyRes.SendPolicy)
+
+	addresses := []string{s.TestAccs[0].String(), s.TestAccs[1].String(), s.TestAccs[2].String()}
+	_, err = s.msgServer.UpdateDenomAddressList(ctx, types.NewMsgUpdateDenomAddressList(admin, s.defaultDenom, types.AddressList_ALLOWLIST, addresses, addresses[:1]))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeMsgUpdateDenomAddressList, 1)
+
+	listRes, err := s.queryClient.DenomAddressList(ctx, &types.QueryDenomAddressListRequest{Denom: s.defaultDenom, List: types.AddressList_ALLOWLIST})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(addresses[1:], listRes.Addresses)
+
+	listRes, err = s.queryClient.DenomAddressList(ctx, &types.QueryDenomAddressListRequest{Denom: s.defaultDenom, List: types.AddressList_DENYLIST})
+	s.Require().NoError(err)
+	s.Require().Empty(listRes.Addresses)
+
+	// resetting the policy deletes it
+	_, err = s.msgServer.SetDenomSendPolicy(ctx, types.NewMsgSetDenomSendPolicy(admin, s.defaultDenom, types.DenomSendPolicy{}))
+	s.Require().NoError(err)
+	policyRes, err = s.queryClient.DenomSendPolicy(ctx, &types.QueryDenomSendPolicyRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DenomSendPolicy{}, policyRes.SendPolicy)
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,5 +15,97 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	for _, role := range GrantableRoles() {
+		seen := make(map[string]bool)
+		for _, holder := range metadata.GetRoleHolders(role) {
+			_, err := sdk.AccAddressFromBech32(holder)
+			if err != nil {
+				return err
+			}
+			if seen[holder] {
+				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "duplicate %s %s", role, holder)
+			}
+			seen[holder] = true
+		}
+	}
+
+	if metadata.MintLimits != nil {
+		return metadata.MintLimits.Validate()
+	}
+	return nil
+}
+
+// GrantableRoles returns all the roles that can be granted over a denom.
+func GrantableRoles() []Role {
+	return []Role{Role_MINTER, Role_BURNER, Role_METADATA_MANAGER, Role_HOOK_MANAGER}
+}
+
+// ValidateRole returns an error if the role can't be granted over a denom.
+func ValidateRole(role Role) error {
+	if role == Role_ROLE_UNSPECIFIED {
+		return errorsmod.Wrap(ErrInvalidRole, "unspecified role")
+	}
+	if _, ok := Role_name[int32(role)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidRole, "unknown role %d", role)
+	}
 	return nil
 }
+
+// RoleFromString parses a role from its case insensitive name, e.g. "minter" or "HOOK_MANAGER".
+func RoleFromString(s string) (Role, error) {
+	role, ok := Role_value[strings.ToUpper(s)]
+	if !ok || ValidateRole(Role(role)) != nil {
+		return Role_ROLE_UNSPECIFIED, errorsmod.Wrapf(ErrInvalidRole, "unknown role %s, expected one of %v", s, GrantableRoles())
+	}
+	return Role(role), nil
+}
+
+// HasRole returns true if the address holds the role over the denom,
+// either because it is the admin or because the role was granted to it.
+func (metadata DenomAuthorityMetadata) HasRole(role Role, address string) bool {
+	if address == "" {
+		return false
+	}
+	if address == metadata.Admin {
+		return true
+	}
+	for _, holder := range metadata.GetRoleHolders(role) {
+		if holder == address {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRoleHolders returns the addresses the role was granted to, not including the admin.
+func (metadata DenomAuthorityMetadata) GetRoleHolders(role Role) []string {
+	switch role {
+	case Role_MINTER:
+		return metadata.Minters
+	case Role_BURNER:
+		return metadata.Burners
+	case Role_METADATA_MANAGER:
+		return metadata.MetadataManagers
+	case Role_HOOK_MANAGER:
+		return metadata.HookManagers
+	default:
+		return nil
+	}
+}
+
+// SetRoleHolders sets the addresses the role is granted to.
+func (metadata *DenomAuthorityMetadata) SetRoleHolders(role Role, holders []string) {
+	switch role {
+	case Role_MINTER:
+		metadata.Minters = holders
+	case Role_BURNER:
+		metadata.Burners = holders
+	case Role_METADATA_MANAGER:
+		metadata.MetadataManagers = holders
+	case Role_HOOK_MANAGER:
+		metadata.HookManagers = holders
+	default:
+		panic(fmt.Sprintf("unknown role %d", role))
+	}
+}
@@ -24,12 +24,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Role is a permission over a token factory denom, that the admin of the
+// denom can grant to other accounts. The admin implicitly holds every role.
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	// MINTER can mint the denom.
+	Role_MINTER Role = 1
+	// BURNER can burn the denom.
+	Role_BURNER Role = 2
+	// METADATA_MANAGER can set the bank metadata of the denom.
+	Role_METADATA_MANAGER Role = 3
+	// HOOK_MANAGER can set the before send hook and the native send policy of
+	// the denom.
+	Role_HOOK_MANAGER Role = 4
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "MINTER",
+	2: "BURNER",
+	3: "METADATA_MANAGER",
+	4: "HOOK_MANAGER",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED": 0,
+	"MINTER":           1,
+	"BURNER":           2,
+	"METADATA_MANAGER": 3,
+	"HOOK_MANAGER":     4,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{0}
+}
+
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The Admin has every capability,
+// and can grant each role to other addresses.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid osmosis address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Addresses that have been granted the MINTER role
+	Minters []string `protobuf:"bytes,2,rep,name=minters,proto3" json:"minters,omitempty" yaml:"minters"`
+	// Addresses that have been granted the BURNER role
+	Burners []string `protobuf:"bytes,3,rep,name=burners,proto3" json:"burners,omitempty" yaml:"burners"`
+	// Addresses that have been granted the METADATA_MANAGER role
+	MetadataManagers []string `protobuf:"bytes,4,rep,name=metadata_managers,json=metadataManagers,proto3" json:"metadata_managers,omitempty" yaml:"metadata_managers"`
+	// Addresses that have been granted the HOOK_MANAGER role
+	HookManagers []string `protobuf:"bytes,5,rep,name=hook_managers,json=hookManagers,proto3" json:"hook_managers,omitempty" yaml:"hook_managers"`
+	// Supply cap and rate limit on the mints of the denom, if any
+	MintLimits *MintLimits `protobuf:"bytes,6,opt,name=mint_limits,json=mintLimits,proto3" json:"mint_limits,omitempty" yaml:"mint_limits"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,7 +123,43 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetBurners() []string {
+	if m != nil {
+		return m.Burners
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataManagers() []string {
+	if m != nil {
+		return m.MetadataManagers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetHookManagers() []string {
+	if m != nil {
+		return m.HookManagers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetMintLimits() *MintLimits {
+	if m != nil {
+		return m.MintLimits
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.Role", Role_name, Role_value)
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "osmosis.tokenfactory.v1beta1.DenomAuthorityMetadata")
 }
 
@@ -81,22 +168,37 @@ func init() {
 }
 
 var fileDescriptor_99435de88ae175f7 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xea, 0xd2, 0x43, 0xd6, 0xa5, 0x07, 0xd5, 0x25, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0xc9, 0x25, 0x83, 0x35, 0xe9, 0x27, 0x25, 0x16,
-	0xa7, 0xc2, 0x2d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9, 0xa4, 0xe6,
-	0xe5, 0xe7, 0x3a, 0xa2, 0xdb, 0x29, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99, 0x27, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x53, 0xd0, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x3d, 0xa0, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97,
-	0x19, 0x9a, 0xe9, 0x57, 0xa0, 0x86, 0x44, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x89,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0x43, 0x20, 0xf2, 0x2e, 0x01, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x9b, 0xb5, 0x2b, 0x9a, 0x57, 0x50, 0xb0, 0xaa, 0x29, 0x9a, 0xa6, 0xa4, 0xca, 0x01,
+	0x55, 0x08, 0x12, 0x15, 0x10, 0x42, 0x93, 0x38, 0x24, 0x34, 0x40, 0xc5, 0xd2, 0x22, 0xd3, 0x5d,
+	0x10, 0x52, 0xe5, 0x74, 0xa1, 0xb5, 0x56, 0xc7, 0x53, 0xec, 0x4e, 0xf4, 0x29, 0xe0, 0x11, 0x78,
+	0x1c, 0x8e, 0x3b, 0x72, 0x8a, 0x50, 0x7b, 0xe1, 0x9c, 0x27, 0x98, 0x9c, 0xb8, 0xdd, 0xd6, 0xc3,
+	0x6e, 0xf6, 0xf7, 0xfd, 0xbe, 0xcf, 0xff, 0x38, 0x06, 0xaf, 0x18, 0xa7, 0x8c, 0x13, 0xee, 0x0a,
+	0x76, 0x1e, 0x27, 0xdf, 0xf1, 0x58, 0xb0, 0x74, 0xe1, 0x5e, 0x76, 0xa2, 0x58, 0xe0, 0x8e, 0x8b,
+	0xe7, 0x62, 0xca, 0x52, 0x22, 0x16, 0x61, 0x2c, 0xf0, 0x19, 0x16, 0xd8, 0xb9, 0x48, 0x99, 0x60,
+	0xf0, 0x48, 0xa5, 0x9c, 0xdb, 0x29, 0x47, 0xa5, 0x0e, 0x9b, 0x13, 0x36, 0x61, 0x05, 0xe8, 0xca,
+	0x55, 0x99, 0x39, 0x34, 0xc7, 0x45, 0xc8, 0x8d, 0x30, 0x8f, 0x37, 0x07, 0x8c, 0x19, 0x49, 0x94,
+	0xef, 0xdc, 0x3b, 0x09, 0x25, 0x89, 0x18, 0xcd, 0x08, 0x25, 0x82, 0x97, 0xbc, 0xfd, 0xb3, 0x0a,
+	0x0e, 0xba, 0x71, 0xc2, 0xa8, 0xb7, 0x3d, 0x24, 0x7c, 0x02, 0x76, 0xf1, 0x19, 0x25, 0x89, 0xa1,
+	0xb5, 0xb4, 0xf6, 0x9e, 0xaf, 0xe7, 0x99, 0xd5, 0x58, 0x60, 0x3a, 0x3b, 0xb6, 0x0b, 0xd9, 0x46,
+	0xa5, 0x0d, 0x9f, 0x81, 0x07, 0xb2, 0x37, 0x4e, 0xb9, 0xb1, 0xd3, 0xaa, 0xb6, 0xf7, 0x7c, 0x98,
+	0x67, 0xd6, 0xa3, 0x92, 0x54, 0x86, 0x8d, 0xd6, 0x88, 0xa4, 0xa3, 0x79, 0x9a, 0x48, 0xba, 0xba,
+	0x4d, 0x2b, 0xc3, 0x46, 0x6b, 0x04, 0xf6, 0xc0, 0x63, 0xaa, 0xe6, 0x19, 0x51, 0x9c, 0xe0, 0x89,
+	0xcc, 0xd5, 0x8a, 0xdc, 0x51, 0x9e, 0x59, 0x86, 0x3a, 0x65, 0x1b, 0xb1, 0x91, 0xbe, 0xd6, 0x42,
+	0x25, 0xc1, 0xb7, 0xe0, 0xe1, 0x94, 0xb1, 0xf3, 0x9b, 0x9a, 0xdd, 0xa2, 0xc6, 0xc8, 0x33, 0xab,
+	0x59, 0xd6, 0xdc, 0xb1, 0x6d, 0xd4, 0x90, 0xfb, 0x4d, 0x1c, 0x83, 0xfd, 0x5b, 0xb7, 0x67, 0xd4,
+	0x5b, 0x5a, 0x7b, 0xff, 0x45, 0xdb, 0xb9, 0xef, 0x17, 0x3a, 0x21, 0x49, 0xc4, 0x49, 0xc1, 0xfb,
+	0x07, 0x79, 0x66, 0xc1, 0x9b, 0x3b, 0x51, 0x35, 0x36, 0x02, 0x74, 0xc3, 0x1c, 0xd7, 0xfe, 0xff,
+	0xb6, 0xb4, 0xa7, 0xdf, 0x40, 0x0d, 0xb1, 0x59, 0x0c, 0x9b, 0x40, 0x47, 0x83, 0x93, 0x60, 0x74,
+	0xda, 0xff, 0xf2, 0x39, 0x78, 0xd7, 0x7b, 0xdf, 0x0b, 0xba, 0x7a, 0x05, 0x02, 0x50, 0x0f, 0x7b,
+	0xfd, 0x61, 0x80, 0x74, 0x4d, 0xae, 0xfd, 0x53, 0xd4, 0x0f, 0x90, 0xbe, 0x23, 0xe9, 0x30, 0x18,
+	0x7a, 0x5d, 0x6f, 0xe8, 0x8d, 0x42, 0xaf, 0xef, 0x7d, 0x08, 0x90, 0x5e, 0x85, 0x3a, 0x68, 0x7c,
+	0x1c, 0x0c, 0x3e, 0x6d, 0x94, 0x9a, 0x8f, 0xfe, 0x2c, 0x4d, 0xed, 0x6a, 0x69, 0x6a, 0xff, 0x96,
+	0xa6, 0xf6, 0x6b, 0x65, 0x56, 0xae, 0x56, 0x66, 0xe5, 0xef, 0xca, 0xac, 0x7c, 0x7d, 0x33, 0x21,
+	0x62, 0x3a, 0x8f, 0x9c, 0x31, 0xa3, 0xae, 0xfa, 0xaa, 0xe7, 0x33, 0x1c, 0xf1, 0xf5, 0xc6, 0xbd,
+	0xec, 0xbc, 0x76, 0x7f, 0xdc, 0x7d, 0x57, 0x62, 0x71, 0x11, 0xf3, 0xa8, 0x5e, 0x3c, 0xa5, 0x97,
+	0xd7, 0x03, 0x00, 0xc2, 0xd8, 0xf0, 0x98, 0x06, 0x03, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +223,41 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if this.Minters[i] != that1.Minters[i] {
+			return false
+		}
+	}
+	if len(this.Burners) != len(that1.Burners) {
+		return false
+	}
+	for i := range this.Burners {
+		if this.Burners[i] != that1.Burners[i] {
+			return false
+		}
+	}
+	if len(this.MetadataManagers) != len(that1.MetadataManagers) {
+		return false
+	}
+	for i := range this.MetadataManagers {
+		if this.MetadataManagers[i] != that1.MetadataManagers[i] {
+			return false
+		}
+	}
+	if len(this.HookManagers) != len(that1.HookManagers) {
+		return false
+	}
+	for i := range this.HookManagers {
+		if this.HookManagers[i] != that1.HookManagers[i] {
+			return false
+		}
+	}
+	if !this.MintLimits.Equal(that1.MintLimits) {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +280,54 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.MintLimits != nil {
+		{
+			size, err := m.MintLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.HookManagers) > 0 {
+		for iNdEx := len(m.HookManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HookManagers[iNdEx])
+			copy(dAtA[i:], m.HookManagers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.HookManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MetadataManagers) > 0 {
+		for iNdEx := len(m.MetadataManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataManagers[iNdEx])
+			copy(dAtA[i:], m.MetadataManagers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.MetadataManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Burners[iNdEx])
+			copy(dAtA[i:], m.Burners[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -174,6 +359,34 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.Burners) > 0 {
+		for _, s := range m.Burners {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.MetadataManagers) > 0 {
+		for _, s := range m.MetadataManagers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.HookManagers) > 0 {
+		for _, s := range m.HookManagers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if m.MintLimits != nil {
+		l = m.MintLimits.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -244,6 +457,170 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataManagers = append(m.MetadataManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookManagers = append(m.HookManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintLimits == nil {
+				m.MintLimits = &MintLimits{}
+			}
+			if err := m.MintLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -17,6 +17,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgForceTransfer{}, "osmosis/tokenfactory/force-transfer", nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "osmosis/tokenfactory/change-admin", nil)
 	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, "osmosis/tokenfactory/set-beforesend-hook", nil)
+	cdc.RegisterConcrete(&MsgSetDenomSendPolicy{}, "osmosis/tokenfactory/set-denom-send-policy", nil)
+	cdc.RegisterConcrete(&MsgUpdateDenomAddressList{}, "osmosis/tokenfactory/update-denom-address-list", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "osmosis/tokenfactory/grant-role", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "osmosis/tokenfactory/revoke-role", nil)
+	cdc.RegisterConcrete(&MsgSetMintLimits{}, "osmosis/tokenfactory/set-mint-limits", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -28,6 +33,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		// &MsgForceTransfer{},
 		&MsgChangeAdmin{},
 		&MsgSetBeforeSendHook{},
+		&MsgSetDenomSendPolicy{},
+		&MsgUpdateDenomAddressList{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgSetMintLimits{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -20,4 +20,10 @@ var (
 	ErrCreatorTooLong           = errorsmod.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrBurnFromModuleAccount    = errorsmod.Register(ModuleName, 11, "burning from Module Account is not allowed")
+	ErrSendBlockedByPolicy      = errorsmod.Register(ModuleName, 12, "send blocked by denom send policy")
+	ErrInvalidAddressList       = errorsmod.Register(ModuleName, 13, "invalid address list")
+	ErrInvalidRole              = errorsmod.Register(ModuleName, 14, "invalid role")
+	ErrInvalidMintLimits        = errorsmod.Register(ModuleName, 15, "invalid mint limits")
+	ErrMaxSupplyExceeded        = errorsmod.Register(ModuleName, 16, "mint would exceed the max supply of the denom")
+	ErrMintRateLimitExceeded    = errorsmod.Register(ModuleName, 17, "mint would exceed the mint rate limit of the denom")
 )
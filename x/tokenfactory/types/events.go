@@ -14,4 +14,15 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeFrozen                = "frozen"
+	AttributeAllowlistEnabled      = "allowlist_enabled"
+	AttributeAddressList           = "address_list"
+	AttributeAddedAddresses        = "added_addresses"
+	AttributeRemovedAddresses      = "removed_addresses"
+	AttributeRole                  = "role"
+	AttributeAddress               = "address"
+	AttributeMaxSupply             = "max_supply"
+	AttributeMaxSupplyImmutable    = "max_supply_immutable"
+	AttributeRateLimitAmount       = "rate_limit_amount"
+	AttributeRateLimitWindow       = "rate_limit_window"
 )
@@ -45,6 +45,24 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		err = denom.AuthorityMetadata.Validate()
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid authority metadata (%s)", err)
+		}
+
+		if denom.MintWindow != nil && (denom.MintWindow.Minted.IsNil() || denom.MintWindow.Minted.IsNegative()) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid minted amount in the mint window of %s", denom.GetDenom())
+		}
+
+		for _, addresses := range [][]string{denom.GetDenylist(), denom.GetAllowlist()} {
+			for _, address := range addresses {
+				_, err = sdk.AccAddressFromBech32(address)
+				if err != nil {
+					return errorsmod.Wrapf(ErrInvalidAddressList, "Invalid address (%s)", err)
+				}
+			}
+		}
 	}
 
 	return nil
@@ -79,10 +79,15 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, the denom's native send policy along with its address
+// lists, and the denom's current mint rate limit window.
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	SendPolicy        DenomSendPolicy        `protobuf:"bytes,3,opt,name=send_policy,json=sendPolicy,proto3" json:"send_policy" yaml:"send_policy"`
+	Denylist          []string               `protobuf:"bytes,4,rep,name=denylist,proto3" json:"denylist,omitempty" yaml:"denylist"`
+	Allowlist         []string               `protobuf:"bytes,5,rep,name=allowlist,proto3" json:"allowlist,omitempty" yaml:"allowlist"`
+	MintWindow        *MintWindow            `protobuf:"bytes,6,opt,name=mint_window,json=mintWindow,proto3" json:"mint_window,omitempty" yaml:"mint_window"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +137,34 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetSendPolicy() DenomSendPolicy {
+	if m != nil {
+		return m.SendPolicy
+	}
+	return DenomSendPolicy{}
+}
+
+func (m *GenesisDenom) GetDenylist() []string {
+	if m != nil {
+		return m.Denylist
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetMintWindow() *MintWindow {
+	if m != nil {
+		return m.MintWindow
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +175,39 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x99, 0xe0,
-	0x35, 0x3f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31, 0x25,
-	0xb1, 0x24, 0x11, 0xaa, 0x4b, 0x13, 0xaf, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa3, 0x94,
-	0x8e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x9c, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc4, 0xc5,
-	0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xd9, 0x7a, 0x01,
-	0x60, 0xb5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x0a, 0x15, 0x70, 0xf1, 0x41,
-	0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69,
-	0xe1, 0x37, 0x0b, 0xea, 0x0e, 0x17, 0x90, 0x16, 0x27, 0x59, 0x90, 0x89, 0x9f, 0xee, 0xc9, 0x8b,
-	0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x9a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81, 0xf0,
-	0x8f, 0x22, 0xbc, 0x01, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x82, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x1b, 0x23,
-	0x97, 0x10, 0x3c, 0x18, 0xe3, 0x73, 0xa1, 0xe1, 0x28, 0xc1, 0x04, 0xf6, 0xbb, 0x09, 0x7e, 0xf7,
-	0x82, 0x6d, 0x72, 0x44, 0x8f, 0x03, 0x27, 0x45, 0xa8, 0xcb, 0x25, 0x21, 0xf6, 0x61, 0x9a, 0xae,
-	0x14, 0x24, 0x88, 0x11, 0x73, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x05, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x55, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0xa1,
-	0x99, 0x7e, 0x05, 0x6a, 0x8c, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xda, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0x36, 0x65, 0xd1, 0x9b, 0xac, 0x02, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x9b, 0x75, 0xab, 0xa8, 0x3b, 0x60, 0x98, 0x81, 0x42, 0x05, 0x49, 0x89, 0x10, 0x2a,
+	0x93, 0x96, 0xa8, 0x65, 0x42, 0x68, 0x37, 0xac, 0x49, 0x9c, 0x26, 0x4d, 0xde, 0x01, 0x89, 0x4b,
+	0xe5, 0x36, 0xa6, 0x33, 0xc4, 0x76, 0x54, 0x7b, 0x2b, 0xf9, 0x03, 0x9c, 0xf9, 0x09, 0xfc, 0x18,
+	0x0e, 0x3b, 0xee, 0xc8, 0x29, 0x42, 0xed, 0x85, 0x73, 0x6f, 0xdc, 0x50, 0x1d, 0x2f, 0xeb, 0x98,
+	0x14, 0xed, 0x96, 0xd8, 0xcf, 0xfb, 0x7e, 0xdf, 0xeb, 0xcf, 0x06, 0x3b, 0x52, 0x71, 0xa9, 0x98,
+	0x8a, 0xb4, 0xfc, 0x42, 0xc5, 0x27, 0x32, 0xd2, 0x72, 0x92, 0x45, 0x67, 0xbd, 0x21, 0xd5, 0xa4,
+	0x17, 0x8d, 0xa9, 0xa0, 0x8a, 0xa9, 0x30, 0x9d, 0x48, 0x2d, 0xe1, 0x53, 0xcb, 0x86, 0xab, 0x6c,
+	0x68, 0xd9, 0xf6, 0xf6, 0x58, 0x8e, 0xa5, 0x01, 0xa3, 0xe5, 0x57, 0xa1, 0x69, 0xef, 0x55, 0xfa,
+	0x93, 0x53, 0x7d, 0x22, 0x27, 0x4c, 0x67, 0x87, 0x54, 0x93, 0x98, 0x68, 0x62, 0x55, 0xaf, 0x2a,
+	0x55, 0x29, 0x99, 0x10, 0x6e, 0x9b, 0x6a, 0x87, 0x95, 0xa8, 0xa2, 0x22, 0x1e, 0xa4, 0x32, 0x61,
+	0xa3, 0xec, 0x56, 0x3c, 0x67, 0x42, 0x0f, 0x12, 0xc6, 0x99, 0xb6, 0xfe, 0xc1, 0x4f, 0x07, 0x6c,
+	0xbe, 0x2f, 0x8e, 0xe1, 0x58, 0x13, 0x4d, 0x21, 0x02, 0x8d, 0xa2, 0x01, 0xd7, 0xe9, 0x38, 0xdd,
+	0x56, 0xff, 0x45, 0x58, 0x75, 0x2c, 0xe1, 0x91, 0x61, 0xd1, 0xfa, 0x79, 0xee, 0xd7, 0xb0, 0x55,
+	0xc2, 0x14, 0xdc, 0xb3, 0xdc, 0x20, 0xa6, 0x42, 0x72, 0xe5, 0xae, 0x75, 0xea, 0xdd, 0x56, 0x7f,
+	0xa7, 0xda, 0xcb, 0xf6, 0x71, 0xb0, 0x94, 0xa0, 0x67, 0x4b, 0xc7, 0x45, 0xee, 0x3f, 0xca, 0x08,
+	0x4f, 0xf6, 0x83, 0xeb, 0x7e, 0x01, 0xbe, 0x6b, 0x17, 0x0e, 0x8a, 0xff, 0xbf, 0xf5, 0x32, 0x86,
+	0x59, 0x81, 0x2f, 0xc1, 0x86, 0x41, 0x4d, 0x8a, 0x26, 0xda, 0x5a, 0xe4, 0xfe, 0x66, 0xe1, 0x64,
+	0x96, 0x03, 0x5c, 0x6c, 0xc3, 0x6f, 0x0e, 0x80, 0xe5, 0x98, 0x06, 0xdc, 0xce, 0xc9, 0x5d, 0x33,
+	0xd9, 0xf7, 0xaa, 0xfb, 0x35, 0x95, 0xde, 0xfd, 0x3f, 0x63, 0xf4, 0xdc, 0x76, 0xfe, 0xa4, 0xa8,
+	0x77, 0xd3, 0x3d, 0xc0, 0x0f, 0x6e, 0xdc, 0x0c, 0xf8, 0x19, 0xb4, 0x56, 0xa6, 0xe9, 0xd6, 0x4d,
+	0x03, 0xbb, 0xb7, 0x68, 0xe0, 0x98, 0x8a, 0xf8, 0xc8, 0x88, 0x50, 0xdb, 0x56, 0x86, 0x45, 0xe5,
+	0x15, 0xbf, 0x00, 0x03, 0x55, 0x72, 0x30, 0x02, 0x77, 0x62, 0x2a, 0xb2, 0x84, 0x29, 0xed, 0xae,
+	0x77, 0xea, 0xdd, 0x26, 0x7a, 0xb8, 0xc8, 0xfd, 0xfb, 0xe5, 0xf9, 0x98, 0x9d, 0x00, 0x97, 0x10,
+	0xec, 0x83, 0x26, 0x49, 0x12, 0x39, 0x35, 0x8a, 0x0d, 0xa3, 0xd8, 0x5e, 0xe4, 0xfe, 0x96, 0x4d,
+	0x78, 0xb9, 0x15, 0xe0, 0x2b, 0x0c, 0x12, 0xd0, 0x32, 0xd7, 0x6d, 0xca, 0x44, 0x2c, 0xa7, 0x6e,
+	0xc3, 0x04, 0xea, 0x56, 0x07, 0x3a, 0x64, 0x42, 0x7f, 0x30, 0x3c, 0x7a, 0x7c, 0x95, 0x63, 0xc5,
+	0x26, 0xc0, 0x80, 0x97, 0xcc, 0xfe, 0xfa, 0x9f, 0x1f, 0xbe, 0x83, 0xf0, 0xf9, 0xcc, 0x73, 0x2e,
+	0x66, 0x9e, 0xf3, 0x7b, 0xe6, 0x39, 0xdf, 0xe7, 0x5e, 0xed, 0x62, 0xee, 0xd5, 0x7e, 0xcd, 0xbd,
+	0xda, 0xc7, 0xb7, 0x63, 0xa6, 0x4f, 0x4e, 0x87, 0xe1, 0x48, 0xf2, 0xc8, 0xd6, 0xdd, 0x4d, 0xc8,
+	0x50, 0x5d, 0xfe, 0x44, 0x67, 0xbd, 0x37, 0xd1, 0xd7, 0xeb, 0x4f, 0x45, 0x67, 0x29, 0x55, 0xc3,
+	0x86, 0x79, 0x1d, 0xaf, 0xff, 0x0d, 0x00, 0x18, 0xd6, 0x84, 0x36, 0x40, 0x04, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +235,28 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.SendPolicy.Equal(&that1.SendPolicy) {
+		return false
+	}
+	if len(this.Denylist) != len(that1.Denylist) {
+		return false
+	}
+	for i := range this.Denylist {
+		if this.Denylist[i] != that1.Denylist[i] {
+			return false
+		}
+	}
+	if len(this.Allowlist) != len(that1.Allowlist) {
+		return false
+	}
+	for i := range this.Allowlist {
+		if this.Allowlist[i] != that1.Allowlist[i] {
+			return false
+		}
+	}
+	if !this.MintWindow.Equal(that1.MintWindow) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +326,46 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintWindow != nil {
+		{
+			size, err := m.MintWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.SendPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +426,24 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SendPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintWindow != nil {
+		l = m.MintWindow.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -542,6 +664,139 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintWindow == nil {
+				m.MintWindow = &MintWindow{}
+			}
+			if err := m.MintWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
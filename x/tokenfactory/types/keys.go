@@ -30,6 +30,10 @@ var (
 	CreatorPrefixKey               = "creator"
 	AdminPrefixKey                 = "admin"
 	BeforeSendHookAddressPrefixKey = "beforesendhook"
+	DenomSendPolicyKey             = "sendpolicy"
+	DenylistPrefixKey              = "denylist"
+	AllowlistPrefixKey             = "allowlist"
+	MintWindowKey                  = "mintwindow"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -48,3 +52,13 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
 }
+
+// GetAddressListPrefix returns the store prefix, within a denom's prefix store,
+// where the addresses of the given send policy address list are stored
+func GetAddressListPrefix(list AddressList) []byte {
+	listPrefixKey := DenylistPrefixKey
+	if list == AddressList_ALLOWLIST {
+		listPrefixKey = AllowlistPrefixKey
+	}
+	return []byte(strings.Join([]string{listPrefixKey, ""}, KeySeparator))
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasMaxSupply returns true if the supply of the denom is capped.
+func (l MintLimits) HasMaxSupply() bool {
+	return !l.MaxSupply.IsNil() && l.MaxSupply.IsPositive()
+}
+
+// HasRateLimit returns true if the mints of the denom are rate limited.
+func (l MintLimits) HasRateLimit() bool {
+	return !l.RateLimitAmount.IsNil() && l.RateLimitAmount.IsPositive()
+}
+
+// IsEmpty returns true if the mint limits don't restrict anything.
+func (l MintLimits) IsEmpty() bool {
+	return !l.HasMaxSupply() && !l.MaxSupplyImmutable && !l.HasRateLimit()
+}
+
+func (l MintLimits) Validate() error {
+	if !l.MaxSupply.IsNil() && l.MaxSupply.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "negative max supply %s", l.MaxSupply)
+	}
+	if l.MaxSupplyImmutable && !l.HasMaxSupply() {
+		return errorsmod.Wrap(ErrInvalidMintLimits, "an immutable max supply must be positive")
+	}
+	if !l.RateLimitAmount.IsNil() && l.RateLimitAmount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "negative rate limit amount %s", l.RateLimitAmount)
+	}
+	if l.HasRateLimit() && l.RateLimitWindow <= 0 {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "rate limit window must be positive, got %s", l.RateLimitWindow)
+	}
+	return nil
+}
+
+// ValidateMintLimitsUpdate returns an error if the mint limits of a denom
+// can't be changed from current to updated. Once set, the max supply can only
+// be lowered, and not at all if it is immutable.
+func ValidateMintLimitsUpdate(current *MintLimits, updated MintLimits) error {
+	if current == nil || !current.HasMaxSupply() {
+		return nil
+	}
+
+	if current.MaxSupplyImmutable {
+		if !updated.MaxSupplyImmutable || !updated.HasMaxSupply() || !updated.MaxSupply.Equal(current.MaxSupply) {
+			return errorsmod.Wrapf(ErrInvalidMintLimits, "max supply %s is immutable", current.MaxSupply)
+		}
+		return nil
+	}
+
+	if !updated.HasMaxSupply() || updated.MaxSupply.GT(current.MaxSupply) {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "max supply can only be lowered, from %s", current.MaxSupply)
+	}
+	return nil
+}
+
+// normalizeInt returns zero for nil ints, for them to be safely compared.
+func normalizeInt(i sdk.Int) sdk.Int {
+	if i.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return i
+}
+
+// Normalize returns the mint limits with nil ints set to zero.
+func (l MintLimits) Normalize() MintLimits {
+	l.MaxSupply = normalizeInt(l.MaxSupply)
+	l.RateLimitAmount = normalizeInt(l.RateLimitAmount)
+	return l
+}
@@ -0,0 +1,766 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/mint_limits.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintLimits restricts the minting of a tokenfactory denom. They are enforced
+// on every mint of the denom, whoever the minter is.
+type MintLimits struct {
+	// max_supply is the maximum total supply of the denom. Zero means the
+	// supply isn't capped. Once set, it can only be lowered.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// max_supply_immutable prevents max_supply from ever being changed again.
+	MaxSupplyImmutable bool `protobuf:"varint,2,opt,name=max_supply_immutable,json=maxSupplyImmutable,proto3" json:"max_supply_immutable,omitempty" yaml:"max_supply_immutable"`
+	// rate_limit_amount is the maximum amount of the denom that can be minted
+	// per rate limit window. Zero means minting isn't rate limited.
+	RateLimitAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=rate_limit_amount,json=rateLimitAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"rate_limit_amount" yaml:"rate_limit_amount"`
+	// rate_limit_window is the duration of the rate limit windows.
+	RateLimitWindow time.Duration `protobuf:"bytes,4,opt,name=rate_limit_window,json=rateLimitWindow,proto3,stdduration" json:"rate_limit_window" yaml:"rate_limit_window"`
+}
+
+func (m *MintLimits) Reset()         { *m = MintLimits{} }
+func (m *MintLimits) String() string { return proto.CompactTextString(m) }
+func (*MintLimits) ProtoMessage()    {}
+func (*MintLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8ef6b2aa570967, []int{0}
+}
+func (m *MintLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintLimits.Merge(m, src)
+}
+func (m *MintLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintLimits proto.InternalMessageInfo
+
+func (m *MintLimits) GetMaxSupplyImmutable() bool {
+	if m != nil {
+		return m.MaxSupplyImmutable
+	}
+	return false
+}
+
+func (m *MintLimits) GetRateLimitWindow() time.Duration {
+	if m != nil {
+		return m.RateLimitWindow
+	}
+	return 0
+}
+
+// MintWindow tracks the amount of a rate limited denom minted in the current
+// rate limit window.
+type MintWindow struct {
+	// start is the block time at which the window started.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start" yaml:"start"`
+	// minted is the amount minted since the start of the window.
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted" yaml:"minted"`
+}
+
+func (m *MintWindow) Reset()         { *m = MintWindow{} }
+func (m *MintWindow) String() string { return proto.CompactTextString(m) }
+func (*MintWindow) ProtoMessage()    {}
+func (*MintWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8ef6b2aa570967, []int{1}
+}
+func (m *MintWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintWindow.Merge(m, src)
+}
+func (m *MintWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintWindow proto.InternalMessageInfo
+
+func (m *MintWindow) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*MintLimits)(nil), "osmosis.tokenfactory.v1beta1.MintLimits")
+	proto.RegisterType((*MintWindow)(nil), "osmosis.tokenfactory.v1beta1.MintWindow")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/mint_limits.proto", fileDescriptor_6b8ef6b2aa570967)
+}
+
+var fileDescriptor_6b8ef6b2aa570967 = []byte{
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xd1, 0x52, 0x11, 0x17, 0x84, 0x6a, 0x75, 0x30, 0x01, 0xd9, 0xd1, 0x09, 0xa1, 0x2c,
+	0xbd, 0x53, 0x8a, 0x84, 0x50, 0x17, 0x84, 0x61, 0x69, 0x05, 0x03, 0x06, 0xa9, 0x12, 0x4b, 0x74,
+	0x4e, 0x5c, 0x73, 0x8a, 0xcf, 0x67, 0xf9, 0x9e, 0xd3, 0xe4, 0xbf, 0xe8, 0xc8, 0xc8, 0x5f, 0xc0,
+	0xca, 0xbf, 0xd0, 0xb1, 0x23, 0x62, 0x08, 0x28, 0x59, 0x98, 0xfb, 0x17, 0x20, 0xdf, 0x5d, 0x48,
+	0xfa, 0x63, 0xc9, 0x94, 0xbb, 0xf7, 0xbe, 0xf7, 0x7d, 0x5f, 0xde, 0xe7, 0x73, 0x88, 0x54, 0x42,
+	0x2a, 0xae, 0x28, 0xc8, 0x61, 0x92, 0x9f, 0xb0, 0x3e, 0xc8, 0x72, 0x42, 0x47, 0xdd, 0x38, 0x01,
+	0xd6, 0xa5, 0x82, 0xe7, 0xd0, 0xcb, 0xb8, 0xe0, 0xa0, 0x48, 0x51, 0x4a, 0x90, 0xee, 0x13, 0x8b,
+	0x27, 0xab, 0x78, 0x62, 0xf1, 0xad, 0xdd, 0x54, 0xa6, 0x52, 0x03, 0x69, 0x7d, 0x32, 0x33, 0x2d,
+	0x3f, 0x95, 0x32, 0xcd, 0x12, 0xaa, 0x6f, 0x71, 0x75, 0x42, 0x07, 0x55, 0xc9, 0x80, 0xcb, 0xdc,
+	0xf6, 0x83, 0xeb, 0x7d, 0xe0, 0x22, 0x51, 0xc0, 0x44, 0x61, 0x00, 0xf8, 0xfb, 0x86, 0xe3, 0xbc,
+	0xe7, 0x39, 0xbc, 0xd3, 0x4e, 0xdc, 0xd8, 0x71, 0x04, 0x1b, 0xf7, 0x54, 0x55, 0x14, 0xd9, 0xc4,
+	0x43, 0x6d, 0xd4, 0x69, 0x86, 0x6f, 0xce, 0xa7, 0x41, 0xe3, 0xd7, 0x34, 0x78, 0x96, 0x72, 0xf8,
+	0x52, 0xc5, 0xa4, 0x2f, 0x05, 0xed, 0x6b, 0xaf, 0xf6, 0x67, 0x4f, 0x0d, 0x86, 0x14, 0x26, 0x45,
+	0xa2, 0xc8, 0x61, 0x0e, 0x97, 0xd3, 0x60, 0x67, 0xc2, 0x44, 0x76, 0x80, 0x97, 0x4c, 0x38, 0x6a,
+	0x0a, 0x36, 0xfe, 0xa8, 0xcf, 0xee, 0x07, 0x67, 0x77, 0xd9, 0xe9, 0x71, 0x21, 0x2a, 0x60, 0x71,
+	0x96, 0x78, 0x77, 0xda, 0xa8, 0x73, 0x2f, 0x0c, 0x2e, 0xa7, 0xc1, 0xe3, 0xeb, 0xf3, 0x4b, 0x14,
+	0x8e, 0xdc, 0xff, 0x4c, 0x87, 0x8b, 0xa2, 0x3b, 0x72, 0x76, 0x4a, 0x06, 0x89, 0xd9, 0x67, 0x8f,
+	0x09, 0x59, 0xe5, 0xe0, 0x6d, 0x68, 0xf7, 0x47, 0x6b, 0xbb, 0xf7, 0x8c, 0xfa, 0x0d, 0x42, 0x1c,
+	0x3d, 0xac, 0x6b, 0x7a, 0x53, 0xaf, 0x75, 0xc5, 0x1d, 0x5e, 0xd1, 0x3d, 0xe5, 0xf9, 0x40, 0x9e,
+	0x7a, 0x9b, 0x6d, 0xd4, 0xd9, 0xde, 0x7f, 0x44, 0xcc, 0xea, 0xc9, 0x62, 0xf5, 0xe4, 0xad, 0x8d,
+	0x26, 0x7c, 0x5a, 0x5b, 0xba, 0x55, 0xc8, 0x30, 0xe0, 0xaf, 0xbf, 0x03, 0xb4, 0x22, 0x76, 0xac,
+	0xab, 0x07, 0x9b, 0x7f, 0xbf, 0x05, 0x08, 0xff, 0x40, 0x26, 0x30, 0x53, 0x74, 0x8f, 0x9c, 0xbb,
+	0x0a, 0x58, 0x09, 0x3a, 0xab, 0xed, 0xfd, 0xd6, 0x0d, 0xd5, 0x4f, 0x8b, 0xc0, 0x43, 0xcf, 0xca,
+	0xde, 0x37, 0xb2, 0x7a, 0x0c, 0x9f, 0xd5, 0x52, 0x86, 0xc2, 0x3d, 0x76, 0xb6, 0xea, 0xaf, 0x32,
+	0x19, 0xe8, 0x28, 0x9a, 0xe1, 0xab, 0xb5, 0x57, 0xf7, 0xc0, 0x06, 0xa7, 0x59, 0x70, 0x64, 0xe9,
+	0x8c, 0xf3, 0x30, 0x3a, 0x9f, 0xf9, 0xe8, 0x62, 0xe6, 0xa3, 0x3f, 0x33, 0x1f, 0x9d, 0xcd, 0xfd,
+	0xc6, 0xc5, 0xdc, 0x6f, 0xfc, 0x9c, 0xfb, 0x8d, 0xcf, 0x2f, 0x57, 0x04, 0xec, 0x23, 0xd8, 0xcb,
+	0x58, 0xac, 0x16, 0x17, 0x3a, 0xea, 0xbe, 0xa0, 0xe3, 0xab, 0xef, 0x48, 0xcb, 0xc6, 0x5b, 0xfa,
+	0x7f, 0x3e, 0xff, 0x37, 0x00, 0xc8, 0x37, 0xf0, 0x13, 0x6c, 0x03, 0x00, 0x00,
+}
+
+func (this *MintLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintLimits)
+	if !ok {
+		that2, ok := that.(MintLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if this.MaxSupplyImmutable != that1.MaxSupplyImmutable {
+		return false
+	}
+	if !this.RateLimitAmount.Equal(that1.RateLimitAmount) {
+		return false
+	}
+	if this.RateLimitWindow != that1.RateLimitWindow {
+		return false
+	}
+	return true
+}
+func (this *MintWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintWindow)
+	if !ok {
+		that2, ok := that.(MintWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if !this.Minted.Equal(that1.Minted) {
+		return false
+	}
+	return true
+}
+func (m *MintLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RateLimitWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RateLimitWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMintLimits(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RateLimitAmount.Size()
+		i -= size
+		if _, err := m.RateLimitAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxSupplyImmutable {
+		i--
+		if m.MaxSupplyImmutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMintLimits(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintLimits(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintLimits(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMintLimits(uint64(l))
+	if m.MaxSupplyImmutable {
+		n += 2
+	}
+	l = m.RateLimitAmount.Size()
+	n += 1 + l + sovMintLimits(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RateLimitWindow)
+	n += 1 + l + sovMintLimits(uint64(l))
+	return n
+}
+
+func (m *MintWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovMintLimits(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovMintLimits(uint64(l))
+	return n
+}
+
+func sovMintLimits(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintLimits(x uint64) (n int) {
+	return sovMintLimits(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintLimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyImmutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxSupplyImmutable = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimitAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RateLimitWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintLimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintLimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintLimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintLimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintLimits(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintLimits
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintLimits
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintLimits
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintLimits
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintLimits        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintLimits          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintLimits = fmt.Errorf("proto: unexpected end of group")
+)
@@ -16,6 +16,12 @@ const (
 	TypeMsgChangeAdmin       = "change_admin"
 	TypeMsgSetDenomMetadata  = "set_denom_metadata"
 	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+
+	TypeMsgSetDenomSendPolicy     = "set_denom_send_policy"
+	TypeMsgUpdateDenomAddressList = "update_denom_address_list"
+	TypeMsgGrantRole              = "grant_role"
+	TypeMsgRevokeRole             = "revoke_role"
+	TypeMsgSetMintLimits          = "set_mint_limits"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -306,3 +312,225 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetDenomSendPolicy{}
+
+// NewMsgSetDenomSendPolicy creates a message to set the send policy of a denom
+func NewMsgSetDenomSendPolicy(sender string, denom string, sendPolicy DenomSendPolicy) *MsgSetDenomSendPolicy {
+	return &MsgSetDenomSendPolicy{
+		Sender:     sender,
+		Denom:      denom,
+		SendPolicy: sendPolicy,
+	}
+}
+
+func (m MsgSetDenomSendPolicy) Route() string { return RouterKey }
+func (m MsgSetDenomSendPolicy) Type() string  { return TypeMsgSetDenomSendPolicy }
+func (m MsgSetDenomSendPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	return nil
+}
+
+func (m MsgSetDenomSendPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetDenomSendPolicy) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUpdateDenomAddressList{}
+
+// NewMsgUpdateDenomAddressList creates a message to add and remove addresses
+// from the denylist or the allowlist of a denom
+func NewMsgUpdateDenomAddressList(sender string, denom string, list AddressList, addAddresses, removeAddresses []string) *MsgUpdateDenomAddressList {
+	return &MsgUpdateDenomAddressList{
+		Sender:          sender,
+		Denom:           denom,
+		List:            list,
+		AddAddresses:    addAddresses,
+		RemoveAddresses: removeAddresses,
+	}
+}
+
+func (m MsgUpdateDenomAddressList) Route() string { return RouterKey }
+func (m MsgUpdateDenomAddressList) Type() string  { return TypeMsgUpdateDenomAddressList }
+func (m MsgUpdateDenomAddressList) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	if _, ok := AddressList_name[int32(m.List)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidAddressList, "unknown address list %d", m.List)
+	}
+
+	if len(m.AddAddresses) == 0 && len(m.RemoveAddresses) == 0 {
+		return errorsmod.Wrap(ErrInvalidAddressList, "no addresses to add or remove")
+	}
+
+	for _, addresses := range [][]string{m.AddAddresses, m.RemoveAddresses} {
+		for _, address := range addresses {
+			_, err = sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m MsgUpdateDenomAddressList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateDenomAddressList) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgGrantRole{}
+
+// NewMsgGrantRole creates a message to grant a role over a denom to an address
+func NewMsgGrantRole(sender string, denom string, role Role, address string) *MsgGrantRole {
+	return &MsgGrantRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgGrantRole) Route() string { return RouterKey }
+func (m MsgGrantRole) Type() string  { return TypeMsgGrantRole }
+func (m MsgGrantRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	err = ValidateRole(m.Role)
+	if err != nil {
+		return err
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgGrantRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgGrantRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeRole{}
+
+// NewMsgRevokeRole creates a message to revoke a role over a denom from an address
+func NewMsgRevokeRole(sender string, denom string, role Role, address string) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgRevokeRole) Route() string { return RouterKey }
+func (m MsgRevokeRole) Type() string  { return TypeMsgRevokeRole }
+func (m MsgRevokeRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	err = ValidateRole(m.Role)
+	if err != nil {
+		return err
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgRevokeRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMintLimits{}
+
+// NewMsgSetMintLimits creates a message to set the mint limits of a denom
+func NewMsgSetMintLimits(sender string, denom string, mintLimits MintLimits) *MsgSetMintLimits {
+	return &MsgSetMintLimits{
+		Sender:     sender,
+		Denom:      denom,
+		MintLimits: mintLimits,
+	}
+}
+
+func (m MsgSetMintLimits) Route() string { return RouterKey }
+func (m MsgSetMintLimits) Type() string  { return TypeMsgSetMintLimits }
+func (m MsgSetMintLimits) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	return m.MintLimits.Validate()
+}
+
+func (m MsgSetMintLimits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMintLimits) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -448,3 +448,94 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgGrantRole(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper grantRole message
+	baseMsg := types.NewMsgGrantRole(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.Role_MINTER,
+		addr2.String(),
+	)
+
+	// validate grantRole message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "grant_role")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() types.MsgGrantRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() types.MsgGrantRole {
+				return *baseMsg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Sender = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty address",
+			msg: func() types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Address = ""
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unspecified role",
+			msg: func() types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Role = types.Role_ROLE_UNSPECIFIED
+				return msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unknown role",
+			msg: func() types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Role = types.Role(42)
+				return msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -163,6 +163,9 @@ func (m *QueryDenomAuthorityMetadataRequest) GetDenom() string {
 // DenomAuthorityMetadata gRPC query.
 type QueryDenomAuthorityMetadataResponse struct {
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,1,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// mint_window is the current mint rate limit window of the denom, if its
+	// mints are rate limited.
+	MintWindow *MintWindow `protobuf:"bytes,2,opt,name=mint_window,json=mintWindow,proto3" json:"mint_window,omitempty" yaml:"mint_window"`
 }
 
 func (m *QueryDenomAuthorityMetadataResponse) Reset()         { *m = QueryDenomAuthorityMetadataResponse{} }
@@ -205,6 +208,13 @@ func (m *QueryDenomAuthorityMetadataResponse) GetAuthorityMetadata() DenomAuthor
 	return DenomAuthorityMetadata{}
 }
 
+func (m *QueryDenomAuthorityMetadataResponse) GetMintWindow() *MintWindow {
+	if m != nil {
+		return m.MintWindow
+	}
+	return nil
+}
+
 // QueryDenomsFromCreatorRequest defines the request structure for the
 // DenomsFromCreator gRPC query.
 type QueryDenomsFromCreatorRequest struct {
@@ -387,6 +397,214 @@ func (m *QueryBeforeSendHookAddressResponse) GetCosmwasmAddress() string {
 	return ""
 }
 
+// QueryDenomSendPolicyRequest defines the request structure for the
+// DenomSendPolicy gRPC query.
+type QueryDenomSendPolicyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomSendPolicyRequest) Reset()         { *m = QueryDenomSendPolicyRequest{} }
+func (m *QueryDenomSendPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSendPolicyRequest) ProtoMessage()    {}
+func (*QueryDenomSendPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{8}
+}
+func (m *QueryDenomSendPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSendPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSendPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSendPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSendPolicyRequest.Merge(m, src)
+}
+func (m *QueryDenomSendPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSendPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSendPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSendPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryDenomSendPolicyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomSendPolicyResponse defines the response structure for the
+// DenomSendPolicy gRPC query.
+type QueryDenomSendPolicyResponse struct {
+	SendPolicy DenomSendPolicy `protobuf:"bytes,1,opt,name=send_policy,json=sendPolicy,proto3" json:"send_policy" yaml:"send_policy"`
+}
+
+func (m *QueryDenomSendPolicyResponse) Reset()         { *m = QueryDenomSendPolicyResponse{} }
+func (m *QueryDenomSendPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSendPolicyResponse) ProtoMessage()    {}
+func (*QueryDenomSendPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{9}
+}
+func (m *QueryDenomSendPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSendPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSendPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSendPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSendPolicyResponse.Merge(m, src)
+}
+func (m *QueryDenomSendPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSendPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSendPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSendPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryDenomSendPolicyResponse) GetSendPolicy() DenomSendPolicy {
+	if m != nil {
+		return m.SendPolicy
+	}
+	return DenomSendPolicy{}
+}
+
+// QueryDenomAddressListRequest defines the request structure for the
+// DenomAddressList gRPC query.
+type QueryDenomAddressListRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	List       AddressList        `protobuf:"varint,2,opt,name=list,proto3,enum=osmosis.tokenfactory.v1beta1.AddressList" json:"list,omitempty" yaml:"list"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomAddressListRequest) Reset()         { *m = QueryDenomAddressListRequest{} }
+func (m *QueryDenomAddressListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomAddressListRequest) ProtoMessage()    {}
+func (*QueryDenomAddressListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{10}
+}
+func (m *QueryDenomAddressListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomAddressListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomAddressListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomAddressListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomAddressListRequest.Merge(m, src)
+}
+func (m *QueryDenomAddressListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomAddressListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomAddressListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomAddressListRequest proto.InternalMessageInfo
+
+func (m *QueryDenomAddressListRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryDenomAddressListRequest) GetList() AddressList {
+	if m != nil {
+		return m.List
+	}
+	return AddressList_DENYLIST
+}
+
+func (m *QueryDenomAddressListRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomAddressListResponse defines the response structure for the
+// DenomAddressList gRPC query.
+type QueryDenomAddressListResponse struct {
+	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomAddressListResponse) Reset()         { *m = QueryDenomAddressListResponse{} }
+func (m *QueryDenomAddressListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomAddressListResponse) ProtoMessage()    {}
+func (*QueryDenomAddressListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{11}
+}
+func (m *QueryDenomAddressListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomAddressListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomAddressListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomAddressListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomAddressListResponse.Merge(m, src)
+}
+func (m *QueryDenomAddressListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomAddressListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomAddressListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomAddressListResponse proto.InternalMessageInfo
+
+func (m *QueryDenomAddressListResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryDenomAddressListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsResponse")
@@ -396,6 +614,10 @@ func init() {
 	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse")
 	proto.RegisterType((*QueryBeforeSendHookAddressRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryBeforeSendHookAddressRequest")
 	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryBeforeSendHookAddressResponse")
+	proto.RegisterType((*QueryDenomSendPolicyRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomSendPolicyRequest")
+	proto.RegisterType((*QueryDenomSendPolicyResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomSendPolicyResponse")
+	proto.RegisterType((*QueryDenomAddressListRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomAddressListRequest")
+	proto.RegisterType((*QueryDenomAddressListResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomAddressListResponse")
 }
 
 func init() {
@@ -403,50 +625,67 @@ func init() {
 }
 
 var fileDescriptor_6f22013ad0f72e3f = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x4e, 0x13, 0x5f,
-	0x14, 0xee, 0xfc, 0x7e, 0x52, 0xc3, 0xf5, 0x1f, 0x5c, 0xf1, 0x5f, 0xc5, 0xa9, 0x5c, 0x09, 0x01,
-	0x83, 0x1d, 0x8b, 0xc4, 0x18, 0x91, 0x40, 0x07, 0x45, 0x13, 0x24, 0xd1, 0x71, 0xa5, 0x9b, 0xe6,
-	0xb6, 0xbd, 0x94, 0x86, 0xce, 0x9c, 0x32, 0xf7, 0x16, 0x6d, 0x08, 0x1b, 0x17, 0xae, 0x4d, 0x5c,
-	0xfa, 0x0e, 0x3e, 0x07, 0x4b, 0x12, 0x36, 0xae, 0x1a, 0x05, 0xe3, 0x03, 0xf4, 0x09, 0x4c, 0xef,
-	0x3d, 0x45, 0xa0, 0x65, 0xd2, 0xe2, 0xaa, 0x93, 0x73, 0xbe, 0xf3, 0x9d, 0xef, 0xbb, 0xe7, 0x9c,
-	0x94, 0x8c, 0x83, 0xf4, 0x41, 0x96, 0xa4, 0xa3, 0x60, 0x4d, 0x04, 0x2b, 0x3c, 0xaf, 0x20, 0xac,
-	0x39, 0x1b, 0xe9, 0x9c, 0x50, 0x3c, 0xed, 0xac, 0x57, 0x45, 0x58, 0x4b, 0x55, 0x42, 0x50, 0x40,
-	0x87, 0x11, 0x99, 0x3a, 0x8c, 0x4c, 0x21, 0x32, 0x31, 0x54, 0x84, 0x22, 0x68, 0xa0, 0xd3, 0xfc,
-	0x32, 0x35, 0x89, 0xe1, 0x22, 0x40, 0xb1, 0x2c, 0x1c, 0x5e, 0x29, 0x39, 0x3c, 0x08, 0x40, 0x71,
-	0x55, 0x82, 0x40, 0x62, 0xf6, 0x6e, 0x5e, 0x53, 0x3a, 0x39, 0x2e, 0x85, 0x69, 0x75, 0xd0, 0xb8,
-	0xc2, 0x8b, 0xa5, 0x40, 0x83, 0x11, 0x3b, 0x1d, 0xa9, 0x93, 0x57, 0xd5, 0x2a, 0x84, 0x25, 0x55,
-	0x5b, 0x16, 0x8a, 0x17, 0xb8, 0xe2, 0x58, 0x35, 0x11, 0x59, 0x55, 0xe1, 0x21, 0xf7, 0x51, 0x0c,
-	0x1b, 0x22, 0xf4, 0x75, 0x53, 0xc2, 0x2b, 0x1d, 0xf4, 0xc4, 0x7a, 0x55, 0x48, 0xc5, 0xde, 0x92,
-	0xcb, 0x47, 0xa2, 0xb2, 0x02, 0x81, 0x14, 0xd4, 0x25, 0x71, 0x53, 0x7c, 0xdd, 0xba, 0x6d, 0x8d,
-	0x9f, 0x9b, 0x1a, 0x4d, 0x45, 0x3d, 0x4e, 0xca, 0x54, 0xbb, 0x67, 0xb6, 0xeb, 0xc9, 0x98, 0x87,
-	0x95, 0xec, 0x25, 0x61, 0x9a, 0xfa, 0xa9, 0x08, 0xc0, 0xcf, 0x1c, 0x37, 0x80, 0x02, 0xe8, 0x18,
-	0xe9, 0x2b, 0x34, 0x01, 0xba, 0x51, 0xbf, 0x3b, 0xd0, 0xa8, 0x27, 0xcf, 0xd7, 0xb8, 0x5f, 0x7e,
-	0xcc, 0x74, 0x98, 0x79, 0x26, 0xcd, 0xbe, 0x59, 0xe4, 0x4e, 0x24, 0x1d, 0x2a, 0xff, 0x64, 0x11,
-	0x7a, 0xf0, 0x5a, 0x59, 0x1f, 0xd3, 0x68, 0x63, 0x3a, 0xda, 0x46, 0x67, 0x6a, 0x77, 0xa4, 0x69,
-	0xab, 0x51, 0x4f, 0xde, 0x30, 0xba, 0xda, 0xd9, 0x99, 0x37, 0xd8, 0x36, 0x20, 0xb6, 0x4c, 0x6e,
-	0xfd, 0xd5, 0x2b, 0x17, 0x43, 0xf0, 0x17, 0x42, 0xc1, 0x15, 0x84, 0x2d, 0xe7, 0x93, 0xe4, 0x6c,
-	0xde, 0x44, 0xd0, 0x3b, 0x6d, 0xd4, 0x93, 0x17, 0x4d, 0x0f, 0x4c, 0x30, 0xaf, 0x05, 0x61, 0x4b,
-	0xc4, 0x3e, 0x89, 0x0e, 0x9d, 0x4f, 0x90, 0xb8, 0x7e, 0xaa, 0xe6, 0xcc, 0xfe, 0x1f, 0xef, 0x77,
-	0x07, 0x1b, 0xf5, 0xe4, 0x85, 0x43, 0x4f, 0x29, 0x99, 0x87, 0x00, 0xb6, 0x44, 0x46, 0x34, 0x99,
-	0x2b, 0x56, 0x20, 0x14, 0x6f, 0x44, 0x50, 0x78, 0x01, 0xb0, 0x96, 0x29, 0x14, 0x42, 0x21, 0x65,
-	0xaf, 0x93, 0x29, 0xe3, 0x9c, 0x4f, 0x20, 0x43, 0x75, 0x8b, 0x64, 0xa0, 0x79, 0x0d, 0xef, 0xb9,
-	0xf4, 0xb3, 0xdc, 0xe4, 0x90, 0xf8, 0x66, 0xa3, 0x9e, 0xbc, 0x86, 0xb6, 0x8f, 0x21, 0x98, 0x77,
-	0xa9, 0x15, 0x42, 0xbe, 0xa9, 0xed, 0x38, 0xe9, 0xd3, 0xed, 0xe8, 0x57, 0x8b, 0xc4, 0xcd, 0xe2,
-	0xd1, 0xfb, 0xd1, 0x73, 0x6d, 0xdf, 0xfb, 0x44, 0xba, 0x87, 0x0a, 0xe3, 0x80, 0x4d, 0x7e, 0xdc,
-	0xfd, 0xf5, 0xe5, 0xbf, 0x31, 0x3a, 0xea, 0x74, 0x71, 0x74, 0xf4, 0xb7, 0x45, 0xae, 0x76, 0xde,
-	0x27, 0x3a, 0xdf, 0x45, 0xef, 0xc8, 0xa3, 0x49, 0x64, 0xfe, 0x81, 0x01, 0xdd, 0x3c, 0xd7, 0x6e,
-	0x32, 0x74, 0x2e, 0xda, 0x8d, 0x59, 0x18, 0x67, 0x53, 0xff, 0x6e, 0x39, 0xed, 0xbb, 0x4f, 0x77,
-	0x2d, 0x32, 0xd8, 0xb6, 0x94, 0x74, 0xa6, 0x5b, 0x85, 0x1d, 0x2e, 0x23, 0xf1, 0xe4, 0x74, 0xc5,
-	0xe8, 0x6c, 0x41, 0x3b, 0x9b, 0xa5, 0x33, 0xdd, 0x38, 0xcb, 0xae, 0x84, 0xe0, 0x67, 0xf1, 0xc8,
-	0x9c, 0x4d, 0xfc, 0xd8, 0xa2, 0x3f, 0x2d, 0x72, 0xa5, 0xe3, 0x42, 0xd3, 0xb9, 0x2e, 0xc4, 0x45,
-	0xdd, 0x55, 0x62, 0xfe, 0xf4, 0x04, 0xe8, 0xf0, 0x99, 0x76, 0x38, 0x47, 0x67, 0x7b, 0x9a, 0x5d,
-	0x4e, 0x73, 0x66, 0xa5, 0x08, 0x0a, 0xd9, 0x55, 0x80, 0x35, 0xd7, 0xdb, 0xde, 0xb3, 0xad, 0x9d,
-	0x3d, 0xdb, 0xfa, 0xb1, 0x67, 0x5b, 0x9f, 0xf7, 0xed, 0xd8, 0xce, 0xbe, 0x1d, 0xfb, 0xbe, 0x6f,
-	0xc7, 0xde, 0x3d, 0x2a, 0x96, 0xd4, 0x6a, 0x35, 0x97, 0xca, 0x83, 0xdf, 0x6a, 0x71, 0xaf, 0xcc,
-	0x73, 0xf2, 0xa0, 0xdf, 0x46, 0xfa, 0xa1, 0xf3, 0xe1, 0x68, 0x57, 0x55, 0xab, 0x08, 0x99, 0x8b,
-	0xeb, 0x3f, 0x9b, 0x07, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x30, 0xc1, 0xbe, 0x16, 0x77, 0x07,
-	0x00, 0x00,
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x18, 0x8d, 0xd3, 0x36, 0x28, 0x13, 0x68, 0x92, 0x21, 0x94, 0xe0, 0x86, 0x35, 0x1d, 0xaa, 0x90,
+	0xa0, 0xd6, 0x26, 0xa1, 0x42, 0x6d, 0x42, 0x95, 0xac, 0x4b, 0x53, 0xa4, 0x36, 0xa8, 0x98, 0x03,
+	0x82, 0x8b, 0x35, 0xbb, 0x3b, 0xd9, 0x98, 0xac, 0x3d, 0x5b, 0xcf, 0xa4, 0x61, 0x55, 0xf5, 0xc2,
+	0x81, 0x03, 0x27, 0x10, 0x47, 0xf8, 0x41, 0x15, 0x07, 0x54, 0xa9, 0x17, 0x4e, 0x16, 0x24, 0xa8,
+	0x3f, 0xc0, 0xbf, 0x00, 0x79, 0xe6, 0xdb, 0x5d, 0x6f, 0x76, 0x31, 0xde, 0x70, 0xca, 0x6a, 0xe6,
+	0x7d, 0xef, 0x7b, 0xef, 0x9b, 0x99, 0xe7, 0xa0, 0x15, 0x2e, 0x42, 0x2e, 0x02, 0xe1, 0x48, 0x7e,
+	0xc0, 0xa2, 0x3d, 0x5a, 0x97, 0x3c, 0xee, 0x38, 0x8f, 0xd7, 0x6a, 0x4c, 0xd2, 0x35, 0xe7, 0xd1,
+	0x21, 0x8b, 0x3b, 0x76, 0x3b, 0xe6, 0x92, 0xe3, 0x25, 0x40, 0xda, 0x79, 0xa4, 0x0d, 0x48, 0x73,
+	0xa1, 0xc9, 0x9b, 0x5c, 0x01, 0x9d, 0xec, 0x97, 0xae, 0x31, 0x97, 0x9a, 0x9c, 0x37, 0x5b, 0xcc,
+	0xa1, 0xed, 0xc0, 0xa1, 0x51, 0xc4, 0x25, 0x95, 0x01, 0x8f, 0x04, 0xec, 0xbe, 0x5f, 0x57, 0x94,
+	0x4e, 0x8d, 0x0a, 0xa6, 0x5b, 0xf5, 0x1a, 0xb7, 0x69, 0x33, 0x88, 0x14, 0x18, 0xb0, 0x37, 0x0a,
+	0x75, 0xd2, 0x43, 0xb9, 0xcf, 0xe3, 0x40, 0x76, 0x76, 0x99, 0xa4, 0x0d, 0x2a, 0x29, 0x54, 0xd9,
+	0x85, 0x55, 0x61, 0x10, 0x49, 0xbf, 0x15, 0x84, 0x81, 0xec, 0x2a, 0x5a, 0x2d, 0xc4, 0xb7, 0x69,
+	0x4c, 0x43, 0x51, 0x8a, 0x5a, 0xb0, 0xa8, 0xe1, 0xb7, 0x79, 0x2b, 0xa8, 0xc3, 0xf8, 0xc8, 0x02,
+	0xc2, 0x9f, 0x67, 0x16, 0x1f, 0x2a, 0x12, 0x8f, 0x3d, 0x3a, 0x64, 0x42, 0x92, 0xaf, 0xd0, 0xeb,
+	0x03, 0xab, 0xa2, 0xcd, 0x23, 0xc1, 0xb0, 0x8b, 0xa6, 0x74, 0xb3, 0x45, 0xe3, 0x1d, 0x63, 0x65,
+	0x66, 0xfd, 0xaa, 0x5d, 0x34, 0x7c, 0x5b, 0x57, 0xbb, 0xe7, 0x9f, 0x25, 0xd6, 0x84, 0x07, 0x95,
+	0xe4, 0x01, 0x22, 0x8a, 0xfa, 0x13, 0x16, 0xf1, 0xb0, 0x7a, 0x7a, 0x40, 0x20, 0x00, 0x2f, 0xa3,
+	0x0b, 0x8d, 0x0c, 0xa0, 0x1a, 0x4d, 0xbb, 0x73, 0x69, 0x62, 0xbd, 0xda, 0xa1, 0x61, 0x6b, 0x83,
+	0xa8, 0x65, 0xe2, 0xe9, 0x6d, 0xf2, 0xd3, 0x24, 0x7a, 0xb7, 0x90, 0x0e, 0x94, 0x7f, 0x6f, 0x20,
+	0xdc, 0x3b, 0x0d, 0x3f, 0x84, 0x6d, 0xb0, 0x71, 0xa3, 0xd8, 0xc6, 0x68, 0x6a, 0xf7, 0x4a, 0x66,
+	0x2b, 0x4d, 0xac, 0xb7, 0xb4, 0xae, 0x61, 0x76, 0xe2, 0xcd, 0x0f, 0x5d, 0x00, 0x4c, 0xd1, 0x8c,
+	0x3a, 0xdf, 0xa3, 0x20, 0x6a, 0xf0, 0xa3, 0xc5, 0x49, 0x25, 0x60, 0xa5, 0x58, 0xc0, 0x6e, 0x10,
+	0xc9, 0x2f, 0x15, 0xde, 0xbd, 0x94, 0x26, 0x16, 0xd6, 0x0d, 0x73, 0x34, 0xc4, 0x43, 0x61, 0x0f,
+	0x43, 0x76, 0xd1, 0xdb, 0xfd, 0x91, 0x88, 0x9d, 0x98, 0x87, 0x77, 0x62, 0x46, 0x25, 0x8f, 0xbb,
+	0xc3, 0xbd, 0x86, 0x5e, 0xa9, 0xeb, 0x15, 0x18, 0x2f, 0x4e, 0x13, 0xeb, 0xa2, 0x66, 0x85, 0x0d,
+	0xe2, 0x75, 0x21, 0xe4, 0x3e, 0xaa, 0xfc, 0x1b, 0x1d, 0x0c, 0x77, 0x15, 0x4d, 0xa9, 0xd3, 0xc8,
+	0xae, 0xc5, 0xb9, 0x95, 0x69, 0x77, 0x3e, 0x4d, 0xac, 0xd7, 0x72, 0xa7, 0x25, 0x88, 0x07, 0x00,
+	0x72, 0x1f, 0x5d, 0x51, 0x64, 0x2e, 0xdb, 0xe3, 0x31, 0xfb, 0x82, 0x45, 0x8d, 0x4f, 0x39, 0x3f,
+	0xa8, 0x36, 0x1a, 0x31, 0x13, 0x62, 0xdc, 0xc3, 0x6f, 0x21, 0x52, 0x44, 0x06, 0xea, 0x76, 0xd0,
+	0x5c, 0xf6, 0xa0, 0x8f, 0xa8, 0x08, 0x7d, 0xaa, 0xf7, 0x80, 0xf8, 0x72, 0x9a, 0x58, 0x6f, 0x82,
+	0xed, 0x53, 0x08, 0xe2, 0xcd, 0x76, 0x97, 0x80, 0x8f, 0xdc, 0x45, 0x97, 0xfb, 0x73, 0xc8, 0x9a,
+	0x3d, 0x54, 0xef, 0x68, 0x5c, 0xd1, 0x3f, 0x18, 0x68, 0x69, 0x34, 0x0f, 0xe8, 0xfd, 0x06, 0xcd,
+	0xe4, 0x9e, 0x29, 0x5c, 0xd1, 0xeb, 0x25, 0xae, 0x68, 0x9f, 0xcb, 0x35, 0xe1, 0x6e, 0xc2, 0x55,
+	0xc9, 0xf1, 0x11, 0x0f, 0x89, 0x1e, 0x8e, 0x24, 0x03, 0x62, 0xc0, 0xe9, 0x83, 0x40, 0xc8, 0x31,
+	0x5d, 0xe1, 0xcf, 0xd0, 0xf9, 0x56, 0x20, 0xa4, 0xba, 0xcf, 0x17, 0xd7, 0x57, 0x8b, 0xd5, 0xe6,
+	0xfa, 0xb8, 0xb3, 0x69, 0x62, 0xcd, 0x68, 0xc6, 0x8c, 0x80, 0x78, 0x8a, 0x07, 0xef, 0x20, 0xd4,
+	0xcf, 0xda, 0xc5, 0x73, 0x6a, 0x06, 0xcb, 0xb6, 0x0e, 0x66, 0x3b, 0x0b, 0x66, 0x5b, 0x7f, 0x03,
+	0xfa, 0x51, 0xd3, 0x64, 0xa0, 0xd9, 0xcb, 0x55, 0x92, 0x5f, 0x8d, 0xfc, 0x63, 0x18, 0x30, 0x08,
+	0xe3, 0x5e, 0x47, 0xd3, 0x70, 0xe6, 0xac, 0x7b, 0x7f, 0x17, 0xd2, 0xc4, 0x9a, 0x83, 0x57, 0xdd,
+	0xdd, 0x22, 0x5e, 0x1f, 0x86, 0xef, 0x0d, 0xa8, 0xd3, 0x6f, 0xf8, 0xbd, 0xff, 0x54, 0xa7, 0x1b,
+	0xe6, 0xe5, 0xad, 0xbf, 0x9c, 0x46, 0x17, 0x94, 0x3c, 0xfc, 0x8b, 0x81, 0xa6, 0x74, 0x5e, 0xe2,
+	0x0f, 0x8a, 0xa7, 0x37, 0x1c, 0xd7, 0xe6, 0xda, 0x18, 0x15, 0x5a, 0x05, 0xb9, 0xf6, 0xdd, 0x8b,
+	0xbf, 0x7f, 0x9e, 0x5c, 0xc6, 0x57, 0x9d, 0x12, 0xdf, 0x16, 0xfc, 0xd2, 0x40, 0x97, 0x46, 0xc7,
+	0x20, 0xde, 0x2e, 0xd1, 0xbb, 0x30, 0xeb, 0xcd, 0xea, 0xff, 0x60, 0x00, 0x37, 0xf7, 0x94, 0x9b,
+	0x2a, 0xde, 0x2a, 0x76, 0xa3, 0x43, 0xc8, 0x79, 0xa2, 0xfe, 0x3e, 0x75, 0x86, 0x23, 0x1b, 0xbf,
+	0x30, 0xd0, 0xfc, 0x50, 0xd0, 0xe1, 0xcd, 0xb2, 0x0a, 0x47, 0xa4, 0xad, 0xf9, 0xf1, 0xd9, 0x8a,
+	0xc1, 0xd9, 0x1d, 0xe5, 0xec, 0x36, 0xde, 0x2c, 0xe3, 0xcc, 0xdf, 0x8b, 0x79, 0xe8, 0x43, 0x70,
+	0x3b, 0x4f, 0xe0, 0xc7, 0x53, 0xfc, 0x97, 0x81, 0xde, 0x18, 0x19, 0x92, 0x78, 0xab, 0x84, 0xb8,
+	0xa2, 0xac, 0x36, 0xb7, 0xcf, 0x4e, 0x00, 0x0e, 0xef, 0x2a, 0x87, 0x5b, 0xf8, 0xf6, 0x58, 0x67,
+	0x57, 0x53, 0x9c, 0xbe, 0x4a, 0xb6, 0x7d, 0xce, 0x0f, 0xf0, 0x6f, 0x06, 0x9a, 0x3d, 0x15, 0x83,
+	0xf8, 0x56, 0xd9, 0xd1, 0x0f, 0xc5, 0xb9, 0xb9, 0x71, 0x96, 0x52, 0x70, 0xb4, 0xad, 0x1c, 0x6d,
+	0xe0, 0x9b, 0x63, 0x39, 0xca, 0x85, 0x34, 0xfe, 0xdd, 0x40, 0x73, 0xa7, 0x13, 0x0b, 0x97, 0x96,
+	0x34, 0x9c, 0xe3, 0xe6, 0xe6, 0x99, 0x6a, 0xc1, 0x4f, 0x55, 0xf9, 0xd9, 0xc4, 0xb7, 0xc6, 0x7b,
+	0x5d, 0x9a, 0xc9, 0xcf, 0xf2, 0xdc, 0xf5, 0x9e, 0x1d, 0x57, 0x8c, 0xe7, 0xc7, 0x15, 0xe3, 0xcf,
+	0xe3, 0x8a, 0xf1, 0xe3, 0x49, 0x65, 0xe2, 0xf9, 0x49, 0x65, 0xe2, 0x8f, 0x93, 0xca, 0xc4, 0xd7,
+	0x37, 0x9b, 0x81, 0xdc, 0x3f, 0xac, 0xd9, 0x75, 0x1e, 0x76, 0xe9, 0xaf, 0xb7, 0x68, 0x4d, 0xf4,
+	0x7a, 0x3d, 0x5e, 0xfb, 0xc8, 0xf9, 0x76, 0xb0, 0xa3, 0xec, 0xb4, 0x99, 0xa8, 0x4d, 0xa9, 0xff,
+	0x60, 0x3f, 0xfc, 0x67, 0x00, 0x08, 0xc7, 0xf6, 0x29, 0x2c, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -473,6 +712,12 @@ type QueryClient interface {
 	// BeforeSendHookAddress defines a gRPC query method for
 	// getting the address registered for the before send hook.
 	BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomSendPolicy defines a gRPC query method for fetching the native send
+	// policy of a denom.
+	DenomSendPolicy(ctx context.Context, in *QueryDenomSendPolicyRequest, opts ...grpc.CallOption) (*QueryDenomSendPolicyResponse, error)
+	// DenomAddressList defines a gRPC query method for fetching the addresses
+	// of a denom's denylist or allowlist.
+	DenomAddressList(ctx context.Context, in *QueryDenomAddressListRequest, opts ...grpc.CallOption) (*QueryDenomAddressListResponse, error)
 }
 
 type queryClient struct {
@@ -519,6 +764,24 @@ func (c *queryClient) BeforeSendHookAddress(ctx context.Context, in *QueryBefore
 	return out, nil
 }
 
+func (c *queryClient) DenomSendPolicy(ctx context.Context, in *QueryDenomSendPolicyRequest, opts ...grpc.CallOption) (*QueryDenomSendPolicyResponse, error) {
+	out := new(QueryDenomSendPolicyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomSendPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomAddressList(ctx context.Context, in *QueryDenomAddressListRequest, opts ...grpc.CallOption) (*QueryDenomAddressListResponse, error) {
+	out := new(QueryDenomAddressListResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomAddressList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -533,6 +796,12 @@ type QueryServer interface {
 	// BeforeSendHookAddress defines a gRPC query method for
 	// getting the address registered for the before send hook.
 	BeforeSendHookAddress(context.Context, *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomSendPolicy defines a gRPC query method for fetching the native send
+	// policy of a denom.
+	DenomSendPolicy(context.Context, *QueryDenomSendPolicyRequest) (*QueryDenomSendPolicyResponse, error)
+	// DenomAddressList defines a gRPC query method for fetching the addresses
+	// of a denom's denylist or allowlist.
+	DenomAddressList(context.Context, *QueryDenomAddressListRequest) (*QueryDenomAddressListResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -551,6 +820,12 @@ func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeforeSendHookAddress not implemented")
 }
+func (*UnimplementedQueryServer) DenomSendPolicy(ctx context.Context, req *QueryDenomSendPolicyRequest) (*QueryDenomSendPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSendPolicy not implemented")
+}
+func (*UnimplementedQueryServer) DenomAddressList(ctx context.Context, req *QueryDenomAddressListRequest) (*QueryDenomAddressListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAddressList not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -628,6 +903,42 @@ func _Query_BeforeSendHookAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomSendPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomSendPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSendPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomSendPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSendPolicy(ctx, req.(*QueryDenomSendPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAddressList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAddressListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAddressList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomAddressList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAddressList(ctx, req.(*QueryDenomAddressListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -648,6 +959,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BeforeSendHookAddress",
 			Handler:    _Query_BeforeSendHookAddress_Handler,
 		},
+		{
+			MethodName: "DenomSendPolicy",
+			Handler:    _Query_DenomSendPolicy_Handler,
+		},
+		{
+			MethodName: "DenomAddressList",
+			Handler:    _Query_DenomAddressList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/query.proto",
@@ -759,6 +1078,18 @@ func (m *QueryDenomAuthorityMetadataResponse) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.MintWindow != nil {
+		{
+			size, err := m.MintWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -894,77 +1225,235 @@ func (m *QueryBeforeSendHookAddressResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomSendPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDenomSendPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomSendPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomSendPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AuthorityMetadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDenomSendPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomSendPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SendPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAddressListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAddressListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAddressListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.List != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.List))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAddressListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAddressListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAddressListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MintWindow != nil {
+		l = m.MintWindow.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1003,6 +1492,69 @@ func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomSendPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSendPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SendPolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomAddressListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.List != 0 {
+		n += 1 + sovQuery(uint64(m.List))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAddressListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1286,6 +1838,42 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintWindow == nil {
+				m.MintWindow = &MintWindow{}
+			}
+			if err := m.MintWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1635,6 +2223,426 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomSendPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSendPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSendPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSendPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSendPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSendPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAddressListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAddressListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAddressListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			m.List = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.List |= AddressList(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAddressListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAddressListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAddressListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// AddressListFromString parses an address list from its case insensitive name,
+// e.g. "denylist" or "ALLOWLIST".
+func AddressListFromString(s string) (AddressList, error) {
+	list, ok := AddressList_value[strings.ToUpper(s)]
+	if !ok {
+		return AddressList_DENYLIST, errorsmod.Wrapf(ErrInvalidAddressList, "unknown address list %s, expected denylist or allowlist", s)
+	}
+	return AddressList(list), nil
+}
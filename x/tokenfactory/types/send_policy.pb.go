@@ -0,0 +1,422 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/send_policy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressList is the type of a denom's send policy address list.
+// Addresses in the denylist can neither send nor receive the denom.
+// Addresses in the allowlist are the only ones that can send or receive the
+// denom, if the allowlist is enabled.
+type AddressList int32
+
+const (
+	AddressList_DENYLIST  AddressList = 0
+	AddressList_ALLOWLIST AddressList = 1
+)
+
+var AddressList_name = map[int32]string{
+	0: "DENYLIST",
+	1: "ALLOWLIST",
+}
+
+var AddressList_value = map[string]int32{
+	"DENYLIST":  0,
+	"ALLOWLIST": 1,
+}
+
+func (x AddressList) String() string {
+	return proto.EnumName(AddressList_name, int32(x))
+}
+
+func (AddressList) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5cc1657bac904ef9, []int{0}
+}
+
+// DenomSendPolicy defines the native transfer restrictions of a tokenfactory
+// denom. They are enforced before every send of the denom, in addition to the
+// before send hook contract if any. Sends from or to the tokenfactory module
+// account (mints and burns) and force transfers are never restricted.
+type DenomSendPolicy struct {
+	// frozen blocks every send of the denom.
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
+	// allowlist_enabled restricts sends of the denom to those where both the
+	// sender and the recipient are in the denom's allowlist.
+	AllowlistEnabled bool `protobuf:"varint,2,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty" yaml:"allowlist_enabled"`
+}
+
+func (m *DenomSendPolicy) Reset()         { *m = DenomSendPolicy{} }
+func (m *DenomSendPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomSendPolicy) ProtoMessage()    {}
+func (*DenomSendPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc1657bac904ef9, []int{0}
+}
+func (m *DenomSendPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSendPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSendPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomSendPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSendPolicy.Merge(m, src)
+}
+func (m *DenomSendPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSendPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSendPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomSendPolicy proto.InternalMessageInfo
+
+func (m *DenomSendPolicy) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *DenomSendPolicy) GetAllowlistEnabled() bool {
+	if m != nil {
+		return m.AllowlistEnabled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.AddressList", AddressList_name, AddressList_value)
+	proto.RegisterType((*DenomSendPolicy)(nil), "osmosis.tokenfactory.v1beta1.DenomSendPolicy")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/send_policy.proto", fileDescriptor_5cc1657bac904ef9)
+}
+
+var fileDescriptor_5cc1657bac904ef9 = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x31, 0x4b, 0xc3, 0x40,
+	0x18, 0x86, 0x73, 0x22, 0xa5, 0x9e, 0x16, 0xdb, 0xe0, 0x50, 0xa4, 0x5c, 0x25, 0x93, 0x16, 0xcc,
+	0x51, 0x04, 0x91, 0x6e, 0x2d, 0xed, 0x50, 0x08, 0x2a, 0xad, 0x20, 0xba, 0x94, 0x4b, 0x73, 0xad,
+	0xc1, 0xcb, 0x7d, 0x25, 0x77, 0x56, 0xe3, 0x7f, 0x10, 0xfc, 0x09, 0xfe, 0x1c, 0xc7, 0x8e, 0x4e,
+	0x45, 0x92, 0xc5, 0xb9, 0xbf, 0x40, 0x4c, 0xa2, 0x28, 0x6e, 0xdf, 0xfb, 0xf2, 0xbc, 0xdf, 0xf0,
+	0x60, 0x1b, 0x54, 0x00, 0xca, 0x57, 0x54, 0xc3, 0x2d, 0x97, 0x13, 0x36, 0xd6, 0x10, 0x46, 0x74,
+	0xde, 0x74, 0xb9, 0x66, 0x4d, 0xaa, 0xb8, 0xf4, 0x46, 0x33, 0x10, 0xfe, 0x38, 0xb2, 0x67, 0x21,
+	0x68, 0x30, 0x6b, 0x39, 0x6f, 0xff, 0xe6, 0xed, 0x9c, 0xdf, 0xdd, 0x99, 0xc2, 0x14, 0x52, 0x90,
+	0x7e, 0x5d, 0xd9, 0xc6, 0x7a, 0x42, 0x78, 0xbb, 0xcb, 0x25, 0x04, 0x43, 0x2e, 0xbd, 0xf3, 0xf4,
+	0x9b, 0x79, 0x80, 0x0b, 0x93, 0x10, 0x1e, 0xb9, 0xac, 0xa2, 0x3d, 0xb4, 0x5f, 0xec, 0x54, 0x56,
+	0xcb, 0x7a, 0x29, 0x62, 0x81, 0x68, 0x59, 0x59, 0x6f, 0x0d, 0x72, 0xc0, 0xec, 0xe3, 0x0a, 0x13,
+	0x02, 0xee, 0x85, 0xaf, 0xf4, 0x88, 0x4b, 0xe6, 0x0a, 0xee, 0x55, 0xd7, 0xd2, 0x55, 0x6d, 0xb5,
+	0xac, 0x57, 0xb3, 0xd5, 0x3f, 0xc4, 0x1a, 0x94, 0x7f, 0xba, 0x5e, 0x56, 0xb5, 0xd6, 0x3f, 0x5e,
+	0xea, 0xa8, 0xd1, 0xc0, 0x9b, 0x6d, 0xcf, 0x0b, 0xb9, 0x52, 0x8e, 0xaf, 0xb4, 0xb9, 0x85, 0x8b,
+	0xdd, 0xde, 0xe9, 0x95, 0xd3, 0x1f, 0x5e, 0x94, 0x0d, 0xb3, 0x84, 0x37, 0xda, 0x8e, 0x73, 0x76,
+	0x99, 0x46, 0xd4, 0x19, 0xbc, 0xc6, 0x04, 0x2d, 0x62, 0x82, 0xde, 0x63, 0x82, 0x9e, 0x13, 0x62,
+	0x2c, 0x12, 0x62, 0xbc, 0x25, 0xc4, 0xb8, 0x3e, 0x99, 0xfa, 0xfa, 0xe6, 0xce, 0xb5, 0xc7, 0x10,
+	0xd0, 0x5c, 0xca, 0xa1, 0x60, 0xae, 0xfa, 0x0e, 0x74, 0xde, 0x3c, 0xa6, 0x0f, 0x7f, 0xbd, 0xea,
+	0x68, 0xc6, 0x95, 0x5b, 0x48, 0xb5, 0x1c, 0x7d, 0x0e, 0x00, 0x39, 0xe4, 0xe6, 0x2e, 0x7c, 0x01,
+	0x00, 0x00,
+}
+
+func (this *DenomSendPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomSendPolicy)
+	if !ok {
+		that2, ok := that.(DenomSendPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Frozen != that1.Frozen {
+		return false
+	}
+	if this.AllowlistEnabled != that1.AllowlistEnabled {
+		return false
+	}
+	return true
+}
+func (m *DenomSendPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSendPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSendPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowlistEnabled {
+		i--
+		if m.AllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSendPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSendPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomSendPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	return n
+}
+
+func sovSendPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSendPolicy(x uint64) (n int) {
+	return sovSendPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomSendPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSendPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSendPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSendPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSendPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSendPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSendPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSendPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSendPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSendPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSendPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSendPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSendPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSendPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSendPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSendPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSendPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSendPolicy = fmt.Errorf("proto: unexpected end of group")
+)
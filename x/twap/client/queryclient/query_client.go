@@ -0,0 +1,152 @@
+package queryclient
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/twap/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/v16/x/twap/client/queryproto"
+)
+
+// Paths of the queries, used by gRPC, ABCI and Stargate queries.
+const (
+	ArithmeticTwapPath      = "/osmosis.twap.v1beta1.Query/ArithmeticTwap"
+	ArithmeticTwapToNowPath = "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow"
+	GeometricTwapPath       = "/osmosis.twap.v1beta1.Query/GeometricTwap"
+	GeometricTwapToNowPath  = "/osmosis.twap.v1beta1.Query/GeometricTwapToNow"
+	ParamsPath              = "/osmosis.twap.v1beta1.Query/Params"
+)
+
+// Client is a typed client of the queries, over a gRPC connection to a node or a client.Context.
+type Client struct {
+	q queryproto.QueryClient
+}
+
+func NewClient(conn gogogrpc.ClientConn) Client {
+	return Client{q: queryproto.NewQueryClient(conn)}
+}
+
+func (c Client) ArithmeticTwap(ctx context.Context,
+	req queryproto.ArithmeticTwapRequest, opts ...grpc.CallOption,
+) (*queryproto.ArithmeticTwapResponse, error) {
+	return c.q.ArithmeticTwap(ctx, &req, opts...)
+}
+
+// NewArithmeticTwapABCIRequest returns the ABCI query of ArithmeticTwap at the given height, 0 being the latest one.
+func NewArithmeticTwapABCIRequest(req queryproto.ArithmeticTwapRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: ArithmeticTwapPath, Data: bz, Height: height}, nil
+}
+
+// DecodeArithmeticTwapResponse decodes the response of ArithmeticTwap, e.g. the value of its ABCI query.
+func DecodeArithmeticTwapResponse(bz []byte) (*queryproto.ArithmeticTwapResponse, error) {
+	res := &queryproto.ArithmeticTwapResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) ArithmeticTwapToNow(ctx context.Context,
+	req queryproto.ArithmeticTwapToNowRequest, opts ...grpc.CallOption,
+) (*queryproto.ArithmeticTwapToNowResponse, error) {
+	return c.q.ArithmeticTwapToNow(ctx, &req, opts...)
+}
+
+// NewArithmeticTwapToNowABCIRequest returns the ABCI query of ArithmeticTwapToNow at the given height, 0 being the latest one.
+func NewArithmeticTwapToNowABCIRequest(req queryproto.ArithmeticTwapToNowRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: ArithmeticTwapToNowPath, Data: bz, Height: height}, nil
+}
+
+// DecodeArithmeticTwapToNowResponse decodes the response of ArithmeticTwapToNow, e.g. the value of its ABCI query.
+func DecodeArithmeticTwapToNowResponse(bz []byte) (*queryproto.ArithmeticTwapToNowResponse, error) {
+	res := &queryproto.ArithmeticTwapToNowResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) GeometricTwap(ctx context.Context,
+	req queryproto.GeometricTwapRequest, opts ...grpc.CallOption,
+) (*queryproto.GeometricTwapResponse, error) {
+	return c.q.GeometricTwap(ctx, &req, opts...)
+}
+
+// NewGeometricTwapABCIRequest returns the ABCI query of GeometricTwap at the given height, 0 being the latest one.
+func NewGeometricTwapABCIRequest(req queryproto.GeometricTwapRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: GeometricTwapPath, Data: bz, Height: height}, nil
+}
+
+// DecodeGeometricTwapResponse decodes the response of GeometricTwap, e.g. the value of its ABCI query.
+func DecodeGeometricTwapResponse(bz []byte) (*queryproto.GeometricTwapResponse, error) {
+	res := &queryproto.GeometricTwapResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) GeometricTwapToNow(ctx context.Context,
+	req queryproto.GeometricTwapToNowRequest, opts ...grpc.CallOption,
+) (*queryproto.GeometricTwapToNowResponse, error) {
+	return c.q.GeometricTwapToNow(ctx, &req, opts...)
+}
+
+// NewGeometricTwapToNowABCIRequest returns the ABCI query of GeometricTwapToNow at the given height, 0 being the latest one.
+func NewGeometricTwapToNowABCIRequest(req queryproto.GeometricTwapToNowRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: GeometricTwapToNowPath, Data: bz, Height: height}, nil
+}
+
+// DecodeGeometricTwapToNowResponse decodes the response of GeometricTwapToNow, e.g. the value of its ABCI query.
+func DecodeGeometricTwapToNowResponse(bz []byte) (*queryproto.GeometricTwapToNowResponse, error) {
+	res := &queryproto.GeometricTwapToNowResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) Params(ctx context.Context,
+	req queryproto.ParamsRequest, opts ...grpc.CallOption,
+) (*queryproto.ParamsResponse, error) {
+	return c.q.Params(ctx, &req, opts...)
+}
+
+// NewParamsABCIRequest returns the ABCI query of Params at the given height, 0 being the latest one.
+func NewParamsABCIRequest(req queryproto.ParamsRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: ParamsPath, Data: bz, Height: height}, nil
+}
+
+// DecodeParamsResponse decodes the response of Params, e.g. the value of its ABCI query.
+func DecodeParamsResponse(bz []byte) (*queryproto.ParamsResponse, error) {
+	res := &queryproto.ParamsResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -1,16 +1,25 @@
 package twap
 
 import (
+	"encoding/json"
+	"io"
 	"sort"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/genesisstream"
 	"github.com/osmosis-labs/osmosis/v16/x/twap/types"
 )
 
+// twapsJSONName is the JSON name of the genesis array streamed by InitGenesisFromJSON and ExportGenesisTo.
+const twapsJSONName = "twaps"
+
 type Keeper struct {
 	storeKey     sdk.StoreKey
 	transientKey *sdk.TransientStoreKey
@@ -84,6 +93,70 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 }
 
+// InitGenesisFromJSON initializes the twap module's state from a provided JSON genesis state,
+// unmarshalling its records one at a time instead of holding all of them in memory.
+func (k Keeper) InitGenesisFromJSON(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := genesisstream.UnmarshalJSON(cdc, bz, &genState, twapsJSONName); err != nil {
+		return err
+	}
+	if err := genState.Params.Validate(); err != nil {
+		return err
+	}
+	// Like InitGenesis, all records are validated before any of them is stored.
+	err := genesisstream.ForEach(cdc, bz, twapsJSONName, func(twap *types.TwapRecord) error {
+		return twap.Validate()
+	})
+	if err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, genState.Params)
+
+	// The records are not sorted by time, so the most recent record of each
+	// pool and asset pair is only indexed once all records are stored.
+	mostRecentRecords := map[string]types.TwapRecord{}
+	err = genesisstream.ForEach(cdc, bz, twapsJSONName, func(twap *types.TwapRecord) error {
+		k.storeHistoricalTWAP(ctx, *twap)
+		key := string(types.FormatMostRecentTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom))
+		if mostRecent, ok := mostRecentRecords[key]; !ok || !twap.Time.Before(mostRecent.Time) {
+			mostRecentRecords[key] = *twap
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(mostRecentRecords))
+	for key := range mostRecentRecords {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		twap := mostRecentRecords[key]
+		osmoutils.MustSet(store, []byte(key), &twap)
+	}
+	return nil
+}
+
+// ExportGenesisTo writes the twap module's exported genesis as sorted JSON to w,
+// exporting its records one at a time instead of holding all of them in memory.
+func (k Keeper) ExportGenesisTo(ctx sdk.Context, cdc codec.JSONCodec, w io.Writer) error {
+	genState := &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+
+	return genesisstream.WriteJSON(w, cdc, genState, map[string]genesisstream.ArrayWriter{
+		twapsJSONName: func(write func(proto.Message) error) error {
+			// in increasing time order, like ExportGenesis.
+			return osmoutils.ForEachValueFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.HistoricalTWAPTimeIndexPrefix), types.ParseTwapFromBz,
+				func(twap types.TwapRecord) error { return write(&twap) })
+		},
+	})
+}
+
 // GetGeometricStrategy gets geometric TWAP keeper.
 func (k Keeper) GetGeometricStrategy() *geometric {
 	return &geometric{k}
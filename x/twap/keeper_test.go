@@ -1,6 +1,8 @@
 package twap_test
 
 import (
+	"bytes"
+	"fmt"
 	"sort"
 	"testing"
 	"time"
@@ -234,29 +236,41 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 	}
 
 	for name, tc := range testCases {
-		suite.Run(name, func() {
-			suite.Setup()
-			// Setup.
-			ctx := suite.Ctx
-			twapKeeper := suite.App.TwapKeeper
-
-			// Test.
-			osmoassert.ConditionalPanic(suite.T(), tc.expectPanic, func() { twapKeeper.InitGenesis(ctx, tc.twapGenesis) })
-			if tc.expectPanic {
-				return
-			}
-
-			// Assertions.
-
-			// Parameters were set.
-			suite.Require().Equal(tc.twapGenesis.Params, twapKeeper.GetParams(ctx))
-
-			for _, expectedMostRecentRecord := range tc.expectedMostRecentRecord {
-				record, err := twapKeeper.GetMostRecentRecordStoreRepresentation(ctx, expectedMostRecentRecord.PoolId, expectedMostRecentRecord.Asset0Denom, expectedMostRecentRecord.Asset1Denom)
-				suite.Require().NoError(err)
-				suite.Require().Equal(expectedMostRecentRecord, record)
-			}
-		})
+		// the streamed import unmarshals the records of the JSON genesis one at a time.
+		for _, streamed := range []bool{false, true} {
+			suite.Run(fmt.Sprintf("%s, streamed %t", name, streamed), func() {
+				suite.Setup()
+				// Setup.
+				ctx := suite.Ctx
+				twapKeeper := suite.App.TwapKeeper
+				initGenesis := func() { twapKeeper.InitGenesis(ctx, tc.twapGenesis) }
+				if streamed {
+					bz := suite.App.AppCodec().MustMarshalJSON(tc.twapGenesis)
+					initGenesis = func() {
+						if err := twapKeeper.InitGenesisFromJSON(ctx, suite.App.AppCodec(), bz); err != nil {
+							panic(err)
+						}
+					}
+				}
+
+				// Test.
+				osmoassert.ConditionalPanic(suite.T(), tc.expectPanic, initGenesis)
+				if tc.expectPanic {
+					return
+				}
+
+				// Assertions.
+
+				// Parameters were set.
+				suite.Require().Equal(tc.twapGenesis.Params, twapKeeper.GetParams(ctx))
+
+				for _, expectedMostRecentRecord := range tc.expectedMostRecentRecord {
+					record, err := twapKeeper.GetMostRecentRecordStoreRepresentation(ctx, expectedMostRecentRecord.PoolId, expectedMostRecentRecord.Asset0Denom, expectedMostRecentRecord.Asset1Denom)
+					suite.Require().NoError(err)
+					suite.Require().Equal(expectedMostRecentRecord, record)
+				}
+			})
+		}
 	}
 }
 
@@ -304,6 +318,12 @@ func (suite *TestSuite) TestTWAPExportGenesis() {
 			})
 
 			suite.Require().Equal(tc.expectedGenesis.Twaps, actualGenesis.Twaps)
+
+			// The streamed export is the sorted JSON of the export.
+			cdc := app.AppCodec()
+			var streamed bytes.Buffer
+			suite.Require().NoError(twapKeeper.ExportGenesisTo(ctx, cdc, &streamed))
+			suite.Require().Equal(string(sdk.MustSortJSON(cdc.MustMarshalJSON(actualGenesis))), streamed.String())
 		})
 	}
 }
@@ -7,10 +7,6 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func (t TwapRecord) Validate() error {
-	return t.validate()
-}
-
 func TestValidatePeriod(t *testing.T) {
 	testCases := map[string]struct {
 		period      interface{}
@@ -25,15 +25,15 @@ func (g *GenesisState) Validate() error {
 	}
 
 	for _, twap := range g.Twaps {
-		if err := twap.validate(); err != nil {
+		if err := twap.Validate(); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// validate validates the twap record, returns nil on success, error otherwise.
-func (t TwapRecord) validate() error {
+// Validate validates the twap record, returns nil on success, error otherwise.
+func (t TwapRecord) Validate() error {
 	if t.PoolId == 0 {
 		return errors.New("pool id cannot be 0")
 	}
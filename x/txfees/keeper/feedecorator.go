@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -141,8 +143,15 @@ func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDeno
 	if tx.GetGas() >= mfd.Opts.HighGasTxThreshold {
 		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForHighGasTx)
 	}
-	if txfee_filters.IsArbTxLoose(tx) {
+	if isArb, reason := txfee_filters.IsArbTx(tx, mfd.Opts.ArbitrageFilter); isArb {
 		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForArbitrageTx)
+		// Only count a tx once, when it first enters the mempool.
+		if !ctx.IsReCheckTx() {
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, "arbitrage_txs"}, 1,
+				[]metrics.Label{telemetry.NewLabel("reason", string(reason))},
+			)
+		}
 	}
 	return cfgMinGasPrice
 }
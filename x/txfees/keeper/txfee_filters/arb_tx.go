@@ -1,54 +1,203 @@
 package txfee_filters
 
 import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
 	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// ArbReason describes which rule classified a tx as an arbitrage.
+// It is used as a metrics label.
+type ArbReason string
+
+const (
+	NotArb                         ArbReason = ""
+	ArbReasonSameInAndOutDenom     ArbReason = "same_in_and_out_denom"
+	ArbReasonDifferentSwapInDenoms ArbReason = "different_swap_in_denoms"
+	ArbReasonRepeatedDenoms        ArbReason = "repeated_denoms"
+	ArbReasonJoinAndExitPool       ArbReason = "join_and_exit_pool"
+	ArbReasonSwapContract          ArbReason = "swap_contract"
+)
+
 // We check if a tx is an arbitrage for the mempool right now by seeing:
 // 1) does start token of a msg = final token of msg (definitionally correct)
 // 2) does it have multiple swap messages, with different tx ins. If so, we assume its an arb.
 //   - This has false positives, but is intended to avoid the obvious solution of splitting
 //     an arb into multiple messages.
 //
-// 3) We record all denoms seen across all swaps, and see if any duplicates. (TODO)
+// 3) We record all denoms seen across all swaps, and see if any duplicates.
 // 4) Contains both JoinPool and ExitPool messages in one tx.
 //   - Has some false positives, but they seem relatively contrived.
 //
+// IsArbTxLoose only applies checks (1), (2) and (4) on the top level messages of the tx.
+// See IsArbTx for the configurable classifier.
+//
 // TODO: Move the first component to a future router module.
 func IsArbTxLoose(tx sdk.Tx) bool {
-	msgs := tx.GetMsgs()
+	isArb, _ := IsArbTx(tx, types.ArbitrageFilterOptions{})
+	return isArb
+}
 
-	swapInDenom := ""
-	lpTypesSeen := make(map[gammtypes.LiquidityChangeType]bool, 2)
+// IsArbTx classifies a tx as an arbitrage according to the given options,
+// returning the reason it was classified as such.
+// On top of the checks documented on IsArbTxLoose, it:
+// - recognizes every poolmanager swap message, including split route swaps.
+// - optionally unwraps authz MsgExec messages, up to a maximum depth.
+// - optionally checks for repeated denoms across all swap routes (3).
+// - optionally treats executions of configured swap contracts as swaps.
+func IsArbTx(tx sdk.Tx, opts types.ArbitrageFilterOptions) (bool, ArbReason) {
+	c := arbTxClassifier{
+		opts:        opts,
+		lpTypesSeen: make(map[gammtypes.LiquidityChangeType]bool, 2),
+		denomsSeen:  make(map[string]bool),
+	}
+	reason := c.classifyMsgs(tx.GetMsgs(), 0)
+	return reason != NotArb, reason
+}
+
+type arbTxClassifier struct {
+	opts types.ArbitrageFilterOptions
 
+	swapInDenom      string
+	swapsSeen        int
+	swapContractSeen bool
+	lpTypesSeen      map[gammtypes.LiquidityChangeType]bool
+	denomsSeen       map[string]bool
+}
+
+func (c *arbTxClassifier) classifyMsgs(msgs []sdk.Msg, depth uint64) ArbReason {
 	for _, m := range msgs {
-		// (4) Check that the tx doesn't have both JoinPool & ExitPool msgs
-		lpMsg, isLpMsg := m.(gammtypes.LiquidityChangeMsg)
-		if isLpMsg {
-			lpTypesSeen[lpMsg.LiquidityChangeType()] = true
-			if len(lpTypesSeen) > 1 {
-				return true
-			}
+		if reason := c.classifyMsg(m, depth); reason != NotArb {
+			return reason
 		}
+	}
+	return NotArb
+}
 
-		swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute)
-		if !isSwapMsg {
-			continue
+func (c *arbTxClassifier) classifyMsg(m sdk.Msg, depth uint64) ArbReason {
+	if execMsg, isExecMsg := m.(*authz.MsgExec); isExecMsg {
+		if !c.opts.UnwrapAuthz || depth >= c.opts.MaxUnwrapDepth {
+			return NotArb
+		}
+		innerMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return NotArb
 		}
+		return c.classifyMsgs(innerMsgs, depth+1)
+	}
+
+	// Messages nested in a MsgExec have not gone through ValidateBasic yet.
+	// Invalid ones will fail on execution, so they are not worth classifying.
+	if depth > 0 && m.ValidateBasic() != nil {
+		return NotArb
+	}
 
-		// (1) Check that swap denom in != swap denom out
-		if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
-			return true
+	// (4) Check that the tx doesn't have both JoinPool & ExitPool msgs
+	lpType, isLpMsg := liquidityChangeType(m)
+	if isLpMsg {
+		c.lpTypesSeen[lpType] = true
+		if len(c.lpTypesSeen) > 1 {
+			return ArbReasonJoinAndExitPool
 		}
+		return NotArb
+	}
 
-		// (2)
-		if swapInDenom != "" && swapMsg.TokenInDenom() != swapInDenom {
-			return true
+	if contractMsg, isContractMsg := m.(*wasmtypes.MsgExecuteContract); isContractMsg {
+		if !c.opts.SwapContracts[contractMsg.Contract] {
+			return NotArb
+		}
+		// We can't introspect what the contract swaps,
+		// so any other swap in the same tx is considered an arb.
+		if c.swapsSeen > 0 || c.swapContractSeen {
+			return ArbReasonSwapContract
 		}
-		swapInDenom = swapMsg.TokenInDenom()
+		c.swapContractSeen = true
+		return NotArb
 	}
 
-	return false
+	var swapMsgs []poolmanagertypes.SwapMsgRoute
+	switch msg := m.(type) {
+	case poolmanagertypes.MultiSwapMsgRoute:
+		swapMsgs = msg.GetSwapMsgs()
+	case poolmanagertypes.SwapMsgRoute:
+		swapMsgs = []poolmanagertypes.SwapMsgRoute{msg}
+	default:
+		return NotArb
+	}
+
+	if c.swapContractSeen {
+		return ArbReasonSwapContract
+	}
+
+	// Denoms are deduplicated within a message, as the routes of a
+	// split route swap are expected to share their first and last denoms.
+	msgDenoms := make(map[string]bool)
+	for _, swapMsg := range swapMsgs {
+		if reason := c.classifySwap(swapMsg, msgDenoms); reason != NotArb {
+			return reason
+		}
+	}
+
+	// (3) Check that no denom was already seen in a previous swap message
+	if c.opts.RepeatedDenoms {
+		for denom := range msgDenoms {
+			if c.denomsSeen[denom] {
+				return ArbReasonRepeatedDenoms
+			}
+		}
+		for denom := range msgDenoms {
+			c.denomsSeen[denom] = true
+		}
+	}
+	return NotArb
+}
+
+func (c *arbTxClassifier) classifySwap(swapMsg poolmanagertypes.SwapMsgRoute, msgDenoms map[string]bool) ArbReason {
+	c.swapsSeen++
+
+	// (1) Check that swap denom in != swap denom out
+	if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
+		return ArbReasonSameInAndOutDenom
+	}
+
+	// (2)
+	if c.swapInDenom != "" && swapMsg.TokenInDenom() != c.swapInDenom {
+		return ArbReasonDifferentSwapInDenoms
+	}
+	c.swapInDenom = swapMsg.TokenInDenom()
+
+	if !c.opts.RepeatedDenoms {
+		return NotArb
+	}
+
+	// (3) Check that a single route never goes through the same denom twice
+	routeDenoms := make(map[string]bool)
+	for _, denom := range swapMsg.TokenDenomsOnPath() {
+		if routeDenoms[denom] {
+			return ArbReasonRepeatedDenoms
+		}
+		routeDenoms[denom] = true
+		msgDenoms[denom] = true
+	}
+	return NotArb
+}
+
+// liquidityChangeType returns whether the msg adds or removes liquidity, if it is an LP msg.
+// The poolmanager joins and exits can't implement gammtypes.LiquidityChangeMsg, as gamm depends on poolmanager.
+func liquidityChangeType(m sdk.Msg) (gammtypes.LiquidityChangeType, bool) {
+	switch msg := m.(type) {
+	case gammtypes.LiquidityChangeMsg:
+		return msg.LiquidityChangeType(), true
+	case *poolmanagertypes.MsgJoinPool:
+		return gammtypes.AddLiquidity, true
+	case *poolmanagertypes.MsgExitPool:
+		return gammtypes.RemoveLiquidity, true
+	default:
+		return 0, false
+	}
 }
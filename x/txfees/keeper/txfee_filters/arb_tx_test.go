@@ -0,0 +1,182 @@
+package txfee_filters_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/keeper/txfee_filters"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+var (
+	sender         = apptesting.CreateRandomAccounts(1)[0]
+	swapContract   = apptesting.CreateRandomAccounts(1)[0].String()
+	defaultTokenIn = sdk.NewCoin("uosmo", sdk.NewInt(100))
+)
+
+func swapIn(tokenIn string, outDenoms ...string) *poolmanagertypes.MsgSwapExactAmountIn {
+	routes := make([]poolmanagertypes.SwapAmountInRoute, 0, len(outDenoms))
+	for i, denom := range outDenoms {
+		routes = append(routes, poolmanagertypes.SwapAmountInRoute{PoolId: uint64(i + 1), TokenOutDenom: denom})
+	}
+	return &poolmanagertypes.MsgSwapExactAmountIn{
+		Sender:            sender.String(),
+		Routes:            routes,
+		TokenIn:           sdk.NewCoin(tokenIn, sdk.NewInt(100)),
+		TokenOutMinAmount: sdk.OneInt(),
+	}
+}
+
+func splitSwapIn(tokenIn string, routes ...[]string) *poolmanagertypes.MsgSplitRouteSwapExactAmountIn {
+	splitRoutes := make([]poolmanagertypes.SwapAmountInSplitRoute, 0, len(routes))
+	for i, outDenoms := range routes {
+		pools := make([]poolmanagertypes.SwapAmountInRoute, 0, len(outDenoms))
+		for j, denom := range outDenoms {
+			pools = append(pools, poolmanagertypes.SwapAmountInRoute{PoolId: uint64(10*i + j + 1), TokenOutDenom: denom})
+		}
+		splitRoutes = append(splitRoutes, poolmanagertypes.SwapAmountInSplitRoute{Pools: pools, TokenInAmount: sdk.NewInt(100)})
+	}
+	return &poolmanagertypes.MsgSplitRouteSwapExactAmountIn{
+		Sender:            sender.String(),
+		Routes:            splitRoutes,
+		TokenInDenom:      tokenIn,
+		TokenOutMinAmount: sdk.OneInt(),
+	}
+}
+
+func exec(msgs ...sdk.Msg) *authz.MsgExec {
+	msg := authz.NewMsgExec(sender, msgs)
+	return &msg
+}
+
+func TestIsArbTx(t *testing.T) {
+	strictOpts := types.NewDefaultArbitrageFilterOptions()
+	strictOpts.SwapContracts = map[string]bool{swapContract: true}
+
+	tests := map[string]struct {
+		msgs           []sdk.Msg
+		expectedLoose  txfee_filters.ArbReason
+		expectedStrict txfee_filters.ArbReason
+	}{
+		"single swap": {
+			msgs: []sdk.Msg{swapIn("uosmo", "uatom")},
+		},
+		"multi hop swap": {
+			msgs: []sdk.Msg{swapIn("uosmo", "uatom", "uion")},
+		},
+		"cyclic swap": {
+			msgs:           []sdk.Msg{swapIn("uosmo", "uatom", "uosmo")},
+			expectedLoose:  txfee_filters.ArbReasonSameInAndOutDenom,
+			expectedStrict: txfee_filters.ArbReasonSameInAndOutDenom,
+		},
+		"gamm cyclic swap": {
+			msgs: []sdk.Msg{&gammtypes.MsgSwapExactAmountIn{
+				Sender:            sender.String(),
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uatom"}, {PoolId: 2, TokenOutDenom: "uosmo"}},
+				TokenIn:           defaultTokenIn,
+				TokenOutMinAmount: sdk.OneInt(),
+			}},
+			expectedLoose:  txfee_filters.ArbReasonSameInAndOutDenom,
+			expectedStrict: txfee_filters.ArbReasonSameInAndOutDenom,
+		},
+		"swaps with different in denoms": {
+			msgs:           []sdk.Msg{swapIn("uosmo", "uatom"), swapIn("uatom", "uosmo")},
+			expectedLoose:  txfee_filters.ArbReasonDifferentSwapInDenoms,
+			expectedStrict: txfee_filters.ArbReasonDifferentSwapInDenoms,
+		},
+		"route going twice through a denom": {
+			msgs:           []sdk.Msg{swapIn("uosmo", "uatom", "uion", "uatom", "uakt")},
+			expectedStrict: txfee_filters.ArbReasonRepeatedDenoms,
+		},
+		"swaps repeating a denom across messages": {
+			msgs:           []sdk.Msg{swapIn("uosmo", "uatom"), swapIn("uosmo", "uion", "uatom")},
+			expectedStrict: txfee_filters.ArbReasonRepeatedDenoms,
+		},
+		"split route swap": {
+			msgs: []sdk.Msg{splitSwapIn("uosmo", []string{"uatom", "uion"}, []string{"uakt", "uion"})},
+		},
+		"cyclic split route swap": {
+			msgs:           []sdk.Msg{splitSwapIn("uosmo", []string{"uatom", "uion"}, []string{"uakt", "uosmo"})},
+			expectedLoose:  txfee_filters.ArbReasonSameInAndOutDenom,
+			expectedStrict: txfee_filters.ArbReasonSameInAndOutDenom,
+		},
+		"join and exit pool": {
+			msgs: []sdk.Msg{
+				&gammtypes.MsgJoinPool{Sender: sender.String(), PoolId: 1, ShareOutAmount: sdk.OneInt()},
+				&gammtypes.MsgExitPool{Sender: sender.String(), PoolId: 1, ShareInAmount: sdk.OneInt()},
+			},
+			expectedLoose:  txfee_filters.ArbReasonJoinAndExitPool,
+			expectedStrict: txfee_filters.ArbReasonJoinAndExitPool,
+		},
+		"join and exit cosmwasm pool through poolmanager": {
+			msgs: []sdk.Msg{
+				&poolmanagertypes.MsgJoinPool{Sender: sender.String(), PoolId: 1, TokensIn: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1)), ShareOutMinAmount: sdk.OneInt()},
+				&poolmanagertypes.MsgExitPool{Sender: sender.String(), PoolId: 1, ShareInAmount: sdk.OneInt()},
+			},
+			expectedLoose:  txfee_filters.ArbReasonJoinAndExitPool,
+			expectedStrict: txfee_filters.ArbReasonJoinAndExitPool,
+		},
+		"gamm join and poolmanager exit": {
+			msgs: []sdk.Msg{
+				&gammtypes.MsgJoinPool{Sender: sender.String(), PoolId: 1, ShareOutAmount: sdk.OneInt()},
+				&poolmanagertypes.MsgExitPool{Sender: sender.String(), PoolId: 1, ShareInAmount: sdk.OneInt()},
+			},
+			expectedLoose:  txfee_filters.ArbReasonJoinAndExitPool,
+			expectedStrict: txfee_filters.ArbReasonJoinAndExitPool,
+		},
+		"cyclic swap in authz exec": {
+			msgs:           []sdk.Msg{exec(swapIn("uosmo", "uatom", "uosmo"))},
+			expectedStrict: txfee_filters.ArbReasonSameInAndOutDenom,
+		},
+		"swaps split between authz exec and top level": {
+			msgs:           []sdk.Msg{swapIn("uosmo", "uatom"), exec(exec(swapIn("uatom", "uosmo")))},
+			expectedStrict: txfee_filters.ArbReasonDifferentSwapInDenoms,
+		},
+		"authz exec nested deeper than the max depth": {
+			msgs: []sdk.Msg{exec(exec(exec(exec(exec(swapIn("uosmo", "uatom", "uosmo"))))))},
+		},
+		"single swap contract execution": {
+			msgs: []sdk.Msg{&wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: swapContract, Msg: []byte("{}")}},
+		},
+		"swap contract execution along with a swap": {
+			msgs: []sdk.Msg{
+				&wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: swapContract, Msg: []byte("{}")},
+				swapIn("uosmo", "uatom"),
+			},
+			expectedStrict: txfee_filters.ArbReasonSwapContract,
+		},
+		"unknown contract execution along with a swap": {
+			msgs: []sdk.Msg{
+				&wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: sender.String(), Msg: []byte("{}")},
+				swapIn("uosmo", "uatom"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tx := mockTx{msgs: tc.msgs}
+
+			isArb := txfee_filters.IsArbTxLoose(tx)
+			require.Equal(t, tc.expectedLoose != txfee_filters.NotArb, isArb)
+
+			isArb, reason := txfee_filters.IsArbTx(tx, strictOpts)
+			require.Equal(t, tc.expectedStrict != txfee_filters.NotArb, isArb)
+			require.Equal(t, tc.expectedStrict, reason)
+		})
+	}
+}
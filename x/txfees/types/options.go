@@ -22,11 +22,42 @@ var (
 	DefaultHighGasTxThreshold      = uint64(1 * 1000 * 1000)
 )
 
+// Defaults for the arbitrage tx classifier.
+var (
+	DefaultArbitrageFilterUnwrapAuthz    = true
+	DefaultArbitrageFilterRepeatedDenoms = true
+	DefaultArbitrageFilterSwapContracts  = []string{}
+	DefaultArbitrageFilterMaxUnwrapDepth = uint64(4)
+)
+
 type MempoolFeeOptions struct {
 	MaxGasWantedPerTx         uint64
 	MinGasPriceForArbitrageTx sdk.Dec
 	HighGasTxThreshold        uint64
 	MinGasPriceForHighGasTx   sdk.Dec
+	ArbitrageFilter           ArbitrageFilterOptions
+}
+
+// ArbitrageFilterOptions configures how the mempool classifies a tx as an arbitrage.
+type ArbitrageFilterOptions struct {
+	// UnwrapAuthz makes the classifier look at the messages nested in authz MsgExec.
+	UnwrapAuthz bool
+	// MaxUnwrapDepth bounds how many nested MsgExec are unwrapped.
+	MaxUnwrapDepth uint64
+	// RepeatedDenoms flags txs that see the same denom twice across all of their swap routes.
+	RepeatedDenoms bool
+	// SwapContracts is a set of contract addresses (e.g. swap routers) whose
+	// executions are treated as swaps, and thus as arbitrages when combined with other swaps.
+	SwapContracts map[string]bool
+}
+
+func NewDefaultArbitrageFilterOptions() ArbitrageFilterOptions {
+	return ArbitrageFilterOptions{
+		UnwrapAuthz:    DefaultArbitrageFilterUnwrapAuthz,
+		MaxUnwrapDepth: DefaultArbitrageFilterMaxUnwrapDepth,
+		RepeatedDenoms: DefaultArbitrageFilterRepeatedDenoms,
+		SwapContracts:  toSet(DefaultArbitrageFilterSwapContracts),
+	}
 }
 
 func NewDefaultMempoolFeeOptions() MempoolFeeOptions {
@@ -35,6 +66,7 @@ func NewDefaultMempoolFeeOptions() MempoolFeeOptions {
 		MinGasPriceForArbitrageTx: DefaultMinGasPriceForArbitrageTx.Clone(),
 		HighGasTxThreshold:        DefaultHighGasTxThreshold,
 		MinGasPriceForHighGasTx:   DefaultMinGasPriceForHighGasTx.Clone(),
+		ArbitrageFilter:           NewDefaultArbitrageFilterOptions(),
 	}
 }
 
@@ -44,6 +76,7 @@ func NewMempoolFeeOptions(opts servertypes.AppOptions) MempoolFeeOptions {
 		MinGasPriceForArbitrageTx: parseMinGasPriceForArbitrageTx(opts),
 		HighGasTxThreshold:        DefaultHighGasTxThreshold,
 		MinGasPriceForHighGasTx:   parseMinGasPriceForHighGasTx(opts),
+		ArbitrageFilter:           parseArbitrageFilterOptions(opts),
 	}
 }
 
@@ -84,3 +117,56 @@ func parseDecFromConfig(opts servertypes.AppOptions, optName string, defaultValu
 	}
 	return value
 }
+
+func parseArbitrageFilterOptions(opts servertypes.AppOptions) ArbitrageFilterOptions {
+	return ArbitrageFilterOptions{
+		UnwrapAuthz:    parseBoolFromConfig(opts, "arbitrage-filter-unwrap-authz", DefaultArbitrageFilterUnwrapAuthz),
+		MaxUnwrapDepth: parseUint64FromConfig(opts, "arbitrage-filter-max-unwrap-depth", DefaultArbitrageFilterMaxUnwrapDepth),
+		RepeatedDenoms: parseBoolFromConfig(opts, "arbitrage-filter-repeated-denoms", DefaultArbitrageFilterRepeatedDenoms),
+		SwapContracts:  toSet(parseStringSliceFromConfig(opts, "arbitrage-filter-swap-contracts", DefaultArbitrageFilterSwapContracts)),
+	}
+}
+
+func parseBoolFromConfig(opts servertypes.AppOptions, optName string, defaultValue bool) bool {
+	valueInterface := opts.Get("osmosis-mempool." + optName)
+	if valueInterface == nil {
+		return defaultValue
+	}
+	value, err := cast.ToBoolE(valueInterface)
+	if err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool.%v, err= %v", optName, err))
+	}
+	return value
+}
+
+func parseUint64FromConfig(opts servertypes.AppOptions, optName string, defaultValue uint64) uint64 {
+	valueInterface := opts.Get("osmosis-mempool." + optName)
+	if valueInterface == nil {
+		return defaultValue
+	}
+	value, err := cast.ToUint64E(valueInterface)
+	if err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool.%v, err= %v", optName, err))
+	}
+	return value
+}
+
+func parseStringSliceFromConfig(opts servertypes.AppOptions, optName string, defaultValue []string) []string {
+	valueInterface := opts.Get("osmosis-mempool." + optName)
+	if valueInterface == nil {
+		return defaultValue
+	}
+	value, err := cast.ToStringSliceE(valueInterface)
+	if err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool.%v, err= %v", optName, err))
+	}
+	return value
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
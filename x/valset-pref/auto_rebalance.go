@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+)
+
+// SetAutoRebalance opts a delegator with a validator set preference in to auto-rebalancing, with the given
+// drift threshold. A zero drift threshold opts the delegator out.
+func (k Keeper) SetAutoRebalance(ctx sdk.Context, delegator string, driftThreshold sdk.Dec) error {
+	store := ctx.KVStore(k.storeKey)
+	if driftThreshold.IsZero() {
+		store.Delete(types.GetAutoRebalanceKey(delegator))
+		store.Delete(types.GetAutoRebalanceQueueKey(delegator))
+		return nil
+	}
+
+	if _, found := k.GetValidatorSetPreference(ctx, delegator); !found {
+		return fmt.Errorf("user %s doesn't have validator set", delegator)
+	}
+
+	osmoutils.MustSet(store, types.GetAutoRebalanceKey(delegator), &types.AutoRebalancePreference{DriftThreshold: driftThreshold})
+	return nil
+}
+
+// GetAutoRebalanceThreshold returns the drift threshold of a delegator that opted in to auto-rebalancing.
+func (k Keeper) GetAutoRebalanceThreshold(ctx sdk.Context, delegator string) (sdk.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	autoRebalance := types.AutoRebalancePreference{}
+	found, err := osmoutils.Get(store, types.GetAutoRebalanceKey(delegator), &autoRebalance)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return sdk.ZeroDec(), false
+	}
+	return autoRebalance.DriftThreshold, true
+}
+
+// GetValidatorSetPreferenceDrift returns how far the delegations of a delegator drifted from their validator set
+// preference, along with the weights of their delegations.
+// The drift is half the sum of the absolute differences between the actual and preferred weight of each validator,
+// so 0 when the delegations follow the preference and 1 when none of them are to preferred validators.
+func (k Keeper) GetValidatorSetPreferenceDrift(ctx sdk.Context, delegator string) (sdk.Dec, []types.ValidatorPreference, error) {
+	valSetPref, err := k.GetResolvedValidatorSetPreference(ctx, delegator)
+	if err != nil {
+		return sdk.Dec{}, nil, err
+	}
+
+	actualWeights, err := k.getDelegationWeights(ctx, delegator)
+	if err != nil {
+		return sdk.Dec{}, nil, err
+	}
+
+	weightDiffs := map[string]sdk.Dec{}
+	for _, val := range actualWeights {
+		weightDiffs[val.ValOperAddress] = val.Weight
+	}
+	for _, val := range valSetPref.Preferences {
+		actualWeight, found := weightDiffs[val.ValOperAddress]
+		if !found {
+			actualWeight = sdk.ZeroDec()
+		}
+		weightDiffs[val.ValOperAddress] = actualWeight.Sub(val.Weight)
+	}
+
+	drift := sdk.ZeroDec()
+	for _, weightDiff := range weightDiffs {
+		drift = drift.Add(weightDiff.Abs())
+	}
+	return drift.QuoInt64(2), actualWeights, nil
+}
+
+// getDelegationWeights returns the weight of the delegations of a delegator to each validator, by tokens.
+// Unlike GetExistingStakingDelegations, weights are not computed from shares, whose value differs across
+// validators once one got slashed.
+func (k Keeper) getDelegationWeights(ctx sdk.Context, delegator string) ([]types.ValidatorPreference, error) {
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := k.GetExistingStakingDelegations(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	totalTokens := sdk.ZeroDec()
+	tokens := make([]sdk.Dec, len(delegations))
+	for i, delegation := range delegations {
+		valAddr, validator, err := k.GetValidatorInfo(ctx, delegation.ValOperAddress)
+		if err != nil {
+			return nil, err
+		}
+		stakingDelegation, _ := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		tokens[i] = validator.TokensFromShares(stakingDelegation.Shares)
+		totalTokens = totalTokens.Add(tokens[i])
+	}
+	if !totalTokens.IsPositive() {
+		return nil, fmt.Errorf("user %s has no delegated tokens", delegator)
+	}
+
+	weights := make([]types.ValidatorPreference, len(delegations))
+	for i, delegation := range delegations {
+		weights[i] = types.ValidatorPreference{ValOperAddress: delegation.ValOperAddress, Weight: tokens[i].Quo(totalTokens)}
+	}
+	return weights, nil
+}
+
+// QueueAutoRebalances queues all the delegators that opted in to auto-rebalancing, to be rebalanced over
+// the next blocks.
+func (k Keeper) QueueAutoRebalances(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixAutoRebalance)
+	delegators := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		delegators = append(delegators, string(iterator.Key()[len(types.KeyPrefixAutoRebalance):]))
+	}
+	iterator.Close()
+
+	for _, delegator := range delegators {
+		store.Set(types.GetAutoRebalanceQueueKey(delegator), []byte{})
+	}
+}
+
+// ProcessAutoRebalanceQueue rebalances up to MaxAutoRebalancesPerBlock queued delegators. A rebalance that fails
+// is dropped and logged, the delegator will be retried at the next epoch.
+func (k Keeper) ProcessAutoRebalanceQueue(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixAutoRebalanceQueue)
+	delegators := []string{}
+	for ; iterator.Valid() && len(delegators) < types.MaxAutoRebalancesPerBlock; iterator.Next() {
+		delegators = append(delegators, string(iterator.Key()[len(types.KeyPrefixAutoRebalanceQueue):]))
+	}
+	iterator.Close()
+
+	for _, delegator := range delegators {
+		store.Delete(types.GetAutoRebalanceQueueKey(delegator))
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.autoRebalance(ctx, delegator)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to auto-rebalance delegator %s: %s", delegator, err))
+		}
+	}
+}
+
+// autoRebalance redelegates the delegations of a delegator to their validator set preference, if they drifted
+// from it by more than their threshold.
+// Rebalancing is skipped while a validator the delegations need to move away from is receiving a redelegation,
+// as redelegations cannot be chained until they complete, or when the redelegation entries limit is reached.
+func (k Keeper) autoRebalance(ctx sdk.Context, delegator string) error {
+	driftThreshold, found := k.GetAutoRebalanceThreshold(ctx, delegator)
+	if !found {
+		return nil
+	}
+
+	drift, actualWeights, err := k.GetValidatorSetPreferenceDrift(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if drift.LTE(driftThreshold) {
+		return nil
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return err
+	}
+	valSetPref, err := k.GetResolvedValidatorSetPreference(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkRedelegationLimits(ctx, delAddr, actualWeights, valSetPref.Preferences); err != nil {
+		return err
+	}
+
+	err = k.preformRedelegation(ctx, delAddr, actualWeights, valSetPref.Preferences, true)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtAutoRebalance,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeDelegator, delegator),
+		sdk.NewAttribute(types.AttributeDrift, drift.String()),
+		sdk.NewAttribute(types.AttributeDriftThreshold, driftThreshold.String()),
+	))
+	return nil
+}
+
+// checkRedelegationLimits returns an error if the delegations cannot be redelegated away from a validator
+// with more than its preferred weight to a validator with less than its preferred weight.
+func (k Keeper) checkRedelegationLimits(ctx sdk.Context, delegator sdk.AccAddress, actualWeights, preferences []types.ValidatorPreference) error {
+	preferredWeights := map[string]sdk.Dec{}
+	for _, val := range preferences {
+		preferredWeights[val.ValOperAddress] = val.Weight
+	}
+	actualWeightsByVal := map[string]sdk.Dec{}
+	for _, val := range actualWeights {
+		actualWeightsByVal[val.ValOperAddress] = val.Weight
+	}
+
+	for _, source := range actualWeights {
+		preferredWeight, found := preferredWeights[source.ValOperAddress]
+		if found && source.Weight.LTE(preferredWeight) {
+			continue
+		}
+		sourceAddr, err := sdk.ValAddressFromBech32(source.ValOperAddress)
+		if err != nil {
+			return err
+		}
+		if k.stakingKeeper.HasReceivingRedelegation(ctx, delegator, sourceAddr) {
+			return fmt.Errorf("redelegation to %s is in progress", source.ValOperAddress)
+		}
+
+		for _, target := range preferences {
+			actualWeight, found := actualWeightsByVal[target.ValOperAddress]
+			if found && actualWeight.GTE(target.Weight) {
+				continue
+			}
+			targetAddr, err := sdk.ValAddressFromBech32(target.ValOperAddress)
+			if err != nil {
+				return err
+			}
+			if k.stakingKeeper.HasMaxRedelegationEntries(ctx, delegator, sourceAddr, targetAddr) {
+				return fmt.Errorf("too many redelegations from %s to %s in progress", source.ValOperAddress, target.ValOperAddress)
+			}
+		}
+	}
+	return nil
+}
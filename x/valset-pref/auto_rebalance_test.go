@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+)
+
+// setupDriftedDelegator delegates 1000 tokens to a {valA -> 0.5, valB -> 0.5} validator set,
+// then 500 tokens to valA directly, so that its delegations drift by 1/6 from the validator set.
+func (s *KeeperTestSuite) setupDriftedDelegator() (sdk.AccAddress, []string) {
+	valAddrs := s.SetupMultipleValidators(2)
+	delegator := s.TestAccs[0]
+	s.FundAcc(delegator, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)})
+
+	preferences := []types.ValidatorPreference{
+		{ValOperAddress: valAddrs[0], Weight: sdk.NewDecWithPrec(5, 1)},
+		{ValOperAddress: valAddrs[1], Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	s.App.ValidatorSetPreferenceKeeper.SetValidatorSetPreferences(s.Ctx, delegator.String(), types.ValidatorSetPreferences{Preferences: preferences})
+	err := s.App.ValidatorSetPreferenceKeeper.DelegateToValidatorSet(s.Ctx, delegator.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	s.Require().NoError(err)
+
+	err = s.PrepareExistingDelegations(s.Ctx, valAddrs[:1], delegator, sdk.NewInt(500))
+	s.Require().NoError(err)
+	return delegator, valAddrs
+}
+
+func (s *KeeperTestSuite) delegatedTokens(delegator sdk.AccAddress, valAddrStr string) sdk.Int {
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	s.Require().NoError(err)
+	validator, found := s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+	s.Require().True(found)
+	delegation, found := s.App.StakingKeeper.GetDelegation(s.Ctx, delegator, valAddr)
+	s.Require().True(found)
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// runAutoRebalanceEpoch ends an auto-rebalance epoch and processes the queue in the next end block.
+func (s *KeeperTestSuite) runAutoRebalanceEpoch() {
+	err := s.App.ValidatorSetPreferenceKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.AutoRebalanceEpochIdentifier, 1)
+	s.Require().NoError(err)
+	s.App.ValidatorSetPreferenceKeeper.ProcessAutoRebalanceQueue(s.Ctx)
+}
+
+func (s *KeeperTestSuite) TestGetValidatorSetPreferenceDrift() {
+	s.SetupTest()
+	delegator, valAddrs := s.setupDriftedDelegator()
+	keeper := s.App.ValidatorSetPreferenceKeeper
+
+	drift, actualWeights, err := keeper.GetValidatorSetPreferenceDrift(s.Ctx, delegator.String())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.166666666666666667"), drift)
+	s.Require().Len(actualWeights, 2)
+	for _, weight := range actualWeights {
+		if weight.ValOperAddress == valAddrs[0] {
+			s.Require().Equal(sdk.NewDec(1000).Quo(sdk.NewDec(1500)), weight.Weight)
+		} else {
+			s.Require().Equal(sdk.NewDec(500).Quo(sdk.NewDec(1500)), weight.Weight)
+		}
+	}
+
+	// no validator set
+	_, _, err = keeper.GetValidatorSetPreferenceDrift(s.Ctx, s.TestAccs[1].String())
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestSetAutoRebalance() {
+	s.SetupTest()
+	delegator, _ := s.setupDriftedDelegator()
+	keeper := s.App.ValidatorSetPreferenceKeeper
+
+	// no validator set
+	err := keeper.SetAutoRebalance(s.Ctx, s.TestAccs[1].String(), sdk.NewDecWithPrec(1, 1))
+	s.Require().Error(err)
+
+	err = keeper.SetAutoRebalance(s.Ctx, delegator.String(), sdk.NewDecWithPrec(1, 1))
+	s.Require().NoError(err)
+	threshold, found := keeper.GetAutoRebalanceThreshold(s.Ctx, delegator.String())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDecWithPrec(1, 1), threshold)
+
+	// opt out
+	err = keeper.SetAutoRebalance(s.Ctx, delegator.String(), sdk.ZeroDec())
+	s.Require().NoError(err)
+	_, found = keeper.GetAutoRebalanceThreshold(s.Ctx, delegator.String())
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestAutoRebalance() {
+	tests := map[string]struct {
+		optIn              bool
+		driftThreshold     sdk.Dec
+		receivingValB      bool
+		expectRebalance    bool
+		expectedValATokens int64
+	}{
+		"drift above threshold": {
+			optIn:              true,
+			driftThreshold:     sdk.NewDecWithPrec(1, 1),
+			expectRebalance:    true,
+			expectedValATokens: 750,
+		},
+		"drift below threshold": {
+			optIn:              true,
+			driftThreshold:     sdk.NewDecWithPrec(2, 1),
+			expectedValATokens: 1000,
+		},
+		"not opted in": {
+			expectedValATokens: 1000,
+		},
+		"source validator receiving a redelegation": {
+			optIn:              true,
+			driftThreshold:     sdk.NewDecWithPrec(1, 1),
+			receivingValB:      true,
+			expectedValATokens: 1000,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			delegator, valAddrs := s.setupDriftedDelegator()
+			keeper := s.App.ValidatorSetPreferenceKeeper
+
+			if test.receivingValB {
+				// move 100 tokens from valA to valB, then drift towards valB
+				valA, _ := sdk.ValAddressFromBech32(valAddrs[0])
+				valB, _ := sdk.ValAddressFromBech32(valAddrs[1])
+				_, err := s.App.StakingKeeper.BeginRedelegation(s.Ctx, delegator, valA, valB, sdk.NewDec(100))
+				s.Require().NoError(err)
+				err = s.PrepareExistingDelegations(s.Ctx, valAddrs[1:], delegator, sdk.NewInt(900))
+				s.Require().NoError(err)
+			}
+			if test.optIn {
+				s.Require().NoError(keeper.SetAutoRebalance(s.Ctx, delegator.String(), test.driftThreshold))
+			}
+			valATokensBefore := s.delegatedTokens(delegator, valAddrs[0])
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			s.runAutoRebalanceEpoch()
+
+			if test.expectRebalance {
+				s.Require().Equal(sdk.NewInt(test.expectedValATokens), s.delegatedTokens(delegator, valAddrs[0]))
+				s.Require().Equal(sdk.NewInt(750), s.delegatedTokens(delegator, valAddrs[1]))
+				drift, _, err := keeper.GetValidatorSetPreferenceDrift(s.Ctx, delegator.String())
+				s.Require().NoError(err)
+				s.Require().True(drift.IsZero())
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtAutoRebalance, 1)
+			} else {
+				s.Require().Equal(valATokensBefore, s.delegatedTokens(delegator, valAddrs[0]))
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtAutoRebalance, 0)
+			}
+
+			// the queue is empty, running the end block again does nothing
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			s.App.ValidatorSetPreferenceKeeper.ProcessAutoRebalanceQueue(s.Ctx)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtAutoRebalance, 0)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAutoRebalanceAfterSlash() {
+	s.SetupTest()
+	delegator, valAddrs := s.setupDriftedDelegator()
+	keeper := s.App.ValidatorSetPreferenceKeeper
+	s.Require().NoError(keeper.SetAutoRebalance(s.Ctx, delegator.String(), sdk.NewDecWithPrec(1, 1)))
+
+	// move the direct delegation away, then slash valB by half so that its shares are worth half a token
+	valA, _ := sdk.ValAddressFromBech32(valAddrs[0])
+	valB, _ := sdk.ValAddressFromBech32(valAddrs[1])
+	_, err := s.App.StakingKeeper.Undelegate(s.Ctx, delegator, valA, sdk.NewDec(500))
+	s.Require().NoError(err)
+	validatorB, _ := s.App.StakingKeeper.GetValidator(s.Ctx, valB)
+	s.App.StakingKeeper.RemoveValidatorTokens(s.Ctx, validatorB, validatorB.Tokens.QuoRaw(2))
+
+	drift, _, err := keeper.GetValidatorSetPreferenceDrift(s.Ctx, delegator.String())
+	s.Require().NoError(err)
+	s.Require().True(drift.GT(sdk.NewDecWithPrec(1, 1)))
+
+	s.runAutoRebalanceEpoch()
+
+	valATokens := s.delegatedTokens(delegator, valAddrs[0])
+	valBTokens := s.delegatedTokens(delegator, valAddrs[1])
+	s.Require().True(valATokens.Sub(valBTokens).Abs().LTE(sdk.OneInt()), "valA %s, valB %s", valATokens, valBTokens)
+}
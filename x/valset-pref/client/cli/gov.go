@@ -0,0 +1,113 @@
+package valsetprefcli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+)
+
+func NewCmdSetNamedValidatorSetProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-named-validator-set-proposal [name] [validators] [weights] [flags]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Submit a proposal to create or replace a named validator set that delegators can reference",
+		Example: "osmosisd tx gov submit-proposal set-named-validator-set-proposal decentralization osmovaloper1abc...,osmovaloper1def... 0.5,0.5",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			title, description, err := parseTitleAndDescription(cmd)
+			if err != nil {
+				return err
+			}
+			preferences, err := ValidateValAddrAndWeight(args)
+			if err != nil {
+				return err
+			}
+			content := types.NewSetNamedValidatorSetProposal(title, description, args[0], preferences)
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func NewCmdRemoveNamedValidatorSetProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-named-validator-set-proposal [name] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove a named validator set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			title, description, err := parseTitleAndDescription(cmd)
+			if err != nil {
+				return err
+			}
+			content := types.NewRemoveNamedValidatorSetProposal(title, description, args[0])
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(govcli.FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+}
+
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content) error {
+	from := clientCtx.GetFromAddress()
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func parseTitleAndDescription(cmd *cobra.Command) (string, string, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", err
+	}
+	return title, description, nil
+}
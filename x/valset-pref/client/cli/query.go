@@ -12,6 +12,8 @@ import (
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetCmdValSetPref())
+	cmd.AddCommand(GetCmdValSetPrefDrift())
+	cmd.AddCommand(GetCmdNamedValidatorSet())
 	return cmd
 }
 
@@ -23,3 +25,21 @@ func GetCmdValSetPref() *cobra.Command {
 		types.ModuleName, queryproto.NewQueryClient,
 	)
 }
+
+// GetCmdValSetPrefDrift takes the address and returns how far its delegations drifted from its validator set.
+func GetCmdValSetPrefDrift() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.UserValidatorPreferenceDriftRequest](
+		"drift [address]",
+		"Query how far the delegations of a specific user address drifted from their validator set", "",
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
+
+// GetCmdNamedValidatorSet takes the name of a named validator set and returns its validators.
+func GetCmdNamedValidatorSet() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.NamedValidatorSetRequest](
+		"named-set [name]",
+		"Query a validator set named by governance", "",
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
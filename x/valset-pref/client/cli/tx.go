@@ -21,6 +21,8 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewUnDelValSetCmd)
 	osmocli.AddTxCmd(txCmd, NewReDelValSetCmd)
 	osmocli.AddTxCmd(txCmd, NewWithRewValSetCmd)
+	osmocli.AddTxCmd(txCmd, NewSetAutoRebalanceCmd)
+	osmocli.AddTxCmd(txCmd, NewSetValSetReferenceCmd)
 	return txCmd
 }
 
@@ -71,6 +73,25 @@ func NewWithRewValSetCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawDelegationRewa
 	}, &types.MsgWithdrawDelegationRewards{}
 }
 
+func NewSetAutoRebalanceCmd() (*osmocli.TxCliDesc, *types.MsgSetAutoRebalance) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-auto-rebalance [delegator_addr] [drift_threshold]",
+		Short:   "Rebalance the delegations to the validator set at each epoch when they drift by more than the threshold, 0 to opt out.",
+		Example: "osmosisd tx valset-pref set-auto-rebalance osmo1... 0.05",
+		NumArgs: 2,
+	}, &types.MsgSetAutoRebalance{}
+}
+
+func NewSetValSetReferenceCmd() (*osmocli.TxCliDesc, *types.MsgSetValidatorSetPreference) {
+	return &osmocli.TxCliDesc{
+		Use:              "set-valset-reference [delegator_addr] [referenced_delegator_addr_or_named_set]",
+		Short:            "Makes the validator set of the delegator follow the validator set of another delegator, or a named validator set",
+		Example:          "osmosisd tx valset-pref set-valset-reference osmo1... osmo1abc...",
+		NumArgs:          2,
+		ParseAndBuildMsg: NewMsgSetValidatorSetReference,
+	}, &types.MsgSetValidatorSetPreference{}
+}
+
 func NewMsgSetValidatorSetPreference(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
 	delAddr, err := sdk.AccAddressFromBech32(args[0])
 	if err != nil {
@@ -88,6 +109,27 @@ func NewMsgSetValidatorSetPreference(clientCtx client.Context, args []string, fs
 	), nil
 }
 
+func NewMsgSetValidatorSetReference(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
+	delAddr, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetValidatorSetPreference{
+		Delegator: delAddr.String(),
+		Reference: ParseValidatorSetReference(args[1]),
+	}, nil
+}
+
+// ParseValidatorSetReference returns a reference to a delegator if the argument is an account address,
+// and to a named validator set otherwise.
+func ParseValidatorSetReference(arg string) *types.ValidatorSetReference {
+	if _, err := sdk.AccAddressFromBech32(arg); err == nil {
+		return types.NewDelegatorReference(arg)
+	}
+	return types.NewNamedSetReference(arg)
+}
+
 func NewMsgReDelValidatorSetPreference(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
 	delAddr, err := sdk.AccAddressFromBech32(args[0])
 	if err != nil {
@@ -30,3 +30,23 @@ func (q Querier) UserValidatorPreferences(grpcCtx context.Context,
 	return q.Q.UserValidatorPreferences(ctx, *req)
 }
 
+func (q Querier) UserValidatorPreferenceDrift(grpcCtx context.Context,
+	req *queryproto.UserValidatorPreferenceDriftRequest,
+) (*queryproto.UserValidatorPreferenceDriftResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.UserValidatorPreferenceDrift(ctx, *req)
+}
+
+func (q Querier) NamedValidatorSet(grpcCtx context.Context,
+	req *queryproto.NamedValidatorSetRequest,
+) (*queryproto.NamedValidatorSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.NamedValidatorSet(ctx, *req)
+}
+
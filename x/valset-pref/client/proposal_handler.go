@@ -0,0 +1,13 @@
+package client
+
+import (
+	valsetprefcli "github.com/osmosis-labs/osmosis/v16/x/valset-pref/client/cli"
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	SetNamedValidatorSetProposalHandler    = govclient.NewProposalHandler(valsetprefcli.NewCmdSetNamedValidatorSetProposal, rest.ProposalSetNamedValidatorSetRESTHandler)
+	RemoveNamedValidatorSetProposalHandler = govclient.NewProposalHandler(valsetprefcli.NewCmdRemoveNamedValidatorSetProposal, rest.ProposalRemoveNamedValidatorSetRESTHandler)
+)
@@ -23,7 +23,36 @@ func (q Querier) UserValidatorPreferences(ctx sdk.Context, req queryproto.UserVa
 		return nil, fmt.Errorf("Validator set not found")
 	}
 
+	resolvedSet, err := q.K.GetResolvedValidatorSetPreference(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
 	return &queryproto.UserValidatorPreferencesResponse{
-		Preferences: validatorSet.Preferences,
+		Preferences: resolvedSet.Preferences,
+		Reference:   validatorSet.Reference,
+	}, nil
+}
+
+func (q Querier) UserValidatorPreferenceDrift(ctx sdk.Context, req queryproto.UserValidatorPreferenceDriftRequest) (*queryproto.UserValidatorPreferenceDriftResponse, error) {
+	drift, actualWeights, err := q.K.GetValidatorSetPreferenceDrift(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold, _ := q.K.GetAutoRebalanceThreshold(ctx, req.Address)
+	return &queryproto.UserValidatorPreferenceDriftResponse{
+		Drift:                  drift,
+		ActualWeights:          actualWeights,
+		AutoRebalanceThreshold: threshold,
 	}, nil
 }
+
+func (q Querier) NamedValidatorSet(ctx sdk.Context, req queryproto.NamedValidatorSetRequest) (*queryproto.NamedValidatorSetResponse, error) {
+	namedSet, found := q.K.GetNamedValidatorSet(ctx, req.Name)
+	if !found {
+		return nil, fmt.Errorf("named validator set %s not found", req.Name)
+	}
+
+	return &queryproto.NamedValidatorSetResponse{NamedSet: namedSet}, nil
+}
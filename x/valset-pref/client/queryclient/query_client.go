@@ -0,0 +1,102 @@
+package queryclient
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/valset-pref/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/client/queryproto"
+)
+
+// Paths of the queries, used by gRPC, ABCI and Stargate queries.
+const (
+	NamedValidatorSetPath            = "/osmosis.valsetpref.v1beta1.Query/NamedValidatorSet"
+	UserValidatorPreferenceDriftPath = "/osmosis.valsetpref.v1beta1.Query/UserValidatorPreferenceDrift"
+	UserValidatorPreferencesPath     = "/osmosis.valsetpref.v1beta1.Query/UserValidatorPreferences"
+)
+
+// Client is a typed client of the queries, over a gRPC connection to a node or a client.Context.
+type Client struct {
+	q queryproto.QueryClient
+}
+
+func NewClient(conn gogogrpc.ClientConn) Client {
+	return Client{q: queryproto.NewQueryClient(conn)}
+}
+
+func (c Client) NamedValidatorSet(ctx context.Context,
+	req queryproto.NamedValidatorSetRequest, opts ...grpc.CallOption,
+) (*queryproto.NamedValidatorSetResponse, error) {
+	return c.q.NamedValidatorSet(ctx, &req, opts...)
+}
+
+// NewNamedValidatorSetABCIRequest returns the ABCI query of NamedValidatorSet at the given height, 0 being the latest one.
+func NewNamedValidatorSetABCIRequest(req queryproto.NamedValidatorSetRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: NamedValidatorSetPath, Data: bz, Height: height}, nil
+}
+
+// DecodeNamedValidatorSetResponse decodes the response of NamedValidatorSet, e.g. the value of its ABCI query.
+func DecodeNamedValidatorSetResponse(bz []byte) (*queryproto.NamedValidatorSetResponse, error) {
+	res := &queryproto.NamedValidatorSetResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) UserValidatorPreferenceDrift(ctx context.Context,
+	req queryproto.UserValidatorPreferenceDriftRequest, opts ...grpc.CallOption,
+) (*queryproto.UserValidatorPreferenceDriftResponse, error) {
+	return c.q.UserValidatorPreferenceDrift(ctx, &req, opts...)
+}
+
+// NewUserValidatorPreferenceDriftABCIRequest returns the ABCI query of UserValidatorPreferenceDrift at the given height, 0 being the latest one.
+func NewUserValidatorPreferenceDriftABCIRequest(req queryproto.UserValidatorPreferenceDriftRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: UserValidatorPreferenceDriftPath, Data: bz, Height: height}, nil
+}
+
+// DecodeUserValidatorPreferenceDriftResponse decodes the response of UserValidatorPreferenceDrift, e.g. the value of its ABCI query.
+func DecodeUserValidatorPreferenceDriftResponse(bz []byte) (*queryproto.UserValidatorPreferenceDriftResponse, error) {
+	res := &queryproto.UserValidatorPreferenceDriftResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c Client) UserValidatorPreferences(ctx context.Context,
+	req queryproto.UserValidatorPreferencesRequest, opts ...grpc.CallOption,
+) (*queryproto.UserValidatorPreferencesResponse, error) {
+	return c.q.UserValidatorPreferences(ctx, &req, opts...)
+}
+
+// NewUserValidatorPreferencesABCIRequest returns the ABCI query of UserValidatorPreferences at the given height, 0 being the latest one.
+func NewUserValidatorPreferencesABCIRequest(req queryproto.UserValidatorPreferencesRequest, height int64) (abci.RequestQuery, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return abci.RequestQuery{}, err
+	}
+	return abci.RequestQuery{Path: UserValidatorPreferencesPath, Data: bz, Height: height}, nil
+}
+
+// DecodeUserValidatorPreferencesResponse decodes the response of UserValidatorPreferences, e.g. the value of its ABCI query.
+func DecodeUserValidatorPreferencesResponse(bz []byte) (*queryproto.UserValidatorPreferencesResponse, error) {
+	res := &queryproto.UserValidatorPreferencesResponse{}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
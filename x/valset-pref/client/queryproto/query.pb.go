@@ -6,6 +6,7 @@ package queryproto
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -71,7 +72,9 @@ var xxx_messageInfo_UserValidatorPreferencesRequest proto.InternalMessageInfo
 
 // Response type the QueryUserValidatorPreferences query request
 type UserValidatorPreferencesResponse struct {
-	Preferences []types.ValidatorPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences"`
+	// preferences are resolved from the reference, if any.
+	Preferences []types.ValidatorPreference  `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences"`
+	Reference   *types.ValidatorSetReference `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
 }
 
 func (m *UserValidatorPreferencesResponse) Reset()         { *m = UserValidatorPreferencesResponse{} }
@@ -107,9 +110,173 @@ func (m *UserValidatorPreferencesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UserValidatorPreferencesResponse proto.InternalMessageInfo
 
+// Request type for UserValidatorPreferenceDrift.
+type UserValidatorPreferenceDriftRequest struct {
+	// user account address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *UserValidatorPreferenceDriftRequest) Reset()         { *m = UserValidatorPreferenceDriftRequest{} }
+func (m *UserValidatorPreferenceDriftRequest) String() string { return proto.CompactTextString(m) }
+func (*UserValidatorPreferenceDriftRequest) ProtoMessage()    {}
+func (*UserValidatorPreferenceDriftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ffbeb4123fe56ae, []int{2}
+}
+func (m *UserValidatorPreferenceDriftRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserValidatorPreferenceDriftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserValidatorPreferenceDriftRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserValidatorPreferenceDriftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserValidatorPreferenceDriftRequest.Merge(m, src)
+}
+func (m *UserValidatorPreferenceDriftRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserValidatorPreferenceDriftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserValidatorPreferenceDriftRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserValidatorPreferenceDriftRequest proto.InternalMessageInfo
+
+// Response type for the UserValidatorPreferenceDrift query request.
+type UserValidatorPreferenceDriftResponse struct {
+	// drift is half the sum of the absolute differences between the actual and
+	// preferred weights of each validator, between 0 and 1.
+	Drift github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=drift,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"drift"`
+	// actual_weights are the weights of the current delegations of the user.
+	ActualWeights []types.ValidatorPreference `protobuf:"bytes,2,rep,name=actual_weights,json=actualWeights,proto3" json:"actual_weights"`
+	// auto_rebalance_threshold is the drift above which the delegations are
+	// rebalanced, zero if the user did not opt in to auto-rebalancing.
+	AutoRebalanceThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=auto_rebalance_threshold,json=autoRebalanceThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"auto_rebalance_threshold"`
+}
+
+func (m *UserValidatorPreferenceDriftResponse) Reset()         { *m = UserValidatorPreferenceDriftResponse{} }
+func (m *UserValidatorPreferenceDriftResponse) String() string { return proto.CompactTextString(m) }
+func (*UserValidatorPreferenceDriftResponse) ProtoMessage()    {}
+func (*UserValidatorPreferenceDriftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ffbeb4123fe56ae, []int{3}
+}
+func (m *UserValidatorPreferenceDriftResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserValidatorPreferenceDriftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserValidatorPreferenceDriftResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserValidatorPreferenceDriftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserValidatorPreferenceDriftResponse.Merge(m, src)
+}
+func (m *UserValidatorPreferenceDriftResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserValidatorPreferenceDriftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserValidatorPreferenceDriftResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserValidatorPreferenceDriftResponse proto.InternalMessageInfo
+
+// Request type for NamedValidatorSet.
+type NamedValidatorSetRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *NamedValidatorSetRequest) Reset()         { *m = NamedValidatorSetRequest{} }
+func (m *NamedValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*NamedValidatorSetRequest) ProtoMessage()    {}
+func (*NamedValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ffbeb4123fe56ae, []int{4}
+}
+func (m *NamedValidatorSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamedValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamedValidatorSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamedValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedValidatorSetRequest.Merge(m, src)
+}
+func (m *NamedValidatorSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamedValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedValidatorSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedValidatorSetRequest proto.InternalMessageInfo
+
+// Response type for the NamedValidatorSet query request.
+type NamedValidatorSetResponse struct {
+	NamedSet types.NamedValidatorSet `protobuf:"bytes,1,opt,name=named_set,json=namedSet,proto3" json:"named_set"`
+}
+
+func (m *NamedValidatorSetResponse) Reset()         { *m = NamedValidatorSetResponse{} }
+func (m *NamedValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*NamedValidatorSetResponse) ProtoMessage()    {}
+func (*NamedValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ffbeb4123fe56ae, []int{5}
+}
+func (m *NamedValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamedValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamedValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamedValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedValidatorSetResponse.Merge(m, src)
+}
+func (m *NamedValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamedValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedValidatorSetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*UserValidatorPreferencesRequest)(nil), "osmosis.valsetpref.v1beta1.UserValidatorPreferencesRequest")
 	proto.RegisterType((*UserValidatorPreferencesResponse)(nil), "osmosis.valsetpref.v1beta1.UserValidatorPreferencesResponse")
+	proto.RegisterType((*UserValidatorPreferenceDriftRequest)(nil), "osmosis.valsetpref.v1beta1.UserValidatorPreferenceDriftRequest")
+	proto.RegisterType((*UserValidatorPreferenceDriftResponse)(nil), "osmosis.valsetpref.v1beta1.UserValidatorPreferenceDriftResponse")
+	proto.RegisterType((*NamedValidatorSetRequest)(nil), "osmosis.valsetpref.v1beta1.NamedValidatorSetRequest")
+	proto.RegisterType((*NamedValidatorSetResponse)(nil), "osmosis.valsetpref.v1beta1.NamedValidatorSetResponse")
 }
 
 func init() {
@@ -117,29 +284,46 @@ func init() {
 }
 
 var fileDescriptor_9ffbeb4123fe56ae = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x4a, 0xc3, 0x40,
-	0x10, 0xc6, 0xb3, 0xfe, 0xc5, 0xf4, 0x16, 0x3c, 0x84, 0x20, 0xdb, 0xd2, 0x83, 0xf6, 0xd2, 0x5d,
-	0x5a, 0xc1, 0x4b, 0x3d, 0xd5, 0x17, 0xd0, 0x82, 0x0a, 0xde, 0x36, 0xed, 0x34, 0x06, 0xd2, 0x4c,
-	0xba, 0xb3, 0x2d, 0x4a, 0xf1, 0xe2, 0x13, 0x08, 0x3e, 0x93, 0xd0, 0x9b, 0x05, 0x2f, 0x9e, 0x44,
-	0x5b, 0x1f, 0x44, 0xda, 0xa4, 0x54, 0xc1, 0x56, 0xf0, 0x94, 0xec, 0xee, 0x6f, 0xbe, 0x6f, 0xbf,
-	0x99, 0xb5, 0x0f, 0x90, 0x3a, 0x48, 0x21, 0xc9, 0xbe, 0x8a, 0x08, 0x4c, 0x39, 0xd1, 0xd0, 0x96,
-	0xfd, 0x8a, 0x0f, 0x46, 0x55, 0x64, 0xb7, 0x07, 0xfa, 0x56, 0x24, 0x1a, 0x0d, 0x3a, 0x5e, 0x06,
-	0x8a, 0x14, 0x9c, 0x72, 0x22, 0xe3, 0xbc, 0xdd, 0x00, 0x03, 0x9c, 0x61, 0x72, 0xfa, 0x97, 0x56,
-	0x78, 0x7b, 0x01, 0x62, 0x10, 0x81, 0x54, 0x49, 0x28, 0x55, 0x1c, 0xa3, 0x51, 0x26, 0xc4, 0x98,
-	0xb2, 0xd3, 0x95, 0xc6, 0x64, 0x94, 0x81, 0x14, 0x2c, 0xd6, 0xec, 0xfc, 0x39, 0x81, 0xbe, 0x50,
-	0x51, 0xd8, 0x52, 0x06, 0xf5, 0xa9, 0x86, 0x36, 0x68, 0x88, 0x9b, 0x40, 0x0d, 0xe8, 0xf6, 0x80,
-	0x8c, 0xe3, 0xda, 0xdb, 0xaa, 0xd5, 0xd2, 0x40, 0xe4, 0xb2, 0x02, 0x2b, 0xed, 0x34, 0xe6, 0xcb,
-	0xe2, 0xc0, 0x2e, 0x2c, 0x2f, 0xa6, 0x04, 0x63, 0x02, 0xe7, 0xd2, 0xce, 0x25, 0x8b, 0x6d, 0x97,
-	0x15, 0xd6, 0x4b, 0xb9, 0xaa, 0x14, 0xcb, 0xf3, 0x8a, 0x5f, 0xe4, 0xea, 0x1b, 0xc3, 0xb7, 0xbc,
-	0xd5, 0xf8, 0xae, 0x54, 0x7d, 0x66, 0xf6, 0xe6, 0xd9, 0xb4, 0x85, 0xce, 0x13, 0xb3, 0xdd, 0x65,
-	0xf7, 0x70, 0x6a, 0xab, 0xac, 0xfe, 0x88, 0xee, 0x1d, 0xff, 0xaf, 0x38, 0x8d, 0x5e, 0x14, 0xf7,
-	0x2f, 0x9f, 0x8f, 0x6b, 0x25, 0x67, 0x5f, 0xae, 0x9a, 0xc6, 0x20, 0xeb, 0xe6, 0x5d, 0x5d, 0x0d,
-	0x3f, 0xb8, 0x35, 0x1c, 0x73, 0x36, 0x1a, 0x73, 0xf6, 0x3e, 0xe6, 0xec, 0x61, 0xc2, 0xad, 0xd1,
-	0x84, 0x5b, 0xaf, 0x13, 0x6e, 0x5d, 0x9d, 0x04, 0xa1, 0xb9, 0xee, 0xf9, 0xa2, 0x89, 0x9d, 0xb9,
-	0x5e, 0x39, 0x52, 0x3e, 0x2d, 0xc4, 0x2b, 0x47, 0xf2, 0xe6, 0x87, 0x45, 0x33, 0x0a, 0x21, 0x36,
-	0xe9, 0x43, 0x9b, 0x8d, 0xdb, 0xdf, 0x9a, 0x7d, 0x0e, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0xcf, 0x34, 0x63, 0x99, 0x02, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0x16, 0xf8, 0xfd, 0x64, 0x1a, 0x4d, 0x9c, 0x18, 0xb3, 0x36, 0x64, 0x69, 0x56, 0x83,
+	0x3d, 0xd8, 0x19, 0x5b, 0x91, 0x0b, 0x26, 0x18, 0xe4, 0xac, 0xb8, 0xa8, 0x24, 0xc6, 0xa4, 0x99,
+	0xee, 0xbe, 0x6c, 0x37, 0x6e, 0x77, 0x96, 0x9d, 0x29, 0x4a, 0x08, 0x17, 0x3f, 0x81, 0x89, 0x5f,
+	0xc5, 0x83, 0x5f, 0xc0, 0x84, 0x83, 0x07, 0x12, 0x0f, 0x1a, 0x0f, 0x44, 0x29, 0x1f, 0xc4, 0xec,
+	0xce, 0x94, 0x96, 0x40, 0x17, 0xa8, 0xa7, 0xf9, 0xd3, 0xe7, 0x7d, 0xde, 0xf7, 0x79, 0xe6, 0x7d,
+	0xbb, 0xe8, 0x2e, 0x17, 0x1d, 0x2e, 0x02, 0x41, 0xb7, 0x58, 0x28, 0x40, 0xd6, 0xe2, 0x04, 0x36,
+	0xe8, 0x56, 0xbd, 0x05, 0x92, 0xd5, 0xe9, 0x66, 0x17, 0x92, 0x6d, 0x12, 0x27, 0x5c, 0x72, 0x5c,
+	0xd6, 0x40, 0xa2, 0x80, 0x29, 0x8e, 0x68, 0x5c, 0xf9, 0x86, 0xcf, 0x7d, 0x9e, 0xc1, 0x68, 0xba,
+	0x53, 0x11, 0xe5, 0x19, 0x9f, 0x73, 0x3f, 0x04, 0xca, 0xe2, 0x80, 0xb2, 0x28, 0xe2, 0x92, 0xc9,
+	0x80, 0x47, 0x42, 0xff, 0x9a, 0x9b, 0x58, 0x48, 0x26, 0x41, 0x01, 0xed, 0x45, 0x34, 0xfb, 0x52,
+	0x40, 0xf2, 0x8a, 0x85, 0x81, 0xc7, 0x24, 0x4f, 0x56, 0x13, 0xd8, 0x80, 0x04, 0x22, 0x17, 0x84,
+	0x03, 0x9b, 0x5d, 0x10, 0x12, 0x9b, 0xe8, 0x7f, 0xe6, 0x79, 0x09, 0x08, 0x61, 0x1a, 0x15, 0xa3,
+	0x3a, 0xed, 0xf4, 0x8f, 0xf6, 0x37, 0x03, 0x55, 0x46, 0x47, 0x8b, 0x98, 0x47, 0x02, 0xf0, 0x3a,
+	0x2a, 0xc5, 0x83, 0x6b, 0xd3, 0xa8, 0x4c, 0x54, 0x4b, 0x0d, 0x4a, 0x46, 0x0b, 0x26, 0x67, 0xd0,
+	0x2d, 0x4f, 0xee, 0x1d, 0xcc, 0x16, 0x9c, 0x61, 0x26, 0xfc, 0x0c, 0x4d, 0x1f, 0x9f, 0xcc, 0x62,
+	0xc5, 0xa8, 0x96, 0x1a, 0xf5, 0x0b, 0xd1, 0xae, 0x81, 0x74, 0xfa, 0x81, 0xce, 0x80, 0xc3, 0x5e,
+	0x42, 0xb7, 0x47, 0xa8, 0x59, 0x49, 0x82, 0x0d, 0x79, 0xbe, 0x1f, 0x9f, 0x8b, 0xe8, 0x4e, 0x3e,
+	0x83, 0xf6, 0x64, 0x05, 0x4d, 0x79, 0xe9, 0x85, 0x22, 0x58, 0x26, 0xa9, 0xb8, 0x5f, 0x07, 0xb3,
+	0x73, 0x7e, 0x20, 0xdb, 0xdd, 0x16, 0x71, 0x79, 0x87, 0xba, 0x99, 0x12, 0xbd, 0xd4, 0x84, 0xf7,
+	0x96, 0xca, 0xed, 0x18, 0x04, 0x59, 0x01, 0xd7, 0x51, 0xc1, 0xf8, 0x0d, 0xba, 0xc6, 0x5c, 0xd9,
+	0x65, 0x61, 0xf3, 0x1d, 0x04, 0x7e, 0x5b, 0x0a, 0xb3, 0xf8, 0x2f, 0xe6, 0x5e, 0x55, 0x64, 0xeb,
+	0x8a, 0x0b, 0xb7, 0x91, 0xc9, 0xba, 0x92, 0x37, 0x13, 0x68, 0xb1, 0x90, 0x45, 0x2e, 0x34, 0x65,
+	0x3b, 0x01, 0xd1, 0xe6, 0xa1, 0x67, 0x4e, 0x8c, 0x55, 0xf6, 0xcd, 0x94, 0xcf, 0xe9, 0xd3, 0xbd,
+	0xe8, 0xb3, 0xd9, 0x04, 0x99, 0x4f, 0x59, 0x07, 0xbc, 0x93, 0x0f, 0xa4, 0xcc, 0xc6, 0x68, 0x32,
+	0x62, 0x1d, 0xd0, 0x4e, 0x67, 0x7b, 0xbb, 0x83, 0x6e, 0x9d, 0x81, 0xd7, 0xd6, 0xae, 0xa2, 0xe9,
+	0x14, 0xe4, 0x35, 0x05, 0x28, 0x7b, 0x4b, 0x8d, 0x5a, 0x9e, 0x1f, 0xa7, 0x98, 0xb4, 0x1b, 0x57,
+	0x32, 0x96, 0x35, 0x90, 0x8d, 0xa3, 0x49, 0x34, 0xf5, 0x3c, 0x9d, 0x55, 0xfc, 0xd5, 0x40, 0xe6,
+	0xa8, 0x7e, 0xc7, 0x8b, 0x79, 0x59, 0xce, 0x99, 0xb1, 0xf2, 0xa3, 0xf1, 0x82, 0x95, 0x66, 0x9b,
+	0x7c, 0xf8, 0x7e, 0xf4, 0xa9, 0x58, 0xc5, 0x73, 0x34, 0x6f, 0xec, 0x77, 0x74, 0x9b, 0xee, 0xe2,
+	0x1f, 0x06, 0x9a, 0xc9, 0xeb, 0x53, 0xbc, 0x34, 0x46, 0x39, 0xc3, 0x33, 0x52, 0x7e, 0x3c, 0x3e,
+	0x81, 0xd6, 0x34, 0x9f, 0x69, 0x22, 0xf8, 0xde, 0xc5, 0x34, 0x51, 0x35, 0x12, 0x5f, 0x0c, 0x74,
+	0xfd, 0xd4, 0x8b, 0xe2, 0xf9, 0x4b, 0x35, 0x40, 0x5f, 0xc3, 0xc3, 0x4b, 0x46, 0xe9, 0xc2, 0x17,
+	0xb2, 0xc2, 0xef, 0x63, 0x92, 0x5b, 0xf8, 0x71, 0x8f, 0x0a, 0xba, 0x93, 0xee, 0x77, 0x97, 0xd9,
+	0xde, 0x1f, 0xab, 0xb0, 0x77, 0x68, 0x19, 0xfb, 0x87, 0x96, 0xf1, 0xfb, 0xd0, 0x32, 0x3e, 0xf6,
+	0xac, 0xc2, 0x7e, 0xcf, 0x2a, 0xfc, 0xec, 0x59, 0x85, 0xd7, 0x4f, 0x86, 0x66, 0x4c, 0xf3, 0xd6,
+	0x42, 0xd6, 0x12, 0x83, 0x24, 0xf5, 0x05, 0xfa, 0xfe, 0x44, 0x2a, 0x37, 0x0c, 0x20, 0x92, 0xea,
+	0x33, 0x93, 0xfd, 0xd9, 0xb7, 0xfe, 0xcb, 0x96, 0x07, 0x7f, 0x07, 0x00, 0x81, 0x78, 0xe0, 0xab,
+	0x97, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +340,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Returns the list of ValidatorPreferences for the user.
 	UserValidatorPreferences(ctx context.Context, in *UserValidatorPreferencesRequest, opts ...grpc.CallOption) (*UserValidatorPreferencesResponse, error)
+	// Returns how far the delegations of the user drifted from their validator
+	// set preference.
+	UserValidatorPreferenceDrift(ctx context.Context, in *UserValidatorPreferenceDriftRequest, opts ...grpc.CallOption) (*UserValidatorPreferenceDriftResponse, error)
+	// Returns a named validator set maintained by governance.
+	NamedValidatorSet(ctx context.Context, in *NamedValidatorSetRequest, opts ...grpc.CallOption) (*NamedValidatorSetResponse, error)
 }
 
 type queryClient struct {
@@ -175,10 +364,33 @@ func (c *queryClient) UserValidatorPreferences(ctx context.Context, in *UserVali
 	return out, nil
 }
 
+func (c *queryClient) UserValidatorPreferenceDrift(ctx context.Context, in *UserValidatorPreferenceDriftRequest, opts ...grpc.CallOption) (*UserValidatorPreferenceDriftResponse, error) {
+	out := new(UserValidatorPreferenceDriftResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Query/UserValidatorPreferenceDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamedValidatorSet(ctx context.Context, in *NamedValidatorSetRequest, opts ...grpc.CallOption) (*NamedValidatorSetResponse, error) {
+	out := new(NamedValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Query/NamedValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns the list of ValidatorPreferences for the user.
 	UserValidatorPreferences(context.Context, *UserValidatorPreferencesRequest) (*UserValidatorPreferencesResponse, error)
+	// Returns how far the delegations of the user drifted from their validator
+	// set preference.
+	UserValidatorPreferenceDrift(context.Context, *UserValidatorPreferenceDriftRequest) (*UserValidatorPreferenceDriftResponse, error)
+	// Returns a named validator set maintained by governance.
+	NamedValidatorSet(context.Context, *NamedValidatorSetRequest) (*NamedValidatorSetResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -188,6 +400,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) UserValidatorPreferences(ctx context.Context, req *UserValidatorPreferencesRequest) (*UserValidatorPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserValidatorPreferences not implemented")
 }
+func (*UnimplementedQueryServer) UserValidatorPreferenceDrift(ctx context.Context, req *UserValidatorPreferenceDriftRequest) (*UserValidatorPreferenceDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserValidatorPreferenceDrift not implemented")
+}
+func (*UnimplementedQueryServer) NamedValidatorSet(ctx context.Context, req *NamedValidatorSetRequest) (*NamedValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamedValidatorSet not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -211,6 +429,42 @@ func _Query_UserValidatorPreferences_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UserValidatorPreferenceDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserValidatorPreferenceDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserValidatorPreferenceDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.valsetpref.v1beta1.Query/UserValidatorPreferenceDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserValidatorPreferenceDrift(ctx, req.(*UserValidatorPreferenceDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamedValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamedValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamedValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.valsetpref.v1beta1.Query/NamedValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamedValidatorSet(ctx, req.(*NamedValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.valsetpref.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -219,6 +473,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserValidatorPreferences",
 			Handler:    _Query_UserValidatorPreferences_Handler,
 		},
+		{
+			MethodName: "UserValidatorPreferenceDrift",
+			Handler:    _Query_UserValidatorPreferenceDrift_Handler,
+		},
+		{
+			MethodName: "NamedValidatorSet",
+			Handler:    _Query_NamedValidatorSet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/valset-pref/v1beta1/query.proto",
@@ -274,6 +536,18 @@ func (m *UserValidatorPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.Reference != nil {
+		{
+			size, err := m.Reference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Preferences) > 0 {
 		for iNdEx := len(m.Preferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -291,6 +565,156 @@ func (m *UserValidatorPreferencesResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *UserValidatorPreferenceDriftRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserValidatorPreferenceDriftRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserValidatorPreferenceDriftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserValidatorPreferenceDriftResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserValidatorPreferenceDriftResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserValidatorPreferenceDriftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AutoRebalanceThreshold.Size()
+		i -= size
+		if _, err := m.AutoRebalanceThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ActualWeights) > 0 {
+		for iNdEx := len(m.ActualWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActualWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Drift.Size()
+		i -= size
+		if _, err := m.Drift.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NamedValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamedValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NamedSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -327,20 +751,80 @@ func (m *UserValidatorPreferencesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Reference != nil {
+		l = m.Reference.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *UserValidatorPreferencesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *UserValidatorPreferenceDriftRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserValidatorPreferenceDriftResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Drift.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ActualWeights) > 0 {
+		for _, e := range m.ActualWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.AutoRebalanceThreshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NamedValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NamedValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NamedSet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserValidatorPreferencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -481,6 +965,441 @@ func (m *UserValidatorPreferencesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reference == nil {
+				m.Reference = &types.ValidatorSetReference{}
+			}
+			if err := m.Reference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserValidatorPreferenceDriftRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserValidatorPreferenceDriftRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserValidatorPreferenceDriftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserValidatorPreferenceDriftResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserValidatorPreferenceDriftResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserValidatorPreferenceDriftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Drift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActualWeights = append(m.ActualWeights, types.ValidatorPreference{})
+			if err := m.ActualWeights[len(m.ActualWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRebalanceThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoRebalanceThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamedValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamedValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamedValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamedValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamedValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamedValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamedSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NamedSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
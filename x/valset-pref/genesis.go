@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+)
+
+// InitGenesis initializes the auto-rebalance opt-ins and queue from the genesis state.
+// The validator set preferences themselves are not part of the genesis state, so the opt-ins
+// are set without checking that the delegator has one. A delegator without one is skipped
+// when rebalancing.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	store := ctx.KVStore(k.storeKey)
+	for _, autoRebalance := range genState.AutoRebalances {
+		preference := autoRebalance.Preference
+		osmoutils.MustSet(store, types.GetAutoRebalanceKey(autoRebalance.Delegator), &preference)
+	}
+	for _, delegator := range genState.AutoRebalanceQueue {
+		store.Set(types.GetAutoRebalanceQueueKey(delegator), []byte{})
+	}
+}
+
+// ExportGenesis returns the auto-rebalance opt-ins and queue.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	store := ctx.KVStore(k.storeKey)
+	genState := types.DefaultGenesis()
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixAutoRebalance)
+	for ; iterator.Valid(); iterator.Next() {
+		preference := types.AutoRebalancePreference{}
+		if err := preference.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		genState.AutoRebalances = append(genState.AutoRebalances, types.AutoRebalanceRecord{
+			Delegator:  string(iterator.Key()[len(types.KeyPrefixAutoRebalance):]),
+			Preference: preference,
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.KeyPrefixAutoRebalanceQueue)
+	for ; iterator.Valid(); iterator.Next() {
+		genState.AutoRebalanceQueue = append(genState.AutoRebalanceQueue, string(iterator.Key()[len(types.KeyPrefixAutoRebalanceQueue):]))
+	}
+	iterator.Close()
+
+	return genState
+}
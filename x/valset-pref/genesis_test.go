@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+)
+
+// TestGenesis tests that the auto-rebalance opt-ins and queue are exported and imported.
+func (s *KeeperTestSuite) TestGenesis() {
+	s.SetupTest()
+	delegator, _ := s.setupDriftedDelegator()
+	keeper := s.App.ValidatorSetPreferenceKeeper
+	threshold := sdk.NewDecWithPrec(1, 1)
+	s.Require().NoError(keeper.SetAutoRebalance(s.Ctx, delegator.String(), threshold))
+	keeper.QueueAutoRebalances(s.Ctx)
+
+	expectedGenesis := &types.GenesisState{
+		AutoRebalances: []types.AutoRebalanceRecord{
+			{Delegator: delegator.String(), Preference: types.AutoRebalancePreference{DriftThreshold: threshold}},
+		},
+		AutoRebalanceQueue: []string{delegator.String()},
+	}
+	genesis := keeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(expectedGenesis, genesis)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	keeper = s.App.ValidatorSetPreferenceKeeper
+	s.Require().Equal(types.DefaultGenesis(), keeper.ExportGenesis(s.Ctx))
+
+	keeper.InitGenesis(s.Ctx, genesis)
+	s.Require().Equal(expectedGenesis, keeper.ExportGenesis(s.Ctx))
+	actualThreshold, found := keeper.GetAutoRebalanceThreshold(s.Ctx, delegator.String())
+	s.Require().True(found)
+	s.Require().Equal(threshold, actualThreshold)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+)
+
+func NewValidatorSetPreferenceProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetNamedValidatorSetProposal:
+			return handleSetNamedValidatorSetProposal(ctx, k, c)
+		case *types.RemoveNamedValidatorSetProposal:
+			return handleRemoveNamedValidatorSetProposal(ctx, k, c)
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized valset-pref proposal content type: %T", c)
+		}
+	}
+}
+
+func handleSetNamedValidatorSetProposal(ctx sdk.Context, k Keeper, p *types.SetNamedValidatorSetProposal) error {
+	if err := k.SetNamedValidatorSet(ctx, p.Name, p.Preferences); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSetNamedValidatorSet,
+		sdk.NewAttribute(types.AttributeNamedSet, p.Name),
+	))
+	return nil
+}
+
+func handleRemoveNamedValidatorSetProposal(ctx sdk.Context, k Keeper, p *types.RemoveNamedValidatorSetProposal) error {
+	if err := k.RemoveNamedValidatorSet(ctx, p.Name); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtRemoveNamedValidatorSet,
+		sdk.NewAttribute(types.AttributeNamedSet, p.Name),
+	))
+	return nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = &epochhook{}
+
+type epochhook struct {
+	k Keeper
+}
+
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return &epochhook{k}
+}
+
+// AfterEpochEnd queues the delegators that opted in to auto-rebalancing, they are rebalanced in the next end blocks.
+func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == types.AutoRebalanceEpochIdentifier {
+		hook.k.QueueAutoRebalances(ctx)
+	}
+	return nil
+}
+
+func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
@@ -40,7 +40,7 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 // GetDelegationPreferences checks if valset position exists, if it does return that
-// else return existing delegation that's not valset.
+// with its references resolved, else return existing delegation that's not valset.
 func (k Keeper) GetDelegationPreferences(ctx sdk.Context, delegator string) (types.ValidatorSetPreferences, error) {
 	valSet, exists := k.GetValidatorSetPreference(ctx, delegator)
 	if !exists {
@@ -57,7 +57,7 @@ func (k Keeper) GetDelegationPreferences(ctx sdk.Context, delegator string) (typ
 		return types.ValidatorSetPreferences{Preferences: existingDelsValSetFormatted}, nil
 	}
 
-	return valSet, nil
+	return k.resolveValidatorSetPreference(ctx, delegator, valSet)
 }
 
 // GetExistingStakingDelegations returns the existing delegation that's not valset.
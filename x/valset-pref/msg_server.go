@@ -26,7 +26,7 @@ var _ types.MsgServer = msgServer{}
 func (server msgServer) SetValidatorSetPreference(goCtx context.Context, msg *types.MsgSetValidatorSetPreference) (*types.MsgSetValidatorSetPreferenceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	preferences, err := server.keeper.SetValidatorSetPreference(ctx, msg.Delegator, msg.Preferences)
+	preferences, err := server.keeper.setValidatorSetPreferenceOrReference(ctx, msg.Delegator, msg.Preferences, msg.Reference)
 	if err != nil {
 		return nil, err
 	}
@@ -69,19 +69,25 @@ func (server msgServer) RedelegateValidatorSet(goCtx context.Context, msg *types
 	}
 
 	// get existing delegation if there is no valset set, else get valset
-	existingSet, err := server.keeper.GetDelegationPreferences(ctx, msg.Delegator)
+	existingSet, err := server.keeper.getExistingDelegationPreferences(ctx, msg.Delegator)
 	if err != nil {
 		return nil, fmt.Errorf("user has no delegation")
 	}
 
 	// Message 1: override the validator set preference set entry
-	newPreferences, err := server.keeper.SetValidatorSetPreference(ctx, msg.Delegator, msg.Preferences)
+	newPreferences, err := server.keeper.setValidatorSetPreferenceOrReference(ctx, msg.Delegator, msg.Preferences, msg.Reference)
 	if err != nil {
 		return nil, err
 	}
 
 	server.keeper.SetValidatorSetPreferences(ctx, msg.Delegator, newPreferences)
 
+	// a reference is resolved to the current preferences it points to
+	newPreferences, err = server.keeper.GetResolvedValidatorSetPreference(ctx, msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
 	// Message 2: Perform the actual redelegation
 	err = server.keeper.PreformRedelegation(ctx, delegator, existingSet.Preferences, newPreferences.Preferences)
 	if err != nil {
@@ -132,3 +138,23 @@ func (server msgServer) DelegateBondedTokens(goCtx context.Context, msg *types.M
 
 	return &types.MsgDelegateBondedTokensResponse{}, nil
 }
+
+// setValidatorSetPreferenceOrReference validates the preferences, or the reference when it is set.
+func (k Keeper) setValidatorSetPreferenceOrReference(ctx sdk.Context, delegator string, preferences []types.ValidatorPreference, reference *types.ValidatorSetReference) (types.ValidatorSetPreferences, error) {
+	if reference != nil {
+		return k.SetValidatorSetPreferenceReference(ctx, delegator, *reference)
+	}
+	return k.SetValidatorSetPreference(ctx, delegator, preferences)
+}
+
+// SetAutoRebalance opts in or out of the automatic rebalancing of the delegations to the validator set preference.
+func (server msgServer) SetAutoRebalance(goCtx context.Context, msg *types.MsgSetAutoRebalance) (*types.MsgSetAutoRebalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SetAutoRebalance(ctx, msg.Delegator, msg.DriftThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoRebalanceResponse{}, nil
+}
@@ -577,6 +577,46 @@ func (s *KeeperTestSuite) TestRedelegateToValidatorSet() {
 	}
 }
 
+// TestRedelegateToValidatorSet_SlashedValidator tests that MsgRedelegateValidatorSet keeps redelegating
+// token amounts as shares after a validator got slashed, unlike auto-rebalancing which converts them.
+func (s *KeeperTestSuite) TestRedelegateToValidatorSet_SlashedValidator() {
+	s.SetupTest()
+	valAddrs := s.SetupMultipleValidators(3)
+	delegator := s.TestAccs[0]
+	s.FundAcc(delegator, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)})
+
+	msgServer := valPref.NewMsgServerImpl(s.App.ValidatorSetPreferenceKeeper)
+	c := sdk.WrapSDKContext(s.Ctx)
+
+	preferences := []types.ValidatorPreference{
+		{ValOperAddress: valAddrs[0], Weight: sdk.NewDecWithPrec(5, 1)},
+		{ValOperAddress: valAddrs[1], Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	_, err := msgServer.SetValidatorSetPreference(c, types.NewMsgSetValidatorSetPreference(delegator, preferences))
+	s.Require().NoError(err)
+	_, err = msgServer.DelegateToValidatorSet(c, types.NewMsgDelegateToValidatorSet(delegator, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20_000_000))))
+	s.Require().NoError(err)
+
+	// slash valA by half, so that its 10_000_000 shares are worth 5_000_000 tokens
+	valA, err := sdk.ValAddressFromBech32(valAddrs[0])
+	s.Require().NoError(err)
+	validatorA, found := s.App.StakingKeeper.GetValidator(s.Ctx, valA)
+	s.Require().True(found)
+	s.App.StakingKeeper.RemoveValidatorTokens(s.Ctx, validatorA, validatorA.Tokens.QuoRaw(2))
+	s.Require().Equal(sdk.NewInt(5_000_000), s.delegatedTokens(delegator, valAddrs[0]))
+
+	newPreferences := []types.ValidatorPreference{{ValOperAddress: valAddrs[2], Weight: sdk.OneDec()}}
+	_, err = msgServer.RedelegateValidatorSet(c, types.NewMsgRedelegateValidatorSet(delegator, newPreferences))
+	s.Require().NoError(err)
+
+	// the 5_000_000 tokens of valA are redelegated as 5_000_000 shares, worth 2_500_000 tokens,
+	// which leaves half of the delegation to valA.
+	delegationA, found := s.App.StakingKeeper.GetDelegation(s.Ctx, delegator, valA)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDec(5_000_000), delegationA.Shares)
+	s.Require().Equal(sdk.NewInt(12_500_000), s.delegatedTokens(delegator, valAddrs[2]))
+}
+
 func (s *KeeperTestSuite) TestWithdrawDelegationRewards() {
 	s.SetupTest()
 
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+)
+
+// SetNamedValidatorSet creates or replaces a named validator set, after checking that its validators exist.
+func (k Keeper) SetNamedValidatorSet(ctx sdk.Context, name string, preferences []types.ValidatorPreference) error {
+	valSetPref, err := k.IsPreferenceValid(ctx, preferences)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.GetNamedValidatorSetKey(name), &types.NamedValidatorSet{Name: name, Preferences: valSetPref})
+	return nil
+}
+
+// GetNamedValidatorSet returns a named validator set.
+func (k Keeper) GetNamedValidatorSet(ctx sdk.Context, name string) (types.NamedValidatorSet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	namedSet := types.NamedValidatorSet{}
+	found, err := osmoutils.Get(store, types.GetNamedValidatorSetKey(name), &namedSet)
+	if err != nil {
+		panic(err)
+	}
+	return namedSet, found
+}
+
+// RemoveNamedValidatorSet removes a named validator set. The delegators referencing it cannot use their
+// validator set preference until they set a new one.
+func (k Keeper) RemoveNamedValidatorSet(ctx sdk.Context, name string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetNamedValidatorSetKey(name)
+	if !store.Has(key) {
+		return fmt.Errorf("named validator set %s not found", name)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// SetValidatorSetPreferenceReference creates or updates a delegators validator set to reference another delegator's
+// validator set or a named validator set.
+// Errors when the reference is the same as the existing one, or when it cannot be resolved, e.g. because it
+// would create a cycle of references.
+func (k Keeper) SetValidatorSetPreferenceReference(ctx sdk.Context, delegator string, reference types.ValidatorSetReference) (types.ValidatorSetPreferences, error) {
+	existingValSet, found := k.GetValidatorSetPreference(ctx, delegator)
+	if found && existingValSet.Reference != nil && *existingValSet.Reference == reference {
+		return types.ValidatorSetPreferences{}, fmt.Errorf("The preferences reference is the same")
+	}
+
+	valSetPref := types.ValidatorSetPreferences{Reference: &reference}
+	if _, err := k.resolveValidatorSetPreference(ctx, delegator, valSetPref); err != nil {
+		return types.ValidatorSetPreferences{}, err
+	}
+
+	return valSetPref, nil
+}
+
+// resolveValidatorSetPreference follows the references of a delegator's validator set until explicit preferences.
+// References to delegators can be chained up to MaxReferenceDepth, a named validator set ends the chain.
+// Errors when a referenced validator set does not exist, or when the references form a cycle.
+func (k Keeper) resolveValidatorSetPreference(ctx sdk.Context, delegator string, valSetPref types.ValidatorSetPreferences) (types.ValidatorSetPreferences, error) {
+	visited := map[string]bool{delegator: true}
+	for depth := 0; valSetPref.Reference != nil; depth++ {
+		if depth == types.MaxReferenceDepth {
+			return types.ValidatorSetPreferences{}, fmt.Errorf("validator set of %s references more than %d validator sets", delegator, types.MaxReferenceDepth)
+		}
+
+		reference := valSetPref.Reference
+		if reference.NamedSet != "" {
+			namedSet, found := k.GetNamedValidatorSet(ctx, reference.NamedSet)
+			if !found {
+				return types.ValidatorSetPreferences{}, fmt.Errorf("named validator set %s not found", reference.NamedSet)
+			}
+			return types.ValidatorSetPreferences{Preferences: namedSet.Preferences}, nil
+		}
+
+		if visited[reference.Delegator] {
+			return types.ValidatorSetPreferences{}, fmt.Errorf("validator set of %s has a cycle of references through %s", delegator, reference.Delegator)
+		}
+		visited[reference.Delegator] = true
+
+		var found bool
+		valSetPref, found = k.GetValidatorSetPreference(ctx, reference.Delegator)
+		if !found {
+			return types.ValidatorSetPreferences{}, fmt.Errorf("referenced user %s doesn't have validator set", reference.Delegator)
+		}
+	}
+	return valSetPref, nil
+}
+
+// GetResolvedValidatorSetPreference returns the validator set preference of a delegator, with its references
+// resolved to explicit preferences.
+func (k Keeper) GetResolvedValidatorSetPreference(ctx sdk.Context, delegator string) (types.ValidatorSetPreferences, error) {
+	valSetPref, found := k.GetValidatorSetPreference(ctx, delegator)
+	if !found {
+		return types.ValidatorSetPreferences{}, fmt.Errorf("user %s doesn't have validator set", delegator)
+	}
+	return k.resolveValidatorSetPreference(ctx, delegator, valSetPref)
+}
+
+// getExistingDelegationPreferences returns the preferences the existing delegations of a delegator are moved from.
+// These are the delegator's validator set, or its actual delegations if it has none or references another validator
+// set, which can change after the delegations were made.
+func (k Keeper) getExistingDelegationPreferences(ctx sdk.Context, delegator string) (types.ValidatorSetPreferences, error) {
+	valSetPref, found := k.GetValidatorSetPreference(ctx, delegator)
+	if found && valSetPref.Reference == nil {
+		return valSetPref, nil
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return types.ValidatorSetPreferences{}, err
+	}
+	existingDelegations, err := k.GetExistingStakingDelegations(ctx, delAddr)
+	if err != nil {
+		return types.ValidatorSetPreferences{}, err
+	}
+	return types.ValidatorSetPreferences{Preferences: existingDelegations}, nil
+}
@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	valPref "github.com/osmosis-labs/osmosis/v16/x/valset-pref"
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+)
+
+func (s *KeeperTestSuite) evenPreferences(valAddrs []string) []types.ValidatorPreference {
+	preferences := []types.ValidatorPreference{}
+	for _, valAddr := range valAddrs {
+		preferences = append(preferences, types.ValidatorPreference{
+			ValOperAddress: valAddr,
+			Weight:         sdk.OneDec().QuoInt64(int64(len(valAddrs))),
+		})
+	}
+	return preferences
+}
+
+func (s *KeeperTestSuite) TestNamedValidatorSet() {
+	s.SetupTest()
+	valAddrs := s.SetupMultipleValidators(2)
+	keeper := s.App.ValidatorSetPreferenceKeeper
+
+	// validator does not exist
+	err := keeper.SetNamedValidatorSet(s.Ctx, "decentralization", []types.ValidatorPreference{
+		{ValOperAddress: "osmovaloper1x2cfenmflhj3dwm2ph6nkgqr3nppkg86fxaymg", Weight: sdk.OneDec()},
+	})
+	s.Require().Error(err)
+
+	err = keeper.SetNamedValidatorSet(s.Ctx, "decentralization", s.evenPreferences(valAddrs))
+	s.Require().NoError(err)
+	namedSet, found := keeper.GetNamedValidatorSet(s.Ctx, "decentralization")
+	s.Require().True(found)
+	s.Require().Equal("decentralization", namedSet.Name)
+	s.Require().Equal(s.evenPreferences(valAddrs), namedSet.Preferences)
+
+	err = keeper.RemoveNamedValidatorSet(s.Ctx, "decentralization")
+	s.Require().NoError(err)
+	_, found = keeper.GetNamedValidatorSet(s.Ctx, "decentralization")
+	s.Require().False(found)
+
+	err = keeper.RemoveNamedValidatorSet(s.Ctx, "decentralization")
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestResolveValidatorSetPreference() {
+	delegatorA := sdk.AccAddress([]byte("addrA---------------")).String()
+	delegatorB := sdk.AccAddress([]byte("addrB---------------")).String()
+	delegatorC := sdk.AccAddress([]byte("addrC---------------")).String()
+
+	tests := map[string]struct {
+		// stored validator sets, nil preferences are replaced with the validator set of the test
+		stored      map[string]types.ValidatorSetPreferences
+		delegator   string
+		expectError bool
+	}{
+		"explicit preferences": {
+			stored:    map[string]types.ValidatorSetPreferences{delegatorA: {}},
+			delegator: delegatorA,
+		},
+		"reference to a named set": {
+			stored:    map[string]types.ValidatorSetPreferences{delegatorA: {Reference: types.NewNamedSetReference("decentralization")}},
+			delegator: delegatorA,
+		},
+		"reference to a delegator": {
+			stored: map[string]types.ValidatorSetPreferences{
+				delegatorA: {Reference: types.NewDelegatorReference(delegatorB)},
+				delegatorB: {},
+			},
+			delegator: delegatorA,
+		},
+		"chain of references ending with a named set": {
+			stored: map[string]types.ValidatorSetPreferences{
+				delegatorA: {Reference: types.NewDelegatorReference(delegatorB)},
+				delegatorB: {Reference: types.NewDelegatorReference(delegatorC)},
+				delegatorC: {Reference: types.NewNamedSetReference("decentralization")},
+			},
+			delegator: delegatorA,
+		},
+		"cycle of references": {
+			stored: map[string]types.ValidatorSetPreferences{
+				delegatorA: {Reference: types.NewDelegatorReference(delegatorB)},
+				delegatorB: {Reference: types.NewDelegatorReference(delegatorC)},
+				delegatorC: {Reference: types.NewDelegatorReference(delegatorA)},
+			},
+			delegator:   delegatorA,
+			expectError: true,
+		},
+		"referenced delegator without validator set": {
+			stored:      map[string]types.ValidatorSetPreferences{delegatorA: {Reference: types.NewDelegatorReference(delegatorB)}},
+			delegator:   delegatorA,
+			expectError: true,
+		},
+		"missing named set": {
+			stored:      map[string]types.ValidatorSetPreferences{delegatorA: {Reference: types.NewNamedSetReference("unknown")}},
+			delegator:   delegatorA,
+			expectError: true,
+		},
+		"no validator set": {
+			stored:      map[string]types.ValidatorSetPreferences{},
+			delegator:   delegatorA,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			valAddrs := s.SetupMultipleValidators(2)
+			preferences := s.evenPreferences(valAddrs)
+			keeper := s.App.ValidatorSetPreferenceKeeper
+
+			err := keeper.SetNamedValidatorSet(s.Ctx, "decentralization", preferences)
+			s.Require().NoError(err)
+			for delegator, valSet := range test.stored {
+				if valSet.Reference == nil {
+					valSet.Preferences = preferences
+				}
+				keeper.SetValidatorSetPreferences(s.Ctx, delegator, valSet)
+			}
+
+			resolved, err := keeper.GetResolvedValidatorSetPreference(s.Ctx, test.delegator)
+			if test.expectError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Nil(resolved.Reference)
+			s.Require().Equal(preferences, resolved.Preferences)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestResolveValidatorSetPreferenceMaxDepth() {
+	s.SetupTest()
+	valAddrs := s.SetupMultipleValidators(1)
+	keeper := s.App.ValidatorSetPreferenceKeeper
+
+	// delegators[i] references delegators[i+1], and the last one has explicit preferences
+	delegators := []string{}
+	for i := 0; i <= types.MaxReferenceDepth+1; i++ {
+		delegators = append(delegators, sdk.AccAddress([]byte{byte(i)}).String())
+	}
+	for i := 0; i < len(delegators)-1; i++ {
+		keeper.SetValidatorSetPreferences(s.Ctx, delegators[i], types.ValidatorSetPreferences{Reference: types.NewDelegatorReference(delegators[i+1])})
+	}
+	keeper.SetValidatorSetPreferences(s.Ctx, delegators[len(delegators)-1], types.ValidatorSetPreferences{Preferences: s.evenPreferences(valAddrs)})
+
+	_, err := keeper.GetResolvedValidatorSetPreference(s.Ctx, delegators[0])
+	s.Require().Error(err)
+
+	_, err = keeper.GetResolvedValidatorSetPreference(s.Ctx, delegators[1])
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSetValidatorSetPreferenceReference() {
+	s.SetupTest()
+	valAddrs := s.SetupMultipleValidators(2)
+	keeper := s.App.ValidatorSetPreferenceKeeper
+	msgServer := valPref.NewMsgServerImpl(keeper)
+	c := sdk.WrapSDKContext(s.Ctx)
+
+	delegatorA := sdk.AccAddress([]byte("addrA---------------")).String()
+	delegatorB := sdk.AccAddress([]byte("addrB---------------")).String()
+
+	// the referenced delegator doesn't have a validator set
+	_, err := msgServer.SetValidatorSetPreference(c, &types.MsgSetValidatorSetPreference{Delegator: delegatorA, Reference: types.NewDelegatorReference(delegatorB)})
+	s.Require().Error(err)
+
+	_, err = msgServer.SetValidatorSetPreference(c, &types.MsgSetValidatorSetPreference{Delegator: delegatorB, Preferences: s.evenPreferences(valAddrs)})
+	s.Require().NoError(err)
+	_, err = msgServer.SetValidatorSetPreference(c, &types.MsgSetValidatorSetPreference{Delegator: delegatorA, Reference: types.NewDelegatorReference(delegatorB)})
+	s.Require().NoError(err)
+
+	// the reference is stored, not the preferences it points to
+	valSet, found := keeper.GetValidatorSetPreference(s.Ctx, delegatorA)
+	s.Require().True(found)
+	s.Require().Equal(types.NewDelegatorReference(delegatorB), valSet.Reference)
+	s.Require().Empty(valSet.Preferences)
+
+	// same reference
+	_, err = msgServer.SetValidatorSetPreference(c, &types.MsgSetValidatorSetPreference{Delegator: delegatorA, Reference: types.NewDelegatorReference(delegatorB)})
+	s.Require().Error(err)
+
+	// would create a cycle
+	_, err = msgServer.SetValidatorSetPreference(c, &types.MsgSetValidatorSetPreference{Delegator: delegatorB, Reference: types.NewDelegatorReference(delegatorA)})
+	s.Require().Error(err)
+
+	// changes of the referenced validator set are followed
+	_, err = msgServer.SetValidatorSetPreference(c, &types.MsgSetValidatorSetPreference{Delegator: delegatorB, Preferences: s.evenPreferences(valAddrs[:1])})
+	s.Require().NoError(err)
+	resolved, err := keeper.GetResolvedValidatorSetPreference(s.Ctx, delegatorA)
+	s.Require().NoError(err)
+	s.Require().Equal(s.evenPreferences(valAddrs[:1]), resolved.Preferences)
+}
+
+func (s *KeeperTestSuite) TestDelegateToReferencedValidatorSet() {
+	s.SetupTest()
+	valAddrs := s.SetupMultipleValidators(2)
+	keeper := s.App.ValidatorSetPreferenceKeeper
+	msgServer := valPref.NewMsgServerImpl(keeper)
+	c := sdk.WrapSDKContext(s.Ctx)
+
+	delegator := s.TestAccs[0]
+	s.FundAcc(delegator, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)})
+
+	err := keeper.SetNamedValidatorSet(s.Ctx, "decentralization", s.evenPreferences(valAddrs))
+	s.Require().NoError(err)
+	_, err = msgServer.SetValidatorSetPreference(c, &types.MsgSetValidatorSetPreference{Delegator: delegator.String(), Reference: types.NewNamedSetReference("decentralization")})
+	s.Require().NoError(err)
+
+	_, err = msgServer.DelegateToValidatorSet(c, types.NewMsgDelegateToValidatorSet(delegator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(500), s.delegatedTokens(delegator, valAddrs[0]))
+	s.Require().Equal(sdk.NewInt(500), s.delegatedTokens(delegator, valAddrs[1]))
+
+	// governance moves the named set to a single validator, and the delegator redelegates to it
+	err = keeper.SetNamedValidatorSet(s.Ctx, "decentralization", s.evenPreferences(valAddrs[1:]))
+	s.Require().NoError(err)
+	err = keeper.SetNamedValidatorSet(s.Ctx, "single", s.evenPreferences(valAddrs[1:]))
+	s.Require().NoError(err)
+	_, err = msgServer.RedelegateValidatorSet(c, &types.MsgRedelegateValidatorSet{Delegator: delegator.String(), Reference: types.NewNamedSetReference("single")})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(1000), s.delegatedTokens(delegator, valAddrs[1]))
+
+	valSet, found := keeper.GetValidatorSetPreference(s.Ctx, delegator.String())
+	s.Require().True(found)
+	s.Require().Equal(types.NewNamedSetReference("single"), valSet.Reference)
+}
+
+// Test that undelegating from a referenced validator set undelegates from the actual delegations, after the
+// referenced set changed
+func (s *KeeperTestSuite) TestUndelegateFromReferencedValidatorSet() {
+	s.SetupTest()
+	valAddrs := s.SetupMultipleValidators(3)
+	keeper := s.App.ValidatorSetPreferenceKeeper
+	msgServer := valPref.NewMsgServerImpl(keeper)
+	c := sdk.WrapSDKContext(s.Ctx)
+
+	delegator := s.TestAccs[0]
+	s.FundAcc(delegator, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)})
+
+	err := keeper.SetNamedValidatorSet(s.Ctx, "decentralization", s.evenPreferences(valAddrs[:2]))
+	s.Require().NoError(err)
+	_, err = msgServer.SetValidatorSetPreference(c, &types.MsgSetValidatorSetPreference{Delegator: delegator.String(), Reference: types.NewNamedSetReference("decentralization")})
+	s.Require().NoError(err)
+	_, err = msgServer.DelegateToValidatorSet(c, types.NewMsgDelegateToValidatorSet(delegator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	s.Require().NoError(err)
+
+	// governance moves the named set to a validator the delegator never delegated to
+	err = keeper.SetNamedValidatorSet(s.Ctx, "decentralization", s.evenPreferences(valAddrs[2:]))
+	s.Require().NoError(err)
+
+	_, err = msgServer.UndelegateFromValidatorSet(c, types.NewMsgUndelegateFromValidatorSet(delegator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(200), s.delegatedTokens(delegator, valAddrs[0]))
+	s.Require().Equal(sdk.NewInt(200), s.delegatedTokens(delegator, valAddrs[1]))
+	valAddr, err := sdk.ValAddressFromBech32(valAddrs[2])
+	s.Require().NoError(err)
+	_, found := s.App.StakingKeeper.GetDelegation(s.Ctx, delegator, valAddr)
+	s.Require().False(found)
+
+	_, err = msgServer.WithdrawDelegationRewards(c, types.NewMsgWithdrawDelegationRewards(delegator))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestNamedValidatorSetProposals() {
+	s.SetupTest()
+	valAddrs := s.SetupMultipleValidators(2)
+	handler := valPref.NewValidatorSetPreferenceProposalHandler(*s.App.ValidatorSetPreferenceKeeper)
+
+	err := handler(s.Ctx, types.NewSetNamedValidatorSetProposal("title", "description", "decentralization", s.evenPreferences(valAddrs)))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSetNamedValidatorSet, 1)
+	_, found := s.App.ValidatorSetPreferenceKeeper.GetNamedValidatorSet(s.Ctx, "decentralization")
+	s.Require().True(found)
+
+	err = handler(s.Ctx, types.NewRemoveNamedValidatorSetProposal("title", "description", "decentralization"))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtRemoveNamedValidatorSet, 1)
+	_, found = s.App.ValidatorSetPreferenceKeeper.GetNamedValidatorSet(s.Ctx, "decentralization")
+	s.Require().False(found)
+
+	// removing a missing named set fails
+	err = handler(s.Ctx, types.NewRemoveNamedValidatorSetProposal("title", "description", "decentralization"))
+	s.Require().Error(err)
+}
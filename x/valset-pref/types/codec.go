@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -14,6 +15,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelegateToValidatorSet{}, "osmosis/MsgDelegateToValidatorSet", nil)
 	cdc.RegisterConcrete(&MsgUndelegateFromValidatorSet{}, "osmosis/MsgUndelegateFromValidatorSet", nil)
 	cdc.RegisterConcrete(&MsgWithdrawDelegationRewards{}, "osmosis/MsgWithdrawDelegationRewards", nil)
+	cdc.RegisterConcrete(&MsgSetAutoRebalance{}, "osmosis/valset-pref/MsgSetAutoRebalance", nil)
+	cdc.RegisterConcrete(&SetNamedValidatorSetProposal{}, "osmosis/SetNamedValidatorSetProposal", nil)
+	cdc.RegisterConcrete(&RemoveNamedValidatorSetProposal{}, "osmosis/RemoveNamedValidatorSetProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -23,6 +27,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgDelegateToValidatorSet{},
 		&MsgUndelegateFromValidatorSet{},
 		&MsgWithdrawDelegationRewards{},
+		&MsgSetAutoRebalance{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetNamedValidatorSetProposal{},
+		&RemoveNamedValidatorSetProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
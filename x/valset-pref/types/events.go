@@ -0,0 +1,12 @@
+package types
+
+const (
+	TypeEvtAutoRebalance           = "auto_rebalance"
+	TypeEvtSetNamedValidatorSet    = "set_named_validator_set"
+	TypeEvtRemoveNamedValidatorSet = "remove_named_validator_set"
+
+	AttributeDelegator      = "delegator"
+	AttributeDrift          = "drift"
+	AttributeDriftThreshold = "drift_threshold"
+	AttributeNamedSet       = "named_set"
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default valset-pref genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		AutoRebalances:     []AutoRebalanceRecord{},
+		AutoRebalanceQueue: []string{},
+	}
+}
+
+// Validate returns an error if a delegator address is invalid or listed twice, or if a drift threshold
+// is not between 0 and 1. A zero drift threshold is an opt-out, which is not stored.
+func (gs GenesisState) Validate() error {
+	seen := map[string]bool{}
+	for _, autoRebalance := range gs.AutoRebalances {
+		if _, err := sdk.AccAddressFromBech32(autoRebalance.Delegator); err != nil {
+			return err
+		}
+		if seen[autoRebalance.Delegator] {
+			return fmt.Errorf("duplicate auto-rebalance of delegator %s", autoRebalance.Delegator)
+		}
+		seen[autoRebalance.Delegator] = true
+
+		threshold := autoRebalance.Preference.DriftThreshold
+		if threshold.IsNil() || !threshold.IsPositive() || threshold.GT(sdk.OneDec()) {
+			return fmt.Errorf("invalid drift threshold of delegator %s, needs to be above 0 and at most 1, got %s", autoRebalance.Delegator, threshold)
+		}
+	}
+
+	queued := map[string]bool{}
+	for _, delegator := range gs.AutoRebalanceQueue {
+		if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+			return err
+		}
+		if queued[delegator] {
+			return fmt.Errorf("duplicate queued auto-rebalance of delegator %s", delegator)
+		}
+		queued[delegator] = true
+	}
+	return nil
+}
@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/valset-pref/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AutoRebalanceRecord is the opt-in of a delegator to auto-rebalancing.
+type AutoRebalanceRecord struct {
+	Delegator  string                  `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
+	Preference AutoRebalancePreference `protobuf:"bytes,2,opt,name=preference,proto3" json:"preference" yaml:"preference"`
+}
+
+func (m *AutoRebalanceRecord) Reset()         { *m = AutoRebalanceRecord{} }
+func (m *AutoRebalanceRecord) String() string { return proto.CompactTextString(m) }
+func (*AutoRebalanceRecord) ProtoMessage()    {}
+func (*AutoRebalanceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dbeef21026b3d99, []int{0}
+}
+func (m *AutoRebalanceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRebalanceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRebalanceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRebalanceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRebalanceRecord.Merge(m, src)
+}
+func (m *AutoRebalanceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRebalanceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRebalanceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRebalanceRecord proto.InternalMessageInfo
+
+func (m *AutoRebalanceRecord) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *AutoRebalanceRecord) GetPreference() AutoRebalancePreference {
+	if m != nil {
+		return m.Preference
+	}
+	return AutoRebalancePreference{}
+}
+
+// GenesisState defines the valset-pref module's genesis state.
+type GenesisState struct {
+	// auto_rebalances are the delegators that opted in to auto-rebalancing.
+	AutoRebalances []AutoRebalanceRecord `protobuf:"bytes,1,rep,name=auto_rebalances,json=autoRebalances,proto3" json:"auto_rebalances" yaml:"auto_rebalances"`
+	// auto_rebalance_queue are the delegators waiting to be rebalanced in the
+	// next blocks.
+	AutoRebalanceQueue []string `protobuf:"bytes,2,rep,name=auto_rebalance_queue,json=autoRebalanceQueue,proto3" json:"auto_rebalance_queue,omitempty" yaml:"auto_rebalance_queue"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dbeef21026b3d99, []int{1}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetAutoRebalances() []AutoRebalanceRecord {
+	if m != nil {
+		return m.AutoRebalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoRebalanceQueue() []string {
+	if m != nil {
+		return m.AutoRebalanceQueue
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AutoRebalanceRecord)(nil), "osmosis.valsetpref.v1beta1.AutoRebalanceRecord")
+	proto.RegisterType((*GenesisState)(nil), "osmosis.valsetpref.v1beta1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/valset-pref/v1beta1/genesis.proto", fileDescriptor_2dbeef21026b3d99)
+}
+
+var fileDescriptor_2dbeef21026b3d99 = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x3b, 0x97, 0xe4, 0x26, 0x0c, 0x37, 0x57, 0xad, 0xc4, 0x20, 0x26, 0x2d, 0x99, 0x8d,
+	0x75, 0x41, 0x27, 0x40, 0xa2, 0x89, 0x3b, 0xbb, 0x71, 0x2b, 0x75, 0xe7, 0x86, 0x4c, 0xcb, 0xb1,
+	0x92, 0x94, 0x0e, 0x76, 0xa6, 0x04, 0xde, 0xc2, 0xd7, 0xf1, 0x0d, 0x58, 0xb2, 0x34, 0x31, 0x69,
+	0x0c, 0xbc, 0x01, 0x4f, 0x60, 0xca, 0x54, 0xa0, 0x46, 0x8d, 0xbb, 0xa6, 0xf3, 0x9d, 0xff, 0xff,
+	0xcf, 0xf9, 0xf1, 0x19, 0x17, 0x43, 0x2e, 0x06, 0x82, 0x8e, 0x59, 0x28, 0x40, 0x36, 0x47, 0x31,
+	0xdc, 0xd3, 0x71, 0xcb, 0x03, 0xc9, 0x5a, 0x34, 0x80, 0x08, 0xc4, 0x40, 0xd8, 0xa3, 0x98, 0x4b,
+	0xae, 0xd7, 0x73, 0xd4, 0x56, 0x68, 0x46, 0xda, 0x39, 0x59, 0xaf, 0x06, 0x3c, 0xe0, 0x6b, 0x8c,
+	0x66, 0x5f, 0x6a, 0xa2, 0x7e, 0xfa, 0x93, 0xb8, 0x90, 0x4c, 0x82, 0x02, 0xc9, 0x33, 0xc2, 0x87,
+	0x57, 0x89, 0xe4, 0x2e, 0x78, 0x2c, 0x64, 0x91, 0x0f, 0x2e, 0xf8, 0x3c, 0xee, 0xeb, 0x6d, 0x5c,
+	0xee, 0x43, 0x08, 0x01, 0x93, 0x3c, 0xae, 0xa1, 0x06, 0xb2, 0xca, 0x4e, 0x75, 0x95, 0x9a, 0xfb,
+	0x53, 0x36, 0x0c, 0x2f, 0xc9, 0xe6, 0x89, 0xb8, 0x5b, 0x4c, 0x8f, 0x30, 0xce, 0x7c, 0x20, 0x86,
+	0xc8, 0x87, 0xda, 0x9f, 0x06, 0xb2, 0x2a, 0xed, 0x8e, 0xfd, 0x7d, 0x76, 0xbb, 0x60, 0x7c, 0xb3,
+	0x19, 0x75, 0x8e, 0x67, 0xa9, 0xa9, 0xad, 0x52, 0xf3, 0x40, 0xb9, 0x6d, 0x45, 0x89, 0xbb, 0xe3,
+	0x40, 0x5e, 0x11, 0xfe, 0x77, 0xad, 0x0e, 0x75, 0x9b, 0xad, 0xa4, 0x4f, 0xf0, 0x1e, 0x4b, 0x24,
+	0xef, 0xc5, 0x1f, 0x9a, 0xa2, 0x86, 0x1a, 0x25, 0xab, 0xd2, 0xa6, 0xbf, 0x4e, 0xa1, 0xd6, 0x77,
+	0x8c, 0x3c, 0xc1, 0x91, 0x4a, 0xf0, 0x49, 0x95, 0xb8, 0xff, 0xd9, 0xee, 0x90, 0xd0, 0xbb, 0xb8,
+	0x5a, 0x64, 0x7a, 0x8f, 0x09, 0x24, 0xd9, 0x11, 0x4a, 0x56, 0xd9, 0x31, 0x57, 0xa9, 0x79, 0xf2,
+	0x95, 0x92, 0xa2, 0x88, 0xab, 0x17, 0xe4, 0xba, 0xd9, 0x4f, 0xa7, 0x3b, 0x5b, 0x18, 0x68, 0xbe,
+	0x30, 0xd0, 0xdb, 0xc2, 0x40, 0x4f, 0x4b, 0x43, 0x9b, 0x2f, 0x0d, 0xed, 0x65, 0x69, 0x68, 0x77,
+	0x17, 0xc1, 0x40, 0x3e, 0x24, 0x9e, 0xed, 0xf3, 0x21, 0xcd, 0xf7, 0x6a, 0x86, 0xcc, 0x13, 0x74,
+	0x53, 0x7a, 0xeb, 0x9c, 0x4e, 0x0a, 0xd5, 0xcb, 0xe9, 0x08, 0x84, 0xf7, 0x77, 0xdd, 0x79, 0xe7,
+	0x7d, 0x00, 0xd3, 0x6a, 0x14, 0xb4, 0x7b, 0x02, 0x00, 0x00,
+}
+
+func (m *AutoRebalanceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRebalanceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRebalanceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Preference.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AutoRebalanceQueue) > 0 {
+		for iNdEx := len(m.AutoRebalanceQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoRebalanceQueue[iNdEx])
+			copy(dAtA[i:], m.AutoRebalanceQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoRebalanceQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AutoRebalances) > 0 {
+		for iNdEx := len(m.AutoRebalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRebalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AutoRebalanceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Preference.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AutoRebalances) > 0 {
+		for _, e := range m.AutoRebalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoRebalanceQueue) > 0 {
+		for _, s := range m.AutoRebalanceQueue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AutoRebalanceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRebalanceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRebalanceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Preference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRebalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRebalances = append(m.AutoRebalances, AutoRebalanceRecord{})
+			if err := m.AutoRebalances[len(m.AutoRebalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRebalanceQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRebalanceQueue = append(m.AutoRebalanceQueue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	appParams "github.com/osmosis-labs/osmosis/v16/app/params"
+	"github.com/osmosis-labs/osmosis/v16/x/valset-pref/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	addr2 := sdk.AccAddress([]byte("addr2_______________")).String()
+
+	autoRebalance := func(delegator string, threshold sdk.Dec) types.AutoRebalanceRecord {
+		return types.AutoRebalanceRecord{Delegator: delegator, Preference: types.AutoRebalancePreference{DriftThreshold: threshold}}
+	}
+
+	tests := []struct {
+		name       string
+		genesis    types.GenesisState
+		expectPass bool
+	}{
+		{
+			name:       "default genesis",
+			genesis:    *types.DefaultGenesis(),
+			expectPass: true,
+		},
+		{
+			name: "auto-rebalances and queue",
+			genesis: types.GenesisState{
+				AutoRebalances:     []types.AutoRebalanceRecord{autoRebalance(addr1, sdk.NewDecWithPrec(1, 1)), autoRebalance(addr2, sdk.OneDec())},
+				AutoRebalanceQueue: []string{addr1},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid delegator",
+			genesis: types.GenesisState{
+				AutoRebalances: []types.AutoRebalanceRecord{autoRebalance(invalidAddr, sdk.NewDecWithPrec(1, 1))},
+			},
+		},
+		{
+			name: "duplicate delegator",
+			genesis: types.GenesisState{
+				AutoRebalances: []types.AutoRebalanceRecord{autoRebalance(addr1, sdk.NewDecWithPrec(1, 1)), autoRebalance(addr1, sdk.NewDecWithPrec(2, 1))},
+			},
+		},
+		{
+			name: "zero drift threshold",
+			genesis: types.GenesisState{
+				AutoRebalances: []types.AutoRebalanceRecord{autoRebalance(addr1, sdk.ZeroDec())},
+			},
+		},
+		{
+			name: "drift threshold above 1",
+			genesis: types.GenesisState{
+				AutoRebalances: []types.AutoRebalanceRecord{autoRebalance(addr1, sdk.NewDecWithPrec(11, 1))},
+			},
+		},
+		{
+			name: "invalid queued delegator",
+			genesis: types.GenesisState{
+				AutoRebalanceQueue: []string{invalidAddr},
+			},
+		},
+		{
+			name: "duplicate queued delegator",
+			genesis: types.GenesisState{
+				AutoRebalanceQueue: []string{addr1, addr1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.genesis.Validate()
+			if test.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetNamedValidatorSet    = "SetNamedValidatorSet"
+	ProposalTypeRemoveNamedValidatorSet = "RemoveNamedValidatorSet"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetNamedValidatorSet)
+	govtypes.RegisterProposalTypeCodec(&SetNamedValidatorSetProposal{}, "osmosis/SetNamedValidatorSetProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveNamedValidatorSet)
+	govtypes.RegisterProposalTypeCodec(&RemoveNamedValidatorSetProposal{}, "osmosis/RemoveNamedValidatorSetProposal")
+}
+
+var (
+	_ govtypes.Content = &SetNamedValidatorSetProposal{}
+	_ govtypes.Content = &RemoveNamedValidatorSetProposal{}
+)
+
+// NewSetNamedValidatorSetProposal returns a new instance of a set named validator set proposal struct.
+func NewSetNamedValidatorSetProposal(title, description, name string, preferences []ValidatorPreference) govtypes.Content {
+	return &SetNamedValidatorSetProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+		Preferences: preferences,
+	}
+}
+
+func (p *SetNamedValidatorSetProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetNamedValidatorSetProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetNamedValidatorSetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetNamedValidatorSetProposal) ProposalType() string {
+	return ProposalTypeSetNamedValidatorSet
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetNamedValidatorSetProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateNamedSetName(p.Name); err != nil {
+		return err
+	}
+	return ValidatePreferences(p.Preferences)
+}
+
+// String returns a string containing the set named validator set proposal.
+func (p SetNamedValidatorSetProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Named Validator Set Proposal:
+Title:       %s
+Description: %s
+Name:        %s
+Preferences:
+`, p.Title, p.Description, p.Name))
+	for _, val := range p.Preferences {
+		b.WriteString(fmt.Sprintf("\t%s: %s\n", val.ValOperAddress, val.Weight))
+	}
+	return b.String()
+}
+
+// NewRemoveNamedValidatorSetProposal returns a new instance of a remove named validator set proposal struct.
+func NewRemoveNamedValidatorSetProposal(title, description, name string) govtypes.Content {
+	return &RemoveNamedValidatorSetProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+	}
+}
+
+func (p *RemoveNamedValidatorSetProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *RemoveNamedValidatorSetProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *RemoveNamedValidatorSetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *RemoveNamedValidatorSetProposal) ProposalType() string {
+	return ProposalTypeRemoveNamedValidatorSet
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *RemoveNamedValidatorSetProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return ValidateNamedSetName(p.Name)
+}
+
+// String returns a string containing the remove named validator set proposal.
+func (p RemoveNamedValidatorSetProposal) String() string {
+	return fmt.Sprintf(`Remove Named Validator Set Proposal:
+Title:       %s
+Description: %s
+Name:        %s
+`, p.Title, p.Description, p.Name)
+}
@@ -13,6 +13,40 @@ var (
 	// KeyPrefixValidatorSet defines prefix key for validator set.
 	KeyPrefixValidatorSet = []byte{0x01}
 
+	// KeyPrefixAutoRebalance defines prefix key for auto-rebalance preferences.
+	KeyPrefixAutoRebalance = []byte{0x02}
+
+	// KeyPrefixAutoRebalanceQueue defines prefix key for the delegators waiting to be rebalanced.
+	KeyPrefixAutoRebalanceQueue = []byte{0x03}
+
+	// KeyPrefixNamedValidatorSet defines prefix key for named validator sets.
+	KeyPrefixNamedValidatorSet = []byte{0x04}
+
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
+
+const (
+	// AutoRebalanceEpochIdentifier is the epoch at the end of which the delegators that opted in
+	// to auto-rebalancing are queued to be rebalanced.
+	AutoRebalanceEpochIdentifier = "day"
+
+	// MaxAutoRebalancesPerBlock is the number of queued delegators rebalanced per block, spreading
+	// the work of an epoch across blocks.
+	MaxAutoRebalancesPerBlock = 20
+)
+
+// GetAutoRebalanceKey returns the key of the auto-rebalance preference of a delegator.
+func GetAutoRebalanceKey(delegator string) []byte {
+	return append(KeyPrefixAutoRebalance, []byte(delegator)...)
+}
+
+// GetAutoRebalanceQueueKey returns the key of a delegator waiting to be rebalanced.
+func GetAutoRebalanceQueueKey(delegator string) []byte {
+	return append(KeyPrefixAutoRebalanceQueue, []byte(delegator)...)
+}
+
+// GetNamedValidatorSetKey returns the key of a named validator set.
+func GetNamedValidatorSetKey(name string) []byte {
+	return append(KeyPrefixNamedValidatorSet, []byte(name)...)
+}
@@ -34,38 +34,14 @@ func (m MsgSetValidatorSetPreference) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
 	}
 
-	totalWeight := sdk.ZeroDec()
-	validatorAddrs := []string{}
-	for _, validator := range m.Preferences {
-		_, err := sdk.ValAddressFromBech32(validator.ValOperAddress)
-		if err != nil {
-			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid validator address (%s)", err)
-		}
-
-		// all the weights should be positive
-		if !(validator.Weight.IsPositive()) {
-			return fmt.Errorf("Invalid weight, validator weight needs to be positive, got %d", validator.Weight)
+	if m.Reference != nil {
+		if len(m.Preferences) != 0 {
+			return fmt.Errorf("Either preferences or a reference can be set, not both")
 		}
-
-		totalWeight = totalWeight.Add(validator.Weight)
-		validatorAddrs = append(validatorAddrs, validator.ValOperAddress)
+		return m.Reference.Validate(m.Delegator)
 	}
 
-	// check that all the validator address are unique
-	containsDuplicate := osmoutils.ContainsDuplicate(validatorAddrs)
-	if containsDuplicate {
-		return fmt.Errorf("The validator operator address are duplicated")
-	}
-
-	// Round to 2 digit after the decimal. For ex: 0.999 = 1.0, 0.874 = 0.87, 0.5123 = 0.51
-	roundedValue := osmomath.SigFigRound(totalWeight, sdk.NewDec(10).Power(2).TruncateInt())
-
-	// check if the total validator distribution weights equal 1
-	if !roundedValue.Equal(sdk.OneDec()) {
-		return fmt.Errorf("The weights allocated to the validators do not add up to 1, Got: %f", roundedValue)
-	}
-
-	return nil
+	return ValidatePreferences(m.Preferences)
 }
 
 func (m MsgSetValidatorSetPreference) GetSignBytes() []byte {
@@ -179,6 +155,13 @@ func (m MsgRedelegateValidatorSet) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
 	}
 
+	if m.Reference != nil {
+		if len(m.Preferences) != 0 {
+			return fmt.Errorf("Either preferences or a reference can be set, not both")
+		}
+		return m.Reference.Validate(m.Delegator)
+	}
+
 	totalWeight := sdk.NewDec(0)
 	validatorAddrs := []string{}
 	for _, validator := range m.Preferences {
@@ -288,3 +271,42 @@ func (m MsgDelegateBondedTokens) GetSigners() []sdk.AccAddress {
 	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
 	return []sdk.AccAddress{delegator}
 }
+
+// constants
+const (
+	TypeMsgSetAutoRebalance = "set_auto_rebalance"
+)
+
+var _ sdk.Msg = &MsgSetAutoRebalance{}
+
+// NewMsgSetAutoRebalance creates a msg to opt in or out of auto-rebalancing.
+func NewMsgSetAutoRebalance(delegator sdk.AccAddress, driftThreshold sdk.Dec) *MsgSetAutoRebalance {
+	return &MsgSetAutoRebalance{
+		Delegator:      delegator.String(),
+		DriftThreshold: driftThreshold,
+	}
+}
+
+func (m MsgSetAutoRebalance) Route() string { return RouterKey }
+func (m MsgSetAutoRebalance) Type() string  { return TypeMsgSetAutoRebalance }
+func (m MsgSetAutoRebalance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
+	}
+
+	if m.DriftThreshold.IsNil() || m.DriftThreshold.IsNegative() || m.DriftThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("Invalid drift threshold, needs to be between 0 and 1, got %s", m.DriftThreshold)
+	}
+
+	return nil
+}
+
+func (m MsgSetAutoRebalance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetAutoRebalance) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
@@ -14,6 +14,7 @@ import (
 func TestMsgSetValidatorSetPreference(t *testing.T) {
 	appParams.SetAddressPrefixes()
 	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	addr2 := sdk.AccAddress([]byte("addr2_______________")).String()
 
 	tests := []struct {
 		name       string
@@ -138,6 +139,50 @@ func TestMsgSetValidatorSetPreference(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name:       "reference to a named set",
+			msg:        types.MsgSetValidatorSetPreference{Delegator: addr1, Reference: types.NewNamedSetReference("decentralization")},
+			expectPass: true,
+		},
+		{
+			name:       "reference to a delegator",
+			msg:        types.MsgSetValidatorSetPreference{Delegator: addr1, Reference: types.NewDelegatorReference(addr2)},
+			expectPass: true,
+		},
+		{
+			name:       "reference to itself",
+			msg:        types.MsgSetValidatorSetPreference{Delegator: addr1, Reference: types.NewDelegatorReference(addr1)},
+			expectPass: false,
+		},
+		{
+			name:       "reference to an invalid delegator",
+			msg:        types.MsgSetValidatorSetPreference{Delegator: addr1, Reference: types.NewDelegatorReference(invalidAddr)},
+			expectPass: false,
+		},
+		{
+			name:       "empty reference",
+			msg:        types.MsgSetValidatorSetPreference{Delegator: addr1, Reference: &types.ValidatorSetReference{}},
+			expectPass: false,
+		},
+		{
+			name:       "reference to both a delegator and a named set",
+			msg:        types.MsgSetValidatorSetPreference{Delegator: addr1, Reference: &types.ValidatorSetReference{Delegator: addr2, NamedSet: "decentralization"}},
+			expectPass: false,
+		},
+		{
+			name: "reference and preferences",
+			msg: types.MsgSetValidatorSetPreference{
+				Delegator: addr1,
+				Preferences: []types.ValidatorPreference{
+					{
+						ValOperAddress: "osmovaloper1x2cfenmflhj3dwm2ph6nkgqr3nppkg86fxaymg",
+						Weight:         sdk.OneDec(),
+					},
+				},
+				Reference: types.NewNamedSetReference("decentralization"),
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -154,3 +199,59 @@ func TestMsgSetValidatorSetPreference(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetAutoRebalance(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetAutoRebalance
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        types.MsgSetAutoRebalance{Delegator: addr1, DriftThreshold: sdk.NewDecWithPrec(5, 2)},
+			expectPass: true,
+		},
+		{
+			name:       "opt out",
+			msg:        types.MsgSetAutoRebalance{Delegator: addr1, DriftThreshold: sdk.ZeroDec()},
+			expectPass: true,
+		},
+		{
+			name:       "invalid delegator",
+			msg:        types.MsgSetAutoRebalance{Delegator: invalidAddr, DriftThreshold: sdk.NewDecWithPrec(5, 2)},
+			expectPass: false,
+		},
+		{
+			name:       "negative threshold",
+			msg:        types.MsgSetAutoRebalance{Delegator: addr1, DriftThreshold: sdk.NewDecWithPrec(-5, 2)},
+			expectPass: false,
+		},
+		{
+			name:       "threshold above 1",
+			msg:        types.MsgSetAutoRebalance{Delegator: addr1, DriftThreshold: sdk.NewDecWithPrec(11, 1)},
+			expectPass: false,
+		},
+		{
+			name:       "nil threshold",
+			msg:        types.MsgSetAutoRebalance{Delegator: addr1},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Type(), "set_auto_rebalance")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
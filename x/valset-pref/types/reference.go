@@ -0,0 +1,89 @@
+package types
+
+import (
+	fmt "fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+// MaxReferenceDepth is the number of references followed to resolve a validator set preference.
+const MaxReferenceDepth = 10
+
+// NewDelegatorReference returns a reference to the validator set preference of a delegator.
+func NewDelegatorReference(delegator string) *ValidatorSetReference {
+	return &ValidatorSetReference{Delegator: delegator}
+}
+
+// NewNamedSetReference returns a reference to a named validator set.
+func NewNamedSetReference(name string) *ValidatorSetReference {
+	return &ValidatorSetReference{NamedSet: name}
+}
+
+// Validate checks that exactly one of the delegator and named set is set, and that the delegator
+// does not reference themselves.
+func (r ValidatorSetReference) Validate(delegator string) error {
+	if (r.Delegator == "") == (r.NamedSet == "") {
+		return fmt.Errorf("The reference needs exactly one of a delegator or a named set")
+	}
+	if r.NamedSet != "" {
+		return ValidateNamedSetName(r.NamedSet)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Delegator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid referenced delegator address (%s)", err)
+	}
+	if r.Delegator == delegator {
+		return fmt.Errorf("The delegator cannot reference their own validator set")
+	}
+	return nil
+}
+
+// ValidateNamedSetName checks that a named validator set name is not empty and at most 64 characters.
+func ValidateNamedSetName(name string) error {
+	if name == "" {
+		return fmt.Errorf("The named set name cannot be empty")
+	}
+	if len(name) > 64 {
+		return fmt.Errorf("The named set name cannot be longer than 64 characters, got %d", len(name))
+	}
+	return nil
+}
+
+// ValidatePreferences checks that the validators are unique and have positive weights adding up to 1.
+func ValidatePreferences(preferences []ValidatorPreference) error {
+	totalWeight := sdk.ZeroDec()
+	validatorAddrs := []string{}
+	for _, validator := range preferences {
+		_, err := sdk.ValAddressFromBech32(validator.ValOperAddress)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid validator address (%s)", err)
+		}
+
+		// all the weights should be positive
+		if !(validator.Weight.IsPositive()) {
+			return fmt.Errorf("Invalid weight, validator weight needs to be positive, got %d", validator.Weight)
+		}
+
+		totalWeight = totalWeight.Add(validator.Weight)
+		validatorAddrs = append(validatorAddrs, validator.ValOperAddress)
+	}
+
+	// check that all the validator address are unique
+	if osmoutils.ContainsDuplicate(validatorAddrs) {
+		return fmt.Errorf("The validator operator address are duplicated")
+	}
+
+	// Round to 2 digit after the decimal. For ex: 0.999 = 1.0, 0.874 = 0.87, 0.5123 = 0.51
+	roundedValue := osmomath.SigFigRound(totalWeight, sdk.NewDec(10).Power(2).TruncateInt())
+
+	// check if the total validator distribution weights equal 1
+	if !roundedValue.Equal(sdk.OneDec()) {
+		return fmt.Errorf("The weights allocated to the validators do not add up to 1, Got: %f", roundedValue)
+	}
+
+	return nil
+}
@@ -78,6 +78,10 @@ var xxx_messageInfo_ValidatorPreference proto.InternalMessageInfo
 type ValidatorSetPreferences struct {
 	// preference holds {valAddr, weight} for the user who created it.
 	Preferences []ValidatorPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences" yaml:"preferences"`
+	// reference, when set, makes the preferences those of another delegator or
+	// of a named validator set, resolved each time they are used. preferences
+	// is then empty.
+	Reference *ValidatorSetReference `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty" yaml:"reference"`
 }
 
 func (m *ValidatorSetPreferences) Reset()         { *m = ValidatorSetPreferences{} }
@@ -113,9 +117,139 @@ func (m *ValidatorSetPreferences) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSetPreferences proto.InternalMessageInfo
 
+// ValidatorSetReference points to the validator set preference of another
+// delegator, or to a named validator set maintained by governance. Exactly one
+// of them is set.
+type ValidatorSetReference struct {
+	// delegator whose validator set preference is followed, which can itself be
+	// a reference.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
+	// named_set is the name of the named validator set that is followed.
+	NamedSet string `protobuf:"bytes,2,opt,name=named_set,json=namedSet,proto3" json:"named_set,omitempty" yaml:"named_set"`
+}
+
+func (m *ValidatorSetReference) Reset()         { *m = ValidatorSetReference{} }
+func (m *ValidatorSetReference) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetReference) ProtoMessage()    {}
+func (*ValidatorSetReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3010474a5b89fce, []int{2}
+}
+func (m *ValidatorSetReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetReference.Merge(m, src)
+}
+func (m *ValidatorSetReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetReference proto.InternalMessageInfo
+
+// NamedValidatorSet is a validator set maintained by governance, that
+// delegators can reference in their validator set preference.
+type NamedValidatorSet struct {
+	Name        string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	Preferences []ValidatorPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences" yaml:"preferences"`
+}
+
+func (m *NamedValidatorSet) Reset()         { *m = NamedValidatorSet{} }
+func (m *NamedValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*NamedValidatorSet) ProtoMessage()    {}
+func (*NamedValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3010474a5b89fce, []int{3}
+}
+func (m *NamedValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamedValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamedValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamedValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedValidatorSet.Merge(m, src)
+}
+func (m *NamedValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamedValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedValidatorSet proto.InternalMessageInfo
+
+// AutoRebalancePreference defines a delegator's opt-in to have their
+// delegations automatically redelegated to their validator set preference.
+type AutoRebalancePreference struct {
+	// drift_threshold is the drift of the delegations from the preference above
+	// which they are rebalanced at the end of an epoch. The drift is half the sum
+	// of the absolute differences between the actual and preferred weights of
+	// each validator, between 0 and 1.
+	DriftThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=drift_threshold,json=driftThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"drift_threshold" yaml:"drift_threshold"`
+}
+
+func (m *AutoRebalancePreference) Reset()         { *m = AutoRebalancePreference{} }
+func (m *AutoRebalancePreference) String() string { return proto.CompactTextString(m) }
+func (*AutoRebalancePreference) ProtoMessage()    {}
+func (*AutoRebalancePreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3010474a5b89fce, []int{4}
+}
+func (m *AutoRebalancePreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRebalancePreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRebalancePreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRebalancePreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRebalancePreference.Merge(m, src)
+}
+func (m *AutoRebalancePreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRebalancePreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRebalancePreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRebalancePreference proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ValidatorPreference)(nil), "osmosis.valsetpref.v1beta1.ValidatorPreference")
 	proto.RegisterType((*ValidatorSetPreferences)(nil), "osmosis.valsetpref.v1beta1.ValidatorSetPreferences")
+	proto.RegisterType((*ValidatorSetReference)(nil), "osmosis.valsetpref.v1beta1.ValidatorSetReference")
+	proto.RegisterType((*NamedValidatorSet)(nil), "osmosis.valsetpref.v1beta1.NamedValidatorSet")
+	proto.RegisterType((*AutoRebalancePreference)(nil), "osmosis.valsetpref.v1beta1.AutoRebalancePreference")
 }
 
 func init() {
@@ -123,30 +257,40 @@ func init() {
 }
 
 var fileDescriptor_d3010474a5b89fce = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x18, 0xc5, 0x93, 0x5e, 0x28, 0xdc, 0x14, 0x44, 0xa2, 0xd0, 0x12, 0x25, 0x29, 0x59, 0x68, 0x37,
-	0x9d, 0xa1, 0x0a, 0x0a, 0xee, 0x2c, 0xea, 0x56, 0xa9, 0xe8, 0xc2, 0x4d, 0x99, 0x24, 0x5f, 0xd3,
-	0xe0, 0x24, 0x33, 0xcc, 0x8c, 0xd1, 0xbe, 0x81, 0x4b, 0x1f, 0xc2, 0x87, 0xe9, 0xb2, 0x4b, 0x71,
-	0x11, 0xb4, 0x7d, 0x83, 0x3e, 0x81, 0x34, 0x09, 0x6d, 0x15, 0x5d, 0xcd, 0xbf, 0xdf, 0xf9, 0x38,
-	0x67, 0x8e, 0xb1, 0xcf, 0x64, 0xcc, 0x64, 0x24, 0x71, 0x4a, 0xa8, 0x04, 0xd5, 0xe6, 0x02, 0x06,
-	0x38, 0xed, 0x78, 0xa0, 0x48, 0x07, 0x4b, 0x45, 0x14, 0x20, 0x2e, 0x98, 0x62, 0xa6, 0x55, 0x82,
-	0xa8, 0x00, 0x17, 0x1c, 0x2a, 0x39, 0x6b, 0x3b, 0x64, 0x21, 0xcb, 0x31, 0xbc, 0xd8, 0x15, 0x0a,
-	0x6b, 0x37, 0x64, 0x2c, 0xa4, 0x80, 0x09, 0x8f, 0x30, 0x49, 0x12, 0xa6, 0x88, 0x8a, 0x58, 0x22,
-	0x8b, 0x57, 0xf7, 0x55, 0x37, 0xb6, 0x6e, 0x09, 0x8d, 0x02, 0xa2, 0x98, 0xb8, 0x12, 0x30, 0x00,
-	0x01, 0x89, 0x0f, 0xe6, 0xb9, 0xb1, 0x99, 0x12, 0xda, 0x67, 0x1c, 0x44, 0x9f, 0x04, 0x81, 0x00,
-	0x29, 0x1b, 0x7a, 0x53, 0x6f, 0xfd, 0xef, 0xee, 0xcc, 0x33, 0xa7, 0x3e, 0x22, 0x31, 0x3d, 0x71,
-	0x7f, 0x12, 0x6e, 0x6f, 0x23, 0x25, 0xf4, 0x92, 0x83, 0x38, 0x2d, 0x2e, 0xcc, 0x0b, 0xa3, 0xfa,
-	0x08, 0x51, 0x38, 0x54, 0x8d, 0x4a, 0x2e, 0x46, 0xe3, 0xcc, 0xd1, 0xde, 0x33, 0x67, 0x2f, 0x8c,
-	0xd4, 0xf0, 0xc1, 0x43, 0x3e, 0x8b, 0xb1, 0x9f, 0x47, 0x2a, 0x97, 0xb6, 0x0c, 0xee, 0xb1, 0x1a,
-	0x71, 0x90, 0xe8, 0x0c, 0xfc, 0x5e, 0xa9, 0x76, 0x9f, 0x75, 0xa3, 0xbe, 0xb4, 0x79, 0x0d, 0x6a,
-	0xe5, 0x54, 0x9a, 0xb1, 0x51, 0xe3, 0xab, 0x63, 0xa3, 0xd2, 0xfc, 0xd7, 0xaa, 0x1d, 0x60, 0xf4,
-	0xf7, 0x47, 0xa1, 0x5f, 0x02, 0x77, 0xad, 0x85, 0xb3, 0x79, 0xe6, 0x98, 0x45, 0xb4, 0xb5, 0x89,
-	0x6e, 0x6f, 0x7d, 0x7e, 0xf7, 0x66, 0xfc, 0x69, 0x6b, 0xe3, 0xa9, 0xad, 0x4f, 0xa6, 0xb6, 0xfe,
-	0x31, 0xb5, 0xf5, 0x97, 0x99, 0xad, 0x4d, 0x66, 0xb6, 0xf6, 0x36, 0xb3, 0xb5, 0xbb, 0xe3, 0xb5,
-	0x60, 0xa5, 0x83, 0x36, 0x25, 0x9e, 0xc4, 0xcb, 0x82, 0x3b, 0x47, 0xf8, 0xe9, 0x5b, 0xcd, 0x79,
-	0x5a, 0xaf, 0x9a, 0xf7, 0x71, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xe9, 0x48, 0x06, 0x0a,
-	0x02, 0x00, 0x00,
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xb6, 0xa8, 0x22, 0x1b, 0xa9, 0x2d, 0xa6, 0xd0, 0x28, 0x20, 0x3b, 0x5a, 0x24, 0xc8,
+	0x25, 0x5e, 0x25, 0x48, 0x20, 0x71, 0x6b, 0x04, 0x88, 0x13, 0xa0, 0x0d, 0x70, 0xe0, 0x12, 0x6d,
+	0xe2, 0x89, 0x63, 0xb1, 0xf1, 0x9a, 0xdd, 0x6d, 0xa0, 0x17, 0x9e, 0x80, 0x03, 0x0f, 0x81, 0xc4,
+	0xab, 0xe4, 0xd8, 0x23, 0xe2, 0x60, 0x41, 0xf2, 0x00, 0x48, 0x79, 0x02, 0xe4, 0x9f, 0xda, 0x2e,
+	0x2a, 0x12, 0x5c, 0x38, 0x79, 0x67, 0xe6, 0x9b, 0x6f, 0xbe, 0x99, 0x59, 0x2f, 0xbe, 0x23, 0xf5,
+	0x5c, 0xea, 0x40, 0xd3, 0x05, 0x17, 0x1a, 0x4c, 0x37, 0x52, 0x30, 0xa5, 0x8b, 0xde, 0x18, 0x0c,
+	0xef, 0x51, 0x6d, 0xb8, 0x01, 0x37, 0x52, 0xd2, 0x48, 0xab, 0x95, 0x03, 0xdd, 0x0c, 0x98, 0xe0,
+	0xdc, 0x1c, 0xd7, 0x3a, 0xf0, 0xa5, 0x2f, 0x53, 0x18, 0x4d, 0x4e, 0x59, 0x46, 0xeb, 0xa6, 0x2f,
+	0xa5, 0x2f, 0x80, 0xf2, 0x28, 0xa0, 0x3c, 0x0c, 0xa5, 0xe1, 0x26, 0x90, 0xa1, 0xce, 0xa2, 0xe4,
+	0x33, 0xc2, 0x57, 0x5f, 0x71, 0x11, 0x78, 0xdc, 0x48, 0xf5, 0x5c, 0xc1, 0x14, 0x14, 0x84, 0x13,
+	0xb0, 0x1e, 0xe1, 0xfd, 0x05, 0x17, 0x23, 0x19, 0x81, 0x1a, 0x71, 0xcf, 0x53, 0xa0, 0x75, 0x13,
+	0xb5, 0x51, 0xa7, 0x3e, 0xb8, 0xb1, 0x89, 0x9d, 0xc3, 0x13, 0x3e, 0x17, 0x0f, 0xc8, 0xef, 0x08,
+	0xc2, 0x76, 0x17, 0x5c, 0x3c, 0x8b, 0x40, 0x1d, 0x65, 0x0e, 0xeb, 0x31, 0xde, 0x79, 0x07, 0x81,
+	0x3f, 0x33, 0xcd, 0xad, 0x34, 0xd9, 0x5d, 0xc6, 0x4e, 0xed, 0x5b, 0xec, 0xdc, 0xf6, 0x03, 0x33,
+	0x3b, 0x1e, 0xbb, 0x13, 0x39, 0xa7, 0x93, 0xb4, 0xa5, 0xfc, 0xd3, 0xd5, 0xde, 0x1b, 0x6a, 0x4e,
+	0x22, 0xd0, 0xee, 0x43, 0x98, 0xb0, 0x3c, 0x9b, 0xfc, 0x44, 0xf8, 0xb0, 0x90, 0x39, 0x04, 0x53,
+	0x2a, 0xd5, 0xd6, 0x1c, 0x37, 0xa2, 0xd2, 0x6c, 0x6e, 0xb5, 0xb7, 0x3b, 0x8d, 0x3e, 0x75, 0xff,
+	0x3c, 0x28, 0xf7, 0x82, 0x86, 0x07, 0xad, 0x44, 0xd9, 0x26, 0x76, 0xac, 0xac, 0xb5, 0x0a, 0x23,
+	0x61, 0x55, 0x7e, 0x0b, 0x70, 0xbd, 0xb0, 0x9a, 0xdb, 0x6d, 0xd4, 0x69, 0xf4, 0x7b, 0x7f, 0x55,
+	0x6c, 0x08, 0x86, 0x15, 0xe5, 0x0e, 0x36, 0xb1, 0xb3, 0x9f, 0x95, 0x2a, 0xd8, 0x08, 0x2b, 0x99,
+	0xc9, 0x07, 0x7c, 0xed, 0xc2, 0x4c, 0xab, 0x8f, 0xeb, 0x1e, 0x08, 0xf0, 0x93, 0x40, 0xbe, 0x92,
+	0x0a, 0x59, 0x11, 0x22, 0xac, 0x84, 0x59, 0x3d, 0x5c, 0x0f, 0xf9, 0x1c, 0xbc, 0x91, 0x86, 0xb3,
+	0x4d, 0x54, 0x72, 0x8a, 0x10, 0x61, 0x97, 0xd3, 0xf3, 0x10, 0x0c, 0xf9, 0x82, 0xf0, 0x95, 0xa7,
+	0x89, 0x51, 0x55, 0x61, 0xdd, 0xc2, 0x97, 0x12, 0x44, 0x5e, 0x77, 0x6f, 0x13, 0x3b, 0x8d, 0x92,
+	0x83, 0xb0, 0x34, 0xf8, 0x9f, 0x17, 0x42, 0x3e, 0x22, 0x7c, 0x78, 0x74, 0x6c, 0x24, 0x83, 0x31,
+	0x17, 0x3c, 0x9c, 0x40, 0xe5, 0x1a, 0xbf, 0xc5, 0x7b, 0x9e, 0x0a, 0xa6, 0x66, 0x64, 0x66, 0x0a,
+	0xf4, 0x4c, 0x0a, 0x2f, 0x97, 0xfe, 0xe4, 0xdf, 0x2e, 0xe2, 0x26, 0x76, 0xae, 0xe7, 0x03, 0x3e,
+	0x4f, 0x47, 0xd8, 0x6e, 0xea, 0x79, 0x71, 0xe6, 0x18, 0xbc, 0x5c, 0xfe, 0xb0, 0x6b, 0xcb, 0x95,
+	0x8d, 0x4e, 0x57, 0x36, 0xfa, 0xbe, 0xb2, 0xd1, 0xa7, 0xb5, 0x5d, 0x3b, 0x5d, 0xdb, 0xb5, 0xaf,
+	0x6b, 0xbb, 0xf6, 0xfa, 0x7e, 0xa5, 0x5e, 0x3e, 0x90, 0xae, 0xe0, 0x63, 0x4d, 0x8b, 0x07, 0xa0,
+	0x77, 0x8f, 0xbe, 0x3f, 0xf7, 0x0c, 0xa4, 0x22, 0xc6, 0x3b, 0xe9, 0xff, 0x7a, 0xf7, 0xd7, 0x00,
+	0xbd, 0xda, 0xdf, 0xfd, 0x2a, 0x04, 0x00, 0x00,
 }
 
 func (m *ValidatorPreference) Marshal() (dAtA []byte, err error) {
@@ -205,6 +349,92 @@ func (m *ValidatorSetPreferences) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *ValidatorSetPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reference != nil {
+		{
+			size, err := m.Reference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Preferences) > 0 {
+		for iNdEx := len(m.Preferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NamedSet) > 0 {
+		i -= len(m.NamedSet)
+		copy(dAtA[i:], m.NamedSet)
+		i = encodeVarintState(dAtA, i, uint64(len(m.NamedSet)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamedValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -223,6 +453,46 @@ func (m *ValidatorSetPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRebalancePreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRebalancePreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRebalancePreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DriftThreshold.Size()
+		i -= size
+		if _, err := m.DriftThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -264,6 +534,57 @@ func (m *ValidatorSetPreferences) Size() (n int) {
 			n += 1 + l + sovState(uint64(l))
 		}
 	}
+	if m.Reference != nil {
+		l = m.Reference.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSetReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.NamedSet)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *NamedValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Preferences) > 0 {
+		for _, e := range m.Preferences {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AutoRebalancePreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DriftThreshold.Size()
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -452,6 +773,356 @@ func (m *ValidatorSetPreferences) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reference == nil {
+				m.Reference = &ValidatorSetReference{}
+			}
+			if err := m.Reference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamedSet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamedSet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamedValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamedValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamedValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preferences = append(m.Preferences, ValidatorPreference{})
+			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRebalancePreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRebalancePreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRebalancePreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DriftThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DriftThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
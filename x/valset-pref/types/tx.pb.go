@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -37,6 +38,9 @@ type MsgSetValidatorSetPreference struct {
 	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
 	// list of {valAddr, weight} to delegate to
 	Preferences []ValidatorPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences" yaml:"preferences"`
+	// reference to another delegator's validator set preference or to a named
+	// validator set, instead of preferences.
+	Reference *ValidatorSetReference `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty" yaml:"reference"`
 }
 
 func (m *MsgSetValidatorSetPreference) Reset()         { *m = MsgSetValidatorSetPreference{} }
@@ -86,6 +90,13 @@ func (m *MsgSetValidatorSetPreference) GetPreferences() []ValidatorPreference {
 	return nil
 }
 
+func (m *MsgSetValidatorSetPreference) GetReference() *ValidatorSetReference {
+	if m != nil {
+		return m.Reference
+	}
+	return nil
+}
+
 type MsgSetValidatorSetPreferenceResponse struct {
 }
 
@@ -316,6 +327,9 @@ type MsgRedelegateValidatorSet struct {
 	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
 	// list of {valAddr, weight} to delegate to
 	Preferences []ValidatorPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences" yaml:"preferences"`
+	// reference to another delegator's validator set preference or to a named
+	// validator set, instead of preferences.
+	Reference *ValidatorSetReference `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty" yaml:"reference"`
 }
 
 func (m *MsgRedelegateValidatorSet) Reset()         { *m = MsgRedelegateValidatorSet{} }
@@ -365,6 +379,13 @@ func (m *MsgRedelegateValidatorSet) GetPreferences() []ValidatorPreference {
 	return nil
 }
 
+func (m *MsgRedelegateValidatorSet) GetReference() *ValidatorSetReference {
+	if m != nil {
+		return m.Reference
+	}
+	return nil
+}
+
 type MsgRedelegateValidatorSetResponse struct {
 }
 
@@ -577,6 +598,93 @@ func (m *MsgDelegateBondedTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateBondedTokensResponse proto.InternalMessageInfo
 
+// MsgSetAutoRebalance opts the delegator in to having their delegations
+// redelegated to their validator set preference at the end of an epoch, when
+// they drift from it by more than drift_threshold. A zero drift_threshold opts
+// the delegator out.
+type MsgSetAutoRebalance struct {
+	// delegator is the user who is opting in or out of auto-rebalancing.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
+	// drift_threshold is a decimal between 0 and 1.
+	DriftThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=drift_threshold,json=driftThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"drift_threshold" yaml:"drift_threshold"`
+}
+
+func (m *MsgSetAutoRebalance) Reset()         { *m = MsgSetAutoRebalance{} }
+func (m *MsgSetAutoRebalance) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRebalance) ProtoMessage()    {}
+func (*MsgSetAutoRebalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daa95be02b2fc560, []int{12}
+}
+func (m *MsgSetAutoRebalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRebalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRebalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRebalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRebalance.Merge(m, src)
+}
+func (m *MsgSetAutoRebalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRebalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRebalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRebalance proto.InternalMessageInfo
+
+func (m *MsgSetAutoRebalance) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+type MsgSetAutoRebalanceResponse struct {
+}
+
+func (m *MsgSetAutoRebalanceResponse) Reset()         { *m = MsgSetAutoRebalanceResponse{} }
+func (m *MsgSetAutoRebalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRebalanceResponse) ProtoMessage()    {}
+func (*MsgSetAutoRebalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daa95be02b2fc560, []int{13}
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRebalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRebalanceResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRebalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRebalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoRebalanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetValidatorSetPreference)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetPreference")
 	proto.RegisterType((*MsgSetValidatorSetPreferenceResponse)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetPreferenceResponse")
@@ -590,6 +698,8 @@ func init() {
 	proto.RegisterType((*MsgWithdrawDelegationRewardsResponse)(nil), "osmosis.valsetpref.v1beta1.MsgWithdrawDelegationRewardsResponse")
 	proto.RegisterType((*MsgDelegateBondedTokens)(nil), "osmosis.valsetpref.v1beta1.MsgDelegateBondedTokens")
 	proto.RegisterType((*MsgDelegateBondedTokensResponse)(nil), "osmosis.valsetpref.v1beta1.MsgDelegateBondedTokensResponse")
+	proto.RegisterType((*MsgSetAutoRebalance)(nil), "osmosis.valsetpref.v1beta1.MsgSetAutoRebalance")
+	proto.RegisterType((*MsgSetAutoRebalanceResponse)(nil), "osmosis.valsetpref.v1beta1.MsgSetAutoRebalanceResponse")
 }
 
 func init() {
@@ -597,51 +707,59 @@ func init() {
 }
 
 var fileDescriptor_daa95be02b2fc560 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x4f, 0xd4, 0x4e,
-	0x18, 0xde, 0x01, 0xc2, 0x2f, 0x0c, 0x97, 0x9f, 0x0d, 0x41, 0x68, 0xb4, 0x0b, 0x15, 0x85, 0x98,
-	0x6c, 0xc7, 0x5d, 0x02, 0x2a, 0xc6, 0x44, 0x57, 0x62, 0xe2, 0x61, 0x13, 0x2d, 0xa8, 0x89, 0x07,
-	0x93, 0xee, 0xf6, 0xa5, 0x34, 0xb4, 0x9d, 0x4d, 0x67, 0xf8, 0x3a, 0x7b, 0x51, 0x0f, 0xc6, 0x9b,
-	0x89, 0x7f, 0x82, 0x27, 0xff, 0x02, 0xcf, 0x1c, 0x39, 0x7a, 0x42, 0xc3, 0x1e, 0x3c, 0x78, 0xe3,
-	0xe4, 0xd1, 0xb4, 0x9d, 0x2d, 0x4b, 0xd2, 0x69, 0x4d, 0xfd, 0xb8, 0xc0, 0x76, 0xdf, 0xe7, 0x79,
-	0x3f, 0x9e, 0xf7, 0xa3, 0x8b, 0xe7, 0x28, 0xf3, 0x29, 0x73, 0x19, 0xd9, 0xb1, 0x3c, 0x06, 0xbc,
-	0xd6, 0x0d, 0x61, 0x83, 0xec, 0xd4, 0xdb, 0xc0, 0xad, 0x3a, 0xe1, 0x7b, 0x46, 0x37, 0xa4, 0x9c,
-	0x2a, 0xaa, 0x40, 0x19, 0x09, 0x2a, 0x02, 0x19, 0x02, 0xa4, 0x4e, 0x38, 0xd4, 0xa1, 0x31, 0x8c,
-	0x44, 0x9f, 0x12, 0x86, 0x7a, 0xce, 0xf2, 0xdd, 0x80, 0x92, 0xf8, 0xaf, 0xf8, 0xaa, 0xea, 0x50,
-	0xea, 0x78, 0x40, 0xe2, 0xa7, 0xf6, 0xf6, 0x06, 0xe1, 0xae, 0x0f, 0x8c, 0x5b, 0x7e, 0x57, 0x00,
-	0xb4, 0x4e, 0x1c, 0x86, 0xb4, 0x2d, 0x06, 0x69, 0x0e, 0x1d, 0xea, 0x06, 0xc2, 0x3e, 0x9f, 0x97,
-	0x2b, 0xe3, 0x16, 0x87, 0x04, 0xa8, 0xff, 0x40, 0xf8, 0x42, 0x8b, 0x39, 0x6b, 0xc0, 0x9f, 0x58,
-	0x9e, 0x6b, 0x5b, 0x9c, 0x86, 0x6b, 0xc0, 0x1f, 0x86, 0xb0, 0x01, 0x21, 0x04, 0x1d, 0x50, 0x1a,
-	0x78, 0xcc, 0x06, 0x0f, 0x9c, 0xc8, 0x32, 0x85, 0x66, 0xd0, 0xc2, 0x58, 0x73, 0xe2, 0xe4, 0xa8,
-	0xfa, 0xff, 0xbe, 0xe5, 0x7b, 0x2b, 0x7a, 0x6a, 0xd2, 0xcd, 0x53, 0x98, 0xe2, 0xe3, 0xf1, 0x6e,
-	0xea, 0x81, 0x4d, 0x0d, 0xcd, 0x0c, 0x2f, 0x8c, 0x37, 0x88, 0x21, 0x57, 0xc6, 0x48, 0x83, 0x9f,
-	0x46, 0x6e, 0xaa, 0x07, 0x47, 0xd5, 0xca, 0xc9, 0x51, 0x55, 0x49, 0x42, 0x0d, 0x78, 0xd4, 0xcd,
-	0x41, 0xff, 0x2b, 0x4b, 0xaf, 0xbf, 0x7d, 0xbc, 0x7a, 0x2d, 0xab, 0xe2, 0xbc, 0xca, 0xf4, 0x2b,
-	0x78, 0x2e, 0xcf, 0x6e, 0x02, 0xeb, 0xd2, 0x80, 0x81, 0xde, 0x43, 0x78, 0xba, 0xc5, 0x9c, 0xd5,
-	0xa4, 0x3c, 0x58, 0xa7, 0x83, 0xf8, 0x52, 0xfa, 0x3c, 0xc7, 0x23, 0x51, 0xaf, 0xa6, 0x86, 0x66,
-	0xd0, 0xc2, 0x78, 0x63, 0xda, 0x48, 0x9a, 0x69, 0x44, 0xcd, 0x4c, 0x15, 0xb9, 0x47, 0xdd, 0xa0,
-	0x49, 0x22, 0x09, 0x3e, 0x7c, 0xa9, 0xce, 0x3b, 0x2e, 0xdf, 0xdc, 0x6e, 0x1b, 0x1d, 0xea, 0x13,
-	0xd1, 0xf9, 0xe4, 0x5f, 0x8d, 0xd9, 0x5b, 0x84, 0xef, 0x77, 0x81, 0xc5, 0x04, 0x33, 0xf6, 0xbb,
-	0xd2, 0x88, 0x04, 0xa9, 0x49, 0x04, 0xc9, 0xae, 0x43, 0xbf, 0x84, 0x67, 0xa5, 0xc6, 0x54, 0x8a,
-	0xef, 0x08, 0x5f, 0x6c, 0x31, 0xe7, 0x71, 0x20, 0x6a, 0x81, 0xfb, 0x21, 0xf5, 0xff, 0x98, 0x1c,
-	0xc3, 0x7f, 0x49, 0x8e, 0xe5, 0x48, 0x8e, 0xba, 0x44, 0x0e, 0x79, 0x2d, 0xfa, 0x3c, 0xbe, 0x9c,
-	0x0b, 0x48, 0x65, 0xf9, 0x94, 0x4c, 0x88, 0x09, 0x7d, 0xe4, 0x6f, 0x4b, 0xf2, 0x6f, 0x37, 0x48,
-	0x34, 0x3f, 0x3b, 0xff, 0xb4, 0xca, 0x57, 0xc9, 0xa9, 0x78, 0xea, 0xf2, 0x4d, 0x3b, 0xb4, 0x76,
-	0xc5, 0xa8, 0xb8, 0x34, 0x30, 0x61, 0xd7, 0x0a, 0x6d, 0x56, 0xa6, 0xd0, 0xfc, 0xdd, 0x95, 0x86,
-	0x12, 0xbb, 0x2b, 0xb5, 0xa7, 0x39, 0x03, 0x3e, 0x3f, 0x30, 0xd5, 0x4d, 0x1a, 0xd8, 0x60, 0xaf,
-	0xd3, 0x2d, 0x08, 0x4a, 0x65, 0xab, 0x4c, 0xe2, 0x51, 0x8f, 0x76, 0xb6, 0x1e, 0xac, 0xc6, 0xab,
-	0x3b, 0x62, 0x8a, 0x27, 0x7d, 0x16, 0x57, 0x25, 0x61, 0xfa, 0x99, 0x34, 0x5e, 0xfc, 0x87, 0x87,
-	0x5b, 0xcc, 0x51, 0xde, 0x21, 0x3c, 0x2d, 0xbf, 0xb6, 0x37, 0xf2, 0x5a, 0x9c, 0x77, 0xad, 0xd4,
-	0x3b, 0x65, 0x99, 0xfd, 0x0c, 0x95, 0x37, 0x08, 0x4f, 0x4a, 0x8e, 0xdc, 0x52, 0x81, 0xf3, 0x6c,
-	0x9a, 0x7a, 0xbb, 0x14, 0x2d, 0x4d, 0xe8, 0x3d, 0xc2, 0x6a, 0xce, 0xa9, 0xb9, 0x59, 0xe0, 0x5d,
-	0x4e, 0x55, 0xef, 0x96, 0xa6, 0x9e, 0x51, 0x4b, 0xb2, 0xf0, 0x45, 0x6a, 0x65, 0xd3, 0x0a, 0xd5,
-	0xca, 0x5f, 0xcf, 0x78, 0xb0, 0xe4, 0xbb, 0x59, 0x34, 0x58, 0x52, 0x66, 0xe1, 0x60, 0x15, 0x2e,
-	0xa1, 0xf2, 0x12, 0xe1, 0x89, 0xcc, 0x15, 0x5c, 0xfc, 0xc5, 0xf9, 0x18, 0x24, 0xa9, 0xb7, 0x4a,
-	0x90, 0xfa, 0xa9, 0x34, 0x1f, 0x1d, 0x1c, 0x6b, 0xe8, 0xf0, 0x58, 0x43, 0x5f, 0x8f, 0x35, 0xf4,
-	0xb6, 0xa7, 0x55, 0x0e, 0x7b, 0x5a, 0xe5, 0x73, 0x4f, 0xab, 0x3c, 0xbb, 0x3e, 0xf0, 0x4e, 0x11,
-	0x01, 0x6a, 0x9e, 0xd5, 0x66, 0x24, 0xbd, 0x4d, 0xf5, 0x65, 0xb2, 0x77, 0xe6, 0x42, 0xc5, 0x2f,
-	0x9a, 0xf6, 0x68, 0xfc, 0x43, 0x6a, 0xf1, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xa2, 0x8e,
-	0xf2, 0x1f, 0x0a, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0x93, 0xaa, 0x7a, 0x99, 0x4a, 0xef, 0xf5, 0x99, 0xaa, 0xa4, 0x86, 0xc6, 0xad, 0x29,
+	0x4d, 0x85, 0x88, 0x4d, 0x52, 0xb5, 0x85, 0xa0, 0x4a, 0x6d, 0xa8, 0x10, 0x2c, 0x22, 0x81, 0x5b,
+	0x40, 0x62, 0x01, 0x72, 0xe2, 0x89, 0x63, 0xd5, 0xf6, 0x04, 0xcf, 0xf4, 0x4b, 0x62, 0xc3, 0x0e,
+	0x58, 0x20, 0x76, 0x48, 0xec, 0xd9, 0xb0, 0xe2, 0x67, 0x74, 0xd9, 0x25, 0x62, 0x91, 0xa2, 0x66,
+	0x81, 0x04, 0xbb, 0xfe, 0x02, 0xe4, 0x8f, 0x4c, 0x13, 0x91, 0x71, 0x8a, 0x81, 0x0d, 0x9b, 0x24,
+	0x9e, 0x7b, 0xcf, 0xbd, 0x77, 0x8e, 0xcf, 0x9c, 0x09, 0x98, 0x41, 0xd8, 0x46, 0xd8, 0xc4, 0xca,
+	0xb6, 0x66, 0x61, 0x48, 0xf2, 0x4d, 0x17, 0xd6, 0x95, 0xed, 0x42, 0x15, 0x12, 0xad, 0xa0, 0x90,
+	0x5d, 0xb9, 0xe9, 0x22, 0x82, 0x78, 0x21, 0xcc, 0x92, 0x83, 0x2c, 0x2f, 0x49, 0x0e, 0x93, 0x84,
+	0x31, 0x03, 0x19, 0xc8, 0x4f, 0x53, 0xbc, 0x5f, 0x01, 0x42, 0xf8, 0x5f, 0xb3, 0x4d, 0x07, 0x29,
+	0xfe, 0x67, 0xb8, 0x24, 0x1a, 0x08, 0x19, 0x16, 0x54, 0xfc, 0xa7, 0xea, 0x56, 0x5d, 0x21, 0xa6,
+	0x0d, 0x31, 0xd1, 0xec, 0x66, 0x98, 0x90, 0xad, 0xf9, 0x6d, 0x94, 0xaa, 0x86, 0x21, 0x9d, 0xa1,
+	0x86, 0x4c, 0x27, 0x8c, 0xe7, 0xa2, 0x66, 0xc5, 0x44, 0x23, 0x30, 0x48, 0x94, 0x0e, 0x93, 0xe0,
+	0x7c, 0x05, 0x1b, 0xeb, 0x90, 0xdc, 0xd7, 0x2c, 0x53, 0xd7, 0x08, 0x72, 0xd7, 0x21, 0xb9, 0xe3,
+	0xc2, 0x3a, 0x74, 0xa1, 0x53, 0x83, 0x7c, 0x11, 0xa4, 0x75, 0x68, 0x41, 0xc3, 0x8b, 0x64, 0xb8,
+	0x29, 0x6e, 0x2e, 0x5d, 0x1e, 0x3b, 0x6e, 0x89, 0xa3, 0x7b, 0x9a, 0x6d, 0x95, 0x24, 0x1a, 0x92,
+	0xd4, 0x93, 0x34, 0xde, 0x06, 0x23, 0x4d, 0x5a, 0x01, 0x67, 0x92, 0x53, 0xa9, 0xb9, 0x91, 0xa2,
+	0x22, 0xb3, 0x99, 0x91, 0x69, 0xf3, 0x93, 0xce, 0x65, 0x61, 0xbf, 0x25, 0x26, 0x8e, 0x5b, 0x22,
+	0x1f, 0xb4, 0xea, 0xaa, 0x28, 0xa9, 0xdd, 0xf5, 0x79, 0x08, 0xd2, 0xf4, 0x29, 0x93, 0x9a, 0xe2,
+	0xe6, 0x46, 0x8a, 0x85, 0x53, 0x35, 0x5b, 0x87, 0x44, 0xa5, 0xed, 0xba, 0x76, 0x45, 0xab, 0x49,
+	0xea, 0x49, 0xe5, 0xd2, 0xc2, 0xcb, 0x2f, 0x1f, 0x2e, 0x5d, 0xe9, 0x47, 0x6c, 0x14, 0x81, 0xd2,
+	0x2c, 0x98, 0x89, 0x8a, 0xab, 0x10, 0x37, 0x91, 0x83, 0xa1, 0xd4, 0xe6, 0xc0, 0x44, 0x05, 0x1b,
+	0x6b, 0x01, 0x8b, 0x70, 0x03, 0x75, 0xe7, 0xc7, 0x7a, 0x0d, 0x8f, 0xc0, 0x90, 0x27, 0x89, 0x4c,
+	0xd2, 0xa7, 0x64, 0x42, 0x0e, 0x34, 0x23, 0x7b, 0x9a, 0xa1, 0x5c, 0xdc, 0x40, 0xa6, 0x53, 0x56,
+	0x3c, 0xa6, 0xdf, 0x1f, 0x8a, 0x39, 0xc3, 0x24, 0x8d, 0xad, 0xaa, 0x5c, 0x43, 0xb6, 0x12, 0x0a,
+	0x2c, 0xf8, 0xca, 0x63, 0x7d, 0x53, 0x21, 0x7b, 0x4d, 0x88, 0x7d, 0x80, 0xea, 0xd7, 0x2d, 0x15,
+	0x3d, 0x42, 0xf2, 0x0c, 0x42, 0xfa, 0xef, 0x43, 0xba, 0x00, 0xa6, 0x99, 0x41, 0x4a, 0xc5, 0x37,
+	0x0e, 0x4c, 0x56, 0xb0, 0x71, 0xcf, 0x09, 0xf7, 0x02, 0x6f, 0xba, 0xc8, 0xfe, 0x6d, 0x74, 0xa4,
+	0xfe, 0x10, 0x1d, 0x8b, 0x1e, 0x1d, 0x05, 0x06, 0x1d, 0xec, 0xbd, 0x48, 0x39, 0x70, 0x31, 0x32,
+	0x81, 0xd2, 0xf2, 0x2e, 0xe9, 0x2b, 0x44, 0x85, 0x9d, 0xcc, 0x5f, 0xa6, 0xe4, 0xaf, 0x3c, 0xa8,
+	0xa1, 0xc6, 0xfa, 0xd3, 0x44, 0xc9, 0x7c, 0xc1, 0xf9, 0xc6, 0xf7, 0xc0, 0x24, 0x0d, 0xdd, 0xd5,
+	0x76, 0x42, 0x45, 0x9a, 0xc8, 0x51, 0xe1, 0x8e, 0xe6, 0xea, 0x38, 0x0e, 0x9f, 0xd1, 0x16, 0xc1,
+	0x6c, 0x15, 0x5a, 0x04, 0x33, 0x4e, 0x67, 0x86, 0xe0, 0x6c, 0xd7, 0xe1, 0x29, 0x23, 0x47, 0x87,
+	0xfa, 0x06, 0xda, 0x84, 0x4e, 0xac, 0x69, 0xf9, 0x71, 0x30, 0x6c, 0xa1, 0xda, 0xe6, 0xed, 0x35,
+	0xdf, 0x21, 0x86, 0xd4, 0xf0, 0x49, 0x9a, 0x06, 0x22, 0xa3, 0x0d, 0x9d, 0xe4, 0x2b, 0x07, 0xce,
+	0x04, 0xae, 0xb6, 0xba, 0x45, 0x90, 0x0a, 0xab, 0x9a, 0xa5, 0xc5, 0xbd, 0x2d, 0x9e, 0x80, 0xff,
+	0x74, 0xd7, 0xac, 0x93, 0xc7, 0xa4, 0xe1, 0x42, 0xdc, 0x40, 0x96, 0xee, 0xcf, 0x93, 0x2e, 0xdf,
+	0xf2, 0x74, 0xf5, 0xa9, 0x25, 0xce, 0x9e, 0xe2, 0x1c, 0xae, 0xc1, 0xda, 0x71, 0x4b, 0x1c, 0x0f,
+	0xfb, 0xf4, 0x96, 0x93, 0xd4, 0x7f, 0xfd, 0x95, 0x8d, 0xce, 0x42, 0xe9, 0xb2, 0xf7, 0x9e, 0x72,
+	0x6c, 0x2b, 0xef, 0xd9, 0x94, 0x34, 0x09, 0xce, 0xf5, 0x59, 0xee, 0x70, 0x51, 0x7c, 0xf6, 0x0f,
+	0x48, 0x55, 0xb0, 0xc1, 0xbf, 0xe1, 0xc0, 0x04, 0xfb, 0x1e, 0xbd, 0x1a, 0x25, 0xf4, 0xa8, 0x0b,
+	0x42, 0x58, 0x89, 0x8b, 0xec, 0x4c, 0xc8, 0xbf, 0xe2, 0xc0, 0x38, 0xe3, 0x5e, 0x59, 0x18, 0x50,
+	0xbc, 0x3f, 0x4c, 0x58, 0x8e, 0x05, 0xa3, 0x03, 0xbd, 0xe5, 0x80, 0x10, 0xe1, 0xee, 0xd7, 0x06,
+	0x54, 0x67, 0x43, 0x85, 0xd5, 0xd8, 0xd0, 0x1e, 0xb6, 0x18, 0x1e, 0x3b, 0x88, 0xad, 0xfe, 0x30,
+	0x61, 0x39, 0x16, 0x8c, 0x0e, 0xe4, 0x09, 0x8b, 0xed, 0x53, 0x83, 0x84, 0xc5, 0x44, 0x0a, 0x2b,
+	0x71, 0x91, 0x74, 0xb2, 0xe7, 0x1c, 0x18, 0xeb, 0x6b, 0x47, 0xf3, 0xa7, 0xd4, 0x47, 0x37, 0x48,
+	0xb8, 0x1e, 0x03, 0x44, 0x47, 0x79, 0x0a, 0x46, 0x7f, 0x70, 0x23, 0x65, 0xf0, 0xc9, 0xe9, 0x01,
+	0x08, 0x4b, 0x3f, 0x09, 0xe8, 0x74, 0x2f, 0xdf, 0xdd, 0x3f, 0xca, 0x72, 0x07, 0x47, 0x59, 0xee,
+	0xf3, 0x51, 0x96, 0x7b, 0xdd, 0xce, 0x26, 0x0e, 0xda, 0xd9, 0xc4, 0xc7, 0x76, 0x36, 0xf1, 0x70,
+	0xa9, 0xcb, 0xbc, 0xc2, 0xe2, 0x79, 0x4b, 0xab, 0x62, 0x85, 0xba, 0x4f, 0x61, 0x51, 0xd9, 0xed,
+	0xf1, 0x20, 0xdf, 0xd1, 0xaa, 0xc3, 0xfe, 0x1f, 0xf4, 0xf9, 0xef, 0x03, 0x00, 0x17, 0x1c, 0x62,
+	0xe2, 0x77, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -675,6 +793,9 @@ type MsgClient interface {
 	// DelegateBondedTokens allows users to break the lockup bond and delegate
 	// osmo tokens to a predefined validator-set.
 	DelegateBondedTokens(ctx context.Context, in *MsgDelegateBondedTokens, opts ...grpc.CallOption) (*MsgDelegateBondedTokensResponse, error)
+	// SetAutoRebalance opts in or out of the automatic rebalancing of the
+	// delegations to the validator set preference.
+	SetAutoRebalance(ctx context.Context, in *MsgSetAutoRebalance, opts ...grpc.CallOption) (*MsgSetAutoRebalanceResponse, error)
 }
 
 type msgClient struct {
@@ -739,6 +860,15 @@ func (c *msgClient) DelegateBondedTokens(ctx context.Context, in *MsgDelegateBon
 	return out, nil
 }
 
+func (c *msgClient) SetAutoRebalance(ctx context.Context, in *MsgSetAutoRebalance, opts ...grpc.CallOption) (*MsgSetAutoRebalanceResponse, error) {
+	out := new(MsgSetAutoRebalanceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Msg/SetAutoRebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetValidatorSetPreference creates a set of validator preference.
@@ -760,6 +890,9 @@ type MsgServer interface {
 	// DelegateBondedTokens allows users to break the lockup bond and delegate
 	// osmo tokens to a predefined validator-set.
 	DelegateBondedTokens(context.Context, *MsgDelegateBondedTokens) (*MsgDelegateBondedTokensResponse, error)
+	// SetAutoRebalance opts in or out of the automatic rebalancing of the
+	// delegations to the validator set preference.
+	SetAutoRebalance(context.Context, *MsgSetAutoRebalance) (*MsgSetAutoRebalanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -784,6 +917,9 @@ func (*UnimplementedMsgServer) WithdrawDelegationRewards(ctx context.Context, re
 func (*UnimplementedMsgServer) DelegateBondedTokens(ctx context.Context, req *MsgDelegateBondedTokens) (*MsgDelegateBondedTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateBondedTokens not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoRebalance(ctx context.Context, req *MsgSetAutoRebalance) (*MsgSetAutoRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRebalance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -897,6 +1033,24 @@ func _Msg_DelegateBondedTokens_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRebalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.valsetpref.v1beta1.Msg/SetAutoRebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRebalance(ctx, req.(*MsgSetAutoRebalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.valsetpref.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -925,6 +1079,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegateBondedTokens",
 			Handler:    _Msg_DelegateBondedTokens_Handler,
 		},
+		{
+			MethodName: "SetAutoRebalance",
+			Handler:    _Msg_SetAutoRebalance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/valset-pref/v1beta1/tx.proto",
@@ -950,6 +1108,18 @@ func (m *MsgSetValidatorSetPreference) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.Reference != nil {
+		{
+			size, err := m.Reference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Preferences) > 0 {
 		for iNdEx := len(m.Preferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1143,6 +1313,18 @@ func (m *MsgRedelegateValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Reference != nil {
+		{
+			size, err := m.Reference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Preferences) > 0 {
 		for iNdEx := len(m.Preferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1301,6 +1483,69 @@ func (m *MsgDelegateBondedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoRebalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRebalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRebalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DriftThreshold.Size()
+		i -= size
+		if _, err := m.DriftThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoRebalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRebalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRebalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1328,6 +1573,10 @@ func (m *MsgSetValidatorSetPreference) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Reference != nil {
+		l = m.Reference.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1404,6 +1653,10 @@ func (m *MsgRedelegateValidatorSet) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Reference != nil {
+		l = m.Reference.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1463,6 +1716,30 @@ func (m *MsgDelegateBondedTokensResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoRebalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DriftThreshold.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAutoRebalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1564,6 +1841,42 @@ func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reference == nil {
+				m.Reference = &ValidatorSetReference{}
+			}
+			if err := m.Reference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2060,6 +2373,42 @@ func (m *MsgRedelegateValidatorSet) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reference == nil {
+				m.Reference = &ValidatorSetReference{}
+			}
+			if err := m.Reference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2414,6 +2763,172 @@ func (m *MsgDelegateBondedTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoRebalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRebalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRebalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DriftThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DriftThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoRebalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRebalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRebalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
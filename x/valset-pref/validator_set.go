@@ -115,14 +115,14 @@ func (k Keeper) DelegateToValidatorSet(ctx sdk.Context, delegatorAddr string, co
 }
 
 // UndelegateFromValidatorSet undelegates {coin} amount from the validator set.
-// If the valset does not exist, it undelegates from existing staking position.
+// If the valset does not exist or references another validator set, it undelegates from existing staking position.
 // For ex: userA has staked 10tokens with weight {Val->0.5, ValB->0.3, ValC->0.2}
 // undelegate 6osmo with validator-set {ValA -> 0.5, ValB -> 0.3, ValC -> 0.2}
 // our undelegate logic would attempt to undelegate 3osmo from A, 1.8osmo from B, 1.2osmo from C
 // nolint: staticcheck
 func (k Keeper) UndelegateFromValidatorSet(ctx sdk.Context, delegatorAddr string, coin sdk.Coin) error {
 	// get the existingValSet if it exists, if not check existingStakingPosition and return it
-	existingSet, err := k.GetDelegationPreferences(ctx, delegatorAddr)
+	existingSet, err := k.getExistingDelegationPreferences(ctx, delegatorAddr)
 	if err != nil {
 		return fmt.Errorf("user %s doesn't have validator set", delegatorAddr)
 	}
@@ -206,6 +206,16 @@ func (k Keeper) CheckUndelegateTotalAmount(tokenAmt sdk.Dec, existingSet []types
 //   - unbond (10osmo) from valA
 //   - valA --redelegate--> valB (ERROR: new redelegation while unbonding is in progress)
 func (k Keeper) PreformRedelegation(ctx sdk.Context, delegator sdk.AccAddress, existingSet []types.ValidatorPreference, newSet []types.ValidatorPreference) error {
+	return k.preformRedelegation(ctx, delegator, existingSet, newSet, false)
+}
+
+// preformRedelegation redelegates from existingSet to newSet, see PreformRedelegation.
+// Auto-rebalancing redelegates from the actual weights of the delegations to the preferences, which share
+// validators, and from validators that may have been slashed. If autoRebalance is true, the validators in both
+// sets are netted so that nothing is redelegated away from a validator that is also redelegated to, and the
+// token amounts are converted to the shares of the source validator. Otherwise, the redelegation of
+// MsgRedelegateValidatorSet is unchanged.
+func (k Keeper) preformRedelegation(ctx sdk.Context, delegator sdk.AccAddress, existingSet []types.ValidatorPreference, newSet []types.ValidatorPreference, autoRebalance bool) error {
 	var existingValSet []valSet
 	var newValSet []valSet
 	totalTokenAmount := sdk.NewDec(0)
@@ -238,15 +248,23 @@ func (k Keeper) PreformRedelegation(ctx sdk.Context, delegator sdk.AccAddress, e
 		existingValSet = append(existingValSet, new_val_zero_amount)
 	}
 
-	// calculate the difference between two sets
+	// calculate the difference between two sets, netting the validators that are in both when auto-rebalancing
+	// so that we never redelegate away from a validator we also redelegate to.
 	var diffValSets []*valSet
+	diffIndexes := map[string]int{}
 	for i, newVals := range existingValSet {
 		diffAmount := newVals.Amount.Sub(newValSet[i].Amount)
 
+		if idx, found := diffIndexes[newVals.ValAddr]; found && autoRebalance {
+			diffValSets[idx].Amount = diffValSets[idx].Amount.Add(diffAmount)
+			continue
+		}
+
 		diff_val := valSet{
 			ValAddr: newVals.ValAddr,
 			Amount:  diffAmount,
 		}
+		diffIndexes[newVals.ValAddr] = len(diffValSets)
 		diffValSets = append(diffValSets, &diff_val)
 	}
 
@@ -265,7 +283,20 @@ func (k Keeper) PreformRedelegation(ctx sdk.Context, delegator sdk.AccAddress, e
 						break
 					}
 
-					_, err = k.stakingKeeper.BeginRedelegation(ctx, delegator, valSource, valTarget, transferAmount)
+					// the amounts are in tokens, which differ from shares once a validator got slashed
+					sharesAmount := transferAmount
+					if autoRebalance {
+						_, validatorSource, err := k.GetValidatorInfo(ctx, diffVal.ValAddr)
+						if err != nil {
+							return err
+						}
+						sharesAmount, err = validatorSource.SharesFromTokensTruncated(transferAmount.TruncateInt())
+						if err != nil {
+							return err
+						}
+					}
+
+					_, err = k.stakingKeeper.BeginRedelegation(ctx, delegator, valSource, valTarget, sharesAmount)
 					if err != nil {
 						return err
 					}
@@ -300,13 +331,13 @@ func (k Keeper) getValTargetAndSource(ctx sdk.Context, valSource, valTarget stri
 }
 
 // WithdrawDelegationRewards withdraws all the delegation rewards from the validator in the val-set.
-// If the valset does not exist, it withdraws from existing staking position.
+// If the valset does not exist or references another validator set, it withdraws from existing staking position.
 // Delegation reward is collected by the validator and in doing so, they can charge commission to the delegators.
 // Rewards are calculated per period, and is updated each time validator delegation changes. For ex: when a delegator
 // receives new delgation the rewards can be calculated by taking (total rewards before new delegation - the total current rewards).
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delegatorAddr string) error {
 	// get the existingValSet if it exists, if not check existingStakingPosition and return it
-	existingSet, err := k.GetDelegationPreferences(ctx, delegatorAddr)
+	existingSet, err := k.getExistingDelegationPreferences(ctx, delegatorAddr)
 	if err != nil {
 		return fmt.Errorf("user %s doesn't have validator set or existing delegations", delegatorAddr)
 	}